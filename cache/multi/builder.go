@@ -75,6 +75,18 @@ func (b *Builder) WithSkipBackfill(skip bool) *Builder {
 	return b
 }
 
+// WithNotFoundTTL 缓存 loader 的 NotFound 结果
+func (b *Builder) WithNotFoundTTL(ttl time.Duration) *Builder {
+	b.opts = append(b.opts, WithNotFoundTTL(ttl))
+	return b
+}
+
+// WithErrorTTL 缓存 loader 的错误
+func (b *Builder) WithErrorTTL(ttl time.Duration) *Builder {
+	b.opts = append(b.opts, WithErrorTTL(ttl))
+	return b
+}
+
 // Build 构建多层缓存
 func (b *Builder) Build() *Cache {
 	return NewCache(b.layers, b.opts...)
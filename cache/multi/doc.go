@@ -18,6 +18,7 @@
 //   - 从慢速层自动回填到快速层
 //   - Singleflight 请求合并去重
 //   - 缓存雪崩防护
+//   - 可选的 NotFound/错误缓存（WithNotFoundTTL、WithErrorTTL），防止数据源被反复请求
 //
 // --- English ---
 //
@@ -41,4 +42,5 @@
 //   - Automatic backfill from slower to faster layers
 //   - Singleflight deduplication
 //   - Cache stampede protection
+//   - Optional NotFound/error caching (WithNotFoundTTL, WithErrorTTL) to shield the data source
 package multi
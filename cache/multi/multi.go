@@ -8,11 +8,13 @@ import (
 	"reflect"
 	"sync"
 	"time"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
 )
 
 var (
 	// ErrNotFound 数据不存在（所有层都未命中且 loader 返回 NotFound）
-	ErrNotFound = errors.New("multi-cache: not found")
+	ErrNotFound = errorx.NewSentinel(errorx.CategoryNotFound, "multi-cache: not found")
 
 	// ErrInvalidDest dest 参数无效
 	ErrInvalidDest = errors.New("multi-cache: dest must be a non-nil pointer")
@@ -72,6 +74,7 @@ type LayerConfig struct {
 type Cache struct {
 	layers []LayerConfig
 	opts   Options
+	neg    *negativeCache // NotFound/错误缓存，未启用时为 nil
 }
 
 // Options 多层缓存配置
@@ -85,6 +88,20 @@ type Options struct {
 	// SkipBackfill 是否跳过回填（默认 false，即会回填）
 	// 设置为 true 可以减少写入次数，但会降低缓存命中率
 	SkipBackfill bool
+
+	// NotFoundTTL loader 返回 NotFound 后在进程内缓存该结果的时长（默认 0，不缓存）
+	// 有效期内同一 key 直接返回 ErrNotFound，不再调用 loader
+	NotFoundTTL time.Duration
+
+	// ErrorTTL loader 返回错误后在进程内缓存该错误的时长（默认 0，不缓存）
+	// 有效期内同一 key 直接返回 *CachedError，避免故障中的数据源被反复请求，建议设置较短时间
+	ErrorTTL time.Duration
+
+	// IsCacheableError 判断 loader 错误是否可缓存（默认不缓存 context 取消/超时）
+	IsCacheableError func(err error) bool
+
+	// NegativeCacheSize NotFound/错误缓存的最大条目数（默认 10000）
+	NegativeCacheSize int
 }
 
 type Option func(*Options)
@@ -94,8 +111,9 @@ func defaultOptions() Options {
 		IsNotFound: func(err error) bool {
 			return errors.Is(err, ErrNotFound)
 		},
-		OnError:      nil,
-		SkipBackfill: false,
+		OnError:          nil,
+		SkipBackfill:     false,
+		IsCacheableError: defaultIsCacheableError,
 	}
 }
 
@@ -109,6 +127,9 @@ func applyOptions(opts ...Option) Options {
 	if o.IsNotFound == nil {
 		o.IsNotFound = func(err error) bool { return errors.Is(err, ErrNotFound) }
 	}
+	if o.IsCacheableError == nil {
+		o.IsCacheableError = defaultIsCacheableError
+	}
 	return o
 }
 
@@ -127,6 +148,26 @@ func WithSkipBackfill(skip bool) Option {
 	return func(o *Options) { o.SkipBackfill = skip }
 }
 
+// WithNotFoundTTL 缓存 loader 的 NotFound 结果，防止不存在的 key 每次都查询数据源
+func WithNotFoundTTL(ttl time.Duration) Option {
+	return func(o *Options) { o.NotFoundTTL = ttl }
+}
+
+// WithErrorTTL 缓存 loader 的错误，数据源故障期间同一 key 在 ttl 内不再调用 loader
+func WithErrorTTL(ttl time.Duration) Option {
+	return func(o *Options) { o.ErrorTTL = ttl }
+}
+
+// WithIsCacheableError 设置错误是否可缓存的判断函数（仅在 ErrorTTL > 0 时生效）
+func WithIsCacheableError(fn func(err error) bool) Option {
+	return func(o *Options) { o.IsCacheableError = fn }
+}
+
+// WithNegativeCacheSize 设置 NotFound/错误缓存的最大条目数
+func WithNegativeCacheSize(size int) Option {
+	return func(o *Options) { o.NegativeCacheSize = size }
+}
+
 // NewCache 创建多层缓存
 //
 // 参数：
@@ -148,10 +189,14 @@ func NewCache(layers []LayerConfig, opts ...Option) *Cache {
 			panic(fmt.Sprintf("multi-cache: layer[%d] (%s) has nil Layer instance", i, l.Name))
 		}
 	}
-	return &Cache{
+	c := &Cache{
 		layers: layers,
 		opts:   applyOptions(opts...),
 	}
+	if c.opts.NotFoundTTL > 0 || c.opts.ErrorTTL > 0 {
+		c.neg = newNegativeCache(c.opts.NegativeCacheSize)
+	}
+	return c
 }
 
 // GetOrLoad 获取或加载数据（自动处理多层缓存）
@@ -161,6 +206,9 @@ func NewCache(layers []LayerConfig, opts ...Option) *Cache {
 // 2. 所有层都未命中，调用 loader 从数据源加载（只调用一次）
 // 3. 加载成功后回填到所有层
 //
+// 配置 NotFoundTTL/ErrorTTL 后，loader 返回的 NotFound/错误会在进程内缓存，
+// 有效期内直接返回 ErrNotFound 或 *CachedError（errors.Is(err, ErrCachedError) 为 true）
+//
 // 参数：
 //   - ctx: 上下文
 //   - key: 缓存 key
@@ -221,21 +269,40 @@ func (c *Cache) GetOrLoad(
 		c.onError(ctx, layer.Name, "get", key, err)
 	}
 
-	// 2. 所有层都未命中，调用 loader（只调用一次）
+	// 2. 所有层都未命中，先查 NotFound/错误缓存，命中则不调用 loader
+	if c.neg != nil {
+		if e, ok := c.neg.get(key); ok {
+			if e.err == nil {
+				return ErrNotFound
+			}
+			return &CachedError{Key: key, Err: e.err, ExpiresAt: e.expiresAt}
+		}
+	}
+
+	// 3. 调用 loader（只调用一次）
 	val, err := loader(ctx)
 	if err != nil {
 		if c.isNotFound(err) {
+			if c.opts.NotFoundTTL > 0 {
+				c.neg.set(key, nil, c.opts.NotFoundTTL)
+			}
 			return ErrNotFound
 		}
+		if c.opts.ErrorTTL > 0 && c.opts.IsCacheableError(err) {
+			c.neg.set(key, err, c.opts.ErrorTTL)
+		}
 		return err
 	}
+	if c.neg != nil {
+		c.neg.del(key)
+	}
 
-	// 3. 将结果复制到 dest
+	// 4. 将结果复制到 dest
 	if err := copyValue(val, dest); err != nil {
 		return err
 	}
 
-	// 4. 回填到所有层
+	// 5. 回填到所有层
 	if !c.opts.SkipBackfill {
 		c.backfillAll(ctx, key, val)
 	}
@@ -333,6 +400,10 @@ func (c *Cache) Del(ctx context.Context, keys ...string) error {
 		return nil
 	}
 
+	if c.neg != nil {
+		c.neg.del(keys...)
+	}
+
 	var lastErr error
 	for _, layer := range c.layers {
 		err := layer.Layer.Del(ctx, keys...)
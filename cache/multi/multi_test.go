@@ -1125,3 +1125,141 @@ func TestCache_GetOrLoad_LoaderCalledExactlyOnce(t *testing.T) {
 		}
 	}
 }
+
+func TestCache_NotFoundTTL(t *testing.T) {
+	cache := NewCache([]LayerConfig{
+		{Layer: newMockLayer(), TTL: time.Minute, Name: "test"},
+	}, WithNotFoundTTL(50*time.Millisecond))
+
+	ctx := context.Background()
+	var calls atomic.Int32
+	loader := func(ctx context.Context) (any, error) {
+		calls.Add(1)
+		return nil, ErrNotFound
+	}
+
+	var dest string
+	for range 3 {
+		if err := cache.GetOrLoad(ctx, "missing", &dest, loader); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("expected ErrNotFound, got: %v", err)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("loader should be called once within NotFoundTTL, got %d", got)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	_ = cache.GetOrLoad(ctx, "missing", &dest, loader)
+	if got := calls.Load(); got != 2 {
+		t.Errorf("loader should be called again after NotFoundTTL, got %d", got)
+	}
+}
+
+func TestCache_ErrorTTL(t *testing.T) {
+	cache := NewCache([]LayerConfig{
+		{Layer: newMockLayer(), TTL: time.Minute, Name: "test"},
+	}, WithErrorTTL(time.Minute))
+
+	ctx := context.Background()
+	loadErr := errors.New("db down")
+	var calls atomic.Int32
+	loader := func(ctx context.Context) (any, error) {
+		calls.Add(1)
+		return nil, loadErr
+	}
+
+	var dest string
+	err := cache.GetOrLoad(ctx, "key", &dest, loader)
+	if !errors.Is(err, loadErr) || errors.Is(err, ErrCachedError) {
+		t.Fatalf("first call should return the loader error, got: %v", err)
+	}
+
+	err = cache.GetOrLoad(ctx, "key", &dest, loader)
+	if !errors.Is(err, ErrCachedError) || !errors.Is(err, loadErr) {
+		t.Fatalf("second call should return cached error, got: %v", err)
+	}
+	var ce *CachedError
+	if !errors.As(err, &ce) || ce.Key != "key" || ce.ExpiresAt.IsZero() {
+		t.Errorf("expected *CachedError with key and expiry, got: %#v", err)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("loader should be called once within ErrorTTL, got %d", got)
+	}
+
+	// Del 清除错误缓存
+	_ = cache.Del(ctx, "key")
+	err = cache.GetOrLoad(ctx, "key", &dest, func(ctx context.Context) (any, error) {
+		return "value", nil
+	})
+	if err != nil || dest != "value" {
+		t.Errorf("expected value after Del, got %q, %v", dest, err)
+	}
+}
+
+func TestCache_ErrorTTL_SkipsContextErrors(t *testing.T) {
+	cache := NewCache([]LayerConfig{
+		{Layer: newMockLayer(), TTL: time.Minute, Name: "test"},
+	}, WithErrorTTL(time.Minute))
+
+	ctx := context.Background()
+	var calls atomic.Int32
+	loader := func(ctx context.Context) (any, error) {
+		calls.Add(1)
+		return nil, context.DeadlineExceeded
+	}
+
+	var dest string
+	_ = cache.GetOrLoad(ctx, "key", &dest, loader)
+	err := cache.GetOrLoad(ctx, "key", &dest, loader)
+	if errors.Is(err, ErrCachedError) {
+		t.Error("context errors should not be cached by default")
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected 2 loader calls, got %d", got)
+	}
+}
+
+func TestCache_ErrorTTL_IsCacheableError(t *testing.T) {
+	transient := errors.New("transient")
+	cache := NewBuilder().
+		WithLayer(newMockLayer(), time.Minute, "test").
+		WithErrorTTL(time.Minute).
+		WithOptions(WithIsCacheableError(func(err error) bool {
+			return !errors.Is(err, transient)
+		})).
+		Build()
+
+	ctx := context.Background()
+	var dest string
+	_ = cache.GetOrLoad(ctx, "key", &dest, func(ctx context.Context) (any, error) {
+		return nil, transient
+	})
+	err := cache.GetOrLoad(ctx, "key", &dest, func(ctx context.Context) (any, error) {
+		return "ok", nil
+	})
+	if err != nil || dest != "ok" {
+		t.Errorf("non-cacheable error should not be cached, got %q, %v", dest, err)
+	}
+}
+
+func TestCache_NegativeCache_Disabled(t *testing.T) {
+	cache := NewCache([]LayerConfig{
+		{Layer: newMockLayer(), TTL: time.Minute, Name: "test"},
+	})
+	if cache.neg != nil {
+		t.Error("negative cache should be nil by default")
+	}
+}
+
+func TestNegativeCache_Evict(t *testing.T) {
+	n := newNegativeCache(2)
+	n.set("a", nil, time.Minute)
+	n.set("b", nil, time.Minute)
+	n.set("c", nil, time.Minute)
+	if got := n.len(); got != 2 {
+		t.Errorf("expected 2 entries after eviction, got %d", got)
+	}
+	if _, ok := n.get("c"); !ok {
+		t.Error("newly set entry should exist")
+	}
+}
@@ -0,0 +1,132 @@
+package multi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrCachedError 标记错误来自错误缓存（loader 未被调用）
+//
+// 使用 errors.Is(err, multi.ErrCachedError) 判断，原始错误仍可通过 errors.Is/As 识别
+var ErrCachedError = errors.New("multi-cache: cached loader error")
+
+// defaultNegativeCacheSize 负缓存默认最大条目数
+const defaultNegativeCacheSize = 10000
+
+// CachedError 错误缓存命中时返回的错误，包装 loader 上一次返回的原始错误
+type CachedError struct {
+	Key       string    // 缓存 key
+	Err       error     // loader 返回的原始错误
+	ExpiresAt time.Time // 错误缓存的过期时间，之后会重新调用 loader
+}
+
+// Error 实现 error 接口
+func (e *CachedError) Error() string {
+	return fmt.Sprintf("multi-cache: cached error for key %q: %v", e.Key, e.Err)
+}
+
+// Unwrap 返回原始错误
+func (e *CachedError) Unwrap() error {
+	return e.Err
+}
+
+// Is 使 errors.Is(err, ErrCachedError) 返回 true
+func (e *CachedError) Is(target error) bool {
+	return target == ErrCachedError
+}
+
+// defaultIsCacheableError 默认不缓存 context 取消/超时，它们与数据源状态无关
+func defaultIsCacheableError(err error) bool {
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// negativeEntry 负缓存条目，err 为 nil 表示 NotFound
+type negativeEntry struct {
+	err       error
+	expiresAt time.Time
+}
+
+// negativeCache 进程内的 NotFound/错误缓存
+//
+// 只用于保护 loader，条目数超过上限时先清理过期条目，仍超限则随机淘汰
+type negativeCache struct {
+	mu      sync.Mutex
+	entries map[string]negativeEntry
+	max     int
+	now     func() time.Time
+}
+
+func newNegativeCache(max int) *negativeCache {
+	if max <= 0 {
+		max = defaultNegativeCacheSize
+	}
+	return &negativeCache{
+		entries: make(map[string]negativeEntry),
+		max:     max,
+		now:     time.Now,
+	}
+}
+
+// get 返回未过期的条目
+func (n *negativeCache) get(key string) (negativeEntry, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	e, ok := n.entries[key]
+	if !ok {
+		return negativeEntry{}, false
+	}
+	if !n.now().Before(e.expiresAt) {
+		delete(n.entries, key)
+		return negativeEntry{}, false
+	}
+	return e, true
+}
+
+// set 写入条目，err 为 nil 表示 NotFound
+func (n *negativeCache) set(key string, err error, ttl time.Duration) negativeEntry {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	now := n.now()
+	if _, ok := n.entries[key]; !ok && len(n.entries) >= n.max {
+		n.evict(now)
+	}
+	e := negativeEntry{err: err, expiresAt: now.Add(ttl)}
+	n.entries[key] = e
+	return e
+}
+
+// evict 清理过期条目，仍超限时淘汰任意条目（map 遍历顺序随机），调用方需持有锁
+func (n *negativeCache) evict(now time.Time) {
+	for k, e := range n.entries {
+		if !now.Before(e.expiresAt) {
+			delete(n.entries, k)
+		}
+	}
+	for k := range n.entries {
+		if len(n.entries) < n.max {
+			break
+		}
+		delete(n.entries, k)
+	}
+}
+
+// del 删除条目
+func (n *negativeCache) del(keys ...string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, k := range keys {
+		delete(n.entries, k)
+	}
+}
+
+// len 返回条目数（含未清理的过期条目）
+func (n *negativeCache) len() int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return len(n.entries)
+}
@@ -7,11 +7,13 @@ import (
 	"math/rand/v2"
 	"reflect"
 	"time"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
 )
 
 var (
 	// 负缓存命中（表示"确实不存在"），用于防穿透。
-	ErrNotFound = errors.New("cache: not found")
+	ErrNotFound = errorx.NewSentinel(errorx.CategoryNotFound, "cache: not found")
 
 	// 调用方传入的 dest 不合法（必须是非 nil 指针）
 	ErrInvalidDest = errors.New("cache: dest must be a non-nil pointer")
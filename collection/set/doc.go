@@ -17,6 +17,13 @@
 //	inter := s1.Intersection(s2)
 //	diff := s1.Difference(s2)
 //
+// 多重集（记录元素次数）:
+//
+//	ms := set.NewMultiset("go", "rust", "go")
+//	ms.Count("go")  // 2
+//	ms.TopN(1)      // [{go 2}]
+//	sum := ms.Sum(other)  // 另有 Union/Intersection/Difference
+//
 // --- English ---
 //
 // Package set provides a generic set implementation.
@@ -37,4 +44,11 @@
 //	union := s1.Union(s2)
 //	inter := s1.Intersection(s2)
 //	diff := s1.Difference(s2)
+//
+// Multiset (tracks element counts):
+//
+//	ms := set.NewMultiset("go", "rust", "go")
+//	ms.Count("go")  // 2
+//	ms.TopN(1)      // [{go 2}]
+//	sum := ms.Sum(other)  // also Union/Intersection/Difference
 package set
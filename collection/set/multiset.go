@@ -0,0 +1,284 @@
+package set
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Multiset 泛型多重集（Bag），记录每个元素出现的次数
+//
+// 与 Set 不同，同一元素可以多次加入，Count 返回其出现次数。
+// 非线程安全，并发使用时需自行加锁
+type Multiset[T comparable] struct {
+	m    map[T]int
+	size int // 所有元素的次数之和
+}
+
+// MultisetEntry 多重集中的元素及其次数
+type MultisetEntry[T comparable] struct {
+	Item  T
+	Count int
+}
+
+// NewMultiset 创建新的 Multiset，重复的元素会累加次数
+func NewMultiset[T comparable](items ...T) *Multiset[T] {
+	ms := &Multiset[T]{
+		m: make(map[T]int, len(items)),
+	}
+	return ms.Add(items...)
+}
+
+// Add 添加元素，每次添加次数加 1
+func (ms *Multiset[T]) Add(items ...T) *Multiset[T] {
+	for _, item := range items {
+		ms.m[item]++
+	}
+	ms.size += len(items)
+	return ms
+}
+
+// AddN 添加 n 次元素，n <= 0 时不做任何操作
+func (ms *Multiset[T]) AddN(item T, n int) *Multiset[T] {
+	if n > 0 {
+		ms.m[item] += n
+		ms.size += n
+	}
+	return ms
+}
+
+// Remove 移除元素，每次移除次数减 1，次数为 0 时元素从集合中删除
+func (ms *Multiset[T]) Remove(items ...T) *Multiset[T] {
+	for _, item := range items {
+		ms.RemoveN(item, 1)
+	}
+	return ms
+}
+
+// RemoveN 移除 n 次元素，返回实际移除的次数
+func (ms *Multiset[T]) RemoveN(item T, n int) int {
+	count, ok := ms.m[item]
+	if !ok || n <= 0 {
+		return 0
+	}
+	if n >= count {
+		delete(ms.m, item)
+		ms.size -= count
+		return count
+	}
+	ms.m[item] = count - n
+	ms.size -= n
+	return n
+}
+
+// RemoveAll 移除元素的所有次数，返回移除的次数
+func (ms *Multiset[T]) RemoveAll(item T) int {
+	count := ms.m[item]
+	delete(ms.m, item)
+	ms.size -= count
+	return count
+}
+
+// SetCount 设置元素的次数，n <= 0 时移除该元素
+func (ms *Multiset[T]) SetCount(item T, n int) *Multiset[T] {
+	ms.RemoveAll(item)
+	return ms.AddN(item, n)
+}
+
+// Count 返回元素的次数，不存在时返回 0
+func (ms *Multiset[T]) Count(item T) int {
+	return ms.m[item]
+}
+
+// Contains 判断是否包含元素
+func (ms *Multiset[T]) Contains(item T) bool {
+	_, ok := ms.m[item]
+	return ok
+}
+
+// Size 返回所有元素的次数之和
+func (ms *Multiset[T]) Size() int {
+	return ms.size
+}
+
+// Len 返回所有元素的次数之和（Size 的别名）
+func (ms *Multiset[T]) Len() int {
+	return ms.size
+}
+
+// DistinctSize 返回不同元素的数量
+func (ms *Multiset[T]) DistinctSize() int {
+	return len(ms.m)
+}
+
+// IsEmpty 判断是否为空
+func (ms *Multiset[T]) IsEmpty() bool {
+	return ms.size == 0
+}
+
+// Clear 清空所有元素
+func (ms *Multiset[T]) Clear() {
+	ms.m = make(map[T]int)
+	ms.size = 0
+}
+
+// Distinct 返回所有不同的元素（顺序不确定）
+func (ms *Multiset[T]) Distinct() []T {
+	result := make([]T, 0, len(ms.m))
+	for item := range ms.m {
+		result = append(result, item)
+	}
+	return result
+}
+
+// ToSlice 转换为切片，元素按次数重复出现（顺序不确定）
+func (ms *Multiset[T]) ToSlice() []T {
+	result := make([]T, 0, ms.size)
+	for item, count := range ms.m {
+		for range count {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// ToMap 返回元素到次数的映射副本
+func (ms *Multiset[T]) ToMap() map[T]int {
+	result := make(map[T]int, len(ms.m))
+	for item, count := range ms.m {
+		result[item] = count
+	}
+	return result
+}
+
+// ToSet 转换为 Set，丢弃次数信息
+func (ms *Multiset[T]) ToSet() *Set[T] {
+	s := NewWithSize[T](len(ms.m))
+	for item := range ms.m {
+		s.m[item] = struct{}{}
+	}
+	return s
+}
+
+// Clone 克隆 Multiset
+func (ms *Multiset[T]) Clone() *Multiset[T] {
+	return &Multiset[T]{
+		m:    ms.ToMap(),
+		size: ms.size,
+	}
+}
+
+// ForEach 遍历所有不同元素及其次数
+func (ms *Multiset[T]) ForEach(fn func(item T, count int)) {
+	for item, count := range ms.m {
+		fn(item, count)
+	}
+}
+
+// TopN 返回次数最多的 n 个元素，按次数降序排列
+//
+// n <= 0 或超过不同元素数量时返回全部元素；次数相同的元素之间顺序不确定
+//
+// 示例:
+//
+//	ms := set.NewMultiset("go", "rust", "go", "java", "go", "rust")
+//	ms.TopN(2)  // [{go 3} {rust 2}]
+func (ms *Multiset[T]) TopN(n int) []MultisetEntry[T] {
+	entries := make([]MultisetEntry[T], 0, len(ms.m))
+	for item, count := range ms.m {
+		entries = append(entries, MultisetEntry[T]{Item: item, Count: count})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Count > entries[j].Count
+	})
+	if n > 0 && n < len(entries) {
+		entries = entries[:n]
+	}
+	return entries
+}
+
+// --- 多重集运算 ---
+
+// Union 并集，每个元素取两者次数的较大值
+func (ms *Multiset[T]) Union(other *Multiset[T]) *Multiset[T] {
+	result := ms.Clone()
+	for item, count := range other.m {
+		if count > result.m[item] {
+			result.SetCount(item, count)
+		}
+	}
+	return result
+}
+
+// Intersection 交集，每个元素取两者次数的较小值
+func (ms *Multiset[T]) Intersection(other *Multiset[T]) *Multiset[T] {
+	// 遍历较小的集合
+	small, large := ms, other
+	if len(small.m) > len(large.m) {
+		small, large = large, small
+	}
+
+	result := NewMultiset[T]()
+	for item, count := range small.m {
+		result.AddN(item, min(count, large.m[item]))
+	}
+	return result
+}
+
+// Sum 和，每个元素的次数相加
+func (ms *Multiset[T]) Sum(other *Multiset[T]) *Multiset[T] {
+	result := ms.Clone()
+	for item, count := range other.m {
+		result.AddN(item, count)
+	}
+	return result
+}
+
+// Difference 差集（ms - other），每个元素的次数相减，结果不大于 0 的元素被移除
+func (ms *Multiset[T]) Difference(other *Multiset[T]) *Multiset[T] {
+	result := NewMultiset[T]()
+	for item, count := range ms.m {
+		result.AddN(item, count-other.m[item])
+	}
+	return result
+}
+
+// IsSubset 判断是否为子集，即每个元素的次数都不超过 other 中的次数
+func (ms *Multiset[T]) IsSubset(other *Multiset[T]) bool {
+	if ms.size > other.size {
+		return false
+	}
+	for item, count := range ms.m {
+		if count > other.m[item] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset 判断是否为超集
+func (ms *Multiset[T]) IsSuperset(other *Multiset[T]) bool {
+	return other.IsSubset(ms)
+}
+
+// Equal 判断两个 Multiset 是否相等（元素和次数都相同）
+func (ms *Multiset[T]) Equal(other *Multiset[T]) bool {
+	if ms.size != other.size || len(ms.m) != len(other.m) {
+		return false
+	}
+	for item, count := range ms.m {
+		if other.m[item] != count {
+			return false
+		}
+	}
+	return true
+}
+
+// String 返回字符串表示，如 "Multiset{a:2, b:1}"（顺序不确定）
+func (ms *Multiset[T]) String() string {
+	items := make([]string, 0, len(ms.m))
+	for item, count := range ms.m {
+		items = append(items, fmt.Sprintf("%v:%d", item, count))
+	}
+	return "Multiset{" + strings.Join(items, ", ") + "}"
+}
@@ -0,0 +1,187 @@
+package set
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestMultiset_AddCount(t *testing.T) {
+	ms := NewMultiset("a", "b", "a")
+	ms.Add("c").AddN("a", 2).AddN("d", 0)
+
+	if ms.Count("a") != 4 {
+		t.Errorf("expected count 4 for a, got %d", ms.Count("a"))
+	}
+	if ms.Count("x") != 0 {
+		t.Errorf("expected count 0 for missing item, got %d", ms.Count("x"))
+	}
+	if ms.Contains("d") {
+		t.Error("AddN with 0 should not add item")
+	}
+	if ms.Size() != 6 || ms.Len() != 6 {
+		t.Errorf("expected size 6, got %d", ms.Size())
+	}
+	if ms.DistinctSize() != 3 {
+		t.Errorf("expected 3 distinct items, got %d", ms.DistinctSize())
+	}
+}
+
+func TestMultiset_Remove(t *testing.T) {
+	ms := NewMultiset(1, 1, 1, 2)
+
+	ms.Remove(1)
+	if ms.Count(1) != 2 || ms.Size() != 3 {
+		t.Errorf("after Remove: count=%d size=%d", ms.Count(1), ms.Size())
+	}
+
+	if n := ms.RemoveN(1, 5); n != 2 {
+		t.Errorf("expected RemoveN to remove 2, got %d", n)
+	}
+	if ms.Contains(1) {
+		t.Error("expected 1 to be removed")
+	}
+	if n := ms.RemoveN(3, 1); n != 0 {
+		t.Errorf("expected RemoveN on missing item to return 0, got %d", n)
+	}
+
+	ms.AddN(2, 4)
+	if n := ms.RemoveAll(2); n != 5 {
+		t.Errorf("expected RemoveAll to remove 5, got %d", n)
+	}
+	if !ms.IsEmpty() || ms.Size() != 0 {
+		t.Errorf("expected empty multiset, got size %d", ms.Size())
+	}
+}
+
+func TestMultiset_SetCount(t *testing.T) {
+	ms := NewMultiset("a", "a")
+	ms.SetCount("a", 5).SetCount("b", 2)
+	if ms.Count("a") != 5 || ms.Count("b") != 2 || ms.Size() != 7 {
+		t.Errorf("unexpected counts: %v", ms)
+	}
+	ms.SetCount("a", 0)
+	if ms.Contains("a") || ms.Size() != 2 {
+		t.Errorf("SetCount 0 should remove item: %v", ms)
+	}
+}
+
+func TestMultiset_Slices(t *testing.T) {
+	ms := NewMultiset(3, 1, 3, 2, 3)
+
+	all := ms.ToSlice()
+	sort.Ints(all)
+	want := []int{1, 2, 3, 3, 3}
+	if len(all) != len(want) {
+		t.Fatalf("expected %v, got %v", want, all)
+	}
+	for i := range want {
+		if all[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, all)
+		}
+	}
+
+	if len(ms.Distinct()) != 3 {
+		t.Errorf("expected 3 distinct items, got %v", ms.Distinct())
+	}
+	if s := ms.ToSet(); !s.Equal(New(1, 2, 3)) {
+		t.Errorf("unexpected ToSet: %v", s)
+	}
+
+	m := ms.ToMap()
+	m[3] = 100
+	if ms.Count(3) != 3 {
+		t.Error("ToMap should return a copy")
+	}
+}
+
+func TestMultiset_TopN(t *testing.T) {
+	ms := NewMultiset("go", "rust", "go", "java", "go", "rust")
+
+	top := ms.TopN(2)
+	if len(top) != 2 {
+		t.Fatalf("expected 2 entries, got %v", top)
+	}
+	if top[0] != (MultisetEntry[string]{Item: "go", Count: 3}) {
+		t.Errorf("unexpected first entry: %v", top[0])
+	}
+	if top[1] != (MultisetEntry[string]{Item: "rust", Count: 2}) {
+		t.Errorf("unexpected second entry: %v", top[1])
+	}
+
+	if len(ms.TopN(0)) != 3 || len(ms.TopN(10)) != 3 {
+		t.Error("expected all entries when n <= 0 or exceeds distinct size")
+	}
+}
+
+func TestMultiset_Algebra(t *testing.T) {
+	a := NewMultiset("x", "x", "y")
+	b := NewMultiset("x", "y", "y", "z")
+
+	union := a.Union(b)
+	if !union.Equal(NewMultiset("x", "x", "y", "y", "z")) {
+		t.Errorf("unexpected union: %v", union)
+	}
+
+	inter := a.Intersection(b)
+	if !inter.Equal(NewMultiset("x", "y")) {
+		t.Errorf("unexpected intersection: %v", inter)
+	}
+
+	sum := a.Sum(b)
+	if sum.Count("x") != 3 || sum.Count("y") != 3 || sum.Count("z") != 1 || sum.Size() != 7 {
+		t.Errorf("unexpected sum: %v", sum)
+	}
+
+	diff := a.Difference(b)
+	if !diff.Equal(NewMultiset("x")) {
+		t.Errorf("unexpected difference: %v", diff)
+	}
+
+	// 运算不修改原集合
+	if a.Size() != 3 || b.Size() != 4 {
+		t.Error("algebra should not modify operands")
+	}
+}
+
+func TestMultiset_SubsetEqual(t *testing.T) {
+	a := NewMultiset(1, 1, 2)
+	b := NewMultiset(1, 1, 1, 2, 3)
+
+	if !a.IsSubset(b) || !b.IsSuperset(a) {
+		t.Error("expected a to be a subset of b")
+	}
+	if b.IsSubset(a) {
+		t.Error("expected b not to be a subset of a")
+	}
+	if NewMultiset(1, 1, 1).IsSubset(NewMultiset(1, 1, 2, 3)) {
+		t.Error("multiplicity should be respected by IsSubset")
+	}
+
+	if !a.Equal(a.Clone()) {
+		t.Error("clone should be equal")
+	}
+	if a.Equal(NewMultiset(1, 2, 2)) {
+		t.Error("different counts should not be equal")
+	}
+}
+
+func TestMultiset_ClearForEach(t *testing.T) {
+	ms := NewMultiset("a", "a", "b")
+
+	total := 0
+	ms.ForEach(func(_ string, count int) {
+		total += count
+	})
+	if total != 3 {
+		t.Errorf("expected total 3, got %d", total)
+	}
+
+	if got := NewMultiset("a", "a").String(); got != "Multiset{a:2}" {
+		t.Errorf("unexpected String: %s", got)
+	}
+
+	ms.Clear()
+	if !ms.IsEmpty() || ms.DistinctSize() != 0 {
+		t.Error("expected empty multiset after Clear")
+	}
+}
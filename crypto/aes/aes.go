@@ -3,7 +3,9 @@ package aes
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"crypto/subtle"
 	"encoding/base64"
 	"encoding/hex"
@@ -16,6 +18,7 @@ var (
 	ErrInvalidBlockSize  = errors.New("aes: invalid block size")
 	ErrInvalidCiphertext = errors.New("aes: ciphertext too short")
 	ErrInvalidPadding    = errors.New("aes: invalid padding")
+	ErrKeyCommitment     = errors.New("aes: key commitment mismatch")
 )
 
 // --- GCM 模式（推荐，带认证） ---
@@ -24,6 +27,25 @@ var (
 // key: 16/24/32 字节对应 AES-128/192/256
 // 返回: nonce + ciphertext
 func EncryptGCM(plaintext, key []byte) ([]byte, error) {
+	return EncryptGCMWithAAD(plaintext, key, nil)
+}
+
+// DecryptGCM 使用 AES-GCM 解密
+func DecryptGCM(ciphertext, key []byte) ([]byte, error) {
+	return DecryptGCMWithAAD(ciphertext, key, nil)
+}
+
+// EncryptGCMWithAAD 使用 AES-GCM 加密，并认证附加数据（AAD）
+//
+// aad 不会被加密也不包含在密文中，但解密时必须提供相同的 aad，否则认证失败。
+// 将记录 ID 等上下文作为 aad，可以把密文绑定到该记录，防止密文被挪用到其他记录。
+//
+// 示例:
+//
+//	ct, err := aes.EncryptGCMWithAAD(ssn, key, []byte("user:42"))
+//	pt, err := aes.DecryptGCMWithAAD(ct, key, []byte("user:42"))  // 成功
+//	_, err = aes.DecryptGCMWithAAD(ct, key, []byte("user:43"))    // 失败
+func EncryptGCMWithAAD(plaintext, key, aad []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, ErrInvalidKeySize
@@ -39,12 +61,12 @@ func EncryptGCM(plaintext, key []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	ciphertext := gcm.Seal(nonce, nonce, plaintext, nil)
+	ciphertext := gcm.Seal(nonce, nonce, plaintext, aad)
 	return ciphertext, nil
 }
 
-// DecryptGCM 使用 AES-GCM 解密
-func DecryptGCM(ciphertext, key []byte) ([]byte, error) {
+// DecryptGCMWithAAD 使用 AES-GCM 解密，aad 必须与加密时一致
+func DecryptGCMWithAAD(ciphertext, key, aad []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, ErrInvalidKeySize
@@ -61,7 +83,7 @@ func DecryptGCM(ciphertext, key []byte) ([]byte, error) {
 	}
 
 	nonce, ciphertext := ciphertext[:nonceSize], ciphertext[nonceSize:]
-	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, aad)
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +91,111 @@ func DecryptGCM(ciphertext, key []byte) ([]byte, error) {
 	return plaintext, nil
 }
 
+// --- GCM 密钥承诺模式 ---
+
+const (
+	// committedNonceSize 密钥承诺模式的 nonce 长度（GCM 标准 nonce）
+	committedNonceSize = 12
+	// commitmentSize 密钥承诺值长度（HMAC-SHA256）
+	commitmentSize = sha256.Size
+)
+
+// EncryptGCMCommitted 使用带密钥承诺的 AES-GCM 加密
+//
+// 普通 GCM 不具备密钥承诺性：攻击者可以构造一个在两个不同密钥下都能通过认证的密文。
+// 此模式每条消息从 key 和随机 nonce 派生独立的加密密钥，并附加一个承诺值，
+// 解密时先校验承诺值，保证密文只能被加密时使用的密钥解开。
+//
+// key: 16/24/32 字节，派生密钥长度与 key 相同
+// 返回: nonce(12) + commitment(32) + ciphertext
+//
+// 注意: 输出格式与 EncryptGCM 不兼容，必须使用 DecryptGCMCommitted 解密
+func EncryptGCMCommitted(plaintext, key, aad []byte) ([]byte, error) {
+	if !validKeySize(key) {
+		return nil, ErrInvalidKeySize
+	}
+
+	nonce := make([]byte, committedNonceSize)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	encKey, commitment := deriveCommitted(key, nonce)
+	defer ClearBytes(encKey)
+
+	gcm, err := newGCM(encKey)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, len(nonce)+commitmentSize+len(plaintext)+gcm.Overhead())
+	out = append(out, nonce...)
+	out = append(out, commitment...)
+	return gcm.Seal(out, nonce, plaintext, aad), nil
+}
+
+// DecryptGCMCommitted 解密 EncryptGCMCommitted 的输出
+//
+// 承诺值不匹配（密钥错误或密文被篡改）时返回 ErrKeyCommitment
+func DecryptGCMCommitted(ciphertext, key, aad []byte) ([]byte, error) {
+	if !validKeySize(key) {
+		return nil, ErrInvalidKeySize
+	}
+	if len(ciphertext) < committedNonceSize+commitmentSize {
+		return nil, ErrInvalidCiphertext
+	}
+
+	nonce := ciphertext[:committedNonceSize]
+	commitment := ciphertext[committedNonceSize : committedNonceSize+commitmentSize]
+	body := ciphertext[committedNonceSize+commitmentSize:]
+
+	encKey, expected := deriveCommitted(key, nonce)
+	defer ClearBytes(encKey)
+	if subtle.ConstantTimeCompare(commitment, expected) != 1 {
+		return nil, ErrKeyCommitment
+	}
+
+	gcm, err := newGCM(encKey)
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Open(nil, nonce, body, aad)
+}
+
+// deriveCommitted 从主密钥和 nonce 派生加密密钥和承诺值
+//
+// 两者使用不同标签的 HMAC-SHA256 计算，互相独立
+func deriveCommitted(key, nonce []byte) (encKey, commitment []byte) {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("toolkit/aes-gcm/encryption-key"))
+	mac.Write(nonce)
+	encKey = mac.Sum(nil)[:len(key)]
+
+	mac = hmac.New(sha256.New, key)
+	mac.Write([]byte("toolkit/aes-gcm/commitment"))
+	mac.Write(nonce)
+	commitment = mac.Sum(nil)
+	return encKey, commitment
+}
+
+// newGCM 创建 AES-GCM 实例
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, ErrInvalidKeySize
+	}
+	return cipher.NewGCM(block)
+}
+
+// validKeySize 检查是否为 AES-128/192/256 密钥长度
+func validKeySize(key []byte) bool {
+	switch len(key) {
+	case 16, 24, 32:
+		return true
+	}
+	return false
+}
+
 // EncryptGCMString 加密字符串，返回 Base64
 func EncryptGCMString(plaintext, key string) (string, error) {
 	ciphertext, err := EncryptGCM([]byte(plaintext), []byte(key))
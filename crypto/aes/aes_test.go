@@ -109,6 +109,81 @@ func TestGCMInvalidCiphertext(t *testing.T) {
 	}
 }
 
+func TestGCMWithAAD(t *testing.T) {
+	key, _ := GenerateKey(32)
+	plaintext := []byte("123-45-6789")
+
+	ciphertext, err := EncryptGCMWithAAD(plaintext, key, []byte("user:42"))
+	if err != nil {
+		t.Fatalf("EncryptGCMWithAAD failed: %v", err)
+	}
+
+	decrypted, err := DecryptGCMWithAAD(ciphertext, key, []byte("user:42"))
+	if err != nil {
+		t.Fatalf("DecryptGCMWithAAD failed: %v", err)
+	}
+	if !bytes.Equal(plaintext, decrypted) {
+		t.Errorf("decrypted text doesn't match original")
+	}
+
+	// 密文被挪用到其他记录时认证失败
+	if _, err := DecryptGCMWithAAD(ciphertext, key, []byte("user:43")); err == nil {
+		t.Error("expected error for mismatched AAD")
+	}
+	if _, err := DecryptGCM(ciphertext, key); err == nil {
+		t.Error("expected error when AAD is missing")
+	}
+
+	// nil AAD 与 EncryptGCM 兼容
+	ciphertext, _ = EncryptGCM(plaintext, key)
+	if _, err := DecryptGCMWithAAD(ciphertext, key, nil); err != nil {
+		t.Errorf("DecryptGCMWithAAD(nil) should decrypt EncryptGCM output: %v", err)
+	}
+}
+
+func TestGCMCommitted(t *testing.T) {
+	for _, size := range []int{16, 24, 32} {
+		key, _ := GenerateKey(size)
+		plaintext := []byte("Hello, World! 你好世界")
+		aad := []byte("record:1")
+
+		ciphertext, err := EncryptGCMCommitted(plaintext, key, aad)
+		if err != nil {
+			t.Fatalf("EncryptGCMCommitted(%d) failed: %v", size, err)
+		}
+
+		decrypted, err := DecryptGCMCommitted(ciphertext, key, aad)
+		if err != nil {
+			t.Fatalf("DecryptGCMCommitted(%d) failed: %v", size, err)
+		}
+		if !bytes.Equal(plaintext, decrypted) {
+			t.Errorf("decrypted text doesn't match original for key size %d", size)
+		}
+
+		otherKey, _ := GenerateKey(size)
+		if _, err := DecryptGCMCommitted(ciphertext, otherKey, aad); err != ErrKeyCommitment {
+			t.Errorf("expected ErrKeyCommitment for wrong key, got %v", err)
+		}
+		if _, err := DecryptGCMCommitted(ciphertext, key, []byte("record:2")); err == nil {
+			t.Error("expected error for mismatched AAD")
+		}
+
+		tampered := bytes.Clone(ciphertext)
+		tampered[committedNonceSize] ^= 1
+		if _, err := DecryptGCMCommitted(tampered, key, aad); err != ErrKeyCommitment {
+			t.Errorf("expected ErrKeyCommitment for tampered commitment, got %v", err)
+		}
+	}
+
+	if _, err := EncryptGCMCommitted([]byte("x"), []byte("short"), nil); err != ErrInvalidKeySize {
+		t.Errorf("expected ErrInvalidKeySize, got %v", err)
+	}
+	key, _ := GenerateKey(32)
+	if _, err := DecryptGCMCommitted(make([]byte, 20), key, nil); err != ErrInvalidCiphertext {
+		t.Errorf("expected ErrInvalidCiphertext, got %v", err)
+	}
+}
+
 func TestEncryptDecryptCBC(t *testing.T) {
 	key, _ := GenerateKey(32)
 	plaintext := []byte("Hello, World! 你好世界")
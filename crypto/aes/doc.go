@@ -17,6 +17,16 @@
 //	encrypted, nonce, err := aes.EncryptGCM(plaintext, key)
 //	decrypted, err := aes.DecryptGCM(encrypted, key, nonce)
 //
+// 附加认证数据（AAD），把密文绑定到记录 ID，防止跨记录替换:
+//
+//	encrypted, err := aes.EncryptGCMWithAAD(plaintext, key, []byte("user:42"))
+//	decrypted, err := aes.DecryptGCMWithAAD(encrypted, key, []byte("user:42"))
+//
+// 密钥承诺模式（保证密文只能被加密时的密钥解开）:
+//
+//	encrypted, err := aes.EncryptGCMCommitted(plaintext, key, aad)
+//	decrypted, err := aes.DecryptGCMCommitted(encrypted, key, aad)
+//
 // --- English ---
 //
 // Package aes provides AES encryption and decryption utilities.
@@ -37,4 +47,14 @@
 //
 //	encrypted, nonce, err := aes.EncryptGCM(plaintext, key)
 //	decrypted, err := aes.DecryptGCM(encrypted, key, nonce)
+//
+// Additional authenticated data (AAD), binding ciphertexts to a record ID to prevent cross-record swapping:
+//
+//	encrypted, err := aes.EncryptGCMWithAAD(plaintext, key, []byte("user:42"))
+//	decrypted, err := aes.DecryptGCMWithAAD(encrypted, key, []byte("user:42"))
+//
+// Key-committing mode (a ciphertext only decrypts under the key that produced it):
+//
+//	encrypted, err := aes.EncryptGCMCommitted(plaintext, key, aad)
+//	decrypted, err := aes.DecryptGCMCommitted(encrypted, key, aad)
 package aes
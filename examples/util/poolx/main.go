@@ -180,7 +180,7 @@ func hooksExample() {
 		poolx.WithMaxWorkers(2),
 		poolx.WithAutoScale(false),
 		poolx.WithHooks(hooks),
-		poolx.WithPanicHandler(func(v any) {}), // 抑制默认 panic 输出
+		poolx.WithPanicHandler(func(v any, stack []byte) {}), // 抑制默认 panic 输出
 	)
 	defer p.Release()
 
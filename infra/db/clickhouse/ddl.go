@@ -0,0 +1,411 @@
+package clickhouse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrInvalidSchema is returned when a table definition cannot be built.
+var ErrInvalidSchema = errors.New("clickhouse: invalid table schema")
+
+// Column describes a table column.
+type Column struct {
+	Name    string // column name
+	Type    string // ClickHouse type, e.g. "LowCardinality(String)"
+	Default string // optional DEFAULT expression
+	Codec   string // optional CODEC, e.g. "ZSTD(3)"
+	Comment string // optional column comment
+}
+
+// TableBuilder builds CREATE TABLE statements for MergeTree-family tables.
+//
+// 列可以手动添加，也可以通过 ColumnsFrom 从结构体的 ch 标签推导
+// （与 clickhouse-go 批量写入使用的标签一致），chtype 标签可覆盖推导出的类型。
+//
+// Example:
+//
+//	type AccessLog struct {
+//	    Time    time.Time `ch:"time"`
+//	    Service string    `ch:"service" chtype:"LowCardinality(String)"`
+//	    Status  uint16    `ch:"status"`
+//	    Latency float64   `ch:"latency_ms"`
+//	}
+//
+//	tb := clickhouse.NewTableBuilder("access_log").
+//	    ColumnsFrom(AccessLog{}).
+//	    PartitionBy("toYYYYMMDD(time)").
+//	    OrderBy("service", "time").
+//	    TTL("time + INTERVAL 30 DAY")
+//	err := client.EnsureTable(ctx, tb)
+type TableBuilder struct {
+	database    string
+	name        string
+	columns     []Column
+	engine      string
+	partitionBy string
+	orderBy     []string
+	primaryKey  []string
+	ttl         string
+	settings    []string
+	comment     string
+	err         error
+}
+
+// NewTableBuilder creates a builder for the named table with the MergeTree() engine.
+func NewTableBuilder(name string) *TableBuilder {
+	return &TableBuilder{name: name, engine: "MergeTree()"}
+}
+
+// Database sets the database; the connection's default database is used when empty.
+func (b *TableBuilder) Database(db string) *TableBuilder {
+	b.database = db
+	return b
+}
+
+// Column adds a column.
+func (b *TableBuilder) Column(name, typ string) *TableBuilder {
+	b.columns = append(b.columns, Column{Name: name, Type: typ})
+	return b
+}
+
+// Columns adds columns with full definitions.
+func (b *TableBuilder) Columns(cols ...Column) *TableBuilder {
+	b.columns = append(b.columns, cols...)
+	return b
+}
+
+// ColumnsFrom adds columns derived from the exported fields of a struct (or pointer to struct).
+//
+// 列名取 ch 标签（未设置时使用字段名），ch:"-" 跳过该字段，匿名嵌入结构体会被展开。
+// 类型按 Go 类型推导，无法推导时 Build 返回 ErrInvalidSchema，可通过 chtype 标签指定。
+func (b *TableBuilder) ColumnsFrom(v any) *TableBuilder {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		b.setErr(fmt.Errorf("%w: ColumnsFrom expects a struct, got %T", ErrInvalidSchema, v))
+		return b
+	}
+	cols, err := structColumns(t)
+	if err != nil {
+		b.setErr(err)
+		return b
+	}
+	b.columns = append(b.columns, cols...)
+	return b
+}
+
+// Engine sets the table engine, e.g. "ReplacingMergeTree(version)". Defaults to "MergeTree()".
+func (b *TableBuilder) Engine(engine string) *TableBuilder {
+	b.engine = engine
+	return b
+}
+
+// PartitionBy sets the PARTITION BY expression, e.g. "toYYYYMM(time)".
+func (b *TableBuilder) PartitionBy(expr string) *TableBuilder {
+	b.partitionBy = expr
+	return b
+}
+
+// OrderBy sets the ORDER BY (sorting key) expressions. Required for MergeTree tables.
+func (b *TableBuilder) OrderBy(exprs ...string) *TableBuilder {
+	b.orderBy = exprs
+	return b
+}
+
+// PrimaryKey sets the PRIMARY KEY expressions; defaults to the sorting key when unset.
+func (b *TableBuilder) PrimaryKey(exprs ...string) *TableBuilder {
+	b.primaryKey = exprs
+	return b
+}
+
+// TTL sets the table TTL expression, e.g. "time + INTERVAL 30 DAY".
+func (b *TableBuilder) TTL(expr string) *TableBuilder {
+	b.ttl = expr
+	return b
+}
+
+// TTLDays sets a TTL that removes rows days after the given date/time column.
+func (b *TableBuilder) TTLDays(column string, days int) *TableBuilder {
+	return b.TTL(fmt.Sprintf("toDateTime(%s) + INTERVAL %d DAY", quoteIdent(column), days))
+}
+
+// Setting adds a table-level setting, e.g. Setting("index_granularity", 8192).
+// String values are quoted.
+func (b *TableBuilder) Setting(key string, value any) *TableBuilder {
+	if s, ok := value.(string); ok {
+		value = quoteString(s)
+	}
+	b.settings = append(b.settings, fmt.Sprintf("%s = %v", key, value))
+	return b
+}
+
+// Comment sets the table comment.
+func (b *TableBuilder) Comment(comment string) *TableBuilder {
+	b.comment = comment
+	return b
+}
+
+// TableName returns the qualified, quoted table name.
+func (b *TableBuilder) TableName() string {
+	if b.database == "" {
+		return quoteIdent(b.name)
+	}
+	return quoteIdent(b.database) + "." + quoteIdent(b.name)
+}
+
+// Build returns the CREATE TABLE IF NOT EXISTS statement.
+func (b *TableBuilder) Build() (string, error) {
+	if b.err != nil {
+		return "", b.err
+	}
+	if b.name == "" {
+		return "", fmt.Errorf("%w: table name is empty", ErrInvalidSchema)
+	}
+	if len(b.columns) == 0 {
+		return "", fmt.Errorf("%w: table %s has no columns", ErrInvalidSchema, b.name)
+	}
+	if strings.Contains(b.engine, "MergeTree") && len(b.orderBy) == 0 {
+		return "", fmt.Errorf("%w: %s requires ORDER BY", ErrInvalidSchema, b.engine)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "CREATE TABLE IF NOT EXISTS %s\n(\n", b.TableName())
+	seen := make(map[string]bool, len(b.columns))
+	for i, col := range b.columns {
+		if col.Name == "" || col.Type == "" {
+			return "", fmt.Errorf("%w: column %d has empty name or type", ErrInvalidSchema, i)
+		}
+		if seen[col.Name] {
+			return "", fmt.Errorf("%w: duplicate column %q", ErrInvalidSchema, col.Name)
+		}
+		seen[col.Name] = true
+
+		fmt.Fprintf(&sb, "    %s %s", quoteIdent(col.Name), col.Type)
+		if col.Default != "" {
+			sb.WriteString(" DEFAULT " + col.Default)
+		}
+		if col.Codec != "" {
+			sb.WriteString(" CODEC(" + col.Codec + ")")
+		}
+		if col.Comment != "" {
+			sb.WriteString(" COMMENT " + quoteString(col.Comment))
+		}
+		if i < len(b.columns)-1 {
+			sb.WriteByte(',')
+		}
+		sb.WriteByte('\n')
+	}
+	sb.WriteString(")\nENGINE = " + b.engine)
+	if b.partitionBy != "" {
+		sb.WriteString("\nPARTITION BY " + b.partitionBy)
+	}
+	if len(b.orderBy) > 0 {
+		sb.WriteString("\nORDER BY " + tupleExpr(b.orderBy))
+	}
+	if len(b.primaryKey) > 0 {
+		sb.WriteString("\nPRIMARY KEY " + tupleExpr(b.primaryKey))
+	}
+	if b.ttl != "" {
+		sb.WriteString("\nTTL " + b.ttl)
+	}
+	if len(b.settings) > 0 {
+		sb.WriteString("\nSETTINGS " + strings.Join(b.settings, ", "))
+	}
+	if b.comment != "" {
+		sb.WriteString("\nCOMMENT " + quoteString(b.comment))
+	}
+	return sb.String(), nil
+}
+
+func (b *TableBuilder) setErr(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// EnsureTable creates the table if it does not exist. Safe to call on every startup.
+//
+// 表已存在时不会修改结构；需要调整保留时长时使用 ModifyTTL。
+func (c *Client) EnsureTable(ctx context.Context, b *TableBuilder) error {
+	ddl, err := b.Build()
+	if err != nil {
+		return err
+	}
+	return c.Exec(ctx, ddl)
+}
+
+// ModifyTTL changes the TTL of an existing table.
+//
+// materialize 为 false 时只对新写入的数据生效（materialize_ttl_after_modify = 0），
+// 避免对大表立即重写全部分区；为 true 时 ClickHouse 会在后台按新 TTL 重新处理已有数据。
+//
+// Example:
+//
+//	err := client.ModifyTTL(ctx, "access_log", "time + INTERVAL 7 DAY", false)
+func (c *Client) ModifyTTL(ctx context.Context, table, ttl string, materialize bool) error {
+	if table == "" || ttl == "" {
+		return fmt.Errorf("%w: table and ttl must not be empty", ErrInvalidSchema)
+	}
+	flag := 0
+	if materialize {
+		flag = 1
+	}
+	return c.Exec(ctx, fmt.Sprintf("ALTER TABLE %s MODIFY TTL %s SETTINGS materialize_ttl_after_modify = %d",
+		quoteQualified(table), ttl, flag))
+}
+
+var timeType = reflect.TypeFor[time.Time]()
+
+// structColumns derives columns from struct fields.
+func structColumns(t reflect.Type) ([]Column, error) {
+	var cols []Column
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag := f.Tag.Get("ch")
+		if tag == "-" {
+			continue
+		}
+		if f.Anonymous && tag == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct && ft != timeType {
+				sub, err := structColumns(ft)
+				if err != nil {
+					return nil, err
+				}
+				cols = append(cols, sub...)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+		typ := f.Tag.Get("chtype")
+		if typ == "" {
+			var ok bool
+			if typ, ok = goTypeToClickHouse(f.Type); !ok {
+				return nil, fmt.Errorf("%w: cannot map field %s (%s) to a ClickHouse type, use the chtype tag",
+					ErrInvalidSchema, f.Name, f.Type)
+			}
+		}
+		cols = append(cols, Column{Name: name, Type: typ})
+	}
+	return cols, nil
+}
+
+// goTypeToClickHouse maps a Go type to its default ClickHouse type.
+func goTypeToClickHouse(t reflect.Type) (string, bool) {
+	if t == timeType {
+		return "DateTime64(3)", true
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "String", true
+	case reflect.Bool:
+		return "Bool", true
+	case reflect.Int8:
+		return "Int8", true
+	case reflect.Int16:
+		return "Int16", true
+	case reflect.Int32:
+		return "Int32", true
+	case reflect.Int, reflect.Int64:
+		return "Int64", true
+	case reflect.Uint8:
+		return "UInt8", true
+	case reflect.Uint16:
+		return "UInt16", true
+	case reflect.Uint32:
+		return "UInt32", true
+	case reflect.Uint, reflect.Uint64:
+		return "UInt64", true
+	case reflect.Float32:
+		return "Float32", true
+	case reflect.Float64:
+		return "Float64", true
+	case reflect.Pointer:
+		inner, ok := goTypeToClickHouse(t.Elem())
+		if !ok || strings.HasPrefix(inner, "Array(") || strings.HasPrefix(inner, "Map(") {
+			return "", false
+		}
+		return "Nullable(" + inner + ")", true
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && t.Kind() == reflect.Slice {
+			return "String", true // []byte
+		}
+		inner, ok := goTypeToClickHouse(t.Elem())
+		if !ok {
+			return "", false
+		}
+		return "Array(" + inner + ")", true
+	case reflect.Map:
+		k, ok1 := goTypeToClickHouse(t.Key())
+		v, ok2 := goTypeToClickHouse(t.Elem())
+		if !ok1 || !ok2 {
+			return "", false
+		}
+		return "Map(" + k + ", " + v + ")", true
+	}
+	return "", false
+}
+
+// quoteIdent quotes an identifier with backticks.
+func quoteIdent(s string) string {
+	return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+}
+
+// quoteQualified quotes a possibly database-qualified name such as "db.table".
+func quoteQualified(s string) string {
+	parts := strings.Split(s, ".")
+	for i, p := range parts {
+		parts[i] = quoteIdent(p)
+	}
+	return strings.Join(parts, ".")
+}
+
+// quoteString quotes a string literal.
+func quoteString(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+	return "'" + r.Replace(s) + "'"
+}
+
+// tupleExpr renders key expressions; plain column names are quoted.
+func tupleExpr(exprs []string) string {
+	quoted := slices.Clone(exprs)
+	for i, e := range quoted {
+		if isPlainIdent(e) {
+			quoted[i] = quoteIdent(e)
+		}
+	}
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+	return "(" + strings.Join(quoted, ", ") + ")"
+}
+
+func isPlainIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (i > 0 && r >= '0' && r <= '9') {
+			continue
+		}
+		return false
+	}
+	return true
+}
@@ -0,0 +1,288 @@
+package clickhouse
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+)
+
+// recordingConn records the statements passed to Exec; other driver.Conn methods are not used.
+type recordingConn struct {
+	driver.Conn
+	queries []string
+}
+
+func (c *recordingConn) Exec(_ context.Context, query string, _ ...any) error {
+	c.queries = append(c.queries, query)
+	return nil
+}
+
+func TestTableBuilder_Build(t *testing.T) {
+	tests := []struct {
+		name string
+		b    *TableBuilder
+		want string
+	}{
+		{
+			name: "minimal",
+			b:    NewTableBuilder("events").Column("id", "UInt64").OrderBy("id"),
+			want: "CREATE TABLE IF NOT EXISTS `events`\n(\n    `id` UInt64\n)\nENGINE = MergeTree()\nORDER BY `id`",
+		},
+		{
+			name: "all clauses",
+			b: NewTableBuilder("access_log").
+				Database("logs").
+				Columns(
+					Column{Name: "time", Type: "DateTime64(3)", Codec: "Delta, ZSTD(3)"},
+					Column{Name: "service", Type: "LowCardinality(String)", Comment: "caller's service"},
+					Column{Name: "status", Type: "UInt16", Default: "200"},
+				).
+				Engine("ReplacingMergeTree(time)").
+				PartitionBy("toYYYYMMDD(time)").
+				OrderBy("service", "toStartOfHour(time)").
+				PrimaryKey("service").
+				TTLDays("time", 30).
+				Setting("index_granularity", 8192).
+				Setting("storage_policy", "hot_cold").
+				Comment("HTTP access log"),
+			want: "CREATE TABLE IF NOT EXISTS `logs`.`access_log`\n(\n" +
+				"    `time` DateTime64(3) CODEC(Delta, ZSTD(3)),\n" +
+				"    `service` LowCardinality(String) COMMENT 'caller\\'s service',\n" +
+				"    `status` UInt16 DEFAULT 200\n" +
+				")\nENGINE = ReplacingMergeTree(time)" +
+				"\nPARTITION BY toYYYYMMDD(time)" +
+				"\nORDER BY (`service`, toStartOfHour(time))" +
+				"\nPRIMARY KEY `service`" +
+				"\nTTL toDateTime(`time`) + INTERVAL 30 DAY" +
+				"\nSETTINGS index_granularity = 8192, storage_policy = 'hot_cold'" +
+				"\nCOMMENT 'HTTP access log'",
+		},
+		{
+			name: "non-MergeTree engine without ORDER BY",
+			b:    NewTableBuilder("tmp").Column("v", "String").Engine("Memory").TTL("now() + INTERVAL 1 HOUR"),
+			want: "CREATE TABLE IF NOT EXISTS `tmp`\n(\n    `v` String\n)\nENGINE = Memory\nTTL now() + INTERVAL 1 HOUR",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.b.Build()
+			if err != nil {
+				t.Fatalf("Build() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Build() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableBuilder_BuildErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		b    *TableBuilder
+	}{
+		{"empty name", NewTableBuilder("").Column("id", "UInt64").OrderBy("id")},
+		{"no columns", NewTableBuilder("t").OrderBy("id")},
+		{"MergeTree without ORDER BY", NewTableBuilder("t").Column("id", "UInt64")},
+		{"empty column type", NewTableBuilder("t").Column("id", "").OrderBy("id")},
+		{"duplicate column", NewTableBuilder("t").Column("id", "UInt64").Column("id", "String").OrderBy("id")},
+		{"ColumnsFrom non-struct", NewTableBuilder("t").ColumnsFrom(42).OrderBy("id")},
+		{"ColumnsFrom nil", NewTableBuilder("t").ColumnsFrom(nil).OrderBy("id")},
+		{"unmappable field", NewTableBuilder("t").ColumnsFrom(struct {
+			ID   uint64
+			Data chan int
+		}{}).OrderBy("ID")},
+		{"pointer to slice", NewTableBuilder("t").ColumnsFrom(struct {
+			Tags *[]string
+		}{}).OrderBy("Tags")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.b.Build(); !errors.Is(err, ErrInvalidSchema) {
+				t.Errorf("expected ErrInvalidSchema, got %v", err)
+			}
+		})
+	}
+}
+
+type ddlBase struct {
+	ID        uint64    `ch:"id"`
+	CreatedAt time.Time `ch:"created_at"`
+}
+
+type ddlEvent struct {
+	ddlBase
+	*ddlMeta
+	Service  string            `ch:"service" chtype:"LowCardinality(String)"`
+	Status   uint16            `ch:"status,omitempty"`
+	Latency  float64           `ch:"latency_ms"`
+	Note     *string           `ch:"note"`
+	Tags     []string          `ch:"tags"`
+	Scores   [3]float32        `ch:"scores"`
+	Payload  []byte            `ch:"payload"`
+	Labels   map[string]int32  `ch:"labels"`
+	Ignored  string            `ch:"-"`
+	Untagged bool              // column name defaults to the field name
+	internal string            // unexported fields are skipped
+	Nested   map[string][]bool `ch:"nested"`
+}
+
+type ddlMeta struct {
+	Region string `ch:"region"`
+}
+
+func TestTableBuilder_ColumnsFrom(t *testing.T) {
+	b := NewTableBuilder("events").ColumnsFrom(&ddlEvent{})
+	want := []Column{
+		{Name: "id", Type: "UInt64"},
+		{Name: "created_at", Type: "DateTime64(3)"},
+		{Name: "region", Type: "String"},
+		{Name: "service", Type: "LowCardinality(String)"},
+		{Name: "status", Type: "UInt16"},
+		{Name: "latency_ms", Type: "Float64"},
+		{Name: "note", Type: "Nullable(String)"},
+		{Name: "tags", Type: "Array(String)"},
+		{Name: "scores", Type: "Array(Float32)"},
+		{Name: "payload", Type: "String"},
+		{Name: "labels", Type: "Map(String, Int32)"},
+		{Name: "Untagged", Type: "Bool"},
+		{Name: "nested", Type: "Map(String, Array(Bool))"},
+	}
+	if b.err != nil {
+		t.Fatalf("ColumnsFrom error: %v", b.err)
+	}
+	if !reflect.DeepEqual(b.columns, want) {
+		t.Errorf("ColumnsFrom columns =\n%v\nwant\n%v", b.columns, want)
+	}
+}
+
+func TestGoTypeToClickHouse(t *testing.T) {
+	tests := []struct {
+		typ  reflect.Type
+		want string
+		ok   bool
+	}{
+		{reflect.TypeFor[string](), "String", true},
+		{reflect.TypeFor[bool](), "Bool", true},
+		{reflect.TypeFor[int](), "Int64", true},
+		{reflect.TypeFor[int8](), "Int8", true},
+		{reflect.TypeFor[int16](), "Int16", true},
+		{reflect.TypeFor[int32](), "Int32", true},
+		{reflect.TypeFor[uint](), "UInt64", true},
+		{reflect.TypeFor[uint8](), "UInt8", true},
+		{reflect.TypeFor[uint32](), "UInt32", true},
+		{reflect.TypeFor[float32](), "Float32", true},
+		{reflect.TypeFor[time.Time](), "DateTime64(3)", true},
+		{reflect.TypeFor[*time.Time](), "Nullable(DateTime64(3))", true},
+		{reflect.TypeFor[*int64](), "Nullable(Int64)", true},
+		{reflect.TypeFor[[]byte](), "String", true},
+		{reflect.TypeFor[[16]byte](), "Array(UInt8)", true},
+		{reflect.TypeFor[[][]int32](), "Array(Array(Int32))", true},
+		{reflect.TypeFor[map[string]string](), "Map(String, String)", true},
+		{reflect.TypeFor[*[]int](), "", false},
+		{reflect.TypeFor[*map[string]int](), "", false},
+		{reflect.TypeFor[map[string]any](), "", false},
+		{reflect.TypeFor[struct{ A int }](), "", false},
+		{reflect.TypeFor[complex128](), "", false},
+	}
+	for _, tt := range tests {
+		got, ok := goTypeToClickHouse(tt.typ)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("goTypeToClickHouse(%s) = %q, %v; want %q, %v", tt.typ, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestQuoting(t *testing.T) {
+	tests := []struct {
+		fn   func(string) string
+		in   string
+		want string
+	}{
+		{quoteIdent, "time", "`time`"},
+		{quoteIdent, "we`ird", "`we``ird`"},
+		{quoteQualified, "logs.access_log", "`logs`.`access_log`"},
+		{quoteQualified, "access_log", "`access_log`"},
+		{quoteString, `it's a \ test`, `'it\'s a \\ test'`},
+	}
+	for _, tt := range tests {
+		if got := tt.fn(tt.in); got != tt.want {
+			t.Errorf("quote(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestTupleExpr(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"id"}, "`id`"},
+		{[]string{"service", "time"}, "(`service`, `time`)"},
+		{[]string{"toYYYYMM(time)"}, "toYYYYMM(time)"},
+		{[]string{"tenant_id", "cityHash64(user)", "_ts2"}, "(`tenant_id`, cityHash64(user), `_ts2`)"},
+		{[]string{"tuple()"}, "tuple()"},
+	}
+	for _, tt := range tests {
+		if got := tupleExpr(tt.in); got != tt.want {
+			t.Errorf("tupleExpr(%v) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestIsPlainIdent(t *testing.T) {
+	for s, want := range map[string]bool{
+		"time":     true,
+		"_ts":      true,
+		"col2":     true,
+		"2col":     false,
+		"":         false,
+		"a.b":      false,
+		"f(x)":     false,
+		"a b":      false,
+		"время":    false,
+		"Upper_OK": true,
+	} {
+		if got := isPlainIdent(s); got != want {
+			t.Errorf("isPlainIdent(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestClient_EnsureTableAndModifyTTL(t *testing.T) {
+	conn := &recordingConn{}
+	c := &Client{conn: conn, config: DefaultConfig()}
+	ctx := context.Background()
+
+	tb := NewTableBuilder("events").Column("id", "UInt64").OrderBy("id")
+	if err := c.EnsureTable(ctx, tb); err != nil {
+		t.Fatalf("EnsureTable error: %v", err)
+	}
+	if err := c.EnsureTable(ctx, NewTableBuilder("bad")); !errors.Is(err, ErrInvalidSchema) {
+		t.Errorf("expected ErrInvalidSchema for an invalid table, got %v", err)
+	}
+	if err := c.ModifyTTL(ctx, "logs.events", "time + INTERVAL 7 DAY", false); err != nil {
+		t.Fatalf("ModifyTTL error: %v", err)
+	}
+	if err := c.ModifyTTL(ctx, "events", "time + INTERVAL 1 DAY", true); err != nil {
+		t.Fatalf("ModifyTTL error: %v", err)
+	}
+	if err := c.ModifyTTL(ctx, "", "x", false); !errors.Is(err, ErrInvalidSchema) {
+		t.Errorf("expected ErrInvalidSchema for empty table, got %v", err)
+	}
+
+	ddl, _ := tb.Build()
+	want := []string{
+		ddl,
+		"ALTER TABLE `logs`.`events` MODIFY TTL time + INTERVAL 7 DAY SETTINGS materialize_ttl_after_modify = 0",
+		"ALTER TABLE `events` MODIFY TTL time + INTERVAL 1 DAY SETTINGS materialize_ttl_after_modify = 1",
+	}
+	if strings.Join(conn.queries, "\n--\n") != strings.Join(want, "\n--\n") {
+		t.Errorf("executed statements =\n%v\nwant\n%v", conn.queries, want)
+	}
+}
@@ -30,6 +30,15 @@
 //	batch.Append(...)
 //	batch.Send()
 //
+// 建表（启动时幂等执行）:
+//
+//	tb := clickhouse.NewTableBuilder("access_log").
+//	    ColumnsFrom(AccessLog{}).          // 按 ch/chtype 标签推导列
+//	    PartitionBy("toYYYYMMDD(time)").
+//	    OrderBy("service", "time").
+//	    TTL("time + INTERVAL 30 DAY")
+//	err := clickhouse.GetClient().EnsureTable(ctx, tb)
+//
 // 健康检查:
 //
 //	if err := clickhouse.GetClient().Ping(ctx); err != nil {
@@ -71,6 +80,15 @@
 //	batch.Append(...)
 //	batch.Send()
 //
+// Table bootstrap (idempotent on startup):
+//
+//	tb := clickhouse.NewTableBuilder("access_log").
+//	    ColumnsFrom(AccessLog{}).          // columns from ch/chtype tags
+//	    PartitionBy("toYYYYMMDD(time)").
+//	    OrderBy("service", "time").
+//	    TTL("time + INTERVAL 30 DAY")
+//	err := clickhouse.GetClient().EnsureTable(ctx, tb)
+//
 // Health check:
 //
 //	if err := clickhouse.GetClient().Ping(ctx); err != nil {
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 // Config errors.
@@ -50,6 +52,19 @@ type Config struct {
 	CompressRequestBody   bool          `json:"compress_request_body" yaml:"compress_request_body" mapstructure:"compress_request_body"`
 	DiscoverNodesOnStart  bool          `json:"discover_nodes_on_start" yaml:"discover_nodes_on_start" mapstructure:"discover_nodes_on_start"`
 	DiscoverNodesInterval time.Duration `json:"discover_nodes_interval" yaml:"discover_nodes_interval" mapstructure:"discover_nodes_interval"`
+
+	// Observability
+	EnableMetrics bool          `json:"enable_metrics" yaml:"enable_metrics" mapstructure:"enable_metrics"`
+	SlowThreshold time.Duration `json:"slow_threshold" yaml:"slow_threshold" mapstructure:"slow_threshold"`
+
+	// MetricsRegisterer is where metrics are registered (default: prometheus.DefaultRegisterer).
+	MetricsRegisterer prometheus.Registerer `json:"-" yaml:"-" mapstructure:"-"`
+
+	// Logger receives slow request logs (default: standard log package).
+	Logger Logger `json:"-" yaml:"-" mapstructure:"-"`
+
+	// IndexLabel maps a request's index to the index metric label (default: NormalizeIndex).
+	IndexLabel func(index string) string `json:"-" yaml:"-" mapstructure:"-"`
 }
 
 // DefaultConfig returns sensible default configuration.
@@ -142,6 +157,32 @@ func WithCompression(enable bool) Option {
 	return func(c *Config) { c.CompressRequestBody = enable }
 }
 
+// WithMetrics enables Prometheus metrics, registered on reg
+// (nil uses prometheus.DefaultRegisterer).
+func WithMetrics(reg prometheus.Registerer) Option {
+	return func(c *Config) {
+		c.EnableMetrics = true
+		c.MetricsRegisterer = reg
+	}
+}
+
+// WithSlowThreshold logs requests slower than d (0 disables slow logging).
+func WithSlowThreshold(d time.Duration) Option {
+	return func(c *Config) { c.SlowThreshold = d }
+}
+
+// WithLogger sets the logger used for slow request logs.
+func WithLogger(l Logger) Option {
+	return func(c *Config) { c.Logger = l }
+}
+
+// WithIndexLabel sets how request indices map to the index metric label.
+// Use it to collapse index names that NormalizeIndex does not recognize
+// (e.g. per-tenant indices), so label cardinality stays bounded.
+func WithIndexLabel(fn func(index string) string) Option {
+	return func(c *Config) { c.IndexLabel = fn }
+}
+
 // Apply applies options to the config.
 func (c *Config) Apply(opts ...Option) *Config {
 	for _, opt := range opts {
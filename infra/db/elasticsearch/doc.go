@@ -36,6 +36,17 @@
 //	    // 处理不健康状态
 //	}
 //
+// 监控与慢查询日志:
+//
+//	err := elasticsearch.Init(nil,
+//	    elasticsearch.WithMetrics(nil),                      // 注册到 prometheus.DefaultRegisterer
+//	    elasticsearch.WithSlowThreshold(500*time.Millisecond), // 超过 500ms 的请求记录日志
+//	)
+//
+// 指标按 index/operation 统计请求延迟，按状态码统计错误数。
+// index 标签默认经 NormalizeIndex 归一化（logs-2026.10.16 记为 logs-*，多索引记为 _multi），
+// 可通过 WithIndexLabel 自定义。
+//
 // --- English ---
 //
 // Package elasticsearch provides Elasticsearch client singleton management.
@@ -76,4 +87,15 @@
 //	if err := elasticsearch.GetClient().Ping(ctx); err != nil {
 //	    // handle unhealthy
 //	}
+//
+// Metrics and slow request logging:
+//
+//	err := elasticsearch.Init(nil,
+//	    elasticsearch.WithMetrics(nil),                      // register on prometheus.DefaultRegisterer
+//	    elasticsearch.WithSlowThreshold(500*time.Millisecond), // log requests slower than 500ms
+//	)
+//
+// Latency is recorded per index/operation; errors are counted by status code.
+// The index label is normalized by NormalizeIndex by default (logs-2026.10.16
+// becomes logs-*, multi-index requests become _multi); override it with WithIndexLabel.
 package elasticsearch
@@ -117,6 +117,9 @@ func New(cfg *Config, opts ...Option) (*Client, error) {
 	}
 
 	esCfg.Transport = transport
+	if cfg.EnableMetrics || cfg.SlowThreshold > 0 {
+		esCfg.Transport = newInstrumentedTransport(transport, cfg)
+	}
 
 	// Create client
 	client, err := elasticsearch.NewClient(esCfg)
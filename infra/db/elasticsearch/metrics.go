@@ -0,0 +1,295 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// maxSlowLogBody limits how much of a request body is included in slow logs.
+const maxSlowLogBody = 1024
+
+// Logger is the logging interface used for slow request logs.
+type Logger interface {
+	// Printf prints a formatted log line.
+	Printf(format string, args ...any)
+}
+
+// Metrics holds the Prometheus collectors for Elasticsearch requests.
+//
+// Exposed metrics (the index label is normalized, see WithIndexLabel):
+//   - elasticsearch_request_duration_seconds{index,operation}: request latency histogram
+//   - elasticsearch_request_errors_total{index,operation,status}: failed requests by status code
+//     ("error" for transport failures)
+//   - elasticsearch_slow_requests_total{index,operation}: requests above the slow threshold
+type Metrics struct {
+	requestDuration *prometheus.HistogramVec
+	requestErrors   *prometheus.CounterVec
+	slowRequests    *prometheus.CounterVec
+}
+
+// NewMetrics creates and registers the Elasticsearch collectors.
+// If reg is nil, prometheus.DefaultRegisterer is used. Collectors that are
+// already registered (e.g. by another client) are reused.
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	if reg == nil {
+		reg = prometheus.DefaultRegisterer
+	}
+
+	m := &Metrics{
+		requestDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "elasticsearch_request_duration_seconds",
+				Help:    "Elasticsearch request latency in seconds by index and operation",
+				Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+			},
+			[]string{"index", "operation"},
+		),
+		requestErrors: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "elasticsearch_request_errors_total",
+				Help: "Total number of failed Elasticsearch requests by index, operation and status code",
+			},
+			[]string{"index", "operation", "status"},
+		),
+		slowRequests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "elasticsearch_slow_requests_total",
+				Help: "Total number of Elasticsearch requests slower than the configured threshold",
+			},
+			[]string{"index", "operation"},
+		),
+	}
+
+	m.requestDuration = registerOrReuse(reg, m.requestDuration)
+	m.requestErrors = registerOrReuse(reg, m.requestErrors)
+	m.slowRequests = registerOrReuse(reg, m.slowRequests)
+	return m
+}
+
+// registerOrReuse registers c, returning the existing collector if one with
+// the same descriptor is already registered.
+func registerOrReuse[C prometheus.Collector](reg prometheus.Registerer, c C) C {
+	if err := reg.Register(c); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			if existing, ok := are.ExistingCollector.(C); ok {
+				return existing
+			}
+		}
+	}
+	return c
+}
+
+// instrumentedTransport records metrics and slow logs for every request.
+//
+// It sits below the client's retry logic, so each retry attempt is observed
+// separately.
+type instrumentedTransport struct {
+	next          http.RoundTripper
+	metrics       *Metrics
+	slowThreshold time.Duration
+	logger        Logger
+	indexLabel    func(index string) string
+}
+
+// newInstrumentedTransport wraps next with metrics and slow request logging.
+func newInstrumentedTransport(next http.RoundTripper, cfg *Config) http.RoundTripper {
+	t := &instrumentedTransport{
+		next:          next,
+		slowThreshold: cfg.SlowThreshold,
+		logger:        cfg.Logger,
+		indexLabel:    cfg.IndexLabel,
+	}
+	if t.indexLabel == nil {
+		t.indexLabel = NormalizeIndex
+	}
+	if cfg.EnableMetrics {
+		t.metrics = NewMetrics(cfg.MetricsRegisterer)
+	}
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	elapsed := time.Since(start)
+
+	index, op := parseRequest(req.Method, req.URL.Path)
+	status := "error"
+	failed := err != nil
+	if resp != nil {
+		status = strconv.Itoa(resp.StatusCode)
+		failed = failed || resp.StatusCode >= http.StatusBadRequest
+	}
+
+	slow := t.slowThreshold > 0 && elapsed >= t.slowThreshold
+
+	if t.metrics != nil {
+		label := t.indexLabel(index)
+		t.metrics.requestDuration.WithLabelValues(label, op).Observe(elapsed.Seconds())
+		if failed {
+			t.metrics.requestErrors.WithLabelValues(label, op, status).Inc()
+		}
+		if slow {
+			t.metrics.slowRequests.WithLabelValues(label, op).Inc()
+		}
+	}
+
+	if slow {
+		t.logSlow(req, index, op, status, elapsed)
+	}
+	return resp, err
+}
+
+// logSlow logs a slow request, including the beginning of the request body
+// (e.g. the search query) when it can be re-read.
+func (t *instrumentedTransport) logSlow(req *http.Request, index, op, status string, elapsed time.Duration) {
+	format := "elasticsearch: slow request %s %s index=%s operation=%s status=%s took=%s"
+	args := []any{req.Method, req.URL.Path, index, op, status, elapsed}
+
+	if body := peekBody(req); body != "" {
+		format += " body=%s"
+		args = append(args, body)
+	}
+
+	if t.logger != nil {
+		t.logger.Printf(format, args...)
+		return
+	}
+	log.Printf("[WARNING] "+format, args...)
+}
+
+// peekBody returns up to maxSlowLogBody bytes of the request body without
+// consuming it. Only bodies that provide GetBody can be read again.
+func peekBody(req *http.Request) string {
+	if req.GetBody == nil {
+		return ""
+	}
+	rc, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer rc.Close()
+
+	var buf bytes.Buffer
+	n, _ := io.CopyN(&buf, rc, maxSlowLogBody+1)
+	if n > maxSlowLogBody {
+		buf.Truncate(maxSlowLogBody)
+		buf.WriteString("...")
+	}
+	return strings.Join(strings.Fields(buf.String()), " ")
+}
+
+// indexSuffix matches a trailing date, time or rollover counter, e.g.
+// "-2026.10.16", "_202610", "-000001".
+var indexSuffix = regexp.MustCompile(`[-_.]\d{4}(?:[-._]?\d{2}){0,4}$`)
+
+// NormalizeIndex is the default index label normalizer, keeping the number of
+// label values bounded for time-based and rollover indices.
+//
+// Examples:
+//
+//	users                                 -> users
+//	logs-2026.10.16                       -> logs-*
+//	metrics_20261016                      -> metrics_*
+//	.ds-logs-app-2026.10.16-000001        -> .ds-logs-app-*
+//	users,orders                          -> _multi
+func NormalizeIndex(index string) string {
+	if strings.Contains(index, ",") {
+		return "_multi"
+	}
+	base, sep := index, ""
+	for {
+		loc := indexSuffix.FindStringIndex(base)
+		if loc == nil || loc[0] == 0 {
+			break
+		}
+		sep = base[loc[0] : loc[0]+1]
+		base = base[:loc[0]]
+	}
+	if sep == "" {
+		return index
+	}
+	return base + sep + "*"
+}
+
+// parseRequest derives the index and operation labels from a request path.
+// The index is returned as it appears in the path.
+//
+// Examples:
+//
+//	GET  /users/_search        -> index=users operation=search
+//	PUT  /users/_doc/1         -> index=users operation=index
+//	POST /_bulk                -> index=_all  operation=bulk
+//	GET  /_cluster/health      -> index=_all  operation=cluster.health
+//	DELETE /users              -> index=users operation=delete_index
+func parseRequest(method, path string) (index, op string) {
+	index = "_all"
+	var segs []string
+	for _, s := range strings.Split(path, "/") {
+		if s != "" {
+			segs = append(segs, s)
+		}
+	}
+
+	if len(segs) == 0 {
+		if method == http.MethodHead {
+			return index, "ping"
+		}
+		return index, "info"
+	}
+
+	// Paths starting with "_" are cluster-level APIs, e.g. /_bulk, /_cluster/health
+	if strings.HasPrefix(segs[0], "_") {
+		op = strings.TrimPrefix(segs[0], "_")
+		switch op {
+		case "cluster", "nodes", "cat":
+			// Keep the sub-API (health, stats, indices...) but never IDs,
+			// which would explode label cardinality.
+			if len(segs) > 1 {
+				op += "." + strings.TrimPrefix(segs[1], "_")
+			}
+		}
+		return index, op
+	}
+
+	index = segs[0]
+	if len(segs) == 1 {
+		switch method {
+		case http.MethodPut:
+			return index, "create_index"
+		case http.MethodDelete:
+			return index, "delete_index"
+		case http.MethodHead:
+			return index, "exists_index"
+		default:
+			return index, "get_index"
+		}
+	}
+
+	op = strings.TrimPrefix(segs[1], "_")
+	switch op {
+	case "doc", "create":
+		switch method {
+		case http.MethodGet:
+			return index, "get"
+		case http.MethodHead:
+			return index, "exists"
+		case http.MethodDelete:
+			return index, "delete"
+		default:
+			return index, "index"
+		}
+	}
+	return index, op
+}
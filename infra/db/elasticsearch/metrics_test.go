@@ -0,0 +1,215 @@
+package elasticsearch
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestParseRequest(t *testing.T) {
+	tests := []struct {
+		method, path string
+		index, op    string
+	}{
+		{http.MethodGet, "/", "_all", "info"},
+		{http.MethodHead, "/", "_all", "ping"},
+		{http.MethodGet, "", "_all", "info"},
+		{http.MethodPost, "/users/_search", "users", "search"},
+		{http.MethodGet, "/users/_search/", "users", "search"},
+		{http.MethodPost, "/_search", "_all", "search"},
+		{http.MethodPut, "/users/_doc/1", "users", "index"},
+		{http.MethodPost, "/users/_doc", "users", "index"},
+		{http.MethodPut, "/users/_create/1", "users", "index"},
+		{http.MethodGet, "/users/_doc/1", "users", "get"},
+		{http.MethodHead, "/users/_doc/1", "users", "exists"},
+		{http.MethodDelete, "/users/_doc/1", "users", "delete"},
+		{http.MethodPost, "/_bulk", "_all", "bulk"},
+		{http.MethodPost, "/users/_bulk", "users", "bulk"},
+		{http.MethodPost, "/users/_update/1", "users", "update"},
+		{http.MethodGet, "/_cluster/health", "_all", "cluster.health"},
+		{http.MethodGet, "/_cluster/health/users", "_all", "cluster.health"},
+		{http.MethodGet, "/_nodes/abc123/stats", "_all", "nodes.abc123"},
+		{http.MethodGet, "/_cat/indices", "_all", "cat.indices"},
+		{http.MethodPut, "/users", "users", "create_index"},
+		{http.MethodDelete, "/users", "users", "delete_index"},
+		{http.MethodHead, "/users", "users", "exists_index"},
+		{http.MethodGet, "/users", "users", "get_index"},
+		{http.MethodPost, "/users,orders/_search", "users,orders", "search"},
+		{http.MethodPost, "/logs-2026.10.16/_search", "logs-2026.10.16", "search"},
+	}
+	for _, tt := range tests {
+		index, op := parseRequest(tt.method, tt.path)
+		if index != tt.index || op != tt.op {
+			t.Errorf("parseRequest(%s %q) = %s, %s; want %s, %s", tt.method, tt.path, index, op, tt.index, tt.op)
+		}
+	}
+}
+
+func TestNormalizeIndex(t *testing.T) {
+	tests := map[string]string{
+		"users":                           "users",
+		"_all":                            "_all",
+		"logs-*":                          "logs-*",
+		"logs-2026.10.16":                 "logs-*",
+		"logs-2026-10":                    "logs-*",
+		"metrics_20261016":                "metrics_*",
+		"app.2026.10.16.13":               "app.*",
+		"orders-000042":                   "orders-*",
+		".ds-logs-app-2026.10.16-000001":  ".ds-logs-app-*",
+		"users,orders":                    "_multi",
+		"logs-2026.10.15,logs-2026.10.16": "_multi",
+		"2026.10.16":                      "2026.10.16",
+		"v2-users":                        "v2-users",
+	}
+	for in, want := range tests {
+		if got := NormalizeIndex(in); got != want {
+			t.Errorf("NormalizeIndex(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+// recordLogger collects slow request logs.
+type recordLogger struct{ lines []string }
+
+func (l *recordLogger) Printf(format string, args ...any) {
+	l.lines = append(l.lines, fmt.Sprintf(format, args...))
+}
+
+func TestInstrumentedTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "missing") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	reg := prometheus.NewRegistry()
+	logger := &recordLogger{}
+	cfg := DefaultConfig().Apply(
+		WithMetrics(reg),
+		WithSlowThreshold(time.Millisecond),
+		WithLogger(logger),
+	)
+	client := &http.Client{Transport: newInstrumentedTransport(http.DefaultTransport, cfg)}
+
+	for _, path := range []string{
+		"/logs-2026.10.15/_search",
+		"/logs-2026.10.16/_search",
+		"/users,orders/_search",
+		"/missing/_doc/1",
+	} {
+		resp, err := client.Post(server.URL+path, "application/json", strings.NewReader(`{"query": {"match_all": {}}}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]float64{}
+	for _, mf := range families {
+		for _, m := range mf.GetMetric() {
+			got[mf.GetName()+"{"+labelString(m)+"}"] += metricValue(m)
+		}
+	}
+
+	want := map[string]float64{
+		"elasticsearch_request_duration_seconds{index=logs-*,operation=search}":        2,
+		"elasticsearch_request_duration_seconds{index=_multi,operation=search}":        1,
+		"elasticsearch_request_duration_seconds{index=missing,operation=index}":        1,
+		"elasticsearch_request_errors_total{index=missing,operation=index,status=404}": 1,
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s = %v, want %v (all: %v)", k, got[k], v, got)
+		}
+	}
+	if got["elasticsearch_slow_requests_total{index=logs-*,operation=search}"] != 2 {
+		t.Errorf("expected 2 slow searches, got %v", got)
+	}
+
+	// Slow logs keep the raw index and include the request body
+	var found bool
+	for _, line := range logger.lines {
+		if strings.Contains(line, "index=logs-2026.10.16") && strings.Contains(line, `body={"query": {"match_all": {}}}`) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected slow log with raw index and body, got %v", logger.lines)
+	}
+}
+
+func TestInstrumentedTransport_IndexLabel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	reg := prometheus.NewRegistry()
+	cfg := DefaultConfig().Apply(
+		WithMetrics(reg),
+		WithIndexLabel(func(index string) string {
+			if strings.HasPrefix(index, "tenant-") {
+				return "tenant-*"
+			}
+			return "other"
+		}),
+	)
+	client := &http.Client{Transport: newInstrumentedTransport(http.DefaultTransport, cfg)}
+	for _, path := range []string{"/tenant-a/_search", "/tenant-b/_search", "/users/_search"} {
+		resp, err := client.Get(server.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	families, _ := reg.Gather()
+	var labels []string
+	for _, mf := range families {
+		for _, m := range mf.GetMetric() {
+			labels = append(labels, labelString(m))
+		}
+	}
+	if strings.Join(labels, ";") != "index=other,operation=search;index=tenant-*,operation=search" {
+		t.Errorf("unexpected labels: %v", labels)
+	}
+}
+
+func TestNewMetrics_Reuse(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m1 := NewMetrics(reg)
+	m2 := NewMetrics(reg)
+	if m1.requestDuration != m2.requestDuration || m1.requestErrors != m2.requestErrors {
+		t.Error("second NewMetrics on the same registry should reuse the collectors")
+	}
+}
+
+func labelString(m *dto.Metric) string {
+	var parts []string
+	for _, lp := range m.GetLabel() {
+		parts = append(parts, lp.GetName()+"="+lp.GetValue())
+	}
+	return strings.Join(parts, ",")
+}
+
+func metricValue(m *dto.Metric) float64 {
+	switch {
+	case m.GetHistogram() != nil:
+		return float64(m.GetHistogram().GetSampleCount())
+	case m.GetCounter() != nil:
+		return m.GetCounter().GetValue()
+	}
+	return 0
+}
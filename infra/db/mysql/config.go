@@ -2,6 +2,8 @@ package mysql
 
 import (
 	"time"
+
+	"github.com/hexagon-codes/toolkit/util/circuit"
 )
 
 // Config MySQL 配置
@@ -14,6 +16,10 @@ type Config struct {
 	MaxIdleConns    int           // 最大空闲连接数（默认：10）
 	ConnMaxLifetime time.Duration // 连接最大生命周期（默认：1小时）
 	ConnMaxIdleTime time.Duration // 连接最大空闲时间（默认：10分钟）
+	WarmUpConns     int           // 初始化时预热的连接数，超过 MaxIdleConns 时按 MaxIdleConns 计（默认：0，不预热）
+
+	// 预编译语句缓存
+	StmtCacheSize int // 按 SQL 文本缓存的预编译语句数量，LRU 淘汰（默认：0，不缓存）
 
 	// 超时配置
 	ConnectTimeout time.Duration // 连接超时（默认：10秒）
@@ -27,6 +33,9 @@ type Config struct {
 	Loc              string // 时区（默认：Local）
 	MaxAllowedPacket int    // 最大包大小（默认：4MB）
 
+	// 熔断
+	Breaker *circuit.Breaker // 可选的熔断器，建连和所有查询经过熔断器，仅连接级错误计入失败（默认：nil，不启用）
+
 	// 日志
 	Logger Logger // 可选的日志接口
 }
@@ -3,12 +3,14 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 
-	_ "github.com/go-sql-driver/mysql" // MySQL 驱动
+	mysqldriver "github.com/go-sql-driver/mysql" // MySQL 驱动
+	"github.com/hexagon-codes/toolkit/util/circuit"
 )
 
 var (
@@ -21,6 +23,7 @@ var (
 type DB struct {
 	*sql.DB
 	config *Config
+	stmts  *stmtCache // 预编译语句缓存，未开启时为 nil
 }
 
 // Init 初始化全局 MySQL 实例
@@ -58,7 +61,7 @@ func New(config *Config) (*DB, error) {
 	}
 
 	// 打开数据库连接
-	db, err := sql.Open("mysql", dsn)
+	db, err := open(dsn, config.Breaker)
 	if err != nil {
 		if config.Logger != nil {
 			config.Logger.Error("failed to open mysql connection", err)
@@ -88,10 +91,100 @@ func New(config *Config) (*DB, error) {
 		config.Logger.Printf("mysql connected successfully: %s", maskDSN(dsn))
 	}
 
-	return &DB{
+	mdb := &DB{
 		DB:     db,
 		config: config,
-	}, nil
+	}
+
+	// 预热连接池，避免发布后首批请求集中建连
+	if config.WarmUpConns > 0 {
+		n, err := mdb.WarmUp(ctx, config.WarmUpConns)
+		if err != nil {
+			db.Close()
+			if config.Logger != nil {
+				config.Logger.Error("failed to warm up mysql pool", err)
+			}
+			return nil, err
+		}
+		if config.Logger != nil {
+			config.Logger.Printf("mysql pool warmed up: %d connections", n)
+		}
+	}
+
+	if config.StmtCacheSize > 0 {
+		mdb.stmts = newStmtCache(config.StmtCacheSize)
+	}
+
+	return mdb, nil
+}
+
+// open 打开数据库连接，配置了熔断器时所有连接和查询经过熔断器
+func open(dsn string, breaker *circuit.Breaker) (*sql.DB, error) {
+	if breaker == nil {
+		return sql.Open("mysql", dsn)
+	}
+	cfg, err := mysqldriver.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	connector, err := mysqldriver.NewConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return sql.OpenDB(circuit.WrapConnector(connector, breaker, circuit.WithSQLFailure(isConnError))), nil
+}
+
+// isConnError 判断是否为连接级错误，SQL 语法错误、约束冲突等不计入熔断
+func isConnError(err error) bool {
+	return circuit.IsConnError(err) || errors.Is(err, mysqldriver.ErrInvalidConn)
+}
+
+// WarmUp 预热连接池
+//
+// 并发建立 n 个连接并逐个 Ping 验证，完成后归还连接池作为空闲连接。
+// n 超过 MaxIdleConns 时按 MaxIdleConns 计，否则多出的连接归还时会被直接关闭。
+// 返回实际预热的连接数；任一连接失败时返回错误，已建立的连接仍会归还连接池
+func (db *DB) WarmUp(ctx context.Context, n int) (int, error) {
+	if db == nil || db.DB == nil {
+		return 0, fmt.Errorf("mysql db is nil")
+	}
+	if db.config != nil && db.config.MaxIdleConns > 0 && n > db.config.MaxIdleConns {
+		n = db.config.MaxIdleConns
+	}
+	if n <= 0 {
+		return 0, nil
+	}
+
+	conns := make([]*sql.Conn, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			conn, err := db.Conn(ctx)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			conns[i] = conn
+			errs[i] = conn.PingContext(ctx)
+		}(i)
+	}
+	wg.Wait()
+
+	// 全部建立后再归还，保证 n 个连接互不复用
+	for _, conn := range conns {
+		if conn != nil {
+			conn.Close()
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			return 0, fmt.Errorf("mysql warm up failed: %w", err)
+		}
+	}
+	return n, nil
 }
 
 // Health 健康检查
@@ -212,6 +305,9 @@ func (db *DB) Close() error {
 	if db == nil || db.DB == nil {
 		return nil
 	}
+	if db.stmts != nil {
+		db.stmts.close()
+	}
 	return db.DB.Close()
 }
 
@@ -0,0 +1,169 @@
+package mysql
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+)
+
+// stmtCache 按 SQL 文本缓存预编译语句的 LRU 缓存
+//
+// 被淘汰的语句若仍有调用方在使用，延迟到最后一个调用方释放后再关闭，
+// 避免并发执行时出现 "statement is closed" 错误
+type stmtCache struct {
+	mu    sync.Mutex
+	size  int
+	ll    *list.List // 元素为 *stmtEntry，队首为最近使用
+	items map[string]*list.Element
+}
+
+type stmtEntry struct {
+	query   string
+	stmt    *sql.Stmt
+	refs    int  // 正在使用该语句的调用数
+	evicted bool // 已被淘汰，refs 归零时关闭
+}
+
+func newStmtCache(size int) *stmtCache {
+	return &stmtCache{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+// acquire 获取 query 对应的预编译语句，未命中时预编译并放入缓存
+//
+// 使用完毕后必须调用 release
+func (c *stmtCache) acquire(ctx context.Context, db *sql.DB, query string) (*stmtEntry, error) {
+	c.mu.Lock()
+	if e, ok := c.items[query]; ok {
+		c.ll.MoveToFront(e)
+		entry := e.Value.(*stmtEntry)
+		entry.refs++
+		c.mu.Unlock()
+		return entry, nil
+	}
+	c.mu.Unlock()
+
+	// 预编译需要访问数据库，不在锁内进行
+	stmt, err := db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare statement: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// 并发预编译了同一条 SQL，使用先放入缓存的语句
+	if e, ok := c.items[query]; ok {
+		stmt.Close()
+		c.ll.MoveToFront(e)
+		entry := e.Value.(*stmtEntry)
+		entry.refs++
+		return entry, nil
+	}
+
+	entry := &stmtEntry{query: query, stmt: stmt, refs: 1}
+	c.items[query] = c.ll.PushFront(entry)
+	for c.ll.Len() > c.size {
+		c.evict(c.ll.Back())
+	}
+	return entry, nil
+}
+
+// release 释放 acquire 获取的语句
+func (c *stmtCache) release(entry *stmtEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry.refs--
+	if entry.evicted && entry.refs == 0 {
+		entry.stmt.Close()
+	}
+}
+
+// evict 从缓存中移除元素，调用方需持有锁
+func (c *stmtCache) evict(e *list.Element) {
+	entry := e.Value.(*stmtEntry)
+	c.ll.Remove(e)
+	delete(c.items, entry.query)
+	entry.evicted = true
+	if entry.refs == 0 {
+		entry.stmt.Close()
+	}
+}
+
+// len 返回缓存的语句数量
+func (c *stmtCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// close 关闭并清空所有缓存的语句
+func (c *stmtCache) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for c.ll.Len() > 0 {
+		c.evict(c.ll.Back())
+	}
+}
+
+// ExecCached 使用缓存的预编译语句执行 Exec
+//
+// 未开启语句缓存（Config.StmtCacheSize <= 0）时等价于 ExecContext
+func (db *DB) ExecCached(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	if db.stmts == nil {
+		return db.ExecContext(ctx, query, args...)
+	}
+
+	entry, err := db.stmts.acquire(ctx, db.DB, query)
+	if err != nil {
+		return nil, err
+	}
+	defer db.stmts.release(entry)
+	return entry.stmt.ExecContext(ctx, args...)
+}
+
+// QueryCached 使用缓存的预编译语句执行 Query
+//
+// 返回的 Rows 不受语句淘汰影响，调用者仍需自行 Close。
+// 未开启语句缓存时等价于 QueryContext
+func (db *DB) QueryCached(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if db.stmts == nil {
+		return db.QueryContext(ctx, query, args...)
+	}
+
+	entry, err := db.stmts.acquire(ctx, db.DB, query)
+	if err != nil {
+		return nil, err
+	}
+	defer db.stmts.release(entry)
+	return entry.stmt.QueryContext(ctx, args...)
+}
+
+// QueryRowCached 使用缓存的预编译语句执行 QueryRow
+//
+// 预编译失败或未开启语句缓存时等价于 QueryRowContext，错误在 Scan 时返回
+//
+// 示例：
+//
+//	var name string
+//	err := db.QueryRowCached(ctx, "SELECT name FROM users WHERE id = ?", 1).Scan(&name)
+func (db *DB) QueryRowCached(ctx context.Context, query string, args ...any) *sql.Row {
+	if db.stmts == nil {
+		return db.QueryRowContext(ctx, query, args...)
+	}
+
+	entry, err := db.stmts.acquire(ctx, db.DB, query)
+	if err != nil {
+		// sql.Row 无法在包外构造带错误的实例，回退到普通查询
+		return db.QueryRowContext(ctx, query, args...)
+	}
+	defer db.stmts.release(entry)
+	return entry.stmt.QueryRowContext(ctx, args...)
+}
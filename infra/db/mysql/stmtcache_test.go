@@ -0,0 +1,257 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// fakeDriver 用于在无真实数据库时测试连接池预热和语句缓存
+type fakeDriver struct {
+	opens    atomic.Int32
+	prepares atomic.Int32
+	closes   atomic.Int32
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) {
+	d.opens.Add(1)
+	return &fakeConn{d: d}, nil
+}
+
+type fakeConn struct{ d *fakeDriver }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	c.d.prepares.Add(1)
+	return &fakeStmt{d: c.d}, nil
+}
+func (c *fakeConn) Close() error               { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)  { return nil, driver.ErrSkip }
+func (c *fakeConn) Ping(context.Context) error { return nil }
+
+type fakeStmt struct{ d *fakeDriver }
+
+func (s *fakeStmt) Close() error  { s.d.closes.Add(1); return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+func (s *fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(1), nil
+}
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) { return &fakeRows{}, nil }
+
+type fakeRows struct{ done bool }
+
+func (r *fakeRows) Columns() []string { return []string{"v"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = int64(1)
+	return nil
+}
+
+var fakeDriverSeq atomic.Int32
+
+func newFakeDB(t *testing.T, cacheSize int) (*DB, *fakeDriver) {
+	t.Helper()
+
+	d := &fakeDriver{}
+	name := "fakemysql" + strconv.Itoa(int(fakeDriverSeq.Add(1)))
+	sql.Register(name, d)
+	sqlDB, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatalf("open fake db: %v", err)
+	}
+	sqlDB.SetMaxIdleConns(10)
+
+	db := &DB{DB: sqlDB, config: &Config{MaxIdleConns: 10}}
+	if cacheSize > 0 {
+		db.stmts = newStmtCache(cacheSize)
+	}
+	return db, d
+}
+
+func TestWarmUp(t *testing.T) {
+	db, d := newFakeDB(t, 0)
+	defer db.Close()
+
+	n, err := db.WarmUp(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("WarmUp failed: %v", err)
+	}
+	if n != 5 {
+		t.Errorf("expected 5 warmed connections, got %d", n)
+	}
+	if got := d.opens.Load(); got != 5 {
+		t.Errorf("expected 5 opened connections, got %d", got)
+	}
+	if idle := db.Stats().Idle; idle != 5 {
+		t.Errorf("expected 5 idle connections, got %d", idle)
+	}
+}
+
+func TestWarmUp_CappedByMaxIdle(t *testing.T) {
+	db, _ := newFakeDB(t, 0)
+	defer db.Close()
+
+	n, err := db.WarmUp(context.Background(), 50)
+	if err != nil {
+		t.Fatalf("WarmUp failed: %v", err)
+	}
+	if n != 10 {
+		t.Errorf("expected warm up capped at 10, got %d", n)
+	}
+}
+
+func TestWarmUp_NilDB(t *testing.T) {
+	var db *DB
+	if _, err := db.WarmUp(context.Background(), 1); err == nil {
+		t.Error("expected error for nil db")
+	}
+}
+
+func TestStmtCache_Hit(t *testing.T) {
+	db, d := newFakeDB(t, 2)
+	defer db.Close()
+	ctx := context.Background()
+
+	for range 3 {
+		if _, err := db.ExecCached(ctx, "UPDATE t SET v = ?", 1); err != nil {
+			t.Fatalf("ExecCached failed: %v", err)
+		}
+	}
+	if got := d.prepares.Load(); got != 1 {
+		t.Errorf("expected 1 prepare, got %d", got)
+	}
+	if got := db.stmts.len(); got != 1 {
+		t.Errorf("expected 1 cached stmt, got %d", got)
+	}
+}
+
+func TestStmtCache_LRUEviction(t *testing.T) {
+	db, d := newFakeDB(t, 2)
+	defer db.Close()
+	ctx := context.Background()
+
+	exec := func(q string) {
+		t.Helper()
+		if _, err := db.ExecCached(ctx, q); err != nil {
+			t.Fatalf("ExecCached(%q) failed: %v", q, err)
+		}
+	}
+
+	exec("q1")
+	exec("q2")
+	exec("q1") // q1 变为最近使用
+	exec("q3") // 淘汰 q2
+
+	if got := db.stmts.len(); got != 2 {
+		t.Errorf("expected 2 cached stmts, got %d", got)
+	}
+	if got := d.closes.Load(); got != 1 {
+		t.Errorf("expected 1 closed stmt after eviction, got %d", got)
+	}
+
+	before := d.prepares.Load()
+	exec("q1")
+	if got := d.prepares.Load(); got != before {
+		t.Error("expected q1 to stay cached")
+	}
+	exec("q2")
+	if got := d.prepares.Load(); got != before+1 {
+		t.Error("expected q2 to be prepared again after eviction")
+	}
+}
+
+func TestStmtCache_EvictInUse(t *testing.T) {
+	db, d := newFakeDB(t, 1)
+	defer db.Close()
+	ctx := context.Background()
+
+	entry, err := db.stmts.acquire(ctx, db.DB, "q1")
+	if err != nil {
+		t.Fatalf("acquire failed: %v", err)
+	}
+	if _, err := db.ExecCached(ctx, "q2"); err != nil {
+		t.Fatalf("ExecCached failed: %v", err)
+	}
+
+	// q1 已被淘汰但仍在使用，不应关闭
+	if _, err := entry.stmt.ExecContext(ctx); err != nil {
+		t.Fatalf("evicted in-use stmt should still work: %v", err)
+	}
+	closes := d.closes.Load()
+	db.stmts.release(entry)
+	if got := d.closes.Load(); got <= closes {
+		t.Error("expected evicted stmt to be closed after release")
+	}
+}
+
+func TestStmtCache_Query(t *testing.T) {
+	db, _ := newFakeDB(t, 4)
+	defer db.Close()
+	ctx := context.Background()
+
+	rows, err := db.QueryCached(ctx, "SELECT v FROM t")
+	if err != nil {
+		t.Fatalf("QueryCached failed: %v", err)
+	}
+	var n int
+	for rows.Next() {
+		if err := rows.Scan(&n); err != nil {
+			t.Fatalf("scan failed: %v", err)
+		}
+	}
+	rows.Close()
+	if n != 1 {
+		t.Errorf("expected 1, got %d", n)
+	}
+
+	var v int
+	if err := db.QueryRowCached(ctx, "SELECT v FROM t").Scan(&v); err != nil {
+		t.Fatalf("QueryRowCached failed: %v", err)
+	}
+	if v != 1 {
+		t.Errorf("expected 1, got %d", v)
+	}
+}
+
+func TestStmtCache_Concurrent(t *testing.T) {
+	db, _ := newFakeDB(t, 2)
+	defer db.Close()
+	ctx := context.Background()
+
+	queries := []string{"q1", "q2", "q3", "q4"}
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, err := db.ExecCached(ctx, queries[i%len(queries)]); err != nil {
+				t.Errorf("ExecCached failed: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if got := db.stmts.len(); got > 2 {
+		t.Errorf("expected at most 2 cached stmts, got %d", got)
+	}
+}
+
+func TestStmtCache_Disabled(t *testing.T) {
+	db, _ := newFakeDB(t, 0)
+	defer db.Close()
+
+	if _, err := db.ExecCached(context.Background(), "q1"); err != nil {
+		t.Fatalf("ExecCached failed: %v", err)
+	}
+	if db.stmts != nil {
+		t.Error("expected no stmt cache")
+	}
+}
@@ -16,7 +16,18 @@ type Collector struct {
 	goGoroutines   *PrometheusGauge
 	goMemAlloc     *PrometheusGauge
 	goMemSys       *PrometheusGauge
+	goHeapObjects  *PrometheusGauge
+	goHeapInuse    *PrometheusGauge
+	goMaxProcs     *PrometheusGauge
 	goGCPauseTotal *PrometheusCounter
+	goGCCycles     *PrometheusCounter
+
+	// 进程指标（仅在支持 /proc 的系统上注册）
+	process *processMetrics
+
+	// 累计值的上次读数，用于计算 Counter 增量
+	lastGCPause uint64
+	lastNumGC   uint32
 
 	mu   sync.RWMutex
 	done chan struct{} // 用于停止运行时指标收集 goroutine
@@ -32,17 +43,25 @@ func NewCollector(registry *Registry, namespace, subsystem string) *Collector {
 	}
 
 	c.initRuntimeMetrics()
+	c.initProcessMetrics()
+	c.initBuildInfo()
 	c.startRuntimeCollector()
 
 	return c
 }
 
-// initRuntimeMetrics 初始化运行时指标
-func (c *Collector) initRuntimeMetrics() {
+// metricPrefix 返回内置指标的前缀
+func (c *Collector) metricPrefix() string {
 	prefix := c.namespace
 	if c.subsystem != "" {
 		prefix += "_" + c.subsystem
 	}
+	return prefix
+}
+
+// initRuntimeMetrics 初始化运行时指标
+func (c *Collector) initRuntimeMetrics() {
+	prefix := c.metricPrefix()
 
 	// Go 运行时指标
 	c.goGoroutines = c.registry.Gauge(
@@ -57,16 +76,33 @@ func (c *Collector) initRuntimeMetrics() {
 		prefix+"_go_memstats_sys_bytes",
 		"Number of bytes obtained from system",
 	)
+	c.goHeapObjects = c.registry.Gauge(
+		prefix+"_go_memstats_heap_objects",
+		"Number of allocated heap objects",
+	)
+	c.goHeapInuse = c.registry.Gauge(
+		prefix+"_go_memstats_heap_inuse_bytes",
+		"Number of heap bytes in in-use spans",
+	)
+	c.goMaxProcs = c.registry.Gauge(
+		prefix+"_go_gomaxprocs",
+		"Value of GOMAXPROCS",
+	)
 	c.goGCPauseTotal = c.registry.Counter(
 		prefix+"_go_gc_pause_seconds_total",
 		"Total GC pause time in seconds",
 	)
+	c.goGCCycles = c.registry.Counter(
+		prefix+"_go_gc_cycles_total",
+		"Number of completed GC cycles",
+	)
 }
 
 // startRuntimeCollector 启动运行时指标收集
 func (c *Collector) startRuntimeCollector() {
+	c.Collect()
+
 	go func() {
-		var lastGCPause uint64
 		ticker := time.NewTicker(15 * time.Second)
 		defer ticker.Stop()
 
@@ -75,24 +111,44 @@ func (c *Collector) startRuntimeCollector() {
 			case <-c.done:
 				return
 			case <-ticker.C:
-				var m runtime.MemStats
-				runtime.ReadMemStats(&m)
-
-				c.goGoroutines.Set(float64(runtime.NumGoroutine()))
-				c.goMemAlloc.Set(float64(m.Alloc))
-				c.goMemSys.Set(float64(m.Sys))
-
-				// GC 暂停时间
-				gcPause := m.PauseTotalNs
-				if gcPause > lastGCPause {
-					c.goGCPauseTotal.Add(float64(gcPause-lastGCPause) / 1e9)
-					lastGCPause = gcPause
-				}
+				c.Collect()
 			}
 		}
 	}()
 }
 
+// Collect 立即刷新运行时和进程指标
+//
+// 后台每 15 秒自动刷新一次；推送到 Pushgateway 等需要即时数据的场景可手动调用
+func (c *Collector) Collect() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+
+	c.goGoroutines.Set(float64(runtime.NumGoroutine()))
+	c.goMemAlloc.Set(float64(m.Alloc))
+	c.goMemSys.Set(float64(m.Sys))
+	c.goHeapObjects.Set(float64(m.HeapObjects))
+	c.goHeapInuse.Set(float64(m.HeapInuse))
+	c.goMaxProcs.Set(float64(runtime.GOMAXPROCS(0)))
+
+	// GC 暂停时间
+	if m.PauseTotalNs > c.lastGCPause {
+		c.goGCPauseTotal.Add(float64(m.PauseTotalNs-c.lastGCPause) / 1e9)
+		c.lastGCPause = m.PauseTotalNs
+	}
+	if m.NumGC > c.lastNumGC {
+		c.goGCCycles.Add(float64(m.NumGC - c.lastNumGC))
+		c.lastNumGC = m.NumGC
+	}
+
+	if c.process != nil {
+		c.process.collect()
+	}
+}
+
 // Stop 停止运行时指标收集 goroutine
 func (c *Collector) Stop() {
 	close(c.done)
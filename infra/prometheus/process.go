@@ -0,0 +1,198 @@
+package prometheus
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"runtime/debug"
+	"strconv"
+	"strings"
+)
+
+// userHZ /proc 中 CPU 时间的单位（每秒时钟滴答数），Linux 上固定为 100
+const userHZ = 100
+
+// processStats 进程资源快照
+type processStats struct {
+	cpuSeconds float64 // 用户态 + 内核态 CPU 时间（秒）
+	rssBytes   float64 // 常驻内存
+	vsizeBytes float64 // 虚拟内存
+	openFDs    float64 // 打开的文件描述符数
+	maxFDs     float64 // 文件描述符上限（软限制），未知时为 0
+	startTime  float64 // 进程启动时间（Unix 秒）
+}
+
+// readProcessStats 从 /proc 读取当前进程的资源统计
+//
+// 不支持 /proc 的系统（macOS、Windows）返回错误
+func readProcessStats() (processStats, error) {
+	var ps processStats
+
+	data, err := os.ReadFile("/proc/self/stat")
+	if err != nil {
+		return ps, err
+	}
+	// 进程名可能包含空格和括号，从最后一个 ')' 之后开始解析
+	s := string(data)
+	i := strings.LastIndexByte(s, ')')
+	if i < 0 || i+2 > len(s) {
+		return ps, errors.New("prometheus: malformed /proc/self/stat")
+	}
+	// fields[0] 对应 proc(5) 中的第 3 个字段 (state)
+	fields := strings.Fields(s[i+2:])
+	if len(fields) < 22 {
+		return ps, errors.New("prometheus: malformed /proc/self/stat")
+	}
+	utime, _ := strconv.ParseFloat(fields[11], 64)
+	stime, _ := strconv.ParseFloat(fields[12], 64)
+	starttime, _ := strconv.ParseFloat(fields[19], 64)
+	vsize, _ := strconv.ParseFloat(fields[20], 64)
+	rss, _ := strconv.ParseFloat(fields[21], 64)
+
+	ps.cpuSeconds = (utime + stime) / userHZ
+	ps.vsizeBytes = vsize
+	ps.rssBytes = rss * float64(os.Getpagesize())
+
+	if btime, err := readBootTime(); err == nil {
+		ps.startTime = btime + starttime/userHZ
+	}
+	if entries, err := os.ReadDir("/proc/self/fd"); err == nil {
+		ps.openFDs = float64(len(entries))
+	}
+	ps.maxFDs = readMaxFDs()
+
+	return ps, nil
+}
+
+// readBootTime 读取系统启动时间（Unix 秒）
+func readBootTime() (float64, error) {
+	f, err := os.Open("/proc/stat")
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if v, ok := strings.CutPrefix(scanner.Text(), "btime "); ok {
+			return strconv.ParseFloat(strings.TrimSpace(v), 64)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, errors.New("prometheus: btime not found in /proc/stat")
+}
+
+// readMaxFDs 读取文件描述符的软限制，读取失败或无限制时返回 0
+func readMaxFDs() float64 {
+	data, err := os.ReadFile("/proc/self/limits")
+	if err != nil {
+		return 0
+	}
+	for line := range strings.SplitSeq(string(data), "\n") {
+		if !strings.HasPrefix(line, "Max open files") {
+			continue
+		}
+		fields := strings.Fields(strings.TrimPrefix(line, "Max open files"))
+		if len(fields) == 0 {
+			return 0
+		}
+		v, _ := strconv.ParseFloat(fields[0], 64) // "unlimited" 解析失败时为 0
+		return v
+	}
+	return 0
+}
+
+// processMetrics 进程指标
+type processMetrics struct {
+	cpuSeconds *PrometheusCounter
+	rss        *PrometheusGauge
+	vsize      *PrometheusGauge
+	openFDs    *PrometheusGauge
+	maxFDs     *PrometheusGauge
+	startTime  *PrometheusGauge
+
+	lastCPU float64
+}
+
+// initProcessMetrics 初始化进程指标，不支持 /proc 的系统上跳过
+func (c *Collector) initProcessMetrics() {
+	if _, err := readProcessStats(); err != nil {
+		return
+	}
+
+	prefix := c.metricPrefix()
+	c.process = &processMetrics{
+		cpuSeconds: c.registry.Counter(
+			prefix+"_process_cpu_seconds_total",
+			"Total user and system CPU time spent in seconds",
+		),
+		rss: c.registry.Gauge(
+			prefix+"_process_resident_memory_bytes",
+			"Resident memory size in bytes",
+		),
+		vsize: c.registry.Gauge(
+			prefix+"_process_virtual_memory_bytes",
+			"Virtual memory size in bytes",
+		),
+		openFDs: c.registry.Gauge(
+			prefix+"_process_open_fds",
+			"Number of open file descriptors",
+		),
+		maxFDs: c.registry.Gauge(
+			prefix+"_process_max_fds",
+			"Maximum number of open file descriptors",
+		),
+		startTime: c.registry.Gauge(
+			prefix+"_process_start_time_seconds",
+			"Start time of the process since unix epoch in seconds",
+		),
+	}
+}
+
+// collect 刷新进程指标，调用方需持有 Collector.mu
+func (p *processMetrics) collect() {
+	ps, err := readProcessStats()
+	if err != nil {
+		return
+	}
+
+	if ps.cpuSeconds > p.lastCPU {
+		p.cpuSeconds.Add(ps.cpuSeconds - p.lastCPU)
+		p.lastCPU = ps.cpuSeconds
+	}
+	p.rss.Set(ps.rssBytes)
+	p.vsize.Set(ps.vsizeBytes)
+	p.openFDs.Set(ps.openFDs)
+	p.maxFDs.Set(ps.maxFDs)
+	p.startTime.Set(ps.startTime)
+}
+
+// initBuildInfo 注册构建信息指标，值恒为 1，版本信息通过标签携带
+//
+// 标签: path（主模块路径）、version（主模块版本）、revision（VCS 提交）、goversion
+func (c *Collector) initBuildInfo() {
+	path, version, revision := "unknown", "unknown", "unknown"
+	goVersion := "unknown"
+	if info, ok := debug.ReadBuildInfo(); ok {
+		goVersion = info.GoVersion
+		if info.Main.Path != "" {
+			path = info.Main.Path
+		}
+		if info.Main.Version != "" {
+			version = info.Main.Version
+		}
+		for _, s := range info.Settings {
+			if s.Key == "vcs.revision" && s.Value != "" {
+				revision = s.Value
+			}
+		}
+	}
+
+	c.registry.Gauge(
+		c.metricPrefix()+"_build_info",
+		"Build information about the main module, value is always 1",
+		"path", "version", "revision", "goversion",
+	).Set(1, path, version, revision, goVersion)
+}
@@ -1,6 +1,12 @@
 package prometheus
 
 import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
 	"strings"
 	"testing"
 	"time"
@@ -263,3 +269,138 @@ func TestDefaultQuantiles(t *testing.T) {
 		t.Error("expected default quantiles to be non-empty")
 	}
 }
+
+func TestCollectorBuiltinMetrics(t *testing.T) {
+	registry := NewRegistry()
+	collector := NewCollector(registry, "app", "")
+	defer collector.Stop()
+
+	// 创建时立即采集一次，无需等待后台刷新
+	output := registry.Gather()
+	for _, name := range []string{
+		"app_go_goroutines",
+		"app_go_memstats_heap_objects",
+		"app_go_gomaxprocs",
+		"app_go_gc_cycles_total",
+		"app_build_info",
+	} {
+		if !strings.Contains(output, name) {
+			t.Errorf("expected %s in output", name)
+		}
+	}
+	if !strings.Contains(output, `goversion="`+runtime.Version()+`"`) {
+		t.Error("expected goversion label in build info")
+	}
+
+	if runtime.GOOS == "linux" {
+		for _, name := range []string{
+			"app_process_cpu_seconds_total",
+			"app_process_resident_memory_bytes",
+			"app_process_open_fds",
+			"app_process_start_time_seconds",
+		} {
+			if !strings.Contains(output, name) {
+				t.Errorf("expected %s in output", name)
+			}
+		}
+	}
+}
+
+func TestReadProcessStats(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("requires /proc")
+	}
+
+	ps, err := readProcessStats()
+	if err != nil {
+		t.Fatalf("readProcessStats failed: %v", err)
+	}
+	if ps.rssBytes <= 0 || ps.vsizeBytes <= 0 {
+		t.Errorf("expected positive memory stats, got %+v", ps)
+	}
+	if ps.openFDs <= 0 {
+		t.Errorf("expected open fds, got %v", ps.openFDs)
+	}
+	if ps.startTime <= 0 || ps.startTime > float64(time.Now().Unix()+1) {
+		t.Errorf("unexpected start time %v", ps.startTime)
+	}
+}
+
+func TestPusher(t *testing.T) {
+	var gotMethod, gotPath, gotBody, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotMethod, gotPath, gotBody = r.Method, r.URL.EscapedPath(), string(body)
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	registry := NewRegistry()
+	registry.Counter("jobs_processed_total", "Processed jobs").Add(3)
+
+	pusher := NewPusher(registry, server.URL+"/", "nightly",
+		WithGrouping("instance", "host-1"),
+		WithGrouping("path", "/data/in"),
+		WithBasicAuth("user", "pass"),
+	)
+	if err := pusher.Push(context.Background()); err != nil {
+		t.Fatalf("Push failed: %v", err)
+	}
+	if gotMethod != http.MethodPut {
+		t.Errorf("expected PUT, got %s", gotMethod)
+	}
+	expected := "/metrics/job/nightly/instance/host-1/path@base64/L2RhdGEvaW4"
+	if gotPath != expected {
+		t.Errorf("expected path %s, got %s", expected, gotPath)
+	}
+	if !strings.Contains(gotBody, "jobs_processed_total 3") {
+		t.Errorf("unexpected body: %s", gotBody)
+	}
+	if !strings.HasPrefix(gotAuth, "Basic ") {
+		t.Errorf("expected basic auth header, got %q", gotAuth)
+	}
+
+	if err := pusher.Add(context.Background()); err != nil || gotMethod != http.MethodPost {
+		t.Errorf("Add failed: %v, method %s", err, gotMethod)
+	}
+	if err := pusher.Delete(context.Background()); err != nil || gotMethod != http.MethodDelete || gotBody != "" {
+		t.Errorf("Delete failed: %v, method %s", err, gotMethod)
+	}
+}
+
+func TestPusherErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad metrics", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	err := NewPusher(NewRegistry(), server.URL, "job").Push(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "bad metrics") {
+		t.Errorf("expected status error, got %v", err)
+	}
+
+	err = NewPusher(NewRegistry(), server.URL, "").Push(context.Background())
+	if !errors.Is(err, ErrEmptyJob) {
+		t.Errorf("expected ErrEmptyJob, got %v", err)
+	}
+}
+
+func TestExporterPusher(t *testing.T) {
+	var gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+	}))
+	defer server.Close()
+
+	exporter := NewExporter(WithNamespace("cron"))
+	defer exporter.Collector().Stop()
+
+	if err := exporter.Pusher(server.URL, "report").Push(context.Background()); err != nil {
+		t.Fatalf("Push failed: %v", err)
+	}
+	if !strings.Contains(gotBody, "cron_go_goroutines") {
+		t.Error("expected runtime metrics in pushed body")
+	}
+}
@@ -0,0 +1,196 @@
+package prometheus
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrEmptyJob 推送时未指定 job 名称
+var ErrEmptyJob = errors.New("prometheus: push job name is empty")
+
+// Pusher 将指标推送到 Prometheus Pushgateway
+//
+// 适用于定时任务、批处理等无法被 Prometheus 抓取的短生命周期进程。
+//
+// 使用示例:
+//
+//	exporter := prometheus.NewExporter(prometheus.WithNamespace("cron"))
+//	defer exporter.Collector().Stop()
+//
+//	runJob()
+//
+//	pusher := exporter.Pusher("http://pushgateway:9091", "daily_report",
+//	    prometheus.WithGrouping("instance", hostname),
+//	)
+//	if err := pusher.Push(ctx); err != nil {
+//	    log.Printf("push metrics failed: %v", err)
+//	}
+type Pusher struct {
+	// url Pushgateway 地址
+	url string
+
+	// job 任务名称
+	job string
+
+	// grouping 分组标签（按添加顺序）
+	grouping [][2]string
+
+	// registry 指标注册表
+	registry *Registry
+
+	// collector 推送前刷新运行时指标（可选）
+	collector *Collector
+
+	// client HTTP 客户端
+	client *http.Client
+
+	// header 额外的请求头
+	header http.Header
+}
+
+// PushOption 推送选项
+type PushOption func(*Pusher)
+
+// NewPusher 创建 Pushgateway 推送器
+func NewPusher(registry *Registry, gatewayURL, job string, opts ...PushOption) *Pusher {
+	p := &Pusher{
+		url:      strings.TrimRight(gatewayURL, "/"),
+		job:      job,
+		registry: registry,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		header:   make(http.Header),
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// WithGrouping 添加分组标签，同一 job 下不同分组的指标互不覆盖
+func WithGrouping(name, value string) PushOption {
+	return func(p *Pusher) {
+		p.grouping = append(p.grouping, [2]string{name, value})
+	}
+}
+
+// WithPushClient 设置 HTTP 客户端
+func WithPushClient(client *http.Client) PushOption {
+	return func(p *Pusher) {
+		p.client = client
+	}
+}
+
+// WithPushHeader 设置额外的请求头
+func WithPushHeader(key, value string) PushOption {
+	return func(p *Pusher) {
+		p.header.Set(key, value)
+	}
+}
+
+// WithBasicAuth 设置 Basic 认证
+func WithBasicAuth(username, password string) PushOption {
+	return func(p *Pusher) {
+		auth := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+		p.header.Set("Authorization", "Basic "+auth)
+	}
+}
+
+// Pusher 创建使用该导出器注册表的推送器，推送前自动刷新运行时和进程指标
+func (e *Exporter) Pusher(gatewayURL, job string, opts ...PushOption) *Pusher {
+	p := NewPusher(e.registry, gatewayURL, job, opts...)
+	p.collector = e.collector
+	return p
+}
+
+// Push 推送指标，替换该分组下已有的全部指标（HTTP PUT）
+func (p *Pusher) Push(ctx context.Context) error {
+	return p.send(ctx, http.MethodPut, true)
+}
+
+// Add 推送指标，只替换同名指标，保留该分组下的其他指标（HTTP POST）
+func (p *Pusher) Add(ctx context.Context) error {
+	return p.send(ctx, http.MethodPost, true)
+}
+
+// Delete 删除该分组下的全部指标
+func (p *Pusher) Delete(ctx context.Context) error {
+	return p.send(ctx, http.MethodDelete, false)
+}
+
+// send 发送请求
+func (p *Pusher) send(ctx context.Context, method string, withBody bool) error {
+	if p.job == "" {
+		return ErrEmptyJob
+	}
+
+	var body io.Reader
+	if withBody {
+		if p.collector != nil {
+			p.collector.Collect()
+		}
+		body = strings.NewReader(p.registry.Gather())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p.endpoint(), body)
+	if err != nil {
+		return err
+	}
+	for k, v := range p.header {
+		req.Header[k] = v
+	}
+	if withBody {
+		req.Header.Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("prometheus: push to %s: %w", p.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("prometheus: push to %s: unexpected status %d: %s",
+			p.url, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// endpoint 构建推送地址: <url>/metrics/job/<job>{/<label>/<value>}
+func (p *Pusher) endpoint() string {
+	var sb strings.Builder
+	sb.WriteString(p.url)
+	sb.WriteString("/metrics")
+	writePathLabel(&sb, "job", p.job)
+	for _, g := range p.grouping {
+		writePathLabel(&sb, g[0], g[1])
+	}
+	return sb.String()
+}
+
+// writePathLabel 写入一个路径标签，值为空或包含 '/' 时使用 base64 编码
+func writePathLabel(sb *strings.Builder, name, value string) {
+	sb.WriteByte('/')
+	if value == "" || strings.Contains(value, "/") {
+		sb.WriteString(name)
+		sb.WriteString("@base64/")
+		if value == "" {
+			sb.WriteByte('=') // Pushgateway 约定空值编码为 "="
+			return
+		}
+		sb.WriteString(base64.RawURLEncoding.EncodeToString([]byte(value)))
+		return
+	}
+	sb.WriteString(name)
+	sb.WriteByte('/')
+	sb.WriteString(url.PathEscape(value))
+}
@@ -9,6 +9,14 @@
 //	)
 //	http.Handle("/metrics", exporter.Handler())
 //	http.ListenAndServe(":9090", nil)
+//
+// 内置指标: Go 运行时（goroutine、内存、GC）、进程（CPU、内存、文件描述符，
+// 仅 Linux）以及构建信息 build_info。
+//
+// 短生命周期任务无法被抓取时，可推送到 Pushgateway:
+//
+//	pusher := exporter.Pusher("http://pushgateway:9091", "daily_report")
+//	pusher.Push(ctx)
 package prometheus
 
 import (
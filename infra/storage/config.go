@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Config S3 兼容对象存储配置
+//
+// 适用于 AWS S3、阿里云 OSS（S3 兼容接口）、MinIO、腾讯云 COS 等
+type Config struct {
+	// 基础配置
+	Endpoint        string // 服务地址，如 https://s3.amazonaws.com、http://127.0.0.1:9000
+	Region          string // 区域（默认：us-east-1）
+	Bucket          string // 存储桶名称
+	AccessKeyID     string // 访问密钥 ID
+	SecretAccessKey string // 访问密钥
+	SessionToken    string // 临时凭证 Token（可选）
+
+	// UsePathStyle 使用路径风格访问（endpoint/bucket/key），MinIO 通常需要开启；
+	// 关闭时使用虚拟主机风格（bucket.endpoint/key）
+	UsePathStyle bool
+
+	// 分片上传配置
+	PartSize           int64 // 分片大小（默认：16MB，最小 5MB）
+	MultipartThreshold int64 // 超过该大小使用分片上传（默认：64MB）
+
+	// 超时配置
+	Timeout time.Duration // 单次请求超时（默认：0，由 ctx 控制）
+
+	// HTTPClient 自定义 HTTP 客户端（可选）
+	HTTPClient *http.Client
+
+	// 日志
+	Logger Logger // 可选的日志接口
+}
+
+const (
+	minPartSize        = 5 << 20
+	defaultPartSize    = 16 << 20
+	defaultThreshold   = 64 << 20
+	defaultRegion      = "us-east-1"
+	defaultListMaxKeys = 1000
+)
+
+// DefaultConfig 返回默认配置
+func DefaultConfig(endpoint, bucket, accessKeyID, secretAccessKey string) *Config {
+	return &Config{
+		Endpoint:           endpoint,
+		Region:             defaultRegion,
+		Bucket:             bucket,
+		AccessKeyID:        accessKeyID,
+		SecretAccessKey:    secretAccessKey,
+		PartSize:           defaultPartSize,
+		MultipartThreshold: defaultThreshold,
+	}
+}
+
+// Validate 校验配置并填充默认值
+func (c *Config) Validate() error {
+	if c.Endpoint == "" {
+		return fmt.Errorf("%w: endpoint is empty", ErrInvalidConfig)
+	}
+	if c.Bucket == "" {
+		return fmt.Errorf("%w: bucket is empty", ErrInvalidConfig)
+	}
+	if c.AccessKeyID == "" || c.SecretAccessKey == "" {
+		return fmt.Errorf("%w: credentials are empty", ErrInvalidConfig)
+	}
+	if _, err := c.endpointURL(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+	}
+	if c.Region == "" {
+		c.Region = defaultRegion
+	}
+	if c.PartSize <= 0 {
+		c.PartSize = defaultPartSize
+	}
+	if c.PartSize < minPartSize {
+		c.PartSize = minPartSize
+	}
+	if c.MultipartThreshold <= 0 {
+		c.MultipartThreshold = defaultThreshold
+	}
+	return nil
+}
+
+// endpointURL 解析 endpoint，未指定 scheme 时默认使用 https
+func (c *Config) endpointURL() (*url.URL, error) {
+	endpoint := c.Endpoint
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %q", c.Endpoint)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return u, nil
+}
+
+// Logger 日志接口
+type Logger interface {
+	// Printf 格式化输出日志
+	Printf(format string, args ...any)
+
+	// Error 输出错误日志
+	Error(msg string, err error)
+}
@@ -0,0 +1,195 @@
+package storage
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// maxParts S3 单次分片上传允许的最大分片数
+const maxParts = 10000
+
+// putMultipart 分片上传
+//
+// 按 PartSize 依次读取分片上传，任一分片失败时中止上传并清理已上传分片。
+// 内存占用上限为一个分片大小。
+func (c *S3Client) putMultipart(ctx context.Context, key string, r io.Reader, o *putOptions) (*ObjectInfo, error) {
+	uploadID, err := c.createMultipartUpload(ctx, key, o)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, size, err := c.uploadParts(ctx, key, uploadID, r)
+	if err == nil {
+		var etag string
+		etag, err = c.completeMultipartUpload(ctx, key, uploadID, parts)
+		if err == nil {
+			return &ObjectInfo{
+				Key:          key,
+				Size:         size,
+				ETag:         etag,
+				ContentType:  o.contentType,
+				LastModified: c.now(),
+				Metadata:     o.metadata,
+			}, nil
+		}
+	}
+
+	// 使用独立 context 中止上传，避免 ctx 已取消导致残留分片
+	if abortErr := c.abortMultipartUpload(context.WithoutCancel(ctx), key, uploadID); abortErr != nil && c.config.Logger != nil {
+		c.config.Logger.Error("storage abort multipart upload failed", abortErr)
+	}
+	return nil, err
+}
+
+// uploadParts 依次上传所有分片，返回分片列表与总大小
+func (c *S3Client) uploadParts(ctx context.Context, key, uploadID string, r io.Reader) ([]completedPart, int64, error) {
+	buf := make([]byte, c.config.PartSize)
+	var (
+		parts []completedPart
+		total int64
+	)
+
+	for partNumber := 1; ; partNumber++ {
+		n, readErr := io.ReadFull(r, buf)
+		if readErr != nil && !errors.Is(readErr, io.EOF) && !errors.Is(readErr, io.ErrUnexpectedEOF) {
+			return nil, 0, fmt.Errorf("storage: read part %d: %w", partNumber, readErr)
+		}
+		// 空对象也需要上传一个空分片
+		if n == 0 && partNumber > 1 {
+			break
+		}
+		if partNumber > maxParts {
+			return nil, 0, fmt.Errorf("storage: object exceeds %d parts, increase PartSize", maxParts)
+		}
+
+		etag, err := c.uploadPart(ctx, key, uploadID, partNumber, buf[:n])
+		if err != nil {
+			return nil, 0, err
+		}
+		parts = append(parts, completedPart{PartNumber: partNumber, ETag: etag})
+		total += int64(n)
+
+		if readErr != nil {
+			break
+		}
+	}
+	return parts, total, nil
+}
+
+// createMultipartUpload 初始化分片上传
+func (c *S3Client) createMultipartUpload(ctx context.Context, key string, o *putOptions) (string, error) {
+	req, err := c.newRequest(ctx, http.MethodPost, key, url.Values{"uploads": {""}}, nil)
+	if err != nil {
+		return "", err
+	}
+	applyPutOptions(req, o)
+
+	resp, err := c.do(req, emptyPayloadHash)
+	if err != nil {
+		return "", err
+	}
+	defer drainAndClose(resp.Body)
+
+	var out struct {
+		UploadID string `xml:"UploadId"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", fmt.Errorf("storage: decode create multipart upload result: %w", err)
+	}
+	if out.UploadID == "" {
+		return "", errors.New("storage: empty upload id")
+	}
+	return out.UploadID, nil
+}
+
+// uploadPart 上传单个分片
+func (c *S3Client) uploadPart(ctx context.Context, key, uploadID string, partNumber int, data []byte) (string, error) {
+	query := url.Values{
+		"partNumber": {strconv.Itoa(partNumber)},
+		"uploadId":   {uploadID},
+	}
+	body, payloadHash := newBytesBody(data)
+	req, err := c.newRequest(ctx, http.MethodPut, key, query, body)
+	if err != nil {
+		return "", err
+	}
+	req.ContentLength = int64(len(data))
+
+	resp, err := c.do(req, payloadHash)
+	if err != nil {
+		return "", fmt.Errorf("storage: upload part %d: %w", partNumber, err)
+	}
+	defer drainAndClose(resp.Body)
+	return resp.Header.Get("ETag"), nil
+}
+
+// completeMultipartUpload 合并分片
+func (c *S3Client) completeMultipartUpload(ctx context.Context, key, uploadID string, parts []completedPart) (string, error) {
+	data, err := xml.Marshal(completeMultipartUpload{Parts: parts})
+	if err != nil {
+		return "", fmt.Errorf("storage: encode complete multipart upload: %w", err)
+	}
+
+	body, payloadHash := newBytesBody(data)
+	req, err := c.newRequest(ctx, http.MethodPost, key, url.Values{"uploadId": {uploadID}}, body)
+	if err != nil {
+		return "", err
+	}
+	req.ContentLength = int64(len(data))
+	req.Header.Set("Content-Type", "application/xml")
+
+	resp, err := c.do(req, payloadHash)
+	if err != nil {
+		return "", err
+	}
+	defer drainAndClose(resp.Body)
+
+	// CompleteMultipartUpload 可能在 200 响应体中返回错误
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return "", fmt.Errorf("storage: read complete multipart upload result: %w", err)
+	}
+	var xe xmlError
+	if xml.Unmarshal(raw, &xe) == nil && xe.Code != "" {
+		return "", &ResponseError{StatusCode: resp.StatusCode, Code: xe.Code, Message: xe.Message, RequestID: xe.RequestID}
+	}
+	var out struct {
+		ETag string `xml:"ETag"`
+	}
+	if err := xml.Unmarshal(raw, &out); err != nil {
+		return "", fmt.Errorf("storage: decode complete multipart upload result: %w", err)
+	}
+	return trimETag(out.ETag), nil
+}
+
+// abortMultipartUpload 中止分片上传
+func (c *S3Client) abortMultipartUpload(ctx context.Context, key, uploadID string) error {
+	req, err := c.newRequest(ctx, http.MethodDelete, key, url.Values{"uploadId": {uploadID}}, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.do(req, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	drainAndClose(resp.Body)
+	return nil
+}
+
+// completedPart 已上传分片
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+// completeMultipartUpload CompleteMultipartUpload 请求体
+type completeMultipartUpload struct {
+	XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+	Parts   []completedPart `xml:"Part"`
+}
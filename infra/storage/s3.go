@@ -0,0 +1,480 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// 全局实例（使用 mutex + 双重检查，允许失败后重试）
+	globalClient *S3Client
+	globalMu     sync.Mutex
+)
+
+// S3Client S3 兼容对象存储客户端
+//
+// 基于 net/http 与 SigV4 签名实现，不依赖 AWS SDK，
+// 可直接用于 AWS S3、阿里云 OSS、MinIO 等兼容服务
+type S3Client struct {
+	config   *Config
+	endpoint *url.URL
+	client   *http.Client
+	signer   *signer
+	closed   atomic.Bool
+
+	// now 返回当前时间（测试时可替换）
+	now func() time.Time
+}
+
+// 编译期检查接口实现
+var _ Storage = (*S3Client)(nil)
+
+// Init 初始化全局对象存储客户端
+// 使用 mutex + 双重检查模式，允许初始化失败后重试
+func Init(config *Config) (*S3Client, error) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+	if globalClient != nil {
+		return globalClient, nil
+	}
+
+	client, err := New(config)
+	if err != nil {
+		return nil, err
+	}
+	globalClient = client
+	return globalClient, nil
+}
+
+// GetGlobal 获取全局对象存储客户端
+func GetGlobal() *S3Client {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+	return globalClient
+}
+
+// New 创建新的 S3 兼容客户端
+func New(config *Config) (*S3Client, error) {
+	if config == nil {
+		return nil, fmt.Errorf("%w: config is nil", ErrInvalidConfig)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint, _ := config.endpointURL()
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: config.Timeout}
+	}
+
+	c := &S3Client{
+		config:   config,
+		endpoint: endpoint,
+		client:   httpClient,
+		signer: &signer{
+			accessKeyID:     config.AccessKeyID,
+			secretAccessKey: config.SecretAccessKey,
+			sessionToken:    config.SessionToken,
+			region:          config.Region,
+		},
+		now: time.Now,
+	}
+
+	if config.Logger != nil {
+		config.Logger.Printf("storage client created: endpoint=%s bucket=%s", endpoint.Host, config.Bucket)
+	}
+	return c, nil
+}
+
+// Bucket 返回存储桶名称
+func (c *S3Client) Bucket() string {
+	return c.config.Bucket
+}
+
+// Put 上传对象
+//
+// size < 0（长度未知）或 size 超过 MultipartThreshold 时自动使用分片上传，
+// 否则以流式单次 PUT 上传，内容不会被整体读入内存
+func (c *S3Client) Put(ctx context.Context, key string, r io.Reader, size int64, opts ...PutOption) (*ObjectInfo, error) {
+	if err := c.checkKey(key); err != nil {
+		return nil, err
+	}
+
+	o := &putOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if size < 0 || size > c.config.MultipartThreshold {
+		return c.putMultipart(ctx, key, r, o)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPut, key, nil, io.LimitReader(r, size))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = size
+	if size == 0 {
+		req.Body = http.NoBody
+	}
+	applyPutOptions(req, o)
+
+	resp, err := c.do(req, unsignedPayload)
+	if err != nil {
+		return nil, err
+	}
+	defer drainAndClose(resp.Body)
+
+	return &ObjectInfo{
+		Key:          key,
+		Size:         size,
+		ETag:         trimETag(resp.Header.Get("ETag")),
+		ContentType:  o.contentType,
+		LastModified: c.now(),
+		Metadata:     o.metadata,
+	}, nil
+}
+
+// Get 下载对象，调用者负责关闭返回的 ReadCloser
+func (c *S3Client) Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error) {
+	if err := c.checkKey(key); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, key, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.do(req, emptyPayloadHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Body, objectInfoFromHeader(key, resp.Header), nil
+}
+
+// Stat 获取对象元信息
+func (c *S3Client) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	if err := c.checkKey(key); err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodHead, key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+	defer drainAndClose(resp.Body)
+	return objectInfoFromHeader(key, resp.Header), nil
+}
+
+// Delete 删除对象，对象不存在时不返回错误
+func (c *S3Client) Delete(ctx context.Context, key string) error {
+	if err := c.checkKey(key); err != nil {
+		return err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodDelete, key, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req, emptyPayloadHash)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	drainAndClose(resp.Body)
+	return nil
+}
+
+// List 列举对象（ListObjectsV2）
+func (c *S3Client) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	if c.closed.Load() {
+		return nil, ErrClosed
+	}
+
+	query := url.Values{}
+	query.Set("list-type", "2")
+	if opts.Prefix != "" {
+		query.Set("prefix", opts.Prefix)
+	}
+	if opts.Delimiter != "" {
+		query.Set("delimiter", opts.Delimiter)
+	}
+	if opts.ContinuationToken != "" {
+		query.Set("continuation-token", opts.ContinuationToken)
+	}
+	maxKeys := opts.MaxKeys
+	if maxKeys <= 0 {
+		maxKeys = defaultListMaxKeys
+	}
+	query.Set("max-keys", strconv.Itoa(maxKeys))
+
+	req, err := c.newRequest(ctx, http.MethodGet, "", query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+	defer drainAndClose(resp.Body)
+
+	var out listBucketResult
+	if err := xml.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("storage: decode list result: %w", err)
+	}
+
+	result := &ListResult{
+		Objects:     make([]ObjectInfo, 0, len(out.Contents)),
+		IsTruncated: out.IsTruncated,
+		NextToken:   out.NextContinuationToken,
+	}
+	for _, obj := range out.Contents {
+		result.Objects = append(result.Objects, ObjectInfo{
+			Key:          obj.Key,
+			Size:         obj.Size,
+			ETag:         trimETag(obj.ETag),
+			LastModified: obj.LastModified,
+		})
+	}
+	for _, p := range out.CommonPrefixes {
+		result.CommonPrefixes = append(result.CommonPrefixes, p.Prefix)
+	}
+	return result, nil
+}
+
+// PresignURL 生成预签名 URL
+//
+// expires 取值范围为 1 秒到 7 天
+func (c *S3Client) PresignURL(ctx context.Context, method, key string, expires time.Duration) (string, error) {
+	if err := c.checkKey(key); err != nil {
+		return "", err
+	}
+	if expires < time.Second || expires > 7*24*time.Hour {
+		return "", fmt.Errorf("storage: presign expires must be between 1s and 7d, got %v", expires)
+	}
+	if method == "" {
+		method = http.MethodGet
+	}
+	return c.signer.presign(strings.ToUpper(method), c.objectURL(key, nil), expires, c.now()), nil
+}
+
+// Health 健康检查（通过 HEAD Bucket 验证连通性与凭证）
+func (c *S3Client) Health(ctx context.Context) error {
+	if c.closed.Load() {
+		return ErrClosed
+	}
+
+	req, err := c.newRequest(ctx, http.MethodHead, "", nil, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.do(req, emptyPayloadHash)
+	if err != nil {
+		return fmt.Errorf("storage health check failed: %w", err)
+	}
+	drainAndClose(resp.Body)
+	return nil
+}
+
+// Close 关闭客户端，可重复调用
+func (c *S3Client) Close() error {
+	if c.closed.Swap(true) {
+		return nil
+	}
+	c.client.CloseIdleConnections()
+	return nil
+}
+
+// checkKey 校验对象名与客户端状态
+func (c *S3Client) checkKey(key string) error {
+	if c.closed.Load() {
+		return ErrClosed
+	}
+	if key == "" {
+		return errors.New("storage: object key is empty")
+	}
+	return nil
+}
+
+// objectURL 构建对象访问地址（路径风格或虚拟主机风格）
+func (c *S3Client) objectURL(key string, query url.Values) *url.URL {
+	u := *c.endpoint
+	if c.config.UsePathStyle {
+		u.Path = u.Path + "/" + c.config.Bucket
+	} else {
+		u.Host = c.config.Bucket + "." + u.Host
+	}
+	if key != "" {
+		u.Path = u.Path + "/" + strings.TrimPrefix(key, "/")
+	} else if !c.config.UsePathStyle {
+		u.Path = u.Path + "/"
+	}
+	u.RawPath = uriEncode(u.Path, false)
+	if query != nil {
+		u.RawQuery = canonicalQuery(query)
+	}
+	return &u
+}
+
+// newRequest 创建请求
+func (c *S3Client) newRequest(ctx context.Context, method, key string, query url.Values, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.objectURL(key, query).String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("storage: build request: %w", err)
+	}
+	return req, nil
+}
+
+// do 签名并发送请求，非 2xx 响应转换为 *ResponseError
+func (c *S3Client) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	c.signer.sign(req, payloadHash, c.now())
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		if c.config.Logger != nil {
+			c.config.Logger.Error("storage request failed", err)
+		}
+		return nil, fmt.Errorf("storage: %s %s: %w", req.Method, req.URL.Path, err)
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+
+	defer drainAndClose(resp.Body)
+	respErr := &ResponseError{StatusCode: resp.StatusCode}
+	if req.Method != http.MethodHead {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+		var xe xmlError
+		if xml.Unmarshal(body, &xe) == nil {
+			respErr.Code = xe.Code
+			respErr.Message = xe.Message
+			respErr.RequestID = xe.RequestID
+		}
+	}
+	return nil, respErr
+}
+
+// ResponseError 服务端返回的错误
+type ResponseError struct {
+	StatusCode int    // HTTP 状态码
+	Code       string // 错误码，如 NoSuchKey、AccessDenied
+	Message    string // 错误信息
+	RequestID  string // 请求 ID
+}
+
+// Error 实现 error 接口
+func (e *ResponseError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("storage: unexpected status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("storage: %s (status %d): %s", e.Code, e.StatusCode, e.Message)
+}
+
+// Is 支持 errors.Is(err, ErrNotFound)
+func (e *ResponseError) Is(target error) bool {
+	return target == ErrNotFound &&
+		(e.StatusCode == http.StatusNotFound || e.Code == "NoSuchKey")
+}
+
+// applyPutOptions 设置上传相关请求头
+func applyPutOptions(req *http.Request, o *putOptions) {
+	if o.contentType != "" {
+		req.Header.Set("Content-Type", o.contentType)
+	}
+	if o.cacheControl != "" {
+		req.Header.Set("Cache-Control", o.cacheControl)
+	}
+	for k, v := range o.metadata {
+		req.Header.Set("X-Amz-Meta-"+k, v)
+	}
+}
+
+// objectInfoFromHeader 从响应头解析对象元信息
+func objectInfoFromHeader(key string, h http.Header) *ObjectInfo {
+	info := &ObjectInfo{
+		Key:         key,
+		ETag:        trimETag(h.Get("ETag")),
+		ContentType: h.Get("Content-Type"),
+		Size:        -1,
+	}
+	if n, err := strconv.ParseInt(h.Get("Content-Length"), 10, 64); err == nil {
+		info.Size = n
+	}
+	if t, err := http.ParseTime(h.Get("Last-Modified")); err == nil {
+		info.LastModified = t
+	}
+	for name, values := range h {
+		if len(values) == 0 {
+			continue
+		}
+		if meta, ok := strings.CutPrefix(strings.ToLower(name), "x-amz-meta-"); ok {
+			if info.Metadata == nil {
+				info.Metadata = make(map[string]string)
+			}
+			info.Metadata[meta] = values[0]
+		}
+	}
+	return info
+}
+
+func trimETag(etag string) string {
+	return strings.Trim(etag, `"`)
+}
+
+// drainAndClose 读尽并关闭响应体，以便复用连接
+func drainAndClose(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(body, 64<<10))
+	_ = body.Close()
+}
+
+// xmlError S3 错误响应
+type xmlError struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string   `xml:"Code"`
+	Message   string   `xml:"Message"`
+	RequestID string   `xml:"RequestId"`
+}
+
+// listBucketResult ListObjectsV2 响应
+type listBucketResult struct {
+	XMLName               xml.Name `xml:"ListBucketResult"`
+	IsTruncated           bool     `xml:"IsTruncated"`
+	NextContinuationToken string   `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key          string    `xml:"Key"`
+		LastModified time.Time `xml:"LastModified"`
+		ETag         string    `xml:"ETag"`
+		Size         int64     `xml:"Size"`
+	} `xml:"Contents"`
+	CommonPrefixes []struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"CommonPrefixes"`
+}
+
+// newBytesBody 创建可签名的内存请求体
+func newBytesBody(data []byte) (io.Reader, string) {
+	return bytes.NewReader(data), sha256Hex(data)
+}
@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
+)
+
+// 常见错误
+var (
+	// ErrNotFound 对象不存在
+	ErrNotFound = errorx.NewSentinel(errorx.CategoryNotFound, "storage: object not found")
+
+	// ErrInvalidConfig 配置无效
+	ErrInvalidConfig = errors.New("storage: invalid configuration")
+
+	// ErrClosed 客户端已关闭
+	ErrClosed = errors.New("storage: client closed")
+)
+
+// Storage 对象存储统一接口
+//
+// 所有实现（S3、OSS、MinIO 等）都应满足此接口，业务代码只依赖该接口，
+// 便于在不同云厂商之间切换以及在测试中替换为内存实现。
+type Storage interface {
+	// Put 上传对象
+	//
+	// size 为内容长度，未知时传 -1；大于分片阈值或长度未知时自动使用分片上传
+	Put(ctx context.Context, key string, r io.Reader, size int64, opts ...PutOption) (*ObjectInfo, error)
+
+	// Get 下载对象，调用者负责关闭返回的 ReadCloser
+	Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error)
+
+	// Stat 获取对象元信息
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+
+	// Delete 删除对象，对象不存在时不返回错误
+	Delete(ctx context.Context, key string) error
+
+	// List 列举对象
+	List(ctx context.Context, opts ListOptions) (*ListResult, error)
+
+	// PresignURL 生成预签名 URL，method 为 GET/PUT/DELETE 等
+	PresignURL(ctx context.Context, method, key string, expires time.Duration) (string, error)
+
+	// Close 释放资源
+	Close() error
+}
+
+// ObjectInfo 对象元信息
+type ObjectInfo struct {
+	Key          string            `json:"key"`
+	Size         int64             `json:"size"`
+	ETag         string            `json:"etag"`
+	ContentType  string            `json:"content_type,omitempty"`
+	LastModified time.Time         `json:"last_modified"`
+	Metadata     map[string]string `json:"metadata,omitempty"`
+}
+
+// ListOptions 列举选项
+type ListOptions struct {
+	Prefix            string // 对象名前缀
+	Delimiter         string // 分隔符（通常为 "/"，用于模拟目录）
+	ContinuationToken string // 分页游标（来自上一次 ListResult.NextToken）
+	MaxKeys           int    // 单页最大数量（默认：1000）
+}
+
+// ListResult 列举结果
+type ListResult struct {
+	Objects        []ObjectInfo `json:"objects"`
+	CommonPrefixes []string     `json:"common_prefixes,omitempty"`
+	IsTruncated    bool         `json:"is_truncated"`
+	NextToken      string       `json:"next_token,omitempty"`
+}
+
+// putOptions 上传选项
+type putOptions struct {
+	contentType  string
+	cacheControl string
+	metadata     map[string]string
+}
+
+// PutOption 上传选项函数
+type PutOption func(*putOptions)
+
+// WithContentType 设置 Content-Type
+func WithContentType(contentType string) PutOption {
+	return func(o *putOptions) {
+		o.contentType = contentType
+	}
+}
+
+// WithCacheControl 设置 Cache-Control
+func WithCacheControl(cacheControl string) PutOption {
+	return func(o *putOptions) {
+		o.cacheControl = cacheControl
+	}
+}
+
+// WithMetadata 设置自定义元数据（以 x-amz-meta- 头存储）
+func WithMetadata(metadata map[string]string) PutOption {
+	return func(o *putOptions) {
+		if o.metadata == nil {
+			o.metadata = make(map[string]string, len(metadata))
+		}
+		for k, v := range metadata {
+			o.metadata[k] = v
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package cond
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
+)
+
+// Assert 断言条件成立，不成立时返回无效输入错误（不会 panic）
+//
+// 返回的错误为 *errorx.CodedError（CodeInvalidInput，HTTP 400），
+// 可直接沿错误链向上返回。
+//
+// 参数:
+//   - condition: 断言条件
+//   - msg: 条件不成立时的错误消息
+//
+// 返回:
+//   - error: 条件成立时为 nil
+//
+// 示例:
+//
+//	func Transfer(from, to string, amount int) error {
+//	    if err := errorx.Coalesce(
+//	        cond.Assert(from != to, "cannot transfer to the same account"),
+//	        cond.AssertInRange(amount, 1, 1_000_000, "amount"),
+//	    ); err != nil {
+//	        return err
+//	    }
+//	    // ...
+//	}
+func Assert(condition bool, msg string) error {
+	if condition {
+		return nil
+	}
+	return errorx.ErrInvalidInput(msg)
+}
+
+// Assertf 断言条件成立，不成立时返回格式化消息的无效输入错误
+//
+// 参数:
+//   - condition: 断言条件
+//   - format: 错误消息格式
+//   - args: 格式化参数（仅在条件不成立时使用）
+//
+// 返回:
+//   - error: 条件成立时为 nil
+func Assertf(condition bool, format string, args ...any) error {
+	if condition {
+		return nil
+	}
+	return errorx.ErrInvalidInput(fmt.Sprintf(format, args...))
+}
+
+// AssertNotNil 断言值不为 nil，能识别包装在接口中的 nil 指针、map、slice 等
+//
+// 参数:
+//   - v: 要检查的值
+//   - name: 参数名称，用于错误消息和详情中的 field
+//
+// 返回:
+//   - error: 不为 nil 时返回 nil
+//
+// 示例:
+//
+//	if err := cond.AssertNotNil(cfg, "cfg"); err != nil {
+//	    return err  // [GENERAL-1001] cfg must not be nil
+//	}
+func AssertNotNil(v any, name string) error {
+	if !isNil(v) {
+		return nil
+	}
+	return errorx.ErrInvalidInput(name+" must not be nil").
+		WithDetails("field", name)
+}
+
+// AssertNotZero 断言值不为零值（如空字符串、0）
+//
+// 参数:
+//   - v: 要检查的值
+//   - name: 参数名称，用于错误消息和详情中的 field
+//
+// 返回:
+//   - error: 不为零值时返回 nil
+//
+// 示例:
+//
+//	cond.AssertNotZero(userID, "user_id")  // [GENERAL-1001] user_id must not be empty
+func AssertNotZero[T comparable](v T, name string) error {
+	var zero T
+	if v != zero {
+		return nil
+	}
+	return errorx.ErrInvalidInput(name+" must not be empty").
+		WithDetails("field", name)
+}
+
+// AssertInRange 断言值在闭区间 [min, max] 内
+//
+// 参数:
+//   - v: 要检查的值
+//   - min: 最小值（包含）
+//   - max: 最大值（包含）
+//   - name: 参数名称，用于错误消息和详情中的 field
+//
+// 返回:
+//   - error: 在区间内时返回 nil，错误详情包含 min、max 和 value
+//
+// 示例:
+//
+//	cond.AssertInRange(pageSize, 1, 100, "page_size")
+//	// [GENERAL-1001] page_size must be in [1, 100], got 500
+func AssertInRange[T cmp.Ordered](v, min, max T, name string) error {
+	if v >= min && v <= max {
+		return nil
+	}
+	return errorx.ErrInvalidInput(fmt.Sprintf("%s must be in [%v, %v], got %v", name, min, max, v)).
+		WithDetails("field", name).
+		WithDetails("min", min).
+		WithDetails("max", max).
+		WithDetails("value", v)
+}
+
+// isNil 检查值是否为 nil，包括接口中包装的 nil 指针等
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.Interface, reflect.UnsafePointer:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
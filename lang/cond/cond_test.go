@@ -1,7 +1,11 @@
 package cond
 
 import (
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
 )
 
 func TestIf(t *testing.T) {
@@ -455,3 +459,211 @@ func TestSwitchTrue_Result(t *testing.T) {
 		t.Errorf("expected empty string, got %v", s2.Result())
 	}
 }
+
+func TestWhen(t *testing.T) {
+	grade := func(score int) string {
+		return When[string](score >= 90).Then("A").
+			ElseWhen(score >= 80).Then("B").
+			ElseWhen(score >= 60).Then("C").
+			Else("F")
+	}
+
+	tests := []struct {
+		score    int
+		expected string
+	}{
+		{95, "A"},
+		{90, "A"},
+		{85, "B"},
+		{60, "C"},
+		{10, "F"},
+	}
+	for _, tt := range tests {
+		if got := grade(tt.score); got != tt.expected {
+			t.Errorf("grade(%d) = %s, expected %s", tt.score, got, tt.expected)
+		}
+	}
+}
+
+func TestWhen_Lazy(t *testing.T) {
+	calls := 0
+	fn := func(v string) func() string {
+		return func() string {
+			calls++
+			return v
+		}
+	}
+	condCalls := 0
+	condFn := func() bool {
+		condCalls++
+		return true
+	}
+
+	result := When[string](true).ThenFunc(fn("first")).
+		ElseWhenFunc(condFn).ThenFunc(fn("second")).
+		ElseFunc(fn("default"))
+	if result != "first" {
+		t.Errorf("expected 'first', got %v", result)
+	}
+	if calls != 1 || condCalls != 0 {
+		t.Errorf("expected 1 value call and 0 condition calls, got %d and %d", calls, condCalls)
+	}
+
+	result = When[string](false).ThenFunc(fn("first")).
+		ElseWhenFunc(condFn).ThenFunc(fn("second")).
+		Else("default")
+	if result != "second" || condCalls != 1 {
+		t.Errorf("expected 'second' with 1 condition call, got %v and %d", result, condCalls)
+	}
+}
+
+func TestWhen_ResultMatched(t *testing.T) {
+	b := When[int](false).Then(1).ElseWhen(false).Then(2)
+	if b.Matched() || b.Result() != 0 {
+		t.Errorf("expected no match, got %v, %d", b.Matched(), b.Result())
+	}
+	if got := b.ElseFunc(func() int { return 3 }); got != 3 {
+		t.Errorf("expected 3, got %d", got)
+	}
+
+	b = When[int](false).Then(1).ElseWhen(true).Then(2).ElseWhen(true).Then(3)
+	if !b.Matched() || b.Result() != 2 {
+		t.Errorf("expected first matching branch 2, got %d", b.Result())
+	}
+}
+
+func TestSwitch_CaseInFunc(t *testing.T) {
+	calls := 0
+	fn := func(v string) func() string {
+		return func() string {
+			calls++
+			return v
+		}
+	}
+
+	result := Switch[string, string](".png").
+		CaseInFunc(fn("image"), ".jpg", ".png").
+		CaseInFunc(fn("doc"), ".pdf").
+		Default("unknown")
+	if result != "image" {
+		t.Errorf("expected 'image', got %v", result)
+	}
+	if calls != 1 {
+		t.Errorf("expected only matched branch evaluated, got %d calls", calls)
+	}
+}
+
+func TestSwitch_CaseWhen(t *testing.T) {
+	isServerError := func(c int) bool { return c >= 500 }
+	isClientError := func(c int) bool { return c >= 400 }
+
+	tests := []struct {
+		code     int
+		expected string
+	}{
+		{200, "ok"},
+		{404, "client error"},
+		{503, "server error"},
+	}
+	for _, tt := range tests {
+		result := Switch[int, string](tt.code).
+			CaseWhen(isServerError, "server error").
+			CaseWhen(isClientError, "client error").
+			Default("ok")
+		if result != tt.expected {
+			t.Errorf("code %d: expected %s, got %s", tt.code, tt.expected, result)
+		}
+	}
+}
+
+func TestSwitch_CaseWhenFunc(t *testing.T) {
+	predicateCalls := 0
+	predicate := func(c int) bool {
+		predicateCalls++
+		return c >= 500
+	}
+
+	result := Switch[int, string](503).
+		CaseWhenFunc(predicate, func(c int) string { return "server error " + strconv.Itoa(c) }).
+		CaseWhen(predicate, "unreachable").
+		Default("ok")
+	if result != "server error 503" {
+		t.Errorf("expected 'server error 503', got %v", result)
+	}
+	if predicateCalls != 1 {
+		t.Errorf("predicate should not be called after match, got %d calls", predicateCalls)
+	}
+}
+
+func TestAssert(t *testing.T) {
+	if err := Assert(true, "unused"); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	err := Assert(false, "amount must be positive")
+	ce, ok := errorx.IsCodedError(err)
+	if !ok {
+		t.Fatalf("expected CodedError, got %T", err)
+	}
+	if ce.Code != errorx.CodeInvalidInput || ce.Message != "amount must be positive" {
+		t.Errorf("unexpected error: %v", ce)
+	}
+	if ce.HTTPStatus() != 400 {
+		t.Errorf("expected HTTP 400, got %d", ce.HTTPStatus())
+	}
+
+	if err := Assertf(false, "id %d is reserved", 7); err == nil || !strings.Contains(err.Error(), "id 7 is reserved") {
+		t.Errorf("unexpected Assertf error: %v", err)
+	}
+}
+
+func TestAssertNotNil(t *testing.T) {
+	var nilPtr *int
+	var nilMap map[string]int
+	var nilErr error
+
+	for _, v := range []any{nil, nilPtr, nilMap, nilErr} {
+		if AssertNotNil(v, "v") == nil {
+			t.Errorf("expected error for %#v", v)
+		}
+	}
+	for _, v := range []any{0, "", new(int), map[string]int{}} {
+		if err := AssertNotNil(v, "v"); err != nil {
+			t.Errorf("unexpected error for %#v: %v", v, err)
+		}
+	}
+
+	ce, _ := errorx.IsCodedError(AssertNotNil(nilPtr, "cfg"))
+	if ce.Message != "cfg must not be nil" || ce.Details["field"] != "cfg" {
+		t.Errorf("unexpected error: %v, details %v", ce, ce.Details)
+	}
+}
+
+func TestAssertNotZero(t *testing.T) {
+	if AssertNotZero("", "name") == nil || AssertNotZero(0, "id") == nil {
+		t.Error("expected error for zero values")
+	}
+	if AssertNotZero("alice", "name") != nil || AssertNotZero(1, "id") != nil {
+		t.Error("expected nil for non-zero values")
+	}
+}
+
+func TestAssertInRange(t *testing.T) {
+	if err := AssertInRange(5, 1, 10, "n"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if AssertInRange(1, 1, 10, "n") != nil || AssertInRange(10, 1, 10, "n") != nil {
+		t.Error("range bounds should be inclusive")
+	}
+
+	ce, ok := errorx.IsCodedError(AssertInRange(500, 1, 100, "page_size"))
+	if !ok {
+		t.Fatal("expected CodedError")
+	}
+	if ce.Message != "page_size must be in [1, 100], got 500" {
+		t.Errorf("unexpected message: %s", ce.Message)
+	}
+	if ce.Details["value"] != 500 || ce.Details["max"] != 100 {
+		t.Errorf("unexpected details: %v", ce.Details)
+	}
+}
@@ -4,7 +4,9 @@
 //   - If/IfFunc: 三元表达式替代
 //   - IfZero: 零值判断与默认值
 //   - Coalesce: 返回第一个非零值
-//   - Switch: 类型安全的 switch 表达式
+//   - Switch: 类型安全的 switch 表达式，支持多值、谓词匹配和延迟求值
+//   - When: 多分支条件选择，从上到下阅读
+//   - Assert/AssertNotNil/AssertInRange: 前置条件检查，返回 errorx 错误而非 panic
 //
 // 示例:
 //
@@ -23,6 +25,11 @@
 //	    Case("inactive", "非活跃").
 //	    Default("未知")
 //
+//	// 多分支条件选择
+//	level := cond.When[string](score >= 90).Then("优秀").
+//	    ElseWhen(score >= 60).Then("及格").
+//	    Else("不及格")
+//
 // --- English ---
 //
 // Package cond provides conditional utility functions to simplify
@@ -32,7 +39,9 @@
 //   - If/IfFunc: ternary expression replacement
 //   - IfZero: zero-value check with default
 //   - Coalesce: return the first non-zero value
-//   - Switch: type-safe switch expression
+//   - Switch: type-safe switch expression with multi-value, predicate and lazy cases
+//   - When: multi-branch selection that reads top to bottom
+//   - Assert/AssertNotNil/AssertInRange: precondition checks returning errorx errors instead of panicking
 //
 // Examples:
 //
@@ -50,4 +59,9 @@
 //	    Case("active", "Active").
 //	    Case("inactive", "Inactive").
 //	    Default("Unknown")
+//
+//	// Multi-branch selection
+//	level := cond.When[string](score >= 90).Then("excellent").
+//	    ElseWhen(score >= 60).Then("pass").
+//	    Else("fail")
 package cond
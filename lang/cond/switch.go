@@ -90,6 +90,76 @@ func (s *SwitchBuilder[T, R]) CaseIn(result R, caseVals ...T) *SwitchBuilder[T,
 	return s
 }
 
+// CaseInFunc 添加多个可能匹配的值，匹配成功时才执行函数（延迟求值）
+//
+// 参数:
+//   - fn: 匹配成功时执行的函数
+//   - caseVals: 可能匹配的值列表
+//
+// 返回:
+//   - *SwitchBuilder[T, R]: Switch 构建器（支持链式调用）
+func (s *SwitchBuilder[T, R]) CaseInFunc(fn func() R, caseVals ...T) *SwitchBuilder[T, R] {
+	if !s.matched {
+		for _, v := range caseVals {
+			if s.value == v {
+				s.result = fn()
+				s.matched = true
+				break
+			}
+		}
+	}
+	return s
+}
+
+// CaseWhen 添加一个谓词匹配分支，predicate(value) 为 true 时匹配
+//
+// 已匹配时不再调用 predicate
+//
+// 参数:
+//   - predicate: 判断函数
+//   - result: 匹配成功时返回的结果
+//
+// 返回:
+//   - *SwitchBuilder[T, R]: Switch 构建器（支持链式调用）
+//
+// 示例:
+//
+//	Switch[int, string](code).
+//	    Case(404, "Not Found").
+//	    CaseWhen(func(c int) bool { return c >= 500 }, "Server Error").
+//	    Default("Other")
+func (s *SwitchBuilder[T, R]) CaseWhen(predicate func(T) bool, result R) *SwitchBuilder[T, R] {
+	if !s.matched && predicate(s.value) {
+		s.result = result
+		s.matched = true
+	}
+	return s
+}
+
+// CaseWhenFunc 添加一个谓词匹配分支，匹配成功时才执行函数（延迟求值）
+//
+// 参数:
+//   - predicate: 判断函数
+//   - fn: 匹配成功时以匹配值调用的函数
+//
+// 返回:
+//   - *SwitchBuilder[T, R]: Switch 构建器（支持链式调用）
+//
+// 示例:
+//
+//	Switch[int, string](code).
+//	    CaseWhenFunc(isServerError, func(c int) string {
+//	        return fmt.Sprintf("server error %d", c)
+//	    }).
+//	    Default("ok")
+func (s *SwitchBuilder[T, R]) CaseWhenFunc(predicate func(T) bool, fn func(T) R) *SwitchBuilder[T, R] {
+	if !s.matched && predicate(s.value) {
+		s.result = fn(s.value)
+		s.matched = true
+	}
+	return s
+}
+
 // Default 设置默认值（当没有匹配时使用）
 //
 // 参数:
@@ -0,0 +1,135 @@
+package cond
+
+// WhenClause 等待 Then 的条件分支，由 When/ElseWhen 返回
+type WhenClause[R any] WhenBuilder[R]
+
+// WhenBuilder 多分支条件选择构建器，由 Then/ThenFunc 返回
+//
+// 分支按书写顺序从上到下匹配，命中第一个为 true 的条件后，
+// 后续分支的值函数和条件函数都不会再执行
+type WhenBuilder[R any] struct {
+	result  R
+	matched bool
+	cond    bool // 当前待定分支的条件
+}
+
+// When 开始一个多分支条件选择，替代嵌套的 cond.If 调用
+//
+// 参数:
+//   - condition: 第一个分支的条件
+//
+// 返回:
+//   - *WhenClause[R]: 需要接着调用 Then 或 ThenFunc
+//
+// 示例:
+//
+//	level := cond.When[string](score >= 90).Then("A").
+//	    ElseWhen(score >= 80).Then("B").
+//	    ElseWhen(score >= 60).Then("C").
+//	    Else("F")
+func When[R any](condition bool) *WhenClause[R] {
+	return &WhenClause[R]{cond: condition}
+}
+
+// Then 设置当前分支条件为 true 时的结果
+//
+// 参数:
+//   - result: 分支结果
+//
+// 返回:
+//   - *WhenBuilder[R]: 可继续 ElseWhen，或以 Else 结束
+func (c *WhenClause[R]) Then(result R) *WhenBuilder[R] {
+	b := (*WhenBuilder[R])(c)
+	if !b.matched && b.cond {
+		b.result = result
+		b.matched = true
+	}
+	return b
+}
+
+// ThenFunc 设置当前分支条件为 true 时的结果函数（延迟求值）
+//
+// 参数:
+//   - fn: 分支命中时才执行的函数
+//
+// 返回:
+//   - *WhenBuilder[R]: 可继续 ElseWhen，或以 Else 结束
+func (c *WhenClause[R]) ThenFunc(fn func() R) *WhenBuilder[R] {
+	b := (*WhenBuilder[R])(c)
+	if !b.matched && b.cond {
+		b.result = fn()
+		b.matched = true
+	}
+	return b
+}
+
+// ElseWhen 添加下一个条件分支
+//
+// 参数:
+//   - condition: 分支条件
+//
+// 返回:
+//   - *WhenClause[R]: 需要接着调用 Then 或 ThenFunc
+func (b *WhenBuilder[R]) ElseWhen(condition bool) *WhenClause[R] {
+	b.cond = condition
+	return (*WhenClause[R])(b)
+}
+
+// ElseWhenFunc 添加下一个条件分支（条件延迟求值，已命中时不执行）
+//
+// 参数:
+//   - condition: 返回分支条件的函数
+//
+// 返回:
+//   - *WhenClause[R]: 需要接着调用 Then 或 ThenFunc
+//
+// 示例:
+//
+//	src := cond.When[string](local != nil).Then("local").
+//	    ElseWhenFunc(cache.Exists).Then("cache").
+//	    Else("remote")
+func (b *WhenBuilder[R]) ElseWhenFunc(condition func() bool) *WhenClause[R] {
+	b.cond = !b.matched && condition()
+	return (*WhenClause[R])(b)
+}
+
+// Else 设置所有条件都不成立时的结果
+//
+// 参数:
+//   - result: 默认结果
+//
+// 返回:
+//   - R: 最终结果
+func (b *WhenBuilder[R]) Else(result R) R {
+	if b.matched {
+		return b.result
+	}
+	return result
+}
+
+// ElseFunc 设置所有条件都不成立时的结果函数（延迟求值）
+//
+// 参数:
+//   - fn: 返回默认值的函数
+//
+// 返回:
+//   - R: 最终结果
+func (b *WhenBuilder[R]) ElseFunc(fn func() R) R {
+	if b.matched {
+		return b.result
+	}
+	return fn()
+}
+
+// Result 获取结果
+//
+// 返回:
+//   - R: 命中分支的结果，没有命中时为零值
+func (b *WhenBuilder[R]) Result() R {
+	return b.result
+}
+
+// Matched 检查是否有分支命中
+func (b *WhenBuilder[R]) Matched() bool {
+	return b.matched
+}
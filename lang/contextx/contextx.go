@@ -2,6 +2,7 @@ package contextx
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
@@ -29,6 +30,47 @@ func WithDeadlineCause(parent context.Context, deadline time.Time, cause error)
 	return context.WithDeadlineCause(parent, deadline, cause)
 }
 
+// WithTimeoutCausef 创建带超时的 context，超时原因为格式化的可读消息
+//
+// 原因可通过 Cause 获取，并包装了 context.DeadlineExceeded，
+// 因此 errors.Is(Cause(ctx), context.DeadlineExceeded) 仍为 true
+//
+// 示例:
+//
+//	ctx, cancel := contextx.WithTimeoutCausef(ctx, time.Second, "call %s", addr)
+//	defer cancel()
+//	// 超时后 Cause(ctx): "call 10.0.0.1:8080: context deadline exceeded"
+func WithTimeoutCausef(parent context.Context, timeout time.Duration, format string, args ...any) (context.Context, context.CancelFunc) {
+	return context.WithTimeoutCause(parent, timeout, deadlineCause(format, args...))
+}
+
+// WithDeadlineCausef 创建带截止时间的 context，超时原因为格式化的可读消息
+func WithDeadlineCausef(parent context.Context, deadline time.Time, format string, args ...any) (context.Context, context.CancelFunc) {
+	return context.WithDeadlineCause(parent, deadline, deadlineCause(format, args...))
+}
+
+// deadlineCause 创建包装 context.DeadlineExceeded 的超时原因
+func deadlineCause(format string, args ...any) error {
+	return fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), context.DeadlineExceeded)
+}
+
+// ShrinkDeadline 将 context 的截止时间收紧到 d 之后，只缩短不延长
+//
+// 父 context 没有截止时间或截止时间晚于 now+d 时，使用 now+d；
+// 否则保留父 context 更早的截止时间。适合扇出调用时为每个下游设置上限。
+//
+// 示例:
+//
+//	callCtx, cancel := contextx.ShrinkDeadline(ctx, 200*time.Millisecond)
+//	defer cancel()
+func ShrinkDeadline(ctx context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	deadline := time.Now().Add(d)
+	if cur, ok := ctx.Deadline(); ok && cur.Before(deadline) {
+		return context.WithCancel(ctx)
+	}
+	return context.WithDeadline(ctx, deadline)
+}
+
 // --- 取消相关 ---
 
 // WithCancel 创建可取消的 context
@@ -197,6 +239,19 @@ func Remaining(ctx context.Context) time.Duration {
 	return time.Until(deadline)
 }
 
+// RemainingTime 返回 context 剩余时间
+//
+// 返回:
+//   - time.Duration: 剩余时间，已过期时为 0
+//   - bool: 没有设置截止时间时为 false
+func RemainingTime(ctx context.Context) (time.Duration, bool) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0, false
+	}
+	return max(time.Until(deadline), 0), true
+}
+
 // HasDeadline 判断 context 是否设置了截止时间
 func HasDeadline(ctx context.Context) bool {
 	_, ok := ctx.Deadline()
@@ -244,10 +299,39 @@ func RunTimeout(timeout time.Duration, fn func() error) error {
 
 // Detach 创建一个脱离父 context 取消控制的新 context
 // 新 context 会继承父 context 的值，但不会被父 context 取消
+//
+// 适合在 HTTP handler 中启动请求结束后仍需运行的后台任务:
+//
+//	go audit(contextx.Detach(r.Context()), event)
 func Detach(ctx context.Context) context.Context {
 	return &detachedContext{ctx: ctx}
 }
 
+// CopyValues 将 src 中指定 key 的值复制到 dst，返回新的 context
+//
+// 与 Detach 继承全部值不同，CopyValues 只显式传递需要的值，
+// 不会让后台任务持有整个请求 context。src 中不存在的 key 会被跳过。
+// 元数据集合可通过 MergeMeta(dst, Snapshot(src)) 传递。
+//
+// 参数:
+//   - dst: 目标 context，取消和截止时间由它决定
+//   - src: 值的来源 context
+//   - keys: 要复制的 key，如 TraceIDKey 或 NewKey 创建的 key
+//
+// 示例:
+//
+//	bg := contextx.CopyValues(context.Background(), r.Context(),
+//	    contextx.TraceIDKey, contextx.UserIDKey)
+//	go sendEmail(bg, user)
+func CopyValues(dst, src context.Context, keys ...any) context.Context {
+	for _, key := range keys {
+		if v := src.Value(key); v != nil {
+			dst = context.WithValue(dst, key, v)
+		}
+	}
+	return dst
+}
+
 type detachedContext struct {
 	ctx context.Context
 }
@@ -247,6 +247,87 @@ func TestRemaining(t *testing.T) {
 	}
 }
 
+func TestRemainingTime(t *testing.T) {
+	if _, ok := RemainingTime(context.Background()); ok {
+		t.Error("expected false for no deadline")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if d, ok := RemainingTime(ctx); !ok || d <= 0 || d > time.Second {
+		t.Errorf("unexpected remaining time: %v, %v", d, ok)
+	}
+
+	expired, cancel2 := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel2()
+	if d, ok := RemainingTime(expired); !ok || d != 0 {
+		t.Errorf("expected 0 for expired deadline, got %v, %v", d, ok)
+	}
+}
+
+func TestShrinkDeadline(t *testing.T) {
+	// 没有截止时间时设置新的截止时间
+	ctx, cancel := ShrinkDeadline(context.Background(), time.Second)
+	defer cancel()
+	if d, ok := RemainingTime(ctx); !ok || d > time.Second {
+		t.Errorf("expected deadline within 1s, got %v, %v", d, ok)
+	}
+
+	// 收紧较晚的截止时间
+	parent, cancelParent := context.WithTimeout(context.Background(), time.Hour)
+	defer cancelParent()
+	ctx, cancel = ShrinkDeadline(parent, time.Second)
+	defer cancel()
+	if d, _ := RemainingTime(ctx); d > time.Second {
+		t.Errorf("expected shrunk deadline, got %v", d)
+	}
+
+	// 不延长较早的截止时间
+	parent, cancelParent2 := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancelParent2()
+	want, _ := parent.Deadline()
+	ctx, cancel = ShrinkDeadline(parent, time.Hour)
+	defer cancel()
+	if got, _ := ctx.Deadline(); !got.Equal(want) {
+		t.Errorf("expected parent deadline %v, got %v", want, got)
+	}
+
+	// 返回的 cancel 可独立取消
+	cancel()
+	if !IsCanceled(ctx) || parent.Err() != nil {
+		t.Error("cancel should only affect the derived context")
+	}
+}
+
+func TestWithTimeoutCausef(t *testing.T) {
+	ctx, cancel := WithTimeoutCausef(context.Background(), 10*time.Millisecond, "call %s", "user-svc")
+	defer cancel()
+
+	<-ctx.Done()
+
+	cause := Cause(ctx)
+	if cause == nil || cause.Error() != "call user-svc: context deadline exceeded" {
+		t.Errorf("unexpected cause: %v", cause)
+	}
+	if !errors.Is(cause, context.DeadlineExceeded) {
+		t.Error("cause should wrap context.DeadlineExceeded")
+	}
+	if !IsTimeout(ctx) {
+		t.Error("ctx.Err() should still be DeadlineExceeded")
+	}
+}
+
+func TestWithDeadlineCausef(t *testing.T) {
+	ctx, cancel := WithDeadlineCausef(context.Background(), time.Now().Add(10*time.Millisecond), "query %d", 42)
+	defer cancel()
+
+	<-ctx.Done()
+
+	if cause := Cause(ctx); cause == nil || cause.Error() != "query 42: context deadline exceeded" {
+		t.Errorf("unexpected cause: %v", cause)
+	}
+}
+
 func TestHasDeadline(t *testing.T) {
 	if HasDeadline(context.Background()) {
 		t.Error("expected no deadline for background context")
@@ -383,6 +464,29 @@ func TestDetach(t *testing.T) {
 	}
 }
 
+func TestCopyValues(t *testing.T) {
+	src, cancel := context.WithCancel(context.Background())
+	src = WithTraceID(src, "trace-123")
+	src = WithUserID(src, 42)
+	src = WithTenantID(src, "tenant-a")
+	cancel()
+
+	dst := CopyValues(context.Background(), src, TraceIDKey, UserIDKey, RequestIDKey)
+
+	if TraceID(dst) != "trace-123" || UserID(dst) != 42 {
+		t.Error("expected copied values")
+	}
+	if TenantID(dst) != "" {
+		t.Error("keys not listed should not be copied")
+	}
+	if _, ok := Value(dst, RequestIDKey); ok {
+		t.Error("missing keys should be skipped")
+	}
+	if IsDone(dst) {
+		t.Error("dst should not inherit cancellation from src")
+	}
+}
+
 func TestMerge(t *testing.T) {
 	ctx1, cancel1 := context.WithCancel(context.Background())
 	ctx2, cancel2 := context.WithCancel(context.Background())
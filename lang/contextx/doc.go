@@ -9,6 +9,29 @@
 //	ctx := contextx.WithValue(context.Background(), userKey{}, user)
 //	user, ok := contextx.Value[*User](ctx, userKey{})
 //
+// 后台任务（保留值但不随请求取消）:
+//
+//	go audit(contextx.Detach(r.Context()), event)
+//	bg := contextx.CopyValues(context.Background(), r.Context(), contextx.TraceIDKey)
+//
+// 截止时间（扇出调用时只收紧不延长，超时原因可读）:
+//
+//	callCtx, cancel := contextx.ShrinkDeadline(ctx, 200*time.Millisecond)
+//	ctx, cancel := contextx.WithTimeoutCausef(ctx, time.Second, "call %s", addr)
+//
+// 请求级存储（单个 context key 下按命名空间保存多个值）:
+//
+//	ctx, store := contextx.WithStore(r.Context())
+//	store.Set("auth", "user", user)
+//	user, ok := contextx.StoreValue[*User](contextx.StoreFrom(ctx), "auth", "user")
+//	log.Printf("%v", contextx.StoreFrom(ctx).Dump())
+//
+// 元数据（请求属性集合，可一次性取出用于日志）:
+//
+//	ctx = contextx.WithMeta(ctx, "client_ip", ip)
+//	ip, ok := contextx.Meta[string](ctx, "client_ip")
+//	logger.Info("done", "meta", contextx.Snapshot(ctx))
+//
 // --- English ---
 //
 // Package contextx provides type-safe context value handling.
@@ -21,4 +44,27 @@
 //	type userKey struct{}
 //	ctx := contextx.WithValue(context.Background(), userKey{}, user)
 //	user, ok := contextx.Value[*User](ctx, userKey{})
+//
+// Background tasks (keep values without being canceled with the request):
+//
+//	go audit(contextx.Detach(r.Context()), event)
+//	bg := contextx.CopyValues(context.Background(), r.Context(), contextx.TraceIDKey)
+//
+// Deadlines (only tightened for fan-out calls, with readable timeout causes):
+//
+//	callCtx, cancel := contextx.ShrinkDeadline(ctx, 200*time.Millisecond)
+//	ctx, cancel := contextx.WithTimeoutCausef(ctx, time.Second, "call %s", addr)
+//
+// Request-scoped store (namespaced values behind a single context key):
+//
+//	ctx, store := contextx.WithStore(r.Context())
+//	store.Set("auth", "user", user)
+//	user, ok := contextx.StoreValue[*User](contextx.StoreFrom(ctx), "auth", "user")
+//	log.Printf("%v", contextx.StoreFrom(ctx).Dump())
+//
+// Metadata (a bag of request attributes that can be read out at once for logging):
+//
+//	ctx = contextx.WithMeta(ctx, "client_ip", ip)
+//	ip, ok := contextx.Meta[string](ctx, "client_ip")
+//	logger.Info("done", "meta", contextx.Snapshot(ctx))
 package contextx
@@ -0,0 +1,92 @@
+package contextx
+
+import (
+	"context"
+	"maps"
+)
+
+// --- 元数据 ---
+
+// metaKey 元数据在 context 中的 key
+type metaKey struct{}
+
+// metadata 不可变的元数据集合，修改时复制（copy-on-write），可在多个 goroutine 间安全共享
+type metadata map[string]any
+
+// metaFrom 获取 context 中的元数据，不存在时返回 nil
+func metaFrom(ctx context.Context) metadata {
+	m, _ := ctx.Value(metaKey{}).(metadata)
+	return m
+}
+
+// WithMeta 在 context 中设置一项元数据，返回新的 context
+//
+// 所有元数据保存在同一个集合中，可通过 Snapshot 一次性取出用于日志等场景。
+// 同名 key 会覆盖父 context 中的值，父 context 不受影响。
+//
+// 示例:
+//
+//	ctx = contextx.WithMeta(ctx, "client_ip", ip)
+//	ctx = contextx.WithMeta(ctx, "plan", "pro")
+func WithMeta(ctx context.Context, key string, val any) context.Context {
+	parent := metaFrom(ctx)
+	m := make(metadata, len(parent)+1)
+	maps.Copy(m, parent)
+	m[key] = val
+	return context.WithValue(ctx, metaKey{}, m)
+}
+
+// MergeMeta 将多项元数据合并到 context 中，同名 key 以 meta 为准
+//
+// 示例:
+//
+//	ctx = contextx.MergeMeta(ctx, map[string]any{
+//	    "client_ip": ip,
+//	    "user_agent": ua,
+//	})
+func MergeMeta(ctx context.Context, meta map[string]any) context.Context {
+	if len(meta) == 0 {
+		return ctx
+	}
+	parent := metaFrom(ctx)
+	m := make(metadata, len(parent)+len(meta))
+	maps.Copy(m, parent)
+	maps.Copy(m, meta)
+	return context.WithValue(ctx, metaKey{}, m)
+}
+
+// Meta 获取指定 key 的元数据，并转换为类型 T
+//
+// 返回:
+//   - T: 元数据值
+//   - bool: key 不存在或类型不匹配时返回 false
+//
+// 示例:
+//
+//	ip, ok := contextx.Meta[string](ctx, "client_ip")
+func Meta[T any](ctx context.Context, key string) (T, bool) {
+	v, ok := metaFrom(ctx)[key].(T)
+	return v, ok
+}
+
+// MetaOr 获取指定 key 的元数据，不存在或类型不匹配时返回默认值
+func MetaOr[T any](ctx context.Context, key string, defaultValue T) T {
+	if v, ok := Meta[T](ctx, key); ok {
+		return v
+	}
+	return defaultValue
+}
+
+// Snapshot 返回 context 中所有元数据的副本，修改返回值不会影响 context
+//
+// 没有元数据时返回空 map（非 nil）
+//
+// 示例:
+//
+//	logger.Info("request done", "meta", contextx.Snapshot(ctx))
+func Snapshot(ctx context.Context) map[string]any {
+	m := metaFrom(ctx)
+	out := make(map[string]any, len(m))
+	maps.Copy(out, m)
+	return out
+}
@@ -0,0 +1,78 @@
+package contextx
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWithMeta(t *testing.T) {
+	ctx := WithMeta(context.Background(), "ip", "10.0.0.1")
+	child := WithMeta(ctx, "attempt", 2)
+	child = WithMeta(child, "ip", "10.0.0.2")
+
+	if ip, ok := Meta[string](child, "ip"); !ok || ip != "10.0.0.2" {
+		t.Errorf("expected overridden ip, got %q, %v", ip, ok)
+	}
+	if ip, _ := Meta[string](ctx, "ip"); ip != "10.0.0.1" {
+		t.Errorf("parent metadata should be unchanged, got %q", ip)
+	}
+	if _, ok := Meta[int](ctx, "attempt"); ok {
+		t.Error("child metadata should not leak into parent")
+	}
+	if attempt, ok := Meta[int](child, "attempt"); !ok || attempt != 2 {
+		t.Errorf("expected attempt 2, got %d, %v", attempt, ok)
+	}
+}
+
+func TestMeta_TypeMismatch(t *testing.T) {
+	ctx := WithMeta(context.Background(), "attempt", 2)
+	if _, ok := Meta[string](ctx, "attempt"); ok {
+		t.Error("expected false for type mismatch")
+	}
+	if v := MetaOr(ctx, "attempt", "none"); v != "none" {
+		t.Errorf("expected default for type mismatch, got %q", v)
+	}
+	if v := MetaOr(ctx, "attempt", 0); v != 2 {
+		t.Errorf("expected 2, got %d", v)
+	}
+	if v := MetaOr(context.Background(), "missing", 7); v != 7 {
+		t.Errorf("expected default, got %d", v)
+	}
+}
+
+func TestMergeMeta(t *testing.T) {
+	ctx := WithMeta(context.Background(), "a", 1)
+	ctx = MergeMeta(ctx, map[string]any{"a": 10, "b": "x"})
+
+	snap := Snapshot(ctx)
+	if len(snap) != 2 || snap["a"] != 10 || snap["b"] != "x" {
+		t.Errorf("unexpected snapshot: %v", snap)
+	}
+
+	if same := MergeMeta(ctx, nil); same != ctx {
+		t.Error("merging empty metadata should return the same context")
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	if snap := Snapshot(context.Background()); snap == nil || len(snap) != 0 {
+		t.Errorf("expected empty non-nil map, got %#v", snap)
+	}
+
+	ctx := WithMeta(context.Background(), "k", "v")
+	snap := Snapshot(ctx)
+	snap["k"] = "changed"
+	if v, _ := Meta[string](ctx, "k"); v != "v" {
+		t.Error("modifying snapshot should not affect context")
+	}
+}
+
+func TestMeta_Detach(t *testing.T) {
+	ctx, cancel := context.WithCancel(WithMeta(context.Background(), "k", "v"))
+	detached := Detach(ctx)
+	cancel()
+
+	if v, _ := Meta[string](detached, "k"); v != "v" {
+		t.Error("detached context should keep metadata")
+	}
+}
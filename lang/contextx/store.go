@@ -0,0 +1,131 @@
+package contextx
+
+import (
+	"context"
+	"maps"
+	"sync"
+)
+
+// --- 请求级存储 ---
+
+// storeKey Store 在 context 中的 key
+type storeKey struct{}
+
+// Store 挂载在 context 上的请求级存储，按命名空间保存多个值
+//
+// 整个请求只占用一个 context key，避免几十层 WithValue 链带来的查找开销；
+// 与 WithValue 不同，Store 可在原地修改，并发安全。
+// 读取方法对 nil *Store 安全，可直接对 StoreFrom 的结果调用。
+type Store struct {
+	mu   sync.RWMutex
+	data map[string]map[string]any
+}
+
+// NewStore 创建空的 Store
+func NewStore() *Store {
+	return &Store{data: make(map[string]map[string]any)}
+}
+
+// WithStore 在 context 上挂载 Store
+//
+// context 中已有 Store 时直接返回原 context 和已有的 Store，
+// 保证同一请求链路上共享同一个 Store。
+//
+// 示例:
+//
+//	ctx, store := contextx.WithStore(r.Context())
+//	store.Set("auth", "user", user)
+func WithStore(ctx context.Context) (context.Context, *Store) {
+	if s := StoreFrom(ctx); s != nil {
+		return ctx, s
+	}
+	s := NewStore()
+	return context.WithValue(ctx, storeKey{}, s), s
+}
+
+// StoreFrom 获取 context 上的 Store，不存在时返回 nil
+func StoreFrom(ctx context.Context) *Store {
+	s, _ := ctx.Value(storeKey{}).(*Store)
+	return s
+}
+
+// Set 在命名空间 ns 下设置值
+func (s *Store) Set(ns, key string, val any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, ok := s.data[ns]
+	if !ok {
+		m = make(map[string]any)
+		s.data[ns] = m
+	}
+	m[key] = val
+}
+
+// Get 获取命名空间 ns 下的值
+func (s *Store) Get(ns, key string) (any, bool) {
+	if s == nil {
+		return nil, false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.data[ns][key]
+	return v, ok
+}
+
+// Delete 删除命名空间 ns 下的值，命名空间为空时一并删除
+func (s *Store) Delete(ns, key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, ok := s.data[ns]
+	if !ok {
+		return
+	}
+	delete(m, key)
+	if len(m) == 0 {
+		delete(s.data, ns)
+	}
+}
+
+// Namespace 返回命名空间 ns 下所有值的副本，不存在时返回 nil
+func (s *Store) Namespace(ns string) map[string]any {
+	if s == nil {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return maps.Clone(s.data[ns])
+}
+
+// Dump 返回所有命名空间及其值的副本，用于调试查看 context 携带的内容
+//
+// 示例:
+//
+//	log.Printf("ctx store: %v", contextx.StoreFrom(ctx).Dump())
+//	// map[auth:map[user:alice] rpc:map[attempt:2]]
+func (s *Store) Dump() map[string]map[string]any {
+	out := make(map[string]map[string]any)
+	if s == nil {
+		return out
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for ns, m := range s.data {
+		out[ns] = maps.Clone(m)
+	}
+	return out
+}
+
+// StoreValue 从 Store 获取值并转换为类型 T
+//
+// 返回:
+//   - T: 值
+//   - bool: 不存在或类型不匹配时返回 false
+//
+// 示例:
+//
+//	user, ok := contextx.StoreValue[*User](contextx.StoreFrom(ctx), "auth", "user")
+func StoreValue[T any](s *Store, ns, key string) (T, bool) {
+	v, _ := s.Get(ns, key)
+	t, ok := v.(T)
+	return t, ok
+}
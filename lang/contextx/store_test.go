@@ -0,0 +1,89 @@
+package contextx
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestWithStore(t *testing.T) {
+	ctx, s := WithStore(context.Background())
+	s.Set("auth", "user", "alice")
+
+	// 派生 context 共享同一个 Store
+	child := WithTraceID(ctx, "trace-1")
+	child2, s2 := WithStore(child)
+	if s2 != s || child2 != child {
+		t.Error("WithStore should reuse existing store")
+	}
+
+	if v, ok := StoreValue[string](StoreFrom(child), "auth", "user"); !ok || v != "alice" {
+		t.Errorf("expected alice, got %q, %v", v, ok)
+	}
+}
+
+func TestStore_Namespaces(t *testing.T) {
+	s := NewStore()
+	s.Set("auth", "id", 1)
+	s.Set("rpc", "id", "call-9")
+
+	if v, _ := StoreValue[int](s, "auth", "id"); v != 1 {
+		t.Errorf("expected 1, got %d", v)
+	}
+	if v, _ := StoreValue[string](s, "rpc", "id"); v != "call-9" {
+		t.Errorf("expected call-9, got %q", v)
+	}
+	if _, ok := StoreValue[string](s, "auth", "id"); ok {
+		t.Error("expected false for type mismatch")
+	}
+
+	ns := s.Namespace("auth")
+	ns["id"] = 2
+	if v, _ := s.Get("auth", "id"); v != 1 {
+		t.Error("modifying namespace copy should not affect store")
+	}
+
+	s.Delete("auth", "id")
+	s.Delete("missing", "id")
+	if _, ok := s.Get("auth", "id"); ok {
+		t.Error("expected deleted value")
+	}
+	if dump := s.Dump(); len(dump) != 1 || dump["rpc"]["id"] != "call-9" {
+		t.Errorf("unexpected dump: %v", dump)
+	}
+}
+
+func TestStore_Nil(t *testing.T) {
+	s := StoreFrom(context.Background())
+	if s != nil {
+		t.Fatal("expected nil store")
+	}
+	if _, ok := s.Get("a", "b"); ok {
+		t.Error("expected false from nil store")
+	}
+	if _, ok := StoreValue[int](s, "a", "b"); ok {
+		t.Error("expected false from nil store")
+	}
+	if s.Namespace("a") != nil {
+		t.Error("expected nil namespace from nil store")
+	}
+	if dump := s.Dump(); dump == nil || len(dump) != 0 {
+		t.Error("expected empty dump from nil store")
+	}
+}
+
+func TestStore_Concurrent(t *testing.T) {
+	s := NewStore()
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Go(func() {
+			s.Set("n", "k", i)
+			s.Get("n", "k")
+			s.Dump()
+		})
+	}
+	wg.Wait()
+	if _, ok := s.Get("n", "k"); !ok {
+		t.Error("expected value after concurrent writes")
+	}
+}
@@ -0,0 +1,233 @@
+package conv
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// base62Alphabet Base62 字符表（0-9A-Za-z），URL 安全
+const base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// base62Index 字符 -> Base62 数值，非法字符为 -1
+var base62Index = func() [256]int8 {
+	var idx [256]int8
+	for i := range idx {
+		idx[i] = -1
+	}
+	for i := range len(base62Alphabet) {
+		idx[base62Alphabet[i]] = int8(i)
+	}
+	return idx
+}()
+
+// HexToInt 将十六进制字符串转换为 int64
+//
+// 支持可选的符号和 "0x"/"0X" 前缀，不区分大小写；转换失败时返回 0
+//
+// 示例:
+//
+//	conv.HexToInt("ff")     // 255
+//	conv.HexToInt("0x1F")   // 31
+//	conv.HexToInt("-0x10")  // -16
+//	conv.HexToInt("xyz")    // 0
+func HexToInt(s string) int64 {
+	v, _ := HexToIntE(s)
+	return v
+}
+
+// HexToIntE 将十六进制字符串转换为 int64，失败时返回错误
+func HexToIntE(s string) (int64, error) {
+	return parseBase(s, 16, "0x")
+}
+
+// IntToHex 将整数转换为小写十六进制字符串（不带前缀）
+//
+// 示例:
+//
+//	conv.IntToHex(255)  // "ff"
+//	conv.IntToHex(-16)  // "-10"
+func IntToHex(n int64) string {
+	return strconv.FormatInt(n, 16)
+}
+
+// BinToInt 将二进制字符串转换为 int64
+//
+// 支持可选的符号和 "0b"/"0B" 前缀；转换失败时返回 0
+//
+// 示例:
+//
+//	conv.BinToInt("1010")    // 10
+//	conv.BinToInt("0b1111")  // 15
+func BinToInt(s string) int64 {
+	v, _ := BinToIntE(s)
+	return v
+}
+
+// BinToIntE 将二进制字符串转换为 int64，失败时返回错误
+func BinToIntE(s string) (int64, error) {
+	return parseBase(s, 2, "0b")
+}
+
+// IntToBin 将整数转换为二进制字符串（不带前缀）
+//
+// 示例:
+//
+//	conv.IntToBin(10)  // "1010"
+func IntToBin(n int64) string {
+	return strconv.FormatInt(n, 2)
+}
+
+// OctToInt 将八进制字符串转换为 int64
+//
+// 支持可选的符号和 "0o"/"0O" 前缀；转换失败时返回 0
+//
+// 示例:
+//
+//	conv.OctToInt("755")    // 493
+//	conv.OctToInt("0o644")  // 420
+func OctToInt(s string) int64 {
+	v, _ := OctToIntE(s)
+	return v
+}
+
+// OctToIntE 将八进制字符串转换为 int64，失败时返回错误
+func OctToIntE(s string) (int64, error) {
+	return parseBase(s, 8, "0o")
+}
+
+// IntToOct 将整数转换为八进制字符串（不带前缀）
+//
+// 示例:
+//
+//	conv.IntToOct(493)  // "755"
+func IntToOct(n int64) string {
+	return strconv.FormatInt(n, 8)
+}
+
+// parseBase 解析带可选符号和前缀的指定进制整数
+func parseBase(raw string, base int, prefix string) (int64, error) {
+	s := strings.TrimSpace(raw)
+	sign := ""
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		sign, s = s[:1], s[1:]
+	}
+	if len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix) {
+		s = s[len(prefix):]
+	}
+	v, err := strconv.ParseInt(sign+s, base, 64)
+	if err != nil {
+		return 0, convertError(raw, "int64", unwrapNumError(err))
+	}
+	return v, nil
+}
+
+// EncodeBase36 将整数编码为 Base36 字符串（0-9a-z）
+//
+// 适用于生成不区分大小写的短 ID
+//
+// 示例:
+//
+//	conv.EncodeBase36(123456789)  // "21i3v9"
+func EncodeBase36(n int64) string {
+	return strconv.FormatInt(n, 36)
+}
+
+// DecodeBase36 将 Base36 字符串解码为整数（不区分大小写）
+//
+// 解码失败时返回 0
+func DecodeBase36(s string) int64 {
+	v, _ := DecodeBase36E(s)
+	return v
+}
+
+// DecodeBase36E 将 Base36 字符串解码为整数，失败时返回错误
+func DecodeBase36E(s string) (int64, error) {
+	v, err := strconv.ParseInt(s, 36, 64)
+	if err != nil {
+		return 0, convertError(s, "int64", unwrapNumError(err))
+	}
+	return v, nil
+}
+
+// EncodeBase62 将整数编码为 Base62 字符串（0-9A-Za-z）
+//
+// 结果只包含字母和数字，适用于短链接、短 ID 等 URL 场景；
+// 负数带 "-" 前缀（同样是 URL 安全字符）
+//
+// 示例:
+//
+//	conv.EncodeBase62(0)           // "0"
+//	conv.EncodeBase62(61)          // "z"
+//	conv.EncodeBase62(123456789)   // "8M0kX"
+func EncodeBase62(n int64) string {
+	if n == 0 {
+		return "0"
+	}
+	// 使用 uint64 计算，避免 math.MinInt64 取反溢出
+	u := uint64(n)
+	neg := n < 0
+	if neg {
+		u = -u
+	}
+
+	var buf [12]byte // 62^11 > 2^64，加一位符号
+	i := len(buf)
+	for u > 0 {
+		i--
+		buf[i] = base62Alphabet[u%62]
+		u /= 62
+	}
+	if neg {
+		i--
+		buf[i] = '-'
+	}
+	return string(buf[i:])
+}
+
+// DecodeBase62 将 Base62 字符串解码为整数（区分大小写）
+//
+// 解码失败（非法字符、溢出）时返回 0
+//
+// 示例:
+//
+//	conv.DecodeBase62("8M0kX")  // 123456789
+func DecodeBase62(s string) int64 {
+	v, _ := DecodeBase62E(s)
+	return v
+}
+
+// DecodeBase62E 将 Base62 字符串解码为整数，失败时返回错误
+//
+// 非法字符返回包装 strconv.ErrSyntax 的错误，超出 int64 范围返回包装 strconv.ErrRange 的错误
+func DecodeBase62E(s string) (int64, error) {
+	digits := s
+	neg := false
+	if digits != "" && (digits[0] == '-' || digits[0] == '+') {
+		neg = digits[0] == '-'
+		digits = digits[1:]
+	}
+	if digits == "" {
+		return 0, convertError(s, "int64", strconv.ErrSyntax)
+	}
+
+	limit := uint64(math.MaxInt64)
+	if neg {
+		limit++
+	}
+	var u uint64
+	for i := range len(digits) {
+		d := base62Index[digits[i]]
+		if d < 0 {
+			return 0, convertError(s, "int64", strconv.ErrSyntax)
+		}
+		if u > (limit-uint64(d))/62 {
+			return 0, convertError(s, "int64", strconv.ErrRange)
+		}
+		u = u*62 + uint64(d)
+	}
+	if neg {
+		return int64(-u), nil
+	}
+	return int64(u), nil
+}
@@ -0,0 +1,96 @@
+package conv
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestHexBinOct(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(string) int64
+		input    string
+		expected int64
+	}{
+		{"hex", HexToInt, "ff", 255},
+		{"hex prefix", HexToInt, "0x1F", 31},
+		{"hex negative", HexToInt, "-0x10", -16},
+		{"hex invalid", HexToInt, "xyz", 0},
+		{"bin", BinToInt, "1010", 10},
+		{"bin prefix", BinToInt, "0B1111", 15},
+		{"bin invalid", BinToInt, "102", 0},
+		{"oct", OctToInt, "755", 493},
+		{"oct prefix", OctToInt, "0o644", 420},
+		{"oct invalid", OctToInt, "9", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fn(tt.input); got != tt.expected {
+				t.Errorf("%s(%q) = %d, want %d", tt.name, tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	if IntToHex(255) != "ff" || IntToBin(10) != "1010" || IntToOct(493) != "755" {
+		t.Error("IntToHex/IntToBin/IntToOct failed")
+	}
+	if _, err := HexToIntE("0x8000000000000000"); !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("HexToIntE overflow error = %v, want ErrRange", err)
+	}
+}
+
+func TestBase62(t *testing.T) {
+	tests := []struct {
+		n       int64
+		encoded string
+	}{
+		{0, "0"},
+		{61, "z"},
+		{62, "10"},
+		{123456789, "8M0kX"},
+		{-62, "-10"},
+		{math.MaxInt64, "AzL8n0Y58m7"},
+	}
+	for _, tt := range tests {
+		if got := EncodeBase62(tt.n); got != tt.encoded {
+			t.Errorf("EncodeBase62(%d) = %q, want %q", tt.n, got, tt.encoded)
+		}
+		if got := DecodeBase62(tt.encoded); got != tt.n {
+			t.Errorf("DecodeBase62(%q) = %d, want %d", tt.encoded, got, tt.n)
+		}
+	}
+
+	for _, n := range []int64{1, 1e9, math.MinInt64, math.MinInt64 + 1, -1} {
+		if got := DecodeBase62(EncodeBase62(n)); got != n {
+			t.Errorf("round trip %d = %d", n, got)
+		}
+	}
+
+	if _, err := DecodeBase62E("abc!"); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("invalid char error = %v, want ErrSyntax", err)
+	}
+	if _, err := DecodeBase62E(""); !errors.Is(err, ErrConvert) {
+		t.Errorf("empty string error = %v, want ErrConvert", err)
+	}
+	if _, err := DecodeBase62E("AzL8n0Y58m8"); !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("overflow error = %v, want ErrRange", err)
+	}
+	if DecodeBase62("Ab") == DecodeBase62("aB") {
+		t.Error("Base62 should be case-sensitive")
+	}
+}
+
+func TestBase36(t *testing.T) {
+	if got := EncodeBase36(123456789); got != "21i3v9" {
+		t.Errorf("EncodeBase36 = %q", got)
+	}
+	if got := DecodeBase36("21I3V9"); got != 123456789 {
+		t.Errorf("DecodeBase36 = %d", got)
+	}
+	if _, err := DecodeBase36E("!"); err == nil {
+		t.Error("DecodeBase36E should fail for invalid input")
+	}
+}
@@ -0,0 +1,255 @@
+package conv
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// bigFloatPrec BigFloat 解析字符串时使用的精度（二进制位数），约 77 位十进制有效数字
+const bigFloatPrec = 256
+
+// BigInt 将任意类型转换为 *big.Int
+//
+// 支持的类型:
+//   - *big.Int/big.Int: 返回副本
+//   - *big.Float/*big.Rat: 截断为整数
+//   - 整数: 直接转换
+//   - 浮点数: 截断为整数（NaN/Inf 视为失败）
+//   - string/[]byte: 按十进制解析，支持任意长度（如 "123456789012345678901234567890"）
+//
+// 转换失败时返回值为 0 的 *big.Int（而不是 nil），可以安全调用其方法
+//
+// 示例:
+//
+//	conv.BigInt("123456789012345678901234567890")
+//	conv.BigInt(42)      // 42
+//	conv.BigInt("abc")   // 0
+func BigInt(any any) *big.Int {
+	v, err := BigIntE(any)
+	if err != nil {
+		return new(big.Int)
+	}
+	return v
+}
+
+// BigIntE 将任意类型转换为 *big.Int，失败时返回错误
+func BigIntE(any any) (*big.Int, error) {
+	if any == nil {
+		return nil, convertError(any, "*big.Int", nil)
+	}
+	switch value := any.(type) {
+	case *big.Int:
+		if value == nil {
+			return nil, convertError(any, "*big.Int", nil)
+		}
+		return new(big.Int).Set(value), nil
+	case big.Int:
+		return new(big.Int).Set(&value), nil
+	case *big.Float:
+		if value == nil || value.IsInf() {
+			return nil, convertError(any, "*big.Int", nil)
+		}
+		i, _ := value.Int(nil)
+		return i, nil
+	case *big.Rat:
+		if value == nil {
+			return nil, convertError(any, "*big.Int", nil)
+		}
+		return new(big.Int).Quo(value.Num(), value.Denom()), nil
+	case int, int8, int16, int32, int64:
+		return big.NewInt(Int64(value)), nil
+	case uint, uint8, uint16, uint32, uint64:
+		return new(big.Int).SetUint64(Uint64(value)), nil
+	case float32, float64:
+		f := Float64(value)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, convertError(any, "*big.Int", nil)
+		}
+		i, _ := big.NewFloat(f).Int(nil)
+		return i, nil
+	case bool:
+		if value {
+			return big.NewInt(1), nil
+		}
+		return new(big.Int), nil
+	case []byte:
+		return parseBigInt(any, string(value))
+	case string:
+		return parseBigInt(any, value)
+	default:
+		return parseBigInt(any, String(any))
+	}
+}
+
+// parseBigInt 解析十进制大整数字符串
+func parseBigInt(raw any, s string) (*big.Int, error) {
+	i, ok := new(big.Int).SetString(strings.TrimSpace(s), 10)
+	if !ok {
+		return nil, convertError(raw, "*big.Int", strconv.ErrSyntax)
+	}
+	return i, nil
+}
+
+// BigFloat 将任意类型转换为 *big.Float
+//
+// 支持的类型:
+//   - *big.Float/*big.Int/*big.Rat: 精确转换
+//   - 整数: 精确转换
+//   - 浮点数: 按最短十进制表示转换（0.1 转为十进制 0.1，而不是其二进制近似值）
+//   - string/[]byte: 按十进制解析，精度为 256 位
+//
+// 转换失败时返回值为 0 的 *big.Float（而不是 nil）
+//
+// 示例:
+//
+//	conv.BigFloat("12345678901234567890.123456789")
+//	conv.BigFloat(0.1)   // 0.1（十进制精确值）
+func BigFloat(any any) *big.Float {
+	v, err := BigFloatE(any)
+	if err != nil {
+		return new(big.Float)
+	}
+	return v
+}
+
+// BigFloatE 将任意类型转换为 *big.Float，失败时返回错误
+func BigFloatE(any any) (*big.Float, error) {
+	if any == nil {
+		return nil, convertError(any, "*big.Float", nil)
+	}
+	switch value := any.(type) {
+	case *big.Float:
+		if value == nil {
+			return nil, convertError(any, "*big.Float", nil)
+		}
+		return new(big.Float).Copy(value), nil
+	case big.Float:
+		return new(big.Float).Copy(&value), nil
+	case *big.Int:
+		if value == nil {
+			return nil, convertError(any, "*big.Float", nil)
+		}
+		return new(big.Float).SetPrec(bigFloatPrec).SetInt(value), nil
+	case *big.Rat:
+		if value == nil {
+			return nil, convertError(any, "*big.Float", nil)
+		}
+		return new(big.Float).SetPrec(bigFloatPrec).SetRat(value), nil
+	case int, int8, int16, int32, int64:
+		return new(big.Float).SetPrec(bigFloatPrec).SetInt64(Int64(value)), nil
+	case uint, uint8, uint16, uint32, uint64:
+		return new(big.Float).SetPrec(bigFloatPrec).SetUint64(Uint64(value)), nil
+	case float32, float64:
+		f := Float64(value)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, convertError(any, "*big.Float", nil)
+		}
+		bits := 64
+		if _, ok := value.(float32); ok {
+			bits = 32
+		}
+		return parseBigFloat(any, strconv.FormatFloat(f, 'g', -1, bits))
+	case []byte:
+		return parseBigFloat(any, string(value))
+	case string:
+		return parseBigFloat(any, value)
+	default:
+		return parseBigFloat(any, String(any))
+	}
+}
+
+// parseBigFloat 解析十进制浮点数字符串
+func parseBigFloat(raw any, s string) (*big.Float, error) {
+	f, ok := new(big.Float).SetPrec(bigFloatPrec).SetString(strings.TrimSpace(s))
+	if !ok {
+		return nil, convertError(raw, "*big.Float", strconv.ErrSyntax)
+	}
+	return f, nil
+}
+
+// DecimalString 将数值格式化为保留 places 位小数的十进制字符串
+//
+// 参数:
+//   - any: 数值（整数、浮点数、数字字符串、*big.Int/*big.Float/*big.Rat）
+//   - places: 保留的小数位数，负数视为 0
+//
+// 返回:
+//   - string: 四舍五入（0.5 远离零舍入）后的字符串，转换失败时返回空字符串
+//
+// 浮点数按其最短十进制表示参与运算，因此不会出现二进制舍入误差:
+// fmt.Sprintf("%.2f", 2.675) 得到 "2.67"，而 DecimalString(2.675, 2) 得到 "2.68"
+//
+// 示例:
+//
+//	conv.DecimalString(3.14159, 2)   // "3.14"
+//	conv.DecimalString(2.675, 2)     // "2.68"
+//	conv.DecimalString("1.005", 2)   // "1.01"
+//	conv.DecimalString(-0.5, 0)      // "-1"
+//	conv.DecimalString(42, 2)        // "42.00"
+func DecimalString(any any, places int) string {
+	r, err := toRat(any)
+	if err != nil {
+		return ""
+	}
+	if places < 0 {
+		places = 0
+	}
+	return r.FloatString(places)
+}
+
+// toRat 将数值转换为精确的有理数
+func toRat(any any) (*big.Rat, error) {
+	if any == nil {
+		return nil, convertError(any, "*big.Rat", nil)
+	}
+	switch value := any.(type) {
+	case *big.Rat:
+		if value == nil {
+			return nil, convertError(any, "*big.Rat", nil)
+		}
+		return new(big.Rat).Set(value), nil
+	case *big.Int:
+		if value == nil {
+			return nil, convertError(any, "*big.Rat", nil)
+		}
+		return new(big.Rat).SetInt(value), nil
+	case *big.Float:
+		if value == nil || value.IsInf() {
+			return nil, convertError(any, "*big.Rat", nil)
+		}
+		// 按最短十进制表示转换，避免二进制尾数带来的舍入误差
+		return parseRat(any, value.Text('g', -1))
+	case float32, float64:
+		f := Float64(value)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, convertError(any, "*big.Rat", nil)
+		}
+		bits := 64
+		if _, ok := value.(float32); ok {
+			bits = 32
+		}
+		// 按最短十进制表示转换，2.675 得到 2675/1000 而不是其二进制近似值
+		return parseRat(any, strconv.FormatFloat(f, 'g', -1, bits))
+	case int, int8, int16, int32, int64:
+		return new(big.Rat).SetInt64(Int64(value)), nil
+	case uint, uint8, uint16, uint32, uint64:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(Uint64(value))), nil
+	case []byte:
+		return parseRat(any, string(value))
+	case string:
+		return parseRat(any, value)
+	default:
+		return parseRat(any, String(any))
+	}
+}
+
+// parseRat 解析十进制字符串为有理数
+func parseRat(raw any, s string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return nil, convertError(raw, "*big.Rat", strconv.ErrSyntax)
+	}
+	return r, nil
+}
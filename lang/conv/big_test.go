@@ -0,0 +1,101 @@
+package conv
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestBigInt(t *testing.T) {
+	huge := "123456789012345678901234567890"
+	tests := []struct {
+		name     string
+		input    any
+		expected string
+	}{
+		{"huge string", huge, huge},
+		{"int", 42, "42"},
+		{"uint64", uint64(math.MaxUint64), "18446744073709551615"},
+		{"float truncated", 3.99, "3"},
+		{"big.Float", big.NewFloat(1e20), "100000000000000000000"},
+		{"big.Rat", big.NewRat(7, 2), "3"},
+		{"invalid", "abc", "0"},
+		{"nil", nil, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BigInt(tt.input).String(); got != tt.expected {
+				t.Errorf("BigInt(%v) = %s, want %s", tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	src := big.NewInt(5)
+	cp := BigInt(src)
+	cp.SetInt64(6)
+	if src.Int64() != 5 {
+		t.Error("BigInt should return a copy")
+	}
+}
+
+func TestBigFloat(t *testing.T) {
+	if got := String(BigFloat("12345678901234567890.123456789")); got != "12345678901234567890.123456789" {
+		t.Errorf("BigFloat string = %s", got)
+	}
+	if got := String(BigFloat(0.1)); got != "0.1" {
+		t.Errorf("BigFloat(0.1) = %s, want 0.1", got)
+	}
+	if _, err := BigFloatE("x"); err == nil {
+		t.Error("BigFloatE(\"x\") should fail")
+	}
+	if BigFloat(math.NaN()).Sign() != 0 {
+		t.Error("BigFloat(NaN) should return 0")
+	}
+}
+
+func TestDecimalString(t *testing.T) {
+	tests := []struct {
+		input    any
+		places   int
+		expected string
+	}{
+		{3.14159, 2, "3.14"},
+		{2.675, 2, "2.68"},
+		{1.005, 2, "1.01"},
+		{"1.005", 2, "1.01"},
+		{-0.5, 0, "-1"},
+		{0.1 + 0.2, 2, "0.30"},
+		{42, 2, "42.00"},
+		{"123456789012345678.125", 2, "123456789012345678.13"},
+		{big.NewInt(7), 1, "7.0"},
+		{big.NewRat(1, 3), 4, "0.3333"},
+		{1.5, -1, "2"},
+		{"abc", 2, ""},
+		{nil, 2, ""},
+	}
+
+	for _, tt := range tests {
+		if got := DecimalString(tt.input, tt.places); got != tt.expected {
+			t.Errorf("DecimalString(%v, %d) = %q, want %q", tt.input, tt.places, got, tt.expected)
+		}
+	}
+}
+
+func TestBigToBasic(t *testing.T) {
+	if Int64(big.NewInt(123)) != 123 {
+		t.Error("Int64(*big.Int) failed")
+	}
+	if _, err := Int64E(BigInt("123456789012345678901234567890")); err == nil {
+		t.Error("Int64E should fail for values beyond int64")
+	}
+	if Float64(big.NewFloat(1.25)) != 1.25 {
+		t.Error("Float64(*big.Float) failed")
+	}
+	if Float64(big.NewRat(1, 4)) != 0.25 {
+		t.Error("Float64(*big.Rat) failed")
+	}
+	if String(big.NewRat(1, 4)) != "1/4" {
+		t.Errorf("String(*big.Rat) = %s", String(big.NewRat(1, 4)))
+	}
+}
@@ -0,0 +1,554 @@
+package conv
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// copyOptions Copy 的配置
+type copyOptions struct {
+	tagName  string            // 用于字段重命名的标签名
+	mapping  map[string]string // 源字段名 -> 目标字段名
+	ignore   map[string]bool   // 忽略的目标字段名
+	deep     bool              // 是否深拷贝指针/切片/map
+	skipZero bool              // 是否跳过零值源字段
+	maxDepth int               // 最大嵌套深度，防止循环引用
+}
+
+// CopyOption Copy 选项函数
+type CopyOption func(*copyOptions)
+
+// WithTagName 使用结构体标签重命名字段
+//
+// 源和目标字段都会优先使用该标签的名称（逗号前部分）参与匹配，
+// 标签为 "-" 的字段被忽略
+//
+// 示例:
+//
+//	type UserDTO struct {
+//	    UserName string `copy:"name"`
+//	}
+//	conv.Copy(&dto, user, conv.WithTagName("copy"))
+func WithTagName(tag string) CopyOption {
+	return func(o *copyOptions) {
+		o.tagName = tag
+	}
+}
+
+// WithFieldMapping 显式指定字段映射（源字段名 -> 目标字段名）
+//
+// 示例:
+//
+//	conv.Copy(&dto, user, conv.WithFieldMapping(map[string]string{"ID": "UserID"}))
+func WithFieldMapping(mapping map[string]string) CopyOption {
+	return func(o *copyOptions) {
+		if o.mapping == nil {
+			o.mapping = make(map[string]string, len(mapping))
+		}
+		for k, v := range mapping {
+			o.mapping[k] = v
+		}
+	}
+}
+
+// WithIgnoreFields 忽略指定的目标字段
+func WithIgnoreFields(fields ...string) CopyOption {
+	return func(o *copyOptions) {
+		if o.ignore == nil {
+			o.ignore = make(map[string]bool, len(fields))
+		}
+		for _, f := range fields {
+			o.ignore[f] = true
+		}
+	}
+}
+
+// WithDeepCopy 深拷贝指针、切片和 map，使目标与源不共享底层数据
+func WithDeepCopy() CopyOption {
+	return func(o *copyOptions) {
+		o.deep = true
+	}
+}
+
+// WithSkipZero 跳过零值源字段（保留目标字段原值），适用于部分更新
+func WithSkipZero() CopyOption {
+	return func(o *copyOptions) {
+		o.skipZero = true
+	}
+}
+
+// Copy 在结构体之间复制同名/兼容字段
+//
+// 参数:
+//   - dst: 目标结构体指针
+//   - src: 源结构体或结构体指针
+//   - opts: 复制选项
+//
+// 返回:
+//   - error: dst/src 类型不合法或字段无法转换时返回错误
+//
+// 匹配规则:
+//   - 默认按字段名匹配，精确匹配失败时忽略大小写匹配
+//   - 匿名嵌入的结构体字段会被展开参与匹配
+//   - 类型不同时按 conv 的转换规则转换（如 int -> string、"1" -> int）
+//   - 无法解析（如 "abc" -> int）或超出目标类型范围时返回错误，错误信息包含字段路径，
+//     可通过 errors.Is(err, conv.ErrConvert) 判断
+//   - 嵌套结构体、指针、切片、map 会递归转换
+//
+// 示例:
+//
+//	type User struct {
+//	    ID   int64
+//	    Name string
+//	    Age  int
+//	}
+//	type UserDTO struct {
+//	    ID   string
+//	    Name string
+//	    Age  int64
+//	}
+//	var dto UserDTO
+//	err := conv.Copy(&dto, user)
+//	// dto.ID = "1", dto.Name = "Alice", dto.Age = 30
+func Copy(dst, src any, opts ...CopyOption) error {
+	o := &copyOptions{maxDepth: 32}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	dv, err := structElem(dst)
+	if err != nil {
+		return err
+	}
+
+	sv := reflect.ValueOf(src)
+	for sv.Kind() == reflect.Ptr {
+		if sv.IsNil() {
+			return nil
+		}
+		sv = sv.Elem()
+	}
+	if sv.Kind() != reflect.Struct {
+		return fmt.Errorf("conv: src must be a struct or pointer to struct, got %T", src)
+	}
+
+	c := &copier{opts: o}
+	return c.copyStruct(dv, sv, "", 0)
+}
+
+// copier 结构体复制器
+type copier struct {
+	opts *copyOptions
+}
+
+// copyField 可参与复制的字段
+type copyField struct {
+	name  string
+	value reflect.Value
+}
+
+// copyStruct 复制结构体字段
+func (c *copier) copyStruct(dst, src reflect.Value, path string, depth int) error {
+	if depth > c.opts.maxDepth {
+		return fmt.Errorf("conv: max copy depth exceeded at %q", path)
+	}
+
+	srcFields := c.fields(src, nil)
+	byName := make(map[string]reflect.Value, len(srcFields))
+	byFold := make(map[string]reflect.Value, len(srcFields))
+	for _, f := range srcFields {
+		name := f.name
+		if mapped, ok := c.opts.mapping[name]; ok {
+			name = mapped
+		}
+		byName[name] = f.value
+		byFold[strings.ToLower(name)] = f.value
+	}
+
+	for _, f := range c.fields(dst, nil) {
+		if c.opts.ignore[f.name] {
+			continue
+		}
+		sf, ok := byName[f.name]
+		if !ok {
+			sf, ok = byFold[strings.ToLower(f.name)]
+		}
+		if !ok {
+			continue
+		}
+		if c.opts.skipZero && sf.IsZero() {
+			continue
+		}
+		fieldPath := joinPath(path, f.name)
+		if err := c.assign(f.value, sf, fieldPath, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fields 展开结构体的可导出字段（包括匿名嵌入结构体的字段）
+func (c *copier) fields(v reflect.Value, out []copyField) []copyField {
+	t := v.Type()
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		name := sf.Name
+		if c.opts.tagName != "" {
+			if tag := sf.Tag.Get(c.opts.tagName); tag != "" {
+				tag, _, _ = strings.Cut(tag, ",")
+				if tag == "-" {
+					continue
+				}
+				if tag != "" {
+					name = tag
+				}
+			}
+		}
+
+		// 展开未命名的匿名嵌入结构体
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct && name == sf.Name {
+			out = c.fields(v.Field(i), out)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		out = append(out, copyField{name: name, value: v.Field(i)})
+	}
+	return out
+}
+
+// assign 将 src 转换后写入 dst
+func (c *copier) assign(dst, src reflect.Value, path string, depth int) error {
+	if depth > c.opts.maxDepth {
+		return fmt.Errorf("conv: max copy depth exceeded at %q", path)
+	}
+
+	// 解开 interface 包装
+	for src.IsValid() && src.Kind() == reflect.Interface {
+		if src.IsNil() {
+			src = reflect.Value{}
+			break
+		}
+		src = src.Elem()
+	}
+	if !src.IsValid() {
+		dst.SetZero()
+		return nil
+	}
+
+	dt := dst.Type()
+
+	// 类型相同：浅拷贝直接赋值，深拷贝则克隆引用类型
+	if src.Type() == dt {
+		if c.opts.deep {
+			return c.clone(dst, src, path, depth)
+		}
+		dst.Set(src)
+		return nil
+	}
+
+	switch dt.Kind() {
+	case reflect.Ptr:
+		if src.Kind() == reflect.Ptr && src.IsNil() {
+			dst.SetZero()
+			return nil
+		}
+		elem := reflect.New(dt.Elem())
+		if err := c.assign(elem.Elem(), src, path, depth+1); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	case reflect.Interface:
+		if src.Type().Implements(dt) {
+			dst.Set(src)
+			return nil
+		}
+	}
+
+	// 源为指针：解引用后转换
+	if src.Kind() == reflect.Ptr {
+		if src.IsNil() {
+			dst.SetZero()
+			return nil
+		}
+		return c.assign(dst, src.Elem(), path, depth+1)
+	}
+
+	switch dt.Kind() {
+	case reflect.Struct:
+		switch src.Kind() {
+		case reflect.Struct:
+			return c.copyStruct(dst, src, path, depth)
+		case reflect.Map:
+			return c.mapToStruct(dst, src, path, depth)
+		}
+	case reflect.Slice:
+		if src.Kind() == reflect.Slice || src.Kind() == reflect.Array {
+			if src.Kind() == reflect.Slice && src.IsNil() {
+				dst.SetZero()
+				return nil
+			}
+			out := reflect.MakeSlice(dt, src.Len(), src.Len())
+			for i := range src.Len() {
+				if err := c.assign(out.Index(i), src.Index(i), fmt.Sprintf("%s[%d]", path, i), depth+1); err != nil {
+					return err
+				}
+			}
+			dst.Set(out)
+			return nil
+		}
+	case reflect.Array:
+		if src.Kind() == reflect.Slice || src.Kind() == reflect.Array {
+			n := min(dt.Len(), src.Len())
+			dst.SetZero()
+			for i := range n {
+				if err := c.assign(dst.Index(i), src.Index(i), fmt.Sprintf("%s[%d]", path, i), depth+1); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case reflect.Map:
+		if src.Kind() == reflect.Map {
+			if src.IsNil() {
+				dst.SetZero()
+				return nil
+			}
+			out := reflect.MakeMapWithSize(dt, src.Len())
+			iter := src.MapRange()
+			for iter.Next() {
+				k := reflect.New(dt.Key()).Elem()
+				if err := c.assign(k, iter.Key(), path, depth+1); err != nil {
+					return err
+				}
+				v := reflect.New(dt.Elem()).Elem()
+				if err := c.assign(v, iter.Value(), fmt.Sprintf("%s[%v]", path, iter.Key()), depth+1); err != nil {
+					return err
+				}
+				out.SetMapIndex(k, v)
+			}
+			dst.Set(out)
+			return nil
+		}
+	}
+
+	// time.Time 与 time.Duration 按 Time/Duration 的规则转换
+	if isScalarKind(src.Kind()) {
+		switch dt {
+		case timeType:
+			t, err := TimeE(src.Interface())
+			if err != nil {
+				return fmt.Errorf("conv: cannot convert field %q: %w", path, err)
+			}
+			dst.Set(reflect.ValueOf(t))
+			return nil
+		case durationType:
+			d, err := DurationE(src.Interface())
+			if err != nil {
+				return fmt.Errorf("conv: cannot convert field %q: %w", path, err)
+			}
+			dst.SetInt(int64(d))
+			return nil
+		}
+	}
+	if ok, err := assignScalar(dst, src); ok {
+		if err != nil {
+			return fmt.Errorf("conv: cannot convert field %q: %w", path, err)
+		}
+		return nil
+	}
+	if src.Type().ConvertibleTo(dt) && dt.Kind() != reflect.String {
+		dst.Set(src.Convert(dt))
+		return nil
+	}
+	return fmt.Errorf("conv: cannot convert field %q from %s to %s", path, src.Type(), dt)
+}
+
+// mapToStruct 将 map[string]T 的键按字段名写入结构体（忽略大小写匹配）
+func (c *copier) mapToStruct(dst, src reflect.Value, path string, depth int) error {
+	if src.Type().Key().Kind() != reflect.String {
+		return fmt.Errorf("conv: cannot convert field %q from %s to %s", path, src.Type(), dst.Type())
+	}
+	byFold := make(map[string]reflect.Value, src.Len())
+	byName := make(map[string]reflect.Value, src.Len())
+	iter := src.MapRange()
+	for iter.Next() {
+		key := iter.Key().String()
+		byName[key] = iter.Value()
+		byFold[strings.ToLower(key)] = iter.Value()
+	}
+
+	for _, f := range c.fields(dst, nil) {
+		if c.opts.ignore[f.name] {
+			continue
+		}
+		v, ok := byName[f.name]
+		if !ok {
+			v, ok = byFold[strings.ToLower(f.name)]
+		}
+		if !ok {
+			continue
+		}
+		if err := c.assign(f.value, v, joinPath(path, f.name), depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clone 深拷贝同类型的值
+func (c *copier) clone(dst, src reflect.Value, path string, depth int) error {
+	if depth > c.opts.maxDepth {
+		return fmt.Errorf("conv: max copy depth exceeded at %q", path)
+	}
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			dst.SetZero()
+			return nil
+		}
+		elem := reflect.New(src.Type().Elem())
+		if err := c.clone(elem.Elem(), src.Elem(), path, depth+1); err != nil {
+			return err
+		}
+		dst.Set(elem)
+	case reflect.Slice:
+		if src.IsNil() {
+			dst.SetZero()
+			return nil
+		}
+		out := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		for i := range src.Len() {
+			if err := c.clone(out.Index(i), src.Index(i), path, depth+1); err != nil {
+				return err
+			}
+		}
+		dst.Set(out)
+	case reflect.Map:
+		if src.IsNil() {
+			dst.SetZero()
+			return nil
+		}
+		out := reflect.MakeMapWithSize(src.Type(), src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			v := reflect.New(src.Type().Elem()).Elem()
+			if err := c.clone(v, iter.Value(), path, depth+1); err != nil {
+				return err
+			}
+			out.SetMapIndex(iter.Key(), v)
+		}
+		dst.Set(out)
+	case reflect.Struct:
+		dst.Set(src)
+		t := src.Type()
+		for i := range t.NumField() {
+			if !t.Field(i).IsExported() {
+				continue
+			}
+			if err := c.clone(dst.Field(i), src.Field(i), joinPath(path, t.Field(i).Name), depth+1); err != nil {
+				return err
+			}
+		}
+	default:
+		dst.Set(src)
+	}
+	return nil
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// assignScalar 按 conv 转换规则写入基础类型
+//
+// 返回是否处理；无法解析或超出目标类型范围时返回转换错误，dst 保持不变
+func assignScalar(dst, src reflect.Value) (bool, error) {
+	if !isScalarKind(src.Kind()) {
+		return false, nil
+	}
+	v := scalarValue(src)
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(String(src.Interface()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := Int64E(v)
+		if err != nil {
+			return true, err
+		}
+		if dst.OverflowInt(n) {
+			return true, convertError(v, dst.Type().String(), strconv.ErrRange)
+		}
+		dst.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := Uint64E(v)
+		if err != nil {
+			return true, err
+		}
+		if dst.OverflowUint(n) {
+			return true, convertError(v, dst.Type().String(), strconv.ErrRange)
+		}
+		dst.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := Float64E(v)
+		if err != nil {
+			return true, err
+		}
+		if dst.OverflowFloat(f) {
+			return true, convertError(v, dst.Type().String(), strconv.ErrRange)
+		}
+		dst.SetFloat(f)
+	case reflect.Bool:
+		b, err := BoolE(v)
+		if err != nil {
+			return true, err
+		}
+		dst.SetBool(b)
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// scalarValue 将基础类型（含自定义命名类型）转为对应的内置类型，便于 *E 函数识别
+func scalarValue(v reflect.Value) any {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	}
+	return v.Interface()
+}
+
+// isScalarKind 检查是否为基础类型
+func isScalarKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// joinPath 拼接字段路径
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,294 @@
+package conv
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+type copyAddress struct {
+	City string
+	Zip  int
+}
+
+type copyBase struct {
+	ID int64
+}
+
+type copyUser struct {
+	copyBase
+	Name    string
+	Age     int
+	Score   string
+	Active  string
+	Tags    []string
+	Extra   map[string]int
+	Address *copyAddress
+	secret  string
+}
+
+type copyUserDTO struct {
+	ID       string
+	UserName string `copy:"Name"`
+	Age      int64
+	Score    float64
+	Active   bool
+	Tags     []string
+	Extra    map[string]string
+	Address  copyAddressDTO
+	Ignored  string `copy:"-"`
+}
+
+type copyAddressDTO struct {
+	City string
+	Zip  string
+}
+
+func newCopyUser() copyUser {
+	return copyUser{
+		copyBase: copyBase{ID: 7},
+		Name:     "Alice",
+		Age:      30,
+		Score:    "98.5",
+		Active:   "yes",
+		Tags:     []string{"a", "b"},
+		Extra:    map[string]int{"x": 1},
+		Address:  &copyAddress{City: "Beijing", Zip: 100000},
+		secret:   "s",
+	}
+}
+
+func TestCopy_Coercion(t *testing.T) {
+	src := newCopyUser()
+	var dst copyUserDTO
+	if err := Copy(&dst, &src, WithTagName("copy")); err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+
+	if dst.ID != "7" {
+		t.Errorf("ID = %q, want %q", dst.ID, "7")
+	}
+	if dst.UserName != "Alice" {
+		t.Errorf("UserName = %q, want %q", dst.UserName, "Alice")
+	}
+	if dst.Age != 30 || dst.Score != 98.5 || !dst.Active {
+		t.Errorf("unexpected scalars: %+v", dst)
+	}
+	if dst.Extra["x"] != "1" {
+		t.Errorf("Extra = %v", dst.Extra)
+	}
+	if dst.Address.City != "Beijing" || dst.Address.Zip != "100000" {
+		t.Errorf("Address = %+v", dst.Address)
+	}
+}
+
+func TestCopy_FieldMapping(t *testing.T) {
+	type src struct {
+		ID   int
+		Name string
+	}
+	type dst struct {
+		UserID int
+		Name   string
+		Note   string
+	}
+
+	d := dst{Note: "keep"}
+	err := Copy(&d, src{ID: 1, Name: "Bob"}, WithFieldMapping(map[string]string{"ID": "UserID"}))
+	if err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+	if d.UserID != 1 || d.Name != "Bob" || d.Note != "keep" {
+		t.Errorf("unexpected result: %+v", d)
+	}
+}
+
+func TestCopy_CaseInsensitive(t *testing.T) {
+	type src struct{ UserId int }
+	type dst struct{ UserID int }
+
+	var d dst
+	if err := Copy(&d, src{UserId: 5}); err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+	if d.UserID != 5 {
+		t.Errorf("UserID = %d, want 5", d.UserID)
+	}
+}
+
+func TestCopy_DeepCopy(t *testing.T) {
+	src := newCopyUser()
+
+	var shallow, deep copyUser
+	if err := Copy(&shallow, src); err != nil {
+		t.Fatal(err)
+	}
+	if err := Copy(&deep, src, WithDeepCopy()); err != nil {
+		t.Fatal(err)
+	}
+
+	src.Tags[0] = "changed"
+	src.Extra["x"] = 100
+	src.Address.City = "Shanghai"
+
+	if shallow.Tags[0] != "changed" || shallow.Address.City != "Shanghai" {
+		t.Errorf("shallow copy should share data: %+v", shallow)
+	}
+	if deep.Tags[0] != "a" || deep.Extra["x"] != 1 || deep.Address.City != "Beijing" {
+		t.Errorf("deep copy should not share data: %+v", deep)
+	}
+	if deep.secret != "" {
+		t.Error("unexported fields should not be copied")
+	}
+}
+
+func TestCopy_SkipZeroAndIgnore(t *testing.T) {
+	type patch struct {
+		Name string
+		Age  int
+	}
+	type user struct {
+		Name string
+		Age  int
+	}
+
+	u := user{Name: "Alice", Age: 30}
+	if err := Copy(&u, patch{Age: 31}, WithSkipZero()); err != nil {
+		t.Fatal(err)
+	}
+	if u.Name != "Alice" || u.Age != 31 {
+		t.Errorf("SkipZero result: %+v", u)
+	}
+
+	if err := Copy(&u, patch{Name: "Bob", Age: 40}, WithIgnoreFields("Age")); err != nil {
+		t.Fatal(err)
+	}
+	if u.Name != "Bob" || u.Age != 31 {
+		t.Errorf("IgnoreFields result: %+v", u)
+	}
+}
+
+func TestCopy_PointerFields(t *testing.T) {
+	type src struct {
+		Age  int
+		Name *string
+	}
+	type dst struct {
+		Age  *int64
+		Name string
+	}
+
+	name := "Alice"
+	var d dst
+	if err := Copy(&d, src{Age: 3, Name: &name}); err != nil {
+		t.Fatal(err)
+	}
+	if d.Age == nil || *d.Age != 3 || d.Name != "Alice" {
+		t.Errorf("unexpected result: %+v", d)
+	}
+
+	d = dst{Name: "old"}
+	if err := Copy(&d, src{}); err != nil {
+		t.Fatal(err)
+	}
+	if d.Age == nil || *d.Age != 0 || d.Name != "" {
+		t.Errorf("nil pointer should reset destination: %+v", d)
+	}
+}
+
+func TestCopy_Errors(t *testing.T) {
+	type src struct{ Tags []string }
+	type dst struct{ Tags int }
+
+	tests := []struct {
+		name string
+		dst  any
+		src  any
+	}{
+		{"dst not pointer", copyUserDTO{}, copyUser{}},
+		{"dst nil pointer", (*copyUserDTO)(nil), copyUser{}},
+		{"dst not struct", new(int), copyUser{}},
+		{"src not struct", &copyUserDTO{}, 1},
+		{"incompatible field", &dst{}, src{Tags: []string{"a"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Copy(tt.dst, tt.src); err == nil {
+				t.Error("Copy() expected error")
+			}
+		})
+	}
+}
+
+func TestCopy_ConversionErrors(t *testing.T) {
+	type inner struct{ Port string }
+	type srcT struct {
+		Age   string
+		Small int
+		Count int
+		Ratio float64
+		Flag  string
+		Wait  string
+		Inner inner
+	}
+	type innerDst struct{ Port uint16 }
+	type dstT struct {
+		Age   int
+		Small int8
+		Count uint
+		Ratio float32
+		Flag  bool
+		Wait  time.Duration
+		Inner innerDst
+	}
+
+	valid := srcT{Age: "30", Small: 1, Count: 1, Ratio: 1.5, Flag: "yes", Wait: "1s", Inner: inner{Port: "80"}}
+
+	tests := []struct {
+		name  string
+		edit  func(*srcT)
+		field string
+		err   error
+	}{
+		{"unparsable int", func(s *srcT) { s.Age = "abc" }, "Age", strconv.ErrSyntax},
+		{"int overflow", func(s *srcT) { s.Small = 300 }, "Small", strconv.ErrRange},
+		{"negative to uint", func(s *srcT) { s.Count = -1 }, "Count", strconv.ErrRange},
+		{"float overflow", func(s *srcT) { s.Ratio = math.MaxFloat64 }, "Ratio", strconv.ErrRange},
+		{"unparsable bool", func(s *srcT) { s.Flag = "maybe" }, "Flag", strconv.ErrSyntax},
+		{"unparsable duration", func(s *srcT) { s.Wait = "soon" }, "Wait", nil},
+		{"nested overflow", func(s *srcT) { s.Inner.Port = "70000" }, "Inner.Port", strconv.ErrRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := valid
+			tt.edit(&src)
+			var dst dstT
+			err := Copy(&dst, src)
+			if err == nil {
+				t.Fatalf("Copy() expected error, got dst %+v", dst)
+			}
+			if !errors.Is(err, ErrConvert) {
+				t.Errorf("expected ErrConvert, got %v", err)
+			}
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Errorf("expected %v, got %v", tt.err, err)
+			}
+			if !strings.Contains(err.Error(), strconv.Quote(tt.field)) {
+				t.Errorf("error should name field %q: %v", tt.field, err)
+			}
+		})
+	}
+
+	var dst dstT
+	if err := Copy(&dst, valid); err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+	if dst.Age != 30 || dst.Inner.Port != 80 || dst.Wait != time.Second || !dst.Flag {
+		t.Errorf("unexpected dst: %+v", dst)
+	}
+}
@@ -6,10 +6,33 @@
 //
 // 基础类型转换:
 //   - String: 任意类型转字符串
+//   - StringAny: 可配置浮点精度、nil、时间格式、结构体渲染方式（JSON/fmt）的字符串转换
 //   - Int/Int32/Int64: 任意类型转整数
 //   - Uint/Uint32/Uint64: 任意类型转无符号整数
 //   - Float32/Float64: 任意类型转浮点数
 //   - Bool: 任意类型转布尔值
+//   - Duration: "1h30m"、"90s"、纳秒数转 time.Duration
+//   - Time: RFC3339、"2006-01-02 15:04:05"、Unix 秒/毫秒转 time.Time
+//
+// 带错误返回的变体（用于需要区分 "0" 与解析失败的场景）:
+//   - IntE/Int32E/Int64E/UintE/Uint32E/Uint64E/Float32E/Float64E/BoolE/DurationE/TimeE
+//   - 返回的错误满足 errors.Is(err, conv.ErrConvert)
+//
+// 带单位的数值:
+//   - Bytes/HumanBytes: "10MB" 与字节数互转（1024 进制）
+//   - Metric: 解析 "1.5k"、"2M"、"500m" 等国际单位制前缀
+//
+// 进制转换:
+//   - HexToInt/BinToInt/OctToInt 与 IntToHex/IntToBin/IntToOct: 十六/二/八进制互转，支持 0x/0b/0o 前缀
+//   - EncodeBase62/DecodeBase62、EncodeBase36/DecodeBase36: 整数与短 ID 字符串互转
+//
+// 大数与定点小数:
+//   - BigInt/BigFloat: 任意类型转 *big.Int/*big.Float，支持超出 int64 范围的数字字符串
+//   - DecimalString: 按十进制四舍五入到指定小数位，避免浮点二进制舍入误差
+//
+// 切片转换:
+//   - Strings/Ints: 切片元素批量转换
+//   - ToSlice: 任意切片（如 JSON 解码的 []any）或单个值转 []T
 //
 // JSON/Map 操作:
 //   - JSONToMap: JSON 字符串转 Map
@@ -20,6 +43,10 @@
 //   - MapKeys: 提取所有 key
 //   - MapValues: 提取所有 value
 //
+// 结构体复制:
+//   - Copy: 结构体间复制同名字段，支持标签重命名、字段映射、深拷贝和类型转换
+//   - ToStruct: map/JSON 绑定到结构体（按 json 标签匹配，类型不匹配时自动转换）
+//
 // # 使用示例
 //
 //	import "github.com/hexagon-codes/toolkit/lang/conv"
@@ -79,10 +106,33 @@
 //
 // Basic type conversions:
 //   - String: convert any type to string
+//   - StringAny: string conversion with configurable float precision, nil, time layout and struct rendering (JSON/fmt)
 //   - Int/Int32/Int64: convert any type to integer
 //   - Uint/Uint32/Uint64: convert any type to unsigned integer
 //   - Float32/Float64: convert any type to float
 //   - Bool: convert any type to boolean
+//   - Duration: convert "1h30m", "90s" or nanoseconds to time.Duration
+//   - Time: convert RFC3339, "2006-01-02 15:04:05" or Unix seconds/millis to time.Time
+//
+// Error-returning variants (to distinguish "0" from a parse failure):
+//   - IntE/Int32E/Int64E/UintE/Uint32E/Uint64E/Float32E/Float64E/BoolE/DurationE/TimeE
+//   - returned errors satisfy errors.Is(err, conv.ErrConvert)
+//
+// Values with units:
+//   - Bytes/HumanBytes: convert between "10MB" and byte counts (base 1024)
+//   - Metric: parse SI suffixes such as "1.5k", "2M" and "500m"
+//
+// Base conversions:
+//   - HexToInt/BinToInt/OctToInt and IntToHex/IntToBin/IntToOct: hex/binary/octal, with optional 0x/0b/0o prefix
+//   - EncodeBase62/DecodeBase62, EncodeBase36/DecodeBase36: integers to and from short ID strings
+//
+// Big numbers and fixed-point decimals:
+//   - BigInt/BigFloat: convert any type to *big.Int/*big.Float, including digit strings beyond int64
+//   - DecimalString: round to a fixed number of decimal places without float binary rounding surprises
+//
+// Slice conversions:
+//   - Strings/Ints: convert slice elements in bulk
+//   - ToSlice: convert any slice (e.g. JSON-decoded []any) or single value to []T
 //
 // JSON/Map operations:
 //   - JSONToMap: convert JSON string to Map
@@ -93,6 +143,10 @@
 //   - MapKeys: extract all keys
 //   - MapValues: extract all values
 //
+// Struct copying:
+//   - Copy: copy matching fields between structs, with tag renaming, field mapping, deep copy and type coercion
+//   - ToStruct: bind a map or JSON to a struct (matched by json tag, coercing mismatched types)
+//
 // # Usage Examples
 //
 //	import "github.com/hexagon-codes/toolkit/lang/conv"
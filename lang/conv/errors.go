@@ -0,0 +1,45 @@
+package conv
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrConvert 类型转换失败
+//
+// 所有 *E 函数返回的错误都可以通过 errors.Is(err, conv.ErrConvert) 判断
+var ErrConvert = errors.New("conv: conversion failed")
+
+// ConvertError 类型转换错误，记录原始值、目标类型和底层错误
+type ConvertError struct {
+	// Value 原始值
+	Value any
+	// Target 目标类型名称
+	Target string
+	// Err 底层错误（如 strconv.ErrSyntax、strconv.ErrRange），可能为 nil
+	Err error
+}
+
+// Error 实现 error 接口
+func (e *ConvertError) Error() string {
+	msg := fmt.Sprintf("conv: cannot convert %#v (%T) to %s", e.Value, e.Value, e.Target)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+// Unwrap 返回底层错误
+func (e *ConvertError) Unwrap() error {
+	return e.Err
+}
+
+// Is 使 errors.Is(err, ErrConvert) 成立
+func (e *ConvertError) Is(target error) bool {
+	return target == ErrConvert
+}
+
+// convertError 创建转换错误
+func convertError(value any, target string, err error) error {
+	return &ConvertError{Value: value, Target: target, Err: err}
+}
@@ -3,6 +3,7 @@ package conv
 import (
 	"encoding/binary"
 	"math"
+	"math/big"
 	"strconv"
 )
 
@@ -21,42 +22,53 @@ import (
 //	conv.Float32("3.14")    // 3.14
 //	conv.Float32([]byte{...}) // 从二进制解码
 func Float32(any any) float32 {
+	v, _ := Float32E(any)
+	return v
+}
+
+// Float32E 将任意类型转换为 float32，失败时返回错误
+//
+// 转换规则与 Float32 相同；nil、无法解析的字符串或不足 4 字节的 []byte 视为失败
+func Float32E(any any) (float32, error) {
 	if any == nil {
-		return 0
+		return 0, convertError(any, "float32", nil)
 	}
 	switch value := any.(type) {
 	case int:
-		return float32(value)
+		return float32(value), nil
 	case int8:
-		return float32(value)
+		return float32(value), nil
 	case int16:
-		return float32(value)
+		return float32(value), nil
 	case int32:
-		return float32(value)
+		return float32(value), nil
 	case int64:
-		return float32(value)
+		return float32(value), nil
 	case uint:
-		return float32(value)
+		return float32(value), nil
 	case uint8:
-		return float32(value)
+		return float32(value), nil
 	case uint16:
-		return float32(value)
+		return float32(value), nil
 	case uint32:
-		return float32(value)
+		return float32(value), nil
 	case uint64:
-		return float32(value)
+		return float32(value), nil
 	case float32:
-		return value
+		return value, nil
 	case float64:
-		return float32(value)
+		return float32(value), nil
 	case []byte:
-		return bytesToFloat32(value)
+		if len(value) < 4 {
+			return 0, convertError(any, "float32", nil)
+		}
+		return bytesToFloat32(value), nil
 	default:
 		if f, ok := value.(iFloat32); ok {
-			return f.Float32()
+			return f.Float32(), nil
 		}
-		v, _ := strconv.ParseFloat(String(any), 64)
-		return float32(v)
+		v, err := parseFloat64(any, "float32", String(any))
+		return float32(v), err
 	}
 }
 
@@ -75,43 +87,85 @@ func Float32(any any) float32 {
 //	conv.Float64("3.14159")  // 3.14159
 //	conv.Float64(3.14)       // 3.14
 func Float64(any any) float64 {
+	v, _ := Float64E(any)
+	return v
+}
+
+// Float64E 将任意类型转换为 float64，失败时返回错误
+//
+// 转换规则与 Float64 相同；nil、无法解析的字符串或不足 8 字节的 []byte 视为失败
+//
+// 示例:
+//
+//	v, err := conv.Float64E("3.14")  // 3.14, nil
+//	v, err := conv.Float64E("abc")   // 0, error
+func Float64E(any any) (float64, error) {
 	if any == nil {
-		return 0
+		return 0, convertError(any, "float64", nil)
 	}
 	switch value := any.(type) {
 	case int:
-		return float64(value)
+		return float64(value), nil
 	case int8:
-		return float64(value)
+		return float64(value), nil
 	case int16:
-		return float64(value)
+		return float64(value), nil
 	case int32:
-		return float64(value)
+		return float64(value), nil
 	case int64:
-		return float64(value)
+		return float64(value), nil
 	case uint:
-		return float64(value)
+		return float64(value), nil
 	case uint8:
-		return float64(value)
+		return float64(value), nil
 	case uint16:
-		return float64(value)
+		return float64(value), nil
 	case uint32:
-		return float64(value)
+		return float64(value), nil
 	case uint64:
-		return float64(value)
+		return float64(value), nil
 	case float32:
-		return float64(value)
+		return float64(value), nil
 	case float64:
-		return value
+		return value, nil
 	case []byte:
-		return bytesToFloat64(value)
+		if len(value) < 8 {
+			return 0, convertError(any, "float64", nil)
+		}
+		return bytesToFloat64(value), nil
+	case *big.Float:
+		if value == nil {
+			return 0, convertError(any, "float64", nil)
+		}
+		f, _ := value.Float64()
+		return f, nil
+	case *big.Int:
+		if value == nil {
+			return 0, convertError(any, "float64", nil)
+		}
+		f, _ := new(big.Float).SetInt(value).Float64()
+		return f, nil
+	case *big.Rat:
+		if value == nil {
+			return 0, convertError(any, "float64", nil)
+		}
+		f, _ := value.Float64()
+		return f, nil
 	default:
 		if f, ok := value.(iFloat64); ok {
-			return f.Float64()
+			return f.Float64(), nil
 		}
-		v, _ := strconv.ParseFloat(String(any), 64)
-		return v
+		return parseFloat64(any, "float64", String(any))
+	}
+}
+
+// parseFloat64 解析浮点数字符串
+func parseFloat64(raw any, target, s string) (float64, error) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, convertError(raw, target, unwrapNumError(err))
 	}
+	return v, nil
 }
 
 // bytesToFloat32 将 []byte 解码为 float32 (小端序)
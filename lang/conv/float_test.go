@@ -150,3 +150,41 @@ func BenchmarkFloat64(b *testing.B) {
 		})
 	}
 }
+
+func TestFloat64E(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    any
+		expected float64
+		wantErr  bool
+	}{
+		{"zero string", "0", 0, false},
+		{"float string", "3.14", 3.14, false},
+		{"int", 42, 42, false},
+		{"custom", customFloat64(1.5), 1.5, false},
+		{"nil", nil, 0, true},
+		{"invalid", "abc", 0, true},
+		{"short bytes", []byte{1, 2}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Float64E(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Float64E(%v) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("Float64E(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFloat32E(t *testing.T) {
+	if v, err := Float32E("2.5"); err != nil || v != 2.5 {
+		t.Errorf("Float32E(\"2.5\") = %v, %v", v, err)
+	}
+	if _, err := Float32E("x"); err == nil {
+		t.Error("Float32E(\"x\") should fail")
+	}
+}
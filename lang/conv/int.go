@@ -2,6 +2,7 @@ package conv
 
 import (
 	"math"
+	"math/big"
 	"strconv"
 	"strings"
 )
@@ -17,7 +18,7 @@ import (
 //   - []byte: 转为字符串后解析
 //   - 其他: 转为字符串后解析
 //
-// 转换失败时返回 0
+// 转换失败时返回 0，需要区分失败与实际值 0 时使用 IntE
 //
 // 示例:
 //
@@ -26,81 +27,120 @@ import (
 //	conv.Int(true)        // 1
 //	conv.Int("invalid")   // 0
 func Int(any any) int {
-	return int(Int64(any))
+	v, _ := IntE(any)
+	return v
+}
+
+// IntE 将任意类型转换为 int，失败时返回错误
+//
+// 转换规则与 Int 相同；失败时返回 0 和 *ConvertError
+//
+// 示例:
+//
+//	v, err := conv.IntE("123")  // 123, nil
+//	v, err := conv.IntE("abc")  // 0, error
+//	v, err := conv.IntE("0")    // 0, nil
+func IntE(any any) (int, error) {
+	v, err := Int64E(any)
+	if err != nil {
+		return 0, err
+	}
+	if v > math.MaxInt || v < math.MinInt {
+		return 0, convertError(any, "int", strconv.ErrRange)
+	}
+	return int(v), nil
 }
 
 // Int64 将任意类型转换为 int64
 //
 // 转换失败时返回 0
 func Int64(any any) int64 {
+	v, _ := Int64E(any)
+	return v
+}
+
+// Int64E 将任意类型转换为 int64，失败时返回错误
+//
+// 以下情况视为失败: nil、无法解析的字符串、超出 int64 范围的值、NaN/Inf
+func Int64E(any any) (int64, error) {
 	if any == nil {
-		return 0
+		return 0, convertError(any, "int64", nil)
 	}
 	switch value := any.(type) {
 	case int:
-		return int64(value)
+		return int64(value), nil
 	case int8:
-		return int64(value)
+		return int64(value), nil
 	case int16:
-		return int64(value)
+		return int64(value), nil
 	case int32:
-		return int64(value)
+		return int64(value), nil
 	case int64:
-		return value
+		return value, nil
 	case uint:
-		// 防止溢出：大于 math.MaxInt64 时返回 0
+		// 防止溢出：大于 math.MaxInt64 时失败
 		if uint64(value) > math.MaxInt64 {
-			return 0
+			return 0, convertError(any, "int64", strconv.ErrRange)
 		}
-		return int64(value)
+		return int64(value), nil
 	case uint8:
-		return int64(value)
+		return int64(value), nil
 	case uint16:
-		return int64(value)
+		return int64(value), nil
 	case uint32:
-		return int64(value)
+		return int64(value), nil
 	case uint64:
-		// 防止溢出：大于 math.MaxInt64 时返回 0
+		// 防止溢出：大于 math.MaxInt64 时失败
 		if value > math.MaxInt64 {
-			return 0
+			return 0, convertError(any, "int64", strconv.ErrRange)
 		}
-		return int64(value)
+		return int64(value), nil
 	case float32:
-		// 检查 NaN 和 Inf
-		if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
-			return 0
-		}
-		// 检查溢出
-		if value > math.MaxInt64 || value < math.MinInt64 {
-			return 0
-		}
-		return int64(value)
+		return floatToInt64(any, float64(value))
 	case float64:
-		// 检查 NaN 和 Inf
-		if math.IsNaN(value) || math.IsInf(value, 0) {
-			return 0
-		}
-		// 检查溢出
-		if value > math.MaxInt64 || value < math.MinInt64 {
-			return 0
-		}
-		return int64(value)
+		return floatToInt64(any, value)
 	case bool:
 		if value {
-			return 1
+			return 1, nil
 		}
-		return 0
+		return 0, nil
 	case []byte:
-		v, _ := strconv.ParseInt(string(value), 10, 64)
-		return v
+		return parseInt64(any, string(value))
 	case string:
-		v, _ := strconv.ParseInt(value, 10, 64)
-		return v
+		return parseInt64(any, value)
+	case *big.Int, *big.Float, *big.Rat:
+		i, err := BigIntE(value)
+		if err != nil {
+			return 0, err
+		}
+		if !i.IsInt64() {
+			return 0, convertError(any, "int64", strconv.ErrRange)
+		}
+		return i.Int64(), nil
 	default:
 		// 尝试转为字符串后解析
-		v, _ := strconv.ParseInt(String(any), 10, 64)
-		return v
+		return parseInt64(any, String(any))
+	}
+}
+
+// floatToInt64 浮点数截断为 int64，检查 NaN/Inf 和溢出
+func floatToInt64(raw any, f float64) (int64, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, convertError(raw, "int64", nil)
+	}
+	if f > math.MaxInt64 || f < math.MinInt64 {
+		return 0, convertError(raw, "int64", strconv.ErrRange)
+	}
+	return int64(f), nil
+}
+
+// parseInt64 解析十进制整数字符串
+func parseInt64(raw any, s string) (int64, error) {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, convertError(raw, "int64", unwrapNumError(err))
 	}
+	return v, nil
 }
 
 // TryInt64 将任意类型转换为 int64，返回是否成功
@@ -113,186 +153,156 @@ func Int64(any any) int64 {
 //	v, ok := conv.TryInt64("abc")  // 0, false
 //	v, ok := conv.TryInt64("0")    // 0, true
 func TryInt64(any any) (int64, bool) {
-	if any == nil {
-		return 0, false
-	}
-	switch value := any.(type) {
-	case int:
-		return int64(value), true
-	case int8:
-		return int64(value), true
-	case int16:
-		return int64(value), true
-	case int32:
-		return int64(value), true
-	case int64:
-		return value, true
-	case uint:
-		if uint64(value) > math.MaxInt64 {
-			return 0, false
-		}
-		return int64(value), true
-	case uint8:
-		return int64(value), true
-	case uint16:
-		return int64(value), true
-	case uint32:
-		return int64(value), true
-	case uint64:
-		if value > math.MaxInt64 {
-			return 0, false
-		}
-		return int64(value), true
-	case float32:
-		// 检查 NaN 和 Inf
-		if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
-			return 0, false
-		}
-		// 检查溢出
-		if value > math.MaxInt64 || value < math.MinInt64 {
-			return 0, false
-		}
-		return int64(value), true
-	case float64:
-		// 检查 NaN 和 Inf
-		if math.IsNaN(value) || math.IsInf(value, 0) {
-			return 0, false
-		}
-		// 检查溢出
-		if value > math.MaxInt64 || value < math.MinInt64 {
-			return 0, false
-		}
-		return int64(value), true
-	case bool:
-		if value {
-			return 1, true
-		}
-		return 0, true
-	case []byte:
-		v, err := strconv.ParseInt(string(value), 10, 64)
-		return v, err == nil
-	case string:
-		v, err := strconv.ParseInt(value, 10, 64)
-		return v, err == nil
-	default:
-		v, err := strconv.ParseInt(String(any), 10, 64)
-		return v, err == nil
-	}
+	v, err := Int64E(any)
+	return v, err == nil
 }
 
 // TryInt 将任意类型转换为 int，返回是否成功
 func TryInt(any any) (int, bool) {
-	v, ok := TryInt64(any)
-	return int(v), ok
+	v, err := IntE(any)
+	return v, err == nil
 }
 
 // Int32 将任意类型转换为 int32
 //
 // 转换失败或值超出 int32 范围时返回 0
 func Int32(any any) int32 {
-	v := Int64(any)
-	// 防止溢出：超出 int32 范围时返回 0
+	v, _ := Int32E(any)
+	return v
+}
+
+// Int32E 将任意类型转换为 int32，失败或超出 int32 范围时返回错误
+func Int32E(any any) (int32, error) {
+	v, err := Int64E(any)
+	if err != nil {
+		return 0, err
+	}
+	// 防止溢出：超出 int32 范围时失败
 	if v > math.MaxInt32 || v < math.MinInt32 {
-		return 0
+		return 0, convertError(any, "int32", strconv.ErrRange)
 	}
-	return int32(v)
+	return int32(v), nil
 }
 
 // Uint 将任意类型转换为 uint
 //
 // 转换失败时返回 0
 func Uint(any any) uint {
-	return uint(Uint64(any))
+	v, _ := UintE(any)
+	return v
+}
+
+// UintE 将任意类型转换为 uint，失败时返回错误
+func UintE(any any) (uint, error) {
+	v, err := Uint64E(any)
+	if err != nil {
+		return 0, err
+	}
+	if v > math.MaxUint {
+		return 0, convertError(any, "uint", strconv.ErrRange)
+	}
+	return uint(v), nil
 }
 
 // Uint64 将任意类型转换为 uint64
 //
 // 转换失败或负数时返回 0
 func Uint64(any any) uint64 {
+	v, _ := Uint64E(any)
+	return v
+}
+
+// Uint64E 将任意类型转换为 uint64，失败或负数时返回错误
+func Uint64E(any any) (uint64, error) {
 	if any == nil {
-		return 0
+		return 0, convertError(any, "uint64", nil)
 	}
 	switch value := any.(type) {
 	case int:
-		if value < 0 {
-			return 0
-		}
-		return uint64(value)
+		return intToUint64(any, int64(value))
 	case int8:
-		if value < 0 {
-			return 0
-		}
-		return uint64(value)
+		return intToUint64(any, int64(value))
 	case int16:
-		if value < 0 {
-			return 0
-		}
-		return uint64(value)
+		return intToUint64(any, int64(value))
 	case int32:
-		if value < 0 {
-			return 0
-		}
-		return uint64(value)
+		return intToUint64(any, int64(value))
 	case int64:
-		if value < 0 {
-			return 0
-		}
-		return uint64(value)
+		return intToUint64(any, value)
 	case uint:
-		return uint64(value)
+		return uint64(value), nil
 	case uint8:
-		return uint64(value)
+		return uint64(value), nil
 	case uint16:
-		return uint64(value)
+		return uint64(value), nil
 	case uint32:
-		return uint64(value)
+		return uint64(value), nil
 	case uint64:
-		return value
+		return value, nil
 	case float32:
-		// 检查 NaN 和 Inf
-		if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
-			return 0
-		}
-		if value < 0 {
-			return 0
-		}
-		return uint64(value)
+		return floatToUint64(any, float64(value))
 	case float64:
-		// 检查 NaN 和 Inf
-		if math.IsNaN(value) || math.IsInf(value, 0) {
-			return 0
-		}
-		if value < 0 {
-			return 0
-		}
-		return uint64(value)
+		return floatToUint64(any, value)
 	case bool:
 		if value {
-			return 1
+			return 1, nil
 		}
-		return 0
+		return 0, nil
 	case []byte:
-		v, _ := strconv.ParseUint(string(value), 10, 64)
-		return v
+		return parseUint64(any, string(value))
 	case string:
-		v, _ := strconv.ParseUint(value, 10, 64)
-		return v
+		return parseUint64(any, value)
 	default:
-		v, _ := strconv.ParseUint(String(any), 10, 64)
-		return v
+		return parseUint64(any, String(any))
+	}
+}
+
+// intToUint64 有符号整数转 uint64，负数失败
+func intToUint64(raw any, v int64) (uint64, error) {
+	if v < 0 {
+		return 0, convertError(raw, "uint64", strconv.ErrRange)
+	}
+	return uint64(v), nil
+}
+
+// floatToUint64 浮点数截断为 uint64，检查 NaN/Inf、负数和溢出
+func floatToUint64(raw any, f float64) (uint64, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, convertError(raw, "uint64", nil)
+	}
+	if f < 0 || f >= math.MaxUint64 {
+		return 0, convertError(raw, "uint64", strconv.ErrRange)
+	}
+	return uint64(f), nil
+}
+
+// parseUint64 解析十进制无符号整数字符串
+func parseUint64(raw any, s string) (uint64, error) {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, convertError(raw, "uint64", unwrapNumError(err))
 	}
+	return v, nil
 }
 
 // Uint32 将任意类型转换为 uint32
 //
 // 转换失败或值超出 uint32 范围时返回 0
 func Uint32(any any) uint32 {
-	v := Uint64(any)
-	// 防止溢出：超出 uint32 范围时返回 0
+	v, _ := Uint32E(any)
+	return v
+}
+
+// Uint32E 将任意类型转换为 uint32，失败或超出 uint32 范围时返回错误
+func Uint32E(any any) (uint32, error) {
+	v, err := Uint64E(any)
+	if err != nil {
+		return 0, err
+	}
+	// 防止溢出：超出 uint32 范围时失败
 	if v > math.MaxUint32 {
-		return 0
+		return 0, convertError(any, "uint32", strconv.ErrRange)
 	}
-	return uint32(v)
+	return uint32(v), nil
 }
 
 // Bool 将任意类型转换为布尔值
@@ -311,41 +321,63 @@ func Uint32(any any) uint32 {
 //	conv.Bool("true")   // true
 //	conv.Bool("yes")    // true
 func Bool(any any) bool {
+	v, _ := BoolE(any)
+	return v
+}
+
+// BoolE 将任意类型转换为布尔值，失败时返回错误
+//
+// 与 Bool 不同，无法识别的字符串（如 "abc"）返回错误而不是 false；
+// 可识别的值: true/false/1/0/t/f/yes/no/on/off（不区分大小写）
+//
+// 示例:
+//
+//	v, err := conv.BoolE("no")   // false, nil
+//	v, err := conv.BoolE("abc")  // false, error
+func BoolE(any any) (bool, error) {
 	if any == nil {
-		return false
+		return false, convertError(any, "bool", nil)
 	}
 	switch value := any.(type) {
 	case bool:
-		return value
+		return value, nil
 	case int, int8, int16, int32, int64:
-		return Int64(value) != 0
+		return Int64(value) != 0, nil
 	case uint, uint8, uint16, uint32, uint64:
-		return Uint64(value) != 0
+		return Uint64(value) != 0, nil
 	case float32, float64:
-		return Float64(value) != 0
+		return Float64(value) != 0, nil
 	case string:
-		return parseBoolExtended(value)
+		return parseBoolExtended(any, value)
 	case []byte:
-		return parseBoolExtended(string(value))
+		return parseBoolExtended(any, string(value))
 	default:
-		return parseBoolExtended(String(any))
+		return parseBoolExtended(any, String(any))
 	}
 }
 
 // parseBoolExtended 扩展的布尔值解析
 // 除了 strconv.ParseBool 支持的值外，还支持 "yes"/"no"/"on"/"off"（不区分大小写）
-func parseBoolExtended(s string) bool {
+func parseBoolExtended(raw any, s string) (bool, error) {
 	v, err := strconv.ParseBool(s)
 	if err == nil {
-		return v
+		return v, nil
 	}
 	// strconv.ParseBool 不支持的扩展值
 	switch strings.ToLower(s) {
 	case "yes", "on":
-		return true
+		return true, nil
 	case "no", "off":
-		return false
+		return false, nil
 	default:
-		return false
+		return false, convertError(raw, "bool", strconv.ErrSyntax)
+	}
+}
+
+// unwrapNumError 提取 strconv.NumError 的底层错误（ErrSyntax/ErrRange）
+func unwrapNumError(err error) error {
+	if ne, ok := err.(*strconv.NumError); ok {
+		return ne.Err
 	}
+	return err
 }
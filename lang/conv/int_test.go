@@ -1,6 +1,9 @@
 package conv
 
 import (
+	"errors"
+	"math"
+	"strconv"
 	"testing"
 )
 
@@ -271,3 +274,83 @@ func BenchmarkBool(b *testing.B) {
 		})
 	}
 }
+
+func TestIntE(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    any
+		expected int
+		wantErr  bool
+	}{
+		{"zero string", "0", 0, false},
+		{"int string", "123", 123, false},
+		{"float", 45.67, 45, false},
+		{"bool", true, 1, false},
+		{"nil", nil, 0, true},
+		{"invalid", "abc", 0, true},
+		{"overflow string", "99999999999999999999", 0, true},
+		{"overflow uint64", uint64(math.MaxUint64), 0, true},
+		{"nan", math.NaN(), 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IntE(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IntE(%v) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("IntE(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+			if err != nil && !errors.Is(err, ErrConvert) {
+				t.Errorf("IntE(%v) error should match ErrConvert: %v", tt.input, err)
+			}
+		})
+	}
+}
+
+func TestIntE_ErrorDetail(t *testing.T) {
+	_, err := Int64E("99999999999999999999")
+	if !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("expected strconv.ErrRange, got %v", err)
+	}
+
+	var ce *ConvertError
+	if _, err := Int32E(int64(math.MaxInt32) + 1); !errors.As(err, &ce) || ce.Target != "int32" {
+		t.Errorf("expected *ConvertError targeting int32, got %v", err)
+	}
+}
+
+func TestUintE(t *testing.T) {
+	if _, err := Uint64E(-1); err == nil {
+		t.Error("Uint64E(-1) should fail")
+	}
+	if _, err := Uint32E(uint64(math.MaxUint32) + 1); err == nil {
+		t.Error("Uint32E overflow should fail")
+	}
+	if v, err := UintE("42"); err != nil || v != 42 {
+		t.Errorf("UintE(\"42\") = %v, %v", v, err)
+	}
+}
+
+func TestBoolE(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected bool
+		wantErr  bool
+	}{
+		{"true", true, false},
+		{"off", false, false},
+		{"YES", true, false},
+		{0, false, false},
+		{"abc", false, true},
+		{nil, false, true},
+	}
+
+	for _, tt := range tests {
+		got, err := BoolE(tt.input)
+		if (err != nil) != tt.wantErr || got != tt.expected {
+			t.Errorf("BoolE(%v) = %v, %v; want %v, wantErr %v", tt.input, got, err, tt.expected, tt.wantErr)
+		}
+	}
+}
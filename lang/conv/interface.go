@@ -1,5 +1,21 @@
 package conv
 
+// ConvStringer 自定义 conv 字符串转换的接口
+//
+// String 和 StringAny 优先调用 ConvString()，其次才是 String() 方法，
+// 适用于希望日志/转换输出与 fmt 输出不同的类型（如脱敏）
+//
+// 示例:
+//
+//	type Password string
+//
+//	func (Password) ConvString() string { return "******" }
+//
+//	conv.String(Password("secret"))  // "******"
+type ConvStringer interface {
+	ConvString() string
+}
+
 // iString 用于 String() 方法的类型断言接口
 type iString interface {
 	String() string
@@ -0,0 +1,200 @@
+package conv
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// byteUnits 字节单位（小写）-> 倍数，KB 与 KiB 均按 1024 计算
+var byteUnits = map[string]int64{
+	"":    1,
+	"b":   1,
+	"k":   1 << 10,
+	"kb":  1 << 10,
+	"kib": 1 << 10,
+	"m":   1 << 20,
+	"mb":  1 << 20,
+	"mib": 1 << 20,
+	"g":   1 << 30,
+	"gb":  1 << 30,
+	"gib": 1 << 30,
+	"t":   1 << 40,
+	"tb":  1 << 40,
+	"tib": 1 << 40,
+	"p":   1 << 50,
+	"pb":  1 << 50,
+	"pib": 1 << 50,
+	"e":   1 << 60,
+	"eb":  1 << 60,
+	"eib": 1 << 60,
+}
+
+// humanByteUnits HumanBytes 使用的单位
+var humanByteUnits = []string{"B", "KB", "MB", "GB", "TB", "PB", "EB"}
+
+// metricSuffixes 国际单位制前缀 -> 倍数（区分大小写，k 额外接受 K）
+var metricSuffixes = map[string]float64{
+	"":  1,
+	"n": 1e-9,
+	"u": 1e-6,
+	"µ": 1e-6,
+	"m": 1e-3,
+	"k": 1e3,
+	"K": 1e3,
+	"M": 1e6,
+	"G": 1e9,
+	"T": 1e12,
+	"P": 1e15,
+	"E": 1e18,
+}
+
+// Bytes 将带单位的大小转换为字节数
+//
+// 支持的格式（单位不区分大小写，数字与单位之间可以有空格）:
+//   - 纯数字: 按字节处理，如 512、"512"
+//   - B/K/KB/KiB/M/MB/MiB/G/GB/GiB/T/TB/TiB/P/PB/PiB/E/EB/EiB
+//   - 小数: "1.5GB"，结果向零截断为整数
+//
+// 注意: 与配置文件的通常约定一致，KB/MB/GB 按 1024 进制计算，与 KiB/MiB/GiB 相同
+//
+// 转换失败或超出 int64 范围时返回 0
+//
+// 示例:
+//
+//	conv.Bytes("10MB")     // 10485760
+//	conv.Bytes("1.5 KiB")  // 1536
+//	conv.Bytes("512")      // 512
+//	conv.Bytes("10XB")     // 0
+func Bytes(any any) int64 {
+	v, _ := BytesE(any)
+	return v
+}
+
+// BytesE 将带单位的大小转换为字节数，失败时返回错误
+func BytesE(any any) (int64, error) {
+	switch value := any.(type) {
+	case string:
+		return parseBytes(any, value)
+	case []byte:
+		return parseBytes(any, string(value))
+	default:
+		return Int64E(any)
+	}
+}
+
+// parseBytes 解析 "10MB"、"1.5 GiB" 形式的字符串
+func parseBytes(raw any, s string) (int64, error) {
+	num, unit := splitUnit(strings.TrimSpace(s))
+	mult, ok := byteUnits[strings.ToLower(unit)]
+	if !ok || num == "" {
+		return 0, convertError(raw, "bytes", strconv.ErrSyntax)
+	}
+
+	// 整数按整数运算，避免大数值的浮点误差
+	if n, err := strconv.ParseInt(num, 10, 64); err == nil {
+		if n > math.MaxInt64/mult || n < math.MinInt64/mult {
+			return 0, convertError(raw, "bytes", strconv.ErrRange)
+		}
+		return n * mult, nil
+	}
+
+	f, err := strconv.ParseFloat(num, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, convertError(raw, "bytes", strconv.ErrSyntax)
+	}
+	return floatToInt64(raw, f*float64(mult))
+}
+
+// HumanBytes 将字节数格式化为易读的字符串（1024 进制，最多保留 1 位小数）
+//
+// 示例:
+//
+//	conv.HumanBytes(512)        // "512 B"
+//	conv.HumanBytes(1536)       // "1.5 KB"
+//	conv.HumanBytes(10485760)   // "10 MB"
+func HumanBytes(n int64) string {
+	sign := ""
+	u := uint64(n)
+	if n < 0 {
+		sign = "-"
+		u = -u
+	}
+	if u < 1024 {
+		return sign + strconv.FormatUint(u, 10) + " B"
+	}
+
+	f := float64(u)
+	i := 0
+	for f >= 1024 && i < len(humanByteUnits)-1 {
+		f /= 1024
+		i++
+	}
+	// 四舍五入后可能进位到下一个单位，如 1023.96 KB -> 1 MB
+	if math.Round(f*10)/10 >= 1024 && i < len(humanByteUnits)-1 {
+		f /= 1024
+		i++
+	}
+	s := strconv.FormatFloat(f, 'f', 1, 64)
+	s = strings.TrimSuffix(s, ".0")
+	return sign + s + " " + humanByteUnits[i]
+}
+
+// Metric 将带国际单位制前缀的数值转换为 float64
+//
+// 支持的前缀（区分大小写）: n(1e-9) u/µ(1e-6) m(1e-3) k/K(1e3) M(1e6) G(1e9) T(1e12) P(1e15) E(1e18)
+//
+// 注意: 小写 m 表示毫（1e-3），与 Kubernetes 等监控输出一致（"500m" = 0.5）
+//
+// 转换失败时返回 0
+//
+// 示例:
+//
+//	conv.Metric("1.5k")   // 1500
+//	conv.Metric("2M")     // 2000000
+//	conv.Metric("500m")   // 0.5
+//	conv.Metric(42)       // 42
+func Metric(any any) float64 {
+	v, _ := MetricE(any)
+	return v
+}
+
+// MetricE 将带国际单位制前缀的数值转换为 float64，失败时返回错误
+func MetricE(any any) (float64, error) {
+	switch value := any.(type) {
+	case string:
+		return parseMetric(any, value)
+	case []byte:
+		return parseMetric(any, string(value))
+	default:
+		return Float64E(any)
+	}
+}
+
+// parseMetric 解析 "1.5k"、"2M" 形式的字符串
+func parseMetric(raw any, s string) (float64, error) {
+	num, suffix := splitUnit(strings.TrimSpace(s))
+	mult, ok := metricSuffixes[suffix]
+	if !ok || num == "" {
+		return 0, convertError(raw, "float64", strconv.ErrSyntax)
+	}
+	f, err := strconv.ParseFloat(num, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, convertError(raw, "float64", strconv.ErrSyntax)
+	}
+	return f * mult, nil
+}
+
+// splitUnit 将 "1.5 KB" 拆分为数字部分 "1.5" 和单位部分 "KB"
+func splitUnit(s string) (num, unit string) {
+	i := 0
+	for i < len(s) {
+		c := s[i]
+		if (c >= '0' && c <= '9') || c == '.' || ((c == '-' || c == '+') && i == 0) {
+			i++
+			continue
+		}
+		break
+	}
+	return s[:i], strings.TrimSpace(s[i:])
+}
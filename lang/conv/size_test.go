@@ -0,0 +1,101 @@
+package conv
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestBytes(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected int64
+	}{
+		{"10MB", 10 << 20},
+		{"10mb", 10 << 20},
+		{"1.5 KiB", 1536},
+		{"1.5GB", 1536 << 20},
+		{"512", 512},
+		{"512B", 512},
+		{"2k", 2048},
+		{"8EiB", 0}, // 超出 int64
+		{"7EB", 7 << 60},
+		{"10XB", 0},
+		{"MB", 0},
+		{"", 0},
+		{4096, 4096},
+		{[]byte("1G"), 1 << 30},
+	}
+
+	for _, tt := range tests {
+		if got := Bytes(tt.input); got != tt.expected {
+			t.Errorf("Bytes(%v) = %d, want %d", tt.input, got, tt.expected)
+		}
+	}
+
+	if _, err := BytesE("8EB"); !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("BytesE overflow error = %v, want ErrRange", err)
+	}
+	if _, err := BytesE("10XB"); !errors.Is(err, ErrConvert) {
+		t.Errorf("BytesE invalid unit error = %v, want ErrConvert", err)
+	}
+}
+
+func TestHumanBytes(t *testing.T) {
+	tests := []struct {
+		input    int64
+		expected string
+	}{
+		{0, "0 B"},
+		{512, "512 B"},
+		{1024, "1 KB"},
+		{1536, "1.5 KB"},
+		{10485760, "10 MB"},
+		{1048575, "1 MB"},
+		{1 << 40, "1 TB"},
+		{-2048, "-2 KB"},
+		{math.MaxInt64, "8 EB"},
+		{math.MinInt64, "-8 EB"},
+	}
+
+	for _, tt := range tests {
+		if got := HumanBytes(tt.input); got != tt.expected {
+			t.Errorf("HumanBytes(%d) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+
+	if got := Bytes(HumanBytes(10485760)); got != 10485760 {
+		t.Errorf("round trip = %d", got)
+	}
+}
+
+func TestMetric(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected float64
+	}{
+		{"1.5k", 1500},
+		{"1.5K", 1500},
+		{"2M", 2e6},
+		{"3G", 3e9},
+		{"500m", 0.5},
+		{"10u", 1e-5},
+		{"-4k", -4000},
+		{"42", 42},
+		{42, 42},
+		{"2 T", 2e12},
+		{"1x", 0},
+		{"k", 0},
+	}
+
+	for _, tt := range tests {
+		if got := Metric(tt.input); math.Abs(got-tt.expected) > 1e-9*math.Max(1, math.Abs(tt.expected)) {
+			t.Errorf("Metric(%v) = %v, want %v", tt.input, got, tt.expected)
+		}
+	}
+
+	if _, err := MetricE("1x"); !errors.Is(err, ErrConvert) {
+		t.Errorf("MetricE invalid suffix error = %v, want ErrConvert", err)
+	}
+}
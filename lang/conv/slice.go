@@ -0,0 +1,110 @@
+package conv
+
+import (
+	"reflect"
+)
+
+// Strings 将任意类型切片转换为 []string
+//
+// 参数:
+//   - s: 源切片
+//
+// 返回:
+//   - []string: 每个元素经 String 转换后的结果，s 为 nil 时返回 nil
+//
+// 示例:
+//
+//	conv.Strings([]int{1, 2, 3})       // []string{"1", "2", "3"}
+//	conv.Strings([]any{"a", 1, true})  // []string{"a", "1", "true"}
+func Strings[T any](s []T) []string {
+	if s == nil {
+		return nil
+	}
+	result := make([]string, len(s))
+	for i, v := range s {
+		result[i] = String(v)
+	}
+	return result
+}
+
+// Ints 将任意类型切片转换为 []int
+//
+// 参数:
+//   - s: 源切片（常见为 []string）
+//
+// 返回:
+//   - []int: 每个元素经 Int 转换后的结果，无法转换的元素为 0
+//
+// 示例:
+//
+//	conv.Ints([]string{"1", "2", "x"}) // []int{1, 2, 0}
+func Ints[T any](s []T) []int {
+	if s == nil {
+		return nil
+	}
+	result := make([]int, len(s))
+	for i, v := range s {
+		result[i] = Int(v)
+	}
+	return result
+}
+
+// ToSlice 将任意值转换为 []T
+//
+// 参数:
+//   - v: 源值，可以是任意类型的切片/数组（如 JSON 解码得到的 []any），
+//     也可以是单个值（转换为只有一个元素的切片）
+//
+// 返回:
+//   - []T: 转换结果，v 为 nil 时返回 nil；无法转换的元素为零值
+//
+// 注意: 元素按 Copy 的规则转换，因此 []any 中的 map[string]any
+// 也可以转换为结构体切片
+//
+// 示例:
+//
+//	var data map[string]any
+//	json.Unmarshal([]byte(`{"ids":[1,2,"3"]}`), &data)
+//	ids := conv.ToSlice[int64](data["ids"]) // []int64{1, 2, 3}
+//
+//	conv.ToSlice[string](42)                // []string{"42"}
+func ToSlice[T any](v any) []T {
+	if v == nil {
+		return nil
+	}
+	if s, ok := v.([]T); ok {
+		return s
+	}
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []T{convertValue[T](rv)}
+	}
+	if rv.Kind() == reflect.Slice && rv.IsNil() {
+		return nil
+	}
+
+	result := make([]T, rv.Len())
+	for i := range rv.Len() {
+		result[i] = convertValue[T](rv.Index(i))
+	}
+	return result
+}
+
+// convertValue 按 Copy 的规则将值转换为 T，失败返回零值
+func convertValue[T any](v reflect.Value) T {
+	var result T
+	c := &copier{opts: &copyOptions{maxDepth: 32}}
+	if err := c.assign(reflect.ValueOf(&result).Elem(), v, "", 0); err != nil {
+		var zero T
+		return zero
+	}
+	return result
+}
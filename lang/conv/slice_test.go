@@ -0,0 +1,72 @@
+package conv
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestStrings(t *testing.T) {
+	if got := Strings([]int{1, 2, 3}); !reflect.DeepEqual(got, []string{"1", "2", "3"}) {
+		t.Errorf("Strings() = %v", got)
+	}
+	if got := Strings([]any{"a", 1, true, nil}); !reflect.DeepEqual(got, []string{"a", "1", "true", ""}) {
+		t.Errorf("Strings() = %v", got)
+	}
+	if got := Strings[int](nil); got != nil {
+		t.Errorf("Strings(nil) = %v, want nil", got)
+	}
+}
+
+func TestInts(t *testing.T) {
+	if got := Ints([]string{"1", "2", "x"}); !reflect.DeepEqual(got, []int{1, 2, 0}) {
+		t.Errorf("Ints() = %v", got)
+	}
+	if got := Ints([]any{1.9, "3", true}); !reflect.DeepEqual(got, []int{1, 3, 1}) {
+		t.Errorf("Ints() = %v", got)
+	}
+}
+
+func TestToSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    any
+		expected []int64
+	}{
+		{"nil", nil, nil},
+		{"same type", []int64{1, 2}, []int64{1, 2}},
+		{"any slice", []any{1, "2", 3.0}, []int64{1, 2, 3}},
+		{"string slice", []string{"4", "x"}, []int64{4, 0}},
+		{"array", [2]int{5, 6}, []int64{5, 6}},
+		{"single value", "7", []int64{7}},
+		{"pointer", &[]int{8}, []int64{8}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToSlice[int64](tt.input); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ToSlice() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToSlice_JSON(t *testing.T) {
+	type item struct {
+		ID   int
+		Name string
+	}
+
+	var data map[string]any
+	if err := json.Unmarshal([]byte(`{"tags":["a","b"],"items":[{"id":1,"name":"x"},{"id":"2","name":"y"}]}`), &data); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := ToSlice[string](data["tags"]); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("ToSlice[string]() = %v", got)
+	}
+	items := ToSlice[item](data["items"])
+	if len(items) != 2 || items[0] != (item{1, "x"}) || items[1] != (item{2, "y"}) {
+		t.Errorf("ToSlice[item]() = %+v", items)
+	}
+}
@@ -1,8 +1,12 @@
 package conv
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"reflect"
 	"strconv"
+	"time"
 )
 
 // String 将任意类型转换为字符串
@@ -14,6 +18,7 @@ import (
 //   - uint/uint8/uint16/uint32/uint64: 格式化为十进制
 //   - float32/float64: 格式化为十进制（自动精度）
 //   - bool: "true" 或 "false"
+//   - ConvStringer 接口: 调用 ConvString() 方法
 //   - iString 接口: 调用 String() 方法
 //   - 其他: 使用 fmt.Sprintf("%v", value)
 //
@@ -60,7 +65,22 @@ func String(any any) string {
 		return strconv.FormatFloat(value, 'f', -1, 64)
 	case bool:
 		return strconv.FormatBool(value)
+	case *big.Float:
+		// big.Float.String() 只保留 10 位有效数字，这里输出完整精度
+		if value == nil {
+			return ""
+		}
+		return value.Text('f', -1)
+	case *big.Rat:
+		if value == nil {
+			return ""
+		}
+		return value.RatString()
 	default:
+		// 优先使用 conv 专用的 ConvStringer
+		if s, ok := value.(ConvStringer); ok {
+			return s.ConvString()
+		}
 		// 尝试 iString 接口
 		if s, ok := value.(iString); ok {
 			return s.String()
@@ -69,3 +89,159 @@ func String(any any) string {
 		return fmt.Sprintf("%v", value)
 	}
 }
+
+// StructMode 结构体、map、切片的渲染方式
+type StructMode int
+
+const (
+	// StructModeFmt 使用 fmt.Sprintf("%v")（默认，与 String 相同）
+	StructModeFmt StructMode = iota
+	// StructModeFields 使用 fmt.Sprintf("%+v")，输出字段名
+	StructModeFields
+	// StructModeJSON 渲染为 JSON，序列化失败时降级为 fmt.Sprintf("%v")
+	StructModeJSON
+)
+
+// stringOptions StringAny 的配置
+type stringOptions struct {
+	floatPrec  int        // 浮点数小数位数，负数表示自动精度
+	nilString  string     // nil 的渲染结果
+	timeLayout string     // time.Time 的格式，空表示 time.Time.String()
+	structMode StructMode // 复合类型的渲染方式
+}
+
+// StringOption StringAny 选项函数
+type StringOption func(*stringOptions)
+
+// WithFloatPrecision 设置浮点数保留的小数位数（负数表示自动精度）
+//
+// 示例:
+//
+//	conv.StringAny(3.14159, conv.WithFloatPrecision(2))  // "3.14"
+func WithFloatPrecision(prec int) StringOption {
+	return func(o *stringOptions) {
+		o.floatPrec = prec
+	}
+}
+
+// WithNilString 设置 nil 和 nil 指针的渲染结果（默认空字符串）
+//
+// 示例:
+//
+//	conv.StringAny(nil, conv.WithNilString("<nil>"))  // "<nil>"
+func WithNilString(s string) StringOption {
+	return func(o *stringOptions) {
+		o.nilString = s
+	}
+}
+
+// WithTimeLayout 设置 time.Time 的格式
+//
+// 示例:
+//
+//	conv.StringAny(t, conv.WithTimeLayout(time.DateTime))  // "2024-01-15 10:30:00"
+func WithTimeLayout(layout string) StringOption {
+	return func(o *stringOptions) {
+		o.timeLayout = layout
+	}
+}
+
+// WithStructMode 设置结构体、map、切片的渲染方式
+//
+// 示例:
+//
+//	conv.StringAny(user, conv.WithStructMode(conv.StructModeJSON))  // {"name":"Alice","age":30}
+func WithStructMode(mode StructMode) StringOption {
+	return func(o *stringOptions) {
+		o.structMode = mode
+	}
+}
+
+// StringAny 按选项将任意类型转换为字符串
+//
+// 不传选项时与 String 相同，适用于日志等需要控制输出格式、
+// 又不想退回 fmt.Sprintf 的场景。
+//
+// 处理顺序:
+//   - nil、nil 指针: 返回 WithNilString 设置的值
+//   - ConvStringer 接口: 调用 ConvString() 方法
+//   - time.Time/*time.Time: 设置了 WithTimeLayout 时按该格式输出
+//   - float32/float64/*big.Float: 按 WithFloatPrecision 保留小数位
+//   - 结构体、map、切片、数组（及其指针）: 按 WithStructMode 渲染
+//   - 其他: 与 String 相同
+//
+// 示例:
+//
+//	conv.StringAny(3.14159, conv.WithFloatPrecision(2))           // "3.14"
+//	conv.StringAny(nil, conv.WithNilString("null"))               // "null"
+//	conv.StringAny(user, conv.WithStructMode(conv.StructModeJSON)) // {"name":"Alice"}
+func StringAny(v any, opts ...StringOption) string {
+	o := &stringOptions{floatPrec: -1}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if v == nil {
+		return o.nilString
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return o.nilString
+	}
+
+	if s, ok := v.(ConvStringer); ok {
+		return s.ConvString()
+	}
+
+	switch value := v.(type) {
+	case time.Time:
+		if o.timeLayout != "" {
+			return value.Format(o.timeLayout)
+		}
+	case *time.Time:
+		if o.timeLayout != "" {
+			return value.Format(o.timeLayout)
+		}
+	case float32:
+		return strconv.FormatFloat(float64(value), 'f', o.floatPrec, 32)
+	case float64:
+		return strconv.FormatFloat(value, 'f', o.floatPrec, 64)
+	case *big.Float:
+		return value.Text('f', o.floatPrec)
+	case []byte:
+		return string(value)
+	}
+
+	if o.structMode != StructModeFmt && isComposite(rv) {
+		// 实现了 fmt.Stringer 的类型保持原有输出
+		if _, ok := v.(iString); !ok {
+			return formatComposite(v, o.structMode)
+		}
+	}
+	return String(v)
+}
+
+// isComposite 检查是否为结构体、map、切片、数组或指向它们的指针
+func isComposite(rv reflect.Value) bool {
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		return true
+	}
+	return false
+}
+
+// formatComposite 按 mode 渲染复合类型
+func formatComposite(v any, mode StructMode) string {
+	switch mode {
+	case StructModeFields:
+		return fmt.Sprintf("%+v", v)
+	case StructModeJSON:
+		if b, err := json.Marshal(v); err == nil {
+			return string(b)
+		}
+	}
+	return fmt.Sprintf("%v", v)
+}
@@ -1,7 +1,9 @@
 package conv
 
 import (
+	"math/big"
 	"testing"
+	"time"
 )
 
 func TestString(t *testing.T) {
@@ -77,3 +79,56 @@ func BenchmarkString(b *testing.B) {
 		})
 	}
 }
+
+type maskedSecret string
+
+func (maskedSecret) ConvString() string { return "******" }
+
+type stringAnyUser struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+func TestStringAny(t *testing.T) {
+	ts := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	user := stringAnyUser{Name: "Alice", Age: 30}
+	var nilUser *stringAnyUser
+
+	tests := []struct {
+		name     string
+		input    any
+		opts     []StringOption
+		expected string
+	}{
+		{"default same as String", 3.14159, nil, "3.14159"},
+		{"float precision", 3.14159, []StringOption{WithFloatPrecision(2)}, "3.14"},
+		{"float32 precision", float32(2.5), []StringOption{WithFloatPrecision(3)}, "2.500"},
+		{"big.Float precision", big.NewFloat(1.005), []StringOption{WithFloatPrecision(1)}, "1.0"},
+		{"nil", nil, []StringOption{WithNilString("<nil>")}, "<nil>"},
+		{"nil pointer", nilUser, []StringOption{WithNilString("null")}, "null"},
+		{"nil default", nil, nil, ""},
+		{"time layout", ts, []StringOption{WithTimeLayout(time.DateTime)}, "2024-01-15 10:30:00"},
+		{"time pointer layout", &ts, []StringOption{WithTimeLayout(time.DateOnly)}, "2024-01-15"},
+		{"struct fmt", user, nil, "{Alice 30}"},
+		{"struct fields", user, []StringOption{WithStructMode(StructModeFields)}, "{Name:Alice Age:30}"},
+		{"struct json", user, []StringOption{WithStructMode(StructModeJSON)}, `{"name":"Alice","age":30}`},
+		{"struct pointer json", &user, []StringOption{WithStructMode(StructModeJSON)}, `{"name":"Alice","age":30}`},
+		{"map json", map[string]int{"a": 1}, []StringOption{WithStructMode(StructModeJSON)}, `{"a":1}`},
+		{"bytes stay string", []byte("raw"), []StringOption{WithStructMode(StructModeJSON)}, "raw"},
+		{"stringer kept", ts, []StringOption{WithStructMode(StructModeJSON)}, ts.String()},
+		{"conv stringer", maskedSecret("secret"), nil, "******"},
+		{"int unaffected", 42, []StringOption{WithFloatPrecision(2)}, "42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StringAny(tt.input, tt.opts...); got != tt.expected {
+				t.Errorf("StringAny(%v) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	if got := String(maskedSecret("secret")); got != "******" {
+		t.Errorf("String(ConvStringer) = %q, want ******", got)
+	}
+}
@@ -0,0 +1,95 @@
+package conv
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ToStruct 将 map 或 JSON 绑定到结构体，类型不匹配时按 conv 规则转换
+//
+// 参数:
+//   - src: map[string]T、JSON 字符串/[]byte、结构体或它们的指针
+//   - dst: 目标结构体指针
+//   - opts: 选项，与 Copy 相同（WithTagName、WithIgnoreFields 等）
+//
+// 返回:
+//   - error: dst 不合法、JSON 无效或字段无法转换时返回错误
+//
+// 与 encoding/json 不同，类型不匹配时不会报错而是按 conv 规则转换:
+// "18" -> int、"true" -> bool、1 -> string、"1h" -> time.Duration、
+// "2024-01-15" -> time.Time；嵌套 map 和 []any 会递归绑定到嵌套结构体和切片。
+//
+// 字段名默认取 json 标签（逗号前部分），无标签时使用字段名，均忽略大小写匹配；
+// 可通过 WithTagName 改用其他标签。无法解析的字符串按 conv 规则得到零值。
+//
+// 注意: JSON 中的数字先解码为 float64，超过 2^53 的整数会丢失精度，
+// 此类字段建议在 JSON 中使用字符串
+//
+// 示例:
+//
+//	type Config struct {
+//	    Port    int           `json:"port"`
+//	    Debug   bool          `json:"debug"`
+//	    Timeout time.Duration `json:"timeout"`
+//	}
+//	var cfg Config
+//	err := conv.ToStruct(map[string]any{"port": "8080", "debug": "true", "timeout": "5s"}, &cfg)
+//	// cfg = Config{Port: 8080, Debug: true, Timeout: 5 * time.Second}
+//
+//	err = conv.ToStruct(`{"port":"8080","debug":1}`, &cfg)
+func ToStruct(src, dst any, opts ...CopyOption) error {
+	o := &copyOptions{tagName: "json", maxDepth: 32}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	dv, err := structElem(dst)
+	if err != nil {
+		return err
+	}
+
+	switch s := src.(type) {
+	case string:
+		m, err := JSONToMap(s)
+		if err != nil {
+			return fmt.Errorf("conv: invalid JSON: %w", err)
+		}
+		src = m
+	case []byte:
+		m, err := JSONToMap(string(s))
+		if err != nil {
+			return fmt.Errorf("conv: invalid JSON: %w", err)
+		}
+		src = m
+	}
+
+	sv := reflect.ValueOf(src)
+	for sv.Kind() == reflect.Ptr || sv.Kind() == reflect.Interface {
+		if sv.IsNil() {
+			return nil
+		}
+		sv = sv.Elem()
+	}
+
+	c := &copier{opts: o}
+	switch sv.Kind() {
+	case reflect.Map:
+		return c.mapToStruct(dv, sv, "", 0)
+	case reflect.Struct:
+		return c.copyStruct(dv, sv, "", 0)
+	case reflect.Invalid:
+		return nil
+	default:
+		return fmt.Errorf("conv: src must be a map, JSON or struct, got %T", src)
+	}
+}
+
+// structElem 校验 dst 为非 nil 结构体指针并返回其指向的结构体
+func structElem(dst any) (reflect.Value, error) {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Ptr || dv.IsNil() || dv.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, errors.New("conv: dst must be a non-nil pointer to struct")
+	}
+	return dv.Elem(), nil
+}
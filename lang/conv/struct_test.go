@@ -0,0 +1,130 @@
+package conv
+
+import (
+	"testing"
+	"time"
+)
+
+type bindServer struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+type bindConfig struct {
+	Name    string         `json:"name"`
+	Debug   bool           `json:"debug"`
+	Ratio   float64        `json:"ratio"`
+	Timeout time.Duration  `json:"timeout"`
+	Start   time.Time      `json:"start"`
+	Tags    []string       `json:"tags"`
+	Server  bindServer     `json:"server"`
+	Backups []bindServer   `json:"backups"`
+	Limit   *int           `json:"limit,omitempty"`
+	Extra   map[string]int `json:"extra"`
+	Secret  string         `json:"-"`
+	NoTag   int
+}
+
+func TestToStruct_Map(t *testing.T) {
+	src := map[string]any{
+		"name":    "svc",
+		"debug":   "true",
+		"ratio":   "0.5",
+		"timeout": "1m30s",
+		"start":   "2024-01-15",
+		"tags":    []any{"a", 1},
+		"server":  map[string]any{"host": "localhost", "port": "8080"},
+		"backups": []any{map[string]any{"host": "b1", "port": 9000.0}},
+		"limit":   "10",
+		"extra":   map[string]any{"x": "1"},
+		"secret":  "leak",
+		"notag":   "7",
+	}
+
+	var cfg bindConfig
+	if err := ToStruct(src, &cfg); err != nil {
+		t.Fatalf("ToStruct() error = %v", err)
+	}
+
+	if cfg.Name != "svc" || !cfg.Debug || cfg.Ratio != 0.5 {
+		t.Errorf("scalar fields = %+v", cfg)
+	}
+	if cfg.Timeout != 90*time.Second {
+		t.Errorf("Timeout = %v, want 1m30s", cfg.Timeout)
+	}
+	if cfg.Start.Year() != 2024 || cfg.Start.Month() != time.January || cfg.Start.Day() != 15 {
+		t.Errorf("Start = %v", cfg.Start)
+	}
+	if len(cfg.Tags) != 2 || cfg.Tags[1] != "1" {
+		t.Errorf("Tags = %v", cfg.Tags)
+	}
+	if cfg.Server != (bindServer{Host: "localhost", Port: 8080}) {
+		t.Errorf("Server = %+v", cfg.Server)
+	}
+	if len(cfg.Backups) != 1 || cfg.Backups[0].Port != 9000 {
+		t.Errorf("Backups = %+v", cfg.Backups)
+	}
+	if cfg.Limit == nil || *cfg.Limit != 10 {
+		t.Errorf("Limit = %v", cfg.Limit)
+	}
+	if cfg.Extra["x"] != 1 {
+		t.Errorf("Extra = %v", cfg.Extra)
+	}
+	if cfg.Secret != "" {
+		t.Errorf("Secret should be ignored, got %q", cfg.Secret)
+	}
+	if cfg.NoTag != 7 {
+		t.Errorf("NoTag = %d, want 7", cfg.NoTag)
+	}
+}
+
+func TestToStruct_JSON(t *testing.T) {
+	var cfg bindConfig
+	err := ToStruct(`{"name":123,"debug":1,"server":{"host":"h","port":"81"}}`, &cfg)
+	if err != nil {
+		t.Fatalf("ToStruct() error = %v", err)
+	}
+	if cfg.Name != "123" || !cfg.Debug || cfg.Server.Port != 81 {
+		t.Errorf("cfg = %+v", cfg)
+	}
+
+	var cfg2 bindConfig
+	if err := ToStruct([]byte(`{"port":"1"}`), &cfg2.Server); err != nil || cfg2.Server.Port != 1 {
+		t.Errorf("ToStruct([]byte) = %+v, %v", cfg2.Server, err)
+	}
+
+	if err := ToStruct(`{invalid`, &cfg); err == nil {
+		t.Error("invalid JSON should return error")
+	}
+}
+
+func TestToStruct_Options(t *testing.T) {
+	type row struct {
+		UserID int `db:"user_id"`
+		Name   string
+	}
+	var r row
+	err := ToStruct(map[string]string{"user_id": "5", "name": "n"}, &r, WithTagName("db"), WithIgnoreFields("Name"))
+	if err != nil {
+		t.Fatalf("ToStruct() error = %v", err)
+	}
+	if r.UserID != 5 || r.Name != "" {
+		t.Errorf("row = %+v", r)
+	}
+}
+
+func TestToStruct_Errors(t *testing.T) {
+	var s bindServer
+	if err := ToStruct(map[string]any{}, s); err == nil {
+		t.Error("non-pointer dst should return error")
+	}
+	if err := ToStruct(42, &s); err == nil {
+		t.Error("unsupported src should return error")
+	}
+	if err := ToStruct(map[string]any{"server": "oops"}, &bindConfig{}); err == nil {
+		t.Error("string to struct field should return error")
+	}
+	if err := ToStruct(nil, &s); err != nil {
+		t.Errorf("nil src should be a no-op, got %v", err)
+	}
+}
@@ -0,0 +1,180 @@
+package conv
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeLayouts Time 支持的字符串格式，按顺序尝试
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	time.DateTime,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	time.DateOnly,
+	"2006/01/02 15:04:05",
+	"2006/01/02",
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+// Duration 将任意类型转换为 time.Duration
+//
+// 支持的类型:
+//   - time.Duration: 直接返回
+//   - 整数/浮点数: 视为纳秒（与 time.Duration(n) 一致）
+//   - string/[]byte: 先按 time.ParseDuration 解析（如 "1h30m"、"90s"），
+//     失败时按纯数字（纳秒）解析
+//
+// 转换失败时返回 0
+//
+// 示例:
+//
+//	conv.Duration("1h30m")                 // 1h30m0s
+//	conv.Duration("90s")                   // 1m30s
+//	conv.Duration(1500)                    // 1.5µs
+//	conv.Duration(1500 * time.Millisecond) // 1.5s
+//	conv.Duration("invalid")               // 0
+func Duration(any any) time.Duration {
+	v, _ := DurationE(any)
+	return v
+}
+
+// DurationE 将任意类型转换为 time.Duration，失败时返回错误
+//
+// 转换规则与 Duration 相同；nil、无法解析的字符串、超出范围的浮点数视为失败
+func DurationE(any any) (time.Duration, error) {
+	if any == nil {
+		return 0, convertError(any, "time.Duration", nil)
+	}
+	switch value := any.(type) {
+	case time.Duration:
+		return value, nil
+	case *time.Duration:
+		if value == nil {
+			return 0, convertError(any, "time.Duration", nil)
+		}
+		return *value, nil
+	case float32, float64:
+		f := Float64(value)
+		if math.IsNaN(f) || math.IsInf(f, 0) || f > math.MaxInt64 || f < math.MinInt64 {
+			return 0, convertError(any, "time.Duration", strconv.ErrRange)
+		}
+		return time.Duration(f), nil
+	case string:
+		return parseDuration(any, value)
+	case []byte:
+		return parseDuration(any, string(value))
+	default:
+		if n, err := Int64E(value); err == nil {
+			return time.Duration(n), nil
+		}
+		return parseDuration(any, String(value))
+	}
+}
+
+// parseDuration 解析时长字符串
+func parseDuration(raw any, s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Duration(n), nil
+	}
+	return 0, convertError(raw, "time.Duration", strconv.ErrSyntax)
+}
+
+// Time 将任意类型转换为 time.Time
+//
+// 支持的类型:
+//   - time.Time/*time.Time: 直接返回
+//   - 整数: Unix 时间戳，按数量级自动识别秒/毫秒/微秒/纳秒
+//   - 浮点数: 带小数的 Unix 秒
+//   - string/[]byte: 纯数字按时间戳处理，否则依次尝试 RFC3339、
+//     "2006-01-02 15:04:05"、"2006-01-02" 等常见格式
+//
+// 不带时区的字符串按本地时区解析。转换失败时返回零值 time.Time{}
+//
+// 示例:
+//
+//	conv.Time("2024-01-15T10:30:00Z")  // 2024-01-15 10:30:00 UTC
+//	conv.Time("2024-01-15 10:30:00")   // 本地时区
+//	conv.Time(1705314600)              // Unix 秒
+//	conv.Time(1705314600000)           // Unix 毫秒
+//	conv.Time("invalid").IsZero()      // true
+func Time(any any) time.Time {
+	v, _ := TimeE(any)
+	return v
+}
+
+// TimeE 将任意类型转换为 time.Time，失败时返回错误
+//
+// 转换规则与 Time 相同；nil、无法识别格式的字符串视为失败
+func TimeE(any any) (time.Time, error) {
+	if any == nil {
+		return time.Time{}, convertError(any, "time.Time", nil)
+	}
+	switch value := any.(type) {
+	case time.Time:
+		return value, nil
+	case *time.Time:
+		if value == nil {
+			return time.Time{}, convertError(any, "time.Time", nil)
+		}
+		return *value, nil
+	case float32, float64:
+		f := Float64(value)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return time.Time{}, convertError(any, "time.Time", strconv.ErrRange)
+		}
+		sec, frac := math.Modf(f)
+		return time.Unix(int64(sec), int64(frac*1e9)), nil
+	case string:
+		return parseTime(any, value)
+	case []byte:
+		return parseTime(any, string(value))
+	default:
+		if n, err := Int64E(value); err == nil {
+			return unixAuto(n), nil
+		}
+		return parseTime(any, String(value))
+	}
+}
+
+// parseTime 解析时间字符串
+func parseTime(raw any, s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return unixAuto(n), nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, convertError(raw, "time.Time", strconv.ErrSyntax)
+}
+
+// unixAuto 按数量级识别 Unix 时间戳的单位
+//
+// 秒级时间戳在 1e11 以内可覆盖到 5138 年，超出则依次视为毫秒、微秒、纳秒
+func unixAuto(n int64) time.Time {
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+	switch {
+	case abs < 1e11:
+		return time.Unix(n, 0)
+	case abs < 1e14:
+		return time.UnixMilli(n)
+	case abs < 1e17:
+		return time.UnixMicro(n)
+	default:
+		return time.Unix(0, n)
+	}
+}
@@ -0,0 +1,107 @@
+package conv
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDuration(t *testing.T) {
+	d := 3 * time.Second
+	tests := []struct {
+		name     string
+		input    any
+		expected time.Duration
+	}{
+		{"nil", nil, 0},
+		{"duration", 2 * time.Minute, 2 * time.Minute},
+		{"duration pointer", &d, 3 * time.Second},
+		{"hours minutes", "1h30m", 90 * time.Minute},
+		{"seconds", "90s", 90 * time.Second},
+		{"with spaces", " 500ms ", 500 * time.Millisecond},
+		{"int", 1500, 1500},
+		{"int64", int64(time.Second), time.Second},
+		{"numeric string", "1500", 1500},
+		{"float", 2.5e9, 2500 * time.Millisecond},
+		{"bytes", []byte("2h"), 2 * time.Hour},
+		{"invalid", "invalid", 0},
+		{"empty", "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Duration(tt.input); got != tt.expected {
+				t.Errorf("Duration(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTime(t *testing.T) {
+	ref := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	local := time.Date(2024, 1, 15, 10, 30, 0, 0, time.Local)
+
+	tests := []struct {
+		name     string
+		input    any
+		expected time.Time
+	}{
+		{"nil", nil, time.Time{}},
+		{"time", ref, ref},
+		{"time pointer", &ref, ref},
+		{"rfc3339", "2024-01-15T10:30:00Z", ref},
+		{"rfc3339 offset", "2024-01-15T18:30:00+08:00", ref},
+		{"datetime", "2024-01-15 10:30:00", local},
+		{"date only", "2024-01-15", time.Date(2024, 1, 15, 0, 0, 0, 0, time.Local)},
+		{"slash date", "2024/01/15 10:30:00", local},
+		{"unix seconds", ref.Unix(), ref},
+		{"unix millis", ref.UnixMilli(), ref},
+		{"unix micros", ref.UnixMicro(), ref},
+		{"unix nanos", ref.UnixNano(), ref},
+		{"unix string", "1705314600", ref},
+		{"unix float", 1705314600.5, ref.Add(500 * time.Millisecond)},
+		{"invalid", "invalid", time.Time{}},
+		{"empty", "", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Time(tt.input); !got.Equal(tt.expected) {
+				t.Errorf("Time(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCopy_TimeFields(t *testing.T) {
+	type src struct {
+		CreatedAt string
+		Timeout   string
+	}
+	type dst struct {
+		CreatedAt time.Time
+		Timeout   time.Duration
+	}
+
+	var d dst
+	if err := Copy(&d, src{CreatedAt: "2024-01-15T10:30:00Z", Timeout: "30s"}); err != nil {
+		t.Fatal(err)
+	}
+	if !d.CreatedAt.Equal(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)) || d.Timeout != 30*time.Second {
+		t.Errorf("unexpected result: %+v", d)
+	}
+}
+
+func TestDurationE_TimeE(t *testing.T) {
+	if _, err := DurationE("1h"); err != nil {
+		t.Errorf("DurationE(\"1h\") error = %v", err)
+	}
+	if _, err := DurationE("soon"); err == nil {
+		t.Error("DurationE(\"soon\") should fail")
+	}
+	if _, err := TimeE("2024-01-15"); err != nil {
+		t.Errorf("TimeE(\"2024-01-15\") error = %v", err)
+	}
+	if _, err := TimeE("yesterday"); err == nil {
+		t.Error("TimeE(\"yesterday\") should fail")
+	}
+}
@@ -0,0 +1,183 @@
+package errorx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io/fs"
+	"strings"
+)
+
+// ============================================================
+// 错误分类与哨兵错误
+// ============================================================
+
+// ErrorCategory 错误分类
+//
+// 分类本身实现了 error，可直接作为哨兵错误返回和匹配:
+//
+//	return fmt.Errorf("load user %d: %w", id, errorx.CategoryNotFound)
+//	errors.Is(err, errorx.CategoryNotFound)  // true
+//
+// 各模块自己的哨兵错误可通过 NewSentinel 归入某个分类，
+// 调用方无需了解具体模块即可按分类处理。
+type ErrorCategory string
+
+// 错误分类常量
+const (
+	// CategoryNone 无错误
+	CategoryNone ErrorCategory = ""
+	// CategoryUnknown 无法归类的错误
+	CategoryUnknown ErrorCategory = "unknown"
+	// CategoryNotFound 资源不存在
+	CategoryNotFound ErrorCategory = "not_found"
+	// CategoryInvalidInput 输入无效
+	CategoryInvalidInput ErrorCategory = "invalid_input"
+	// CategoryConflict 资源冲突
+	CategoryConflict ErrorCategory = "conflict"
+	// CategoryTimeout 超时
+	CategoryTimeout ErrorCategory = "timeout"
+	// CategoryCanceled 已取消
+	CategoryCanceled ErrorCategory = "canceled"
+	// CategoryUnauthorized 未认证
+	CategoryUnauthorized ErrorCategory = "unauthorized"
+	// CategoryForbidden 无权限
+	CategoryForbidden ErrorCategory = "forbidden"
+	// CategoryRateLimited 请求频率或并发超限
+	CategoryRateLimited ErrorCategory = "rate_limited"
+	// CategoryUnavailable 服务暂不可用（可重试）
+	CategoryUnavailable ErrorCategory = "unavailable"
+	// CategoryInternal 内部错误
+	CategoryInternal ErrorCategory = "internal"
+)
+
+// Error 实现 error 接口，使分类可作为哨兵错误使用
+func (c ErrorCategory) Error() string {
+	return strings.ReplaceAll(string(c), "_", " ")
+}
+
+// String 返回分类名称
+func (c ErrorCategory) String() string {
+	return string(c)
+}
+
+// sentinelError 归属某个分类的哨兵错误
+type sentinelError struct {
+	category ErrorCategory
+	msg      string
+}
+
+// NewSentinel 创建归属指定分类的哨兵错误
+//
+// 返回的错误与 errors.New 一样按身份比较，同时 errors.Is(err, category) 为 true。
+//
+// 示例:
+//
+//	var ErrNotFound = errorx.NewSentinel(errorx.CategoryNotFound, "cache: not found")
+//
+//	errors.Is(err, cache.ErrNotFound)         // 精确匹配
+//	errors.Is(err, errorx.CategoryNotFound)   // 按分类匹配
+func NewSentinel(category ErrorCategory, msg string) error {
+	return &sentinelError{category: category, msg: msg}
+}
+
+// Error 实现 error 接口
+func (e *sentinelError) Error() string {
+	return e.msg
+}
+
+// Is 支持 errors.Is 按分类匹配
+func (e *sentinelError) Is(target error) bool {
+	c, ok := target.(ErrorCategory)
+	return ok && c == e.category
+}
+
+// Is 支持 errors.Is 按分类匹配 CodedError
+//
+//	errors.Is(errorx.ErrNotFound("用户不存在"), errorx.CategoryNotFound)  // true
+func (e *CodedError) Is(target error) bool {
+	c, ok := target.(ErrorCategory)
+	return ok && c == categoryOfCode(e.Code)
+}
+
+// Category 对错误进行分类
+//
+// 识别顺序: 错误链中的分类哨兵 → CodedError 错误码 → 标准库错误
+// （context 超时/取消、超时接口、fs.ErrNotExist、fs.ErrPermission、sql.ErrNoRows）
+//
+// 返回:
+//   - ErrorCategory: err 为 nil 时为 CategoryNone，无法识别时为 CategoryUnknown
+//
+// 示例:
+//
+//	switch errorx.Category(err) {
+//	case errorx.CategoryNotFound:
+//	    return http.StatusNotFound
+//	case errorx.CategoryTimeout, errorx.CategoryUnavailable:
+//	    return retry()
+//	}
+func Category(err error) ErrorCategory {
+	if err == nil {
+		return CategoryNone
+	}
+
+	var category ErrorCategory
+	Walk(err, func(e error) bool {
+		switch v := e.(type) {
+		case ErrorCategory:
+			category = v
+		case *sentinelError:
+			category = v.category
+		case *CodedError:
+			category = categoryOfCode(v.Code)
+		}
+		return category == CategoryNone
+	})
+	if category != CategoryNone {
+		return category
+	}
+
+	var timeout interface{ Timeout() bool }
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return CategoryTimeout
+	case errors.Is(err, context.Canceled):
+		return CategoryCanceled
+	case errors.As(err, &timeout) && timeout.Timeout():
+		return CategoryTimeout
+	case errors.Is(err, fs.ErrNotExist), errors.Is(err, sql.ErrNoRows):
+		return CategoryNotFound
+	case errors.Is(err, fs.ErrPermission):
+		return CategoryForbidden
+	default:
+		return CategoryUnknown
+	}
+}
+
+// categoryOfCode 将错误码映射为分类
+func categoryOfCode(code int) ErrorCategory {
+	switch code {
+	case CodeOK:
+		return CategoryNone
+	case CodeInvalidInput, CodeTokenLimit, CodeContentFiltered, CodeInjectionDetected, CodePIIDetected:
+		return CategoryInvalidInput
+	case CodeNotFound, CodeModelNotFound, CodeSkillNotFound:
+		return CategoryNotFound
+	case CodeConflict:
+		return CategoryConflict
+	case CodeTimeout:
+		return CategoryTimeout
+	case CodeUnavailable:
+		return CategoryUnavailable
+	case CodeUnauthorized, CodeSignatureInvalid:
+		return CategoryUnauthorized
+	case CodeForbidden, CodePermissionDenied, CodeSkillDisabled:
+		return CategoryForbidden
+	case CodeRateLimit, CodeBudgetExceeded:
+		return CategoryRateLimited
+	case CodeUnknown:
+		return CategoryUnknown
+	default:
+		return CategoryInternal
+	}
+}
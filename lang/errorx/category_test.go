@@ -0,0 +1,92 @@
+package errorx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"testing"
+)
+
+func TestErrorCategory_Sentinel(t *testing.T) {
+	err := fmt.Errorf("load user 42: %w", CategoryNotFound)
+	if !errors.Is(err, CategoryNotFound) {
+		t.Error("分类应可直接作为哨兵错误匹配")
+	}
+	if errors.Is(err, CategoryTimeout) {
+		t.Error("不同分类不应匹配")
+	}
+	if CategoryRateLimited.Error() != "rate limited" {
+		t.Errorf("Error() 不匹配: %q", CategoryRateLimited.Error())
+	}
+	if Category(err) != CategoryNotFound {
+		t.Errorf("Category 不匹配: %v", Category(err))
+	}
+}
+
+func TestNewSentinel(t *testing.T) {
+	errCacheMiss := NewSentinel(CategoryNotFound, "cache: not found")
+	errOther := NewSentinel(CategoryNotFound, "cache: not found")
+
+	err := fmt.Errorf("get user: %w", errCacheMiss)
+	if !errors.Is(err, errCacheMiss) {
+		t.Error("应可精确匹配哨兵错误")
+	}
+	if errors.Is(err, errOther) {
+		t.Error("同消息的不同哨兵不应匹配")
+	}
+	if !errors.Is(err, CategoryNotFound) {
+		t.Error("应可按分类匹配")
+	}
+	if err.Error() != "get user: cache: not found" {
+		t.Errorf("Error() 不匹配: %q", err.Error())
+	}
+	if Category(err) != CategoryNotFound {
+		t.Errorf("Category 不匹配: %v", Category(err))
+	}
+}
+
+func TestCodedError_IsCategory(t *testing.T) {
+	if !errors.Is(ErrNotFound("用户不存在"), CategoryNotFound) {
+		t.Error("CodedError 应可按分类匹配")
+	}
+	if errors.Is(ErrNotFound("用户不存在"), CategoryConflict) {
+		t.Error("CodedError 不应匹配其他分类")
+	}
+	if !errors.Is(fmt.Errorf("wrap: %w", NewCodedError(CodeRateLimit, DomainGeneral, "x")), CategoryRateLimited) {
+		t.Error("包装后的 CodedError 应可按分类匹配")
+	}
+}
+
+type timeoutErr struct{}
+
+func (timeoutErr) Error() string { return "i/o timeout" }
+func (timeoutErr) Timeout() bool { return true }
+
+func TestCategory(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected ErrorCategory
+	}{
+		{"nil", nil, CategoryNone},
+		{"unknown", errors.New("boom"), CategoryUnknown},
+		{"coded", ErrForbidden("x"), CategoryForbidden},
+		{"coded internal", ErrInternal("x"), CategoryInternal},
+		{"coded wrapping sentinel", ErrUnauthorized("x").WithCause(CategoryNotFound), CategoryUnauthorized},
+		{"sentinel in join", errors.Join(errors.New("a"), NewSentinel(CategoryConflict, "dup")), CategoryConflict},
+		{"deadline", fmt.Errorf("call: %w", context.DeadlineExceeded), CategoryTimeout},
+		{"canceled", context.Canceled, CategoryCanceled},
+		{"timeout interface", timeoutErr{}, CategoryTimeout},
+		{"fs not exist", &fs.PathError{Op: "open", Path: "x", Err: os.ErrNotExist}, CategoryNotFound},
+		{"sql no rows", fmt.Errorf("query: %w", sql.ErrNoRows), CategoryNotFound},
+		{"fs permission", fs.ErrPermission, CategoryForbidden},
+	}
+	for _, tt := range tests {
+		if got := Category(tt.err); got != tt.expected {
+			t.Errorf("%s: 期望 %q, 实际 %q", tt.name, tt.expected, got)
+		}
+	}
+}
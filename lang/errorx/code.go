@@ -154,7 +154,7 @@ func (e *CodedError) Unwrap() error {
 // HTTPStatus 映射到 HTTP 状态码
 //
 // 根据错误码自动映射合适的 HTTP 状态码，
-// 用于 REST API 响应。
+// 用于 REST API 响应。自定义错误码使用 Register 时登记的状态码。
 func (e *CodedError) HTTPStatus() int {
 	switch e.Code {
 	case CodeOK:
@@ -184,6 +184,9 @@ func (e *CodedError) HTTPStatus() int {
 	case CodeTokenLimit:
 		return http.StatusRequestEntityTooLarge
 	default:
+		if def, ok := LookupCode(e.Code); ok {
+			return def.HTTPStatus
+		}
 		return http.StatusInternalServerError
 	}
 }
@@ -10,6 +10,44 @@
 //	    // 处理特定错误
 //	}
 //
+// 错误码注册:
+//
+//	var ErrOrderClosed = errorx.Register(errorx.CodeDef{Code: 50001, Domain: "ORDER", Message: "订单已关闭"})
+//	catalog, _ := errorx.ExportCodes() // 导出全部错误码用于 API 文档
+//
+// 状态码映射:
+//
+//	err := errorx.WithCode(dbErr, errorx.CodeConflict)
+//	w.WriteHeader(errorx.ToHTTPStatus(err))            // 409
+//	st := status.New(codes.Code(errorx.ToGRPCCode(err)), err.Error())
+//	err = errorx.FromHTTPStatus(resp.StatusCode, "")   // 下游 HTTP 响应转错误码
+//
+// 错误分类（分类本身可作为哨兵错误，模块哨兵可通过 NewSentinel 归类）:
+//
+//	var ErrNotFound = errorx.NewSentinel(errorx.CategoryNotFound, "cache: not found")
+//	errors.Is(err, errorx.CategoryNotFound)  // 匹配任意模块的"不存在"错误
+//	switch errorx.Category(err) { case errorx.CategoryTimeout: ... }
+//
+// 面向用户的多语言消息（与内部 Message 分离）:
+//
+//	errorx.RegisterMessages("en", map[int]string{CodeOrderClosed: "Order {order_id} is closed"})
+//	msg := errorx.UserMessage(err, "en-US")  // {order_id} 取自 Details
+//
+// 堆栈:
+//
+//	err = errorx.WithStack(err)
+//	fmt.Printf("%+v", err)  // 错误信息 + file:line 堆栈
+//	errorx.ConfigureStack(errorx.StackCapture(false))  // 生产环境关闭捕获
+//	errorx.ConfigureStack(errorx.StackSource(2))       // 开发环境附带源码片段
+//
+// panic 转 error（保留原始值和 panic 处的堆栈）:
+//
+//	defer func() {
+//	    if r := recover(); r != nil {
+//	        err = errorx.NewPanicError(r)  // %+v 输出堆栈
+//	    }
+//	}()
+//
 // --- English ---
 //
 // Package errorx provides error handling utilities.
@@ -23,4 +61,42 @@
 //	if errorx.Is(err, targetErr) {
 //	    // handle specific error
 //	}
+//
+// Error code registry:
+//
+//	var ErrOrderClosed = errorx.Register(errorx.CodeDef{Code: 50001, Domain: "ORDER", Message: "order closed"})
+//	catalog, _ := errorx.ExportCodes() // export all codes for API docs
+//
+// Status mapping:
+//
+//	err := errorx.WithCode(dbErr, errorx.CodeConflict)
+//	w.WriteHeader(errorx.ToHTTPStatus(err))            // 409
+//	st := status.New(codes.Code(errorx.ToGRPCCode(err)), err.Error())
+//	err = errorx.FromHTTPStatus(resp.StatusCode, "")   // convert downstream HTTP responses
+//
+// Error categories (categories are sentinels; module sentinels join one via NewSentinel):
+//
+//	var ErrNotFound = errorx.NewSentinel(errorx.CategoryNotFound, "cache: not found")
+//	errors.Is(err, errorx.CategoryNotFound)  // matches "not found" errors from any module
+//	switch errorx.Category(err) { case errorx.CategoryTimeout: ... }
+//
+// Localized user-facing messages (kept separate from the internal Message):
+//
+//	errorx.RegisterMessages("en", map[int]string{CodeOrderClosed: "Order {order_id} is closed"})
+//	msg := errorx.UserMessage(err, "en-US")  // {order_id} comes from Details
+//
+// Stack traces:
+//
+//	err = errorx.WithStack(err)
+//	fmt.Printf("%+v", err)  // message + file:line stack
+//	errorx.ConfigureStack(errorx.StackCapture(false))  // disable capture in production
+//	errorx.ConfigureStack(errorx.StackSource(2))       // source snippets in development
+//
+// Panics as errors (keeps the recovered value and the panicking stack):
+//
+//	defer func() {
+//	    if r := recover(); r != nil {
+//	        err = errorx.NewPanicError(r)  // %+v prints the stack
+//	    }
+//	}()
 package errorx
@@ -3,8 +3,6 @@ package errorx
 import (
 	"errors"
 	"fmt"
-	"runtime"
-	"strings"
 )
 
 // Try 执行函数并捕获 panic，返回 error
@@ -166,59 +164,6 @@ func Coalesce(errs ...error) error {
 	return nil
 }
 
-// StackError 带堆栈信息的 error
-type StackError struct {
-	err   error
-	stack []uintptr
-}
-
-// WithStack 添加堆栈信息到 error
-func WithStack(err error) error {
-	if err == nil {
-		return nil
-	}
-	const depth = 32
-	var pcs [depth]uintptr
-	n := runtime.Callers(2, pcs[:])
-	return &StackError{
-		err:   err,
-		stack: pcs[:n],
-	}
-}
-
-// Error 实现 error 接口
-func (e *StackError) Error() string {
-	return e.err.Error()
-}
-
-// Unwrap 实现 errors.Unwrap 接口
-func (e *StackError) Unwrap() error {
-	return e.err
-}
-
-// Stack 返回堆栈信息
-func (e *StackError) Stack() string {
-	var sb strings.Builder
-	frames := runtime.CallersFrames(e.stack)
-	for {
-		frame, more := frames.Next()
-		sb.WriteString(fmt.Sprintf("%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line))
-		if !more {
-			break
-		}
-	}
-	return sb.String()
-}
-
-// StackTrace 获取 error 的堆栈信息（如果有）
-func StackTrace(err error) string {
-	var se *StackError
-	if errors.As(err, &se) {
-		return se.Stack()
-	}
-	return ""
-}
-
 // Recover 从 panic 中恢复，返回 error
 func Recover() error {
 	if r := recover(); r != nil {
@@ -0,0 +1,151 @@
+package errorx
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ============================================================
+// 面向用户的多语言消息
+// ============================================================
+
+// messages 错误码到多语言用户消息的注册表，locale -> code -> 模板
+var messages = struct {
+	mu            sync.RWMutex
+	defaultLocale string
+	locales       map[string]map[int]string
+}{
+	defaultLocale: "zh",
+	locales:       make(map[string]map[int]string),
+}
+
+// RegisterMessages 登记某个语言下错误码对应的用户消息模板
+//
+// 模板中的 {key} 会被替换为 CodedError.Details 中同名的值，
+// 因此内部消息（Message）可以保留排查细节，返回给客户端的是翻译后的消息。
+// 同一语言重复登记时后者覆盖前者。
+//
+// 示例:
+//
+//	errorx.RegisterMessages("zh-CN", map[int]string{
+//	    CodeOrderClosed: "订单 {order_id} 已关闭",
+//	})
+//	errorx.RegisterMessages("en", map[int]string{
+//	    CodeOrderClosed: "Order {order_id} is closed",
+//	})
+func RegisterMessages(locale string, msgs map[int]string) {
+	locale = normalizeLocale(locale)
+
+	messages.mu.Lock()
+	defer messages.mu.Unlock()
+	m, ok := messages.locales[locale]
+	if !ok {
+		m = make(map[int]string, len(msgs))
+		messages.locales[locale] = m
+	}
+	for code, tmpl := range msgs {
+		m[code] = tmpl
+	}
+}
+
+// SetDefaultLocale 设置找不到请求语言时使用的默认语言，默认为 "zh"
+func SetDefaultLocale(locale string) {
+	messages.mu.Lock()
+	defer messages.mu.Unlock()
+	messages.defaultLocale = normalizeLocale(locale)
+}
+
+// UserMessage 获取错误面向用户的消息
+//
+// 查找顺序: 完整语言（zh-cn）→ 基础语言（zh）→ 默认语言 → 注册表中的默认消息。
+// 非 CodedError 按 CodeInternal 处理，不会把内部错误信息暴露给客户端。
+//
+// 参数:
+//   - err: 错误，nil 时返回空字符串
+//   - locale: 语言标签，如 "zh-CN"、"en-US"，不区分大小写，支持 "_" 分隔
+//
+// 示例:
+//
+//	err := errorx.NewCode(CodeOrderClosed, "order 42 closed by job").
+//	    WithDetails("order_id", 42)
+//	errorx.UserMessage(err, "en-US")  // Order 42 is closed
+func UserMessage(err error, locale string) string {
+	if err == nil {
+		return ""
+	}
+	ce, ok := IsCodedError(err)
+	if !ok {
+		return lookupMessage(CodeInternal, locale)
+	}
+	return expandMessage(lookupMessage(ce.Code, locale), ce.Details)
+}
+
+// lookupMessage 按语言回退顺序查找消息模板
+func lookupMessage(code int, locale string) string {
+	locale = normalizeLocale(locale)
+
+	messages.mu.RLock()
+	candidates := []string{locale}
+	if base, _, found := strings.Cut(locale, "-"); found {
+		candidates = append(candidates, base)
+	}
+	candidates = append(candidates, messages.defaultLocale)
+	for _, l := range candidates {
+		if tmpl, ok := messages.locales[l][code]; ok {
+			messages.mu.RUnlock()
+			return tmpl
+		}
+	}
+	messages.mu.RUnlock()
+
+	if def, ok := LookupCode(code); ok {
+		return def.Message
+	}
+	return lookupMessage(CodeUnknown, locale)
+}
+
+// expandMessage 将模板中的 {key} 替换为 details 中的值
+func expandMessage(tmpl string, details map[string]any) string {
+	if len(details) == 0 || !strings.Contains(tmpl, "{") {
+		return tmpl
+	}
+	pairs := make([]string, 0, len(details)*2)
+	for k, v := range details {
+		pairs = append(pairs, "{"+k+"}", fmt.Sprint(v))
+	}
+	return strings.NewReplacer(pairs...).Replace(tmpl)
+}
+
+// normalizeLocale 统一语言标签格式: 小写，以 "-" 分隔
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+}
+
+// 内置通用域错误码的中英文用户消息
+func init() {
+	RegisterMessages("zh", map[int]string{
+		CodeUnknown:      "未知错误",
+		CodeInvalidInput: "请求参数无效",
+		CodeNotFound:     "请求的资源不存在",
+		CodeConflict:     "资源冲突，请刷新后重试",
+		CodeTimeout:      "请求超时，请稍后重试",
+		CodeUnavailable:  "服务暂时不可用，请稍后重试",
+		CodeUnauthorized: "请先登录",
+		CodeForbidden:    "没有权限执行此操作",
+		CodeRateLimit:    "请求过于频繁，请稍后重试",
+		CodeInternal:     "服务器内部错误",
+	})
+	RegisterMessages("en", map[int]string{
+		CodeUnknown:      "Unknown error",
+		CodeInvalidInput: "Invalid request parameters",
+		CodeNotFound:     "The requested resource was not found",
+		CodeConflict:     "Resource conflict, please refresh and try again",
+		CodeTimeout:      "Request timed out, please try again later",
+		CodeUnavailable:  "Service temporarily unavailable, please try again later",
+		CodeUnauthorized: "Please sign in first",
+		CodeForbidden:    "You do not have permission to perform this action",
+		CodeRateLimit:    "Too many requests, please try again later",
+		CodeInternal:     "Internal server error",
+	})
+}
@@ -0,0 +1,57 @@
+package errorx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUserMessage_Builtin(t *testing.T) {
+	err := ErrNotFound("user 42 not found in shard 3")
+	if got := UserMessage(err, "zh-CN"); got != "请求的资源不存在" {
+		t.Errorf("zh-CN 应回退到 zh: %q", got)
+	}
+	if got := UserMessage(err, "en_US"); got != "The requested resource was not found" {
+		t.Errorf("en_US 应回退到 en: %q", got)
+	}
+	if got := UserMessage(err, "fr"); got != "请求的资源不存在" {
+		t.Errorf("未知语言应使用默认语言: %q", got)
+	}
+}
+
+func TestUserMessage_Template(t *testing.T) {
+	def := Register(CodeDef{Code: 90201, Domain: "ORDER", Message: "订单已关闭"})
+	RegisterMessages("zh-CN", map[int]string{def.Code: "订单 {order_id} 已关闭"})
+	RegisterMessages("en", map[int]string{def.Code: "Order {order_id} is closed"})
+
+	err := def.New("closed by expire job").WithDetails("order_id", 42)
+	if got := UserMessage(err, "zh-cn"); got != "订单 42 已关闭" {
+		t.Errorf("zh-cn 消息不匹配: %q", got)
+	}
+	if got := UserMessage(err, "en-GB"); got != "Order 42 is closed" {
+		t.Errorf("en-GB 消息不匹配: %q", got)
+	}
+	if got := UserMessage(err, "zh-TW"); got != "订单已关闭" {
+		t.Errorf("没有翻译时应使用注册的默认消息: %q", got)
+	}
+}
+
+func TestUserMessage_Fallback(t *testing.T) {
+	if UserMessage(nil, "zh") != "" {
+		t.Error("nil 错误应返回空字符串")
+	}
+	if got := UserMessage(errors.New("db password wrong"), "en"); got != "Internal server error" {
+		t.Errorf("非 CodedError 不应暴露内部信息: %q", got)
+	}
+	if got := UserMessage(NewCodedError(99997, "X", "secret"), "en"); got != "Unknown error" {
+		t.Errorf("未注册错误码应回退到 CodeUnknown: %q", got)
+	}
+}
+
+func TestSetDefaultLocale(t *testing.T) {
+	SetDefaultLocale("en")
+	defer SetDefaultLocale("zh")
+
+	if got := UserMessage(ErrForbidden("x"), "ja"); got != "You do not have permission to perform this action" {
+		t.Errorf("应使用新的默认语言: %q", got)
+	}
+}
@@ -0,0 +1,65 @@
+package errorx
+
+import (
+	"fmt"
+	"io"
+	"runtime/debug"
+)
+
+// PanicError 由 recover 得到的 panic 转换而来的 error
+//
+// 保留原始 panic 值和发生 panic 时的 goroutine 堆栈，
+// 用于在协程池、异步任务等场景中把 panic 作为普通错误返回给调用方。
+// panic 值本身是 error 时可通过 errors.Is/As 匹配。
+type PanicError struct {
+	Value any    // recover() 返回的原始值
+	Stack []byte // 发生 panic 时的 goroutine 堆栈（debug.Stack 格式）
+}
+
+// NewPanicError 使用 recover() 的返回值创建 PanicError，并记录当前堆栈
+//
+// 需要在 defer 的函数中调用，此时堆栈包含 panic 发生处的调用帧。
+// v 已经是 *PanicError 时直接返回，保留最初的堆栈。
+//
+// 示例:
+//
+//	defer func() {
+//	    if r := recover(); r != nil {
+//	        err = errorx.NewPanicError(r)
+//	    }
+//	}()
+func NewPanicError(v any) *PanicError {
+	if pe, ok := v.(*PanicError); ok {
+		return pe
+	}
+	return &PanicError{Value: v, Stack: debug.Stack()}
+}
+
+// Error 实现 error 接口
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap panic 值是 error 时返回该 error
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// Format 实现 fmt.Formatter 接口：%v、%s 输出错误信息，%+v 额外输出堆栈
+func (e *PanicError) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			fmt.Fprintf(s, "%s\n%s", e.Error(), e.Stack)
+			return
+		}
+		io.WriteString(s, e.Error())
+	case 's':
+		io.WriteString(s, e.Error())
+	case 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	}
+}
@@ -0,0 +1,70 @@
+package errorx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func recoverPanic(fn func()) (err *PanicError) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = NewPanicError(r)
+		}
+	}()
+	fn()
+	return nil
+}
+
+func panicHere() {
+	panic("boom")
+}
+
+func TestNewPanicError(t *testing.T) {
+	pe := recoverPanic(panicHere)
+	if pe == nil {
+		t.Fatal("expected PanicError")
+	}
+	if pe.Value != "boom" {
+		t.Errorf("Value = %v, want boom", pe.Value)
+	}
+	if pe.Error() != "panic: boom" {
+		t.Errorf("Error() = %q", pe.Error())
+	}
+	if !strings.Contains(string(pe.Stack), "panicHere") {
+		t.Errorf("stack should contain the panicking function:\n%s", pe.Stack)
+	}
+	if pe.Unwrap() != nil {
+		t.Errorf("non-error panic value should not unwrap, got %v", pe.Unwrap())
+	}
+
+	// 已经是 PanicError 时保留原始堆栈
+	if again := NewPanicError(pe); again != pe {
+		t.Error("NewPanicError should return an existing *PanicError unchanged")
+	}
+}
+
+func TestPanicError_Unwrap(t *testing.T) {
+	base := errors.New("base")
+	var err error = recoverPanic(func() { panic(base) })
+	if !errors.Is(err, base) {
+		t.Error("errors.Is should match the panic value")
+	}
+
+	var pe *PanicError
+	if !errors.As(Wrap(err, "task"), &pe) {
+		t.Error("errors.As should find the PanicError")
+	}
+}
+
+func TestPanicError_Format(t *testing.T) {
+	pe := recoverPanic(panicHere)
+
+	if s := fmt.Sprintf("%v", pe); s != "panic: boom" {
+		t.Errorf("%%v = %q", s)
+	}
+	if s := fmt.Sprintf("%+v", pe); !strings.HasPrefix(s, "panic: boom\n") || !strings.Contains(s, "panicHere") {
+		t.Errorf("%%+v should include the stack, got %q", s)
+	}
+}
@@ -0,0 +1,151 @@
+package errorx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+)
+
+// CodeDef 错误码定义
+//
+// 用于在全局注册表中登记错误码，保证各服务/团队之间错误码唯一且含义一致。
+type CodeDef struct {
+	// Code 错误码（全局唯一）
+	Code int `json:"code"`
+	// Domain 错误域
+	Domain string `json:"domain"`
+	// Message 默认错误消息
+	Message string `json:"message"`
+	// HTTPStatus 对应的 HTTP 状态码，为 0 时视为 500
+	HTTPStatus int `json:"http_status"`
+	// GRPCCode 对应的 gRPC 状态码，为 0（OK）且 HTTPStatus 不是 200 时根据 HTTPStatus 推导
+	GRPCCode GRPCCode `json:"grpc_code"`
+	// Description 详细说明（用于生成文档，可选）
+	Description string `json:"description,omitempty"`
+}
+
+// New 按定义创建 CodedError，msg 为空时使用默认消息
+func (d CodeDef) New(msg ...string) *CodedError {
+	message := d.Message
+	if len(msg) > 0 && msg[0] != "" {
+		message = msg[0]
+	}
+	return NewCodedError(d.Code, d.Domain, message)
+}
+
+// registry 全局错误码注册表
+var registry = struct {
+	mu    sync.RWMutex
+	codes map[int]CodeDef
+}{codes: make(map[int]CodeDef)}
+
+// Register 注册错误码
+//
+// 错误码重复时 panic，应在包的 init 或全局变量初始化中调用，
+// 以便冲突在启动阶段即被发现。
+//
+// 示例:
+//
+//	var ErrOrderClosed = errorx.Register(errorx.CodeDef{
+//	    Code:       50001,
+//	    Domain:     "ORDER",
+//	    Message:    "订单已关闭",
+//	    HTTPStatus: http.StatusConflict,
+//	})
+//
+//	return ErrOrderClosed.New()
+func Register(def CodeDef) CodeDef {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if existing, ok := registry.codes[def.Code]; ok {
+		panic(fmt.Sprintf("errorx: duplicate error code %d: %s-%s conflicts with %s-%s",
+			def.Code, def.Domain, def.Message, existing.Domain, existing.Message))
+	}
+	if def.HTTPStatus == 0 {
+		def.HTTPStatus = http.StatusInternalServerError
+	}
+	if def.GRPCCode == GRPCOK && def.HTTPStatus != http.StatusOK {
+		def.GRPCCode = GRPCCodeFromHTTP(def.HTTPStatus)
+	}
+	registry.codes[def.Code] = def
+	return def
+}
+
+// LookupCode 查询已注册的错误码定义
+func LookupCode(code int) (CodeDef, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	def, ok := registry.codes[code]
+	return def, ok
+}
+
+// RegisteredCodes 返回所有已注册的错误码定义，按错误码升序排列
+func RegisteredCodes() []CodeDef {
+	registry.mu.RLock()
+	defs := make([]CodeDef, 0, len(registry.codes))
+	for _, def := range registry.codes {
+		defs = append(defs, def)
+	}
+	registry.mu.RUnlock()
+
+	slices.SortFunc(defs, func(a, b CodeDef) int { return a.Code - b.Code })
+	return defs
+}
+
+// ExportCodes 将全部错误码导出为 JSON，用于生成 API 文档
+//
+// 输出格式（按错误码排序，两空格缩进）:
+//
+//	[
+//	  ...
+//	  {
+//	    "code": 1000,
+//	    "domain": "GENERAL",
+//	    "message": "未知错误",
+//	    "http_status": 500,
+//	    "grpc_code": 2
+//	  },
+//	  ...
+//	]
+func ExportCodes() ([]byte, error) {
+	return json.MarshalIndent(RegisteredCodes(), "", "  ")
+}
+
+// 注册内置错误码，使其参与冲突检测和文档导出
+func init() {
+	builtin := []CodeDef{
+		{Code: CodeOK, Domain: DomainGeneral, Message: "成功"},
+		{Code: CodeUnknown, Domain: DomainGeneral, Message: "未知错误", GRPCCode: GRPCUnknown},
+		{Code: CodeInvalidInput, Domain: DomainGeneral, Message: "无效输入"},
+		{Code: CodeNotFound, Domain: DomainGeneral, Message: "资源未找到"},
+		{Code: CodeConflict, Domain: DomainGeneral, Message: "资源冲突"},
+		{Code: CodeTimeout, Domain: DomainGeneral, Message: "操作超时"},
+		{Code: CodeUnavailable, Domain: DomainGeneral, Message: "服务不可用"},
+		{Code: CodeUnauthorized, Domain: DomainGeneral, Message: "未认证"},
+		{Code: CodeForbidden, Domain: DomainGeneral, Message: "无权限"},
+		{Code: CodeRateLimit, Domain: DomainGeneral, Message: "请求频率超限"},
+		{Code: CodeInternal, Domain: DomainGeneral, Message: "内部错误"},
+		{Code: CodeLLMError, Domain: DomainAI, Message: "LLM 调用失败"},
+		{Code: CodeTokenLimit, Domain: DomainAI, Message: "Token 数量超限"},
+		{Code: CodeModelNotFound, Domain: DomainAI, Message: "模型未找到"},
+		{Code: CodeBudgetExceeded, Domain: DomainAI, Message: "预算超限"},
+		{Code: CodeContentFiltered, Domain: DomainAI, Message: "内容被过滤"},
+		{Code: CodeAgentError, Domain: DomainAgent, Message: "Agent 执行错误"},
+		{Code: CodeToolError, Domain: DomainAgent, Message: "工具执行错误"},
+		{Code: CodePlanError, Domain: DomainAgent, Message: "规划失败"},
+		{Code: CodeHandoffError, Domain: DomainAgent, Message: "交接失败"},
+		{Code: CodeSkillNotFound, Domain: DomainAgent, Message: "Skill 未找到"},
+		{Code: CodeSkillDisabled, Domain: DomainAgent, Message: "Skill 已禁用"},
+		{Code: CodeInjectionDetected, Domain: DomainSecurity, Message: "检测到注入攻击"},
+		{Code: CodePIIDetected, Domain: DomainSecurity, Message: "检测到 PII 信息"},
+		{Code: CodeSignatureInvalid, Domain: DomainSecurity, Message: "签名无效"},
+		{Code: CodePermissionDenied, Domain: DomainSecurity, Message: "权限被拒绝"},
+	}
+	for _, def := range builtin {
+		def.HTTPStatus = (&CodedError{Code: def.Code}).HTTPStatus()
+		Register(def)
+	}
+}
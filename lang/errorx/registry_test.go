@@ -0,0 +1,85 @@
+package errorx
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRegister(t *testing.T) {
+	def := Register(CodeDef{
+		Code:       90001,
+		Domain:     "ORDER",
+		Message:    "订单已关闭",
+		HTTPStatus: http.StatusConflict,
+	})
+
+	got, ok := LookupCode(90001)
+	if !ok || got.Domain != "ORDER" {
+		t.Fatalf("LookupCode 应返回已注册的定义: %+v", got)
+	}
+
+	err := def.New()
+	if err.Error() != "[ORDER-90001] 订单已关闭" {
+		t.Errorf("Error() 不匹配: %q", err.Error())
+	}
+	if err.HTTPStatus() != http.StatusConflict {
+		t.Errorf("HTTPStatus 应使用注册的状态码, 实际 %d", err.HTTPStatus())
+	}
+	if def.New("自定义消息").Message != "自定义消息" {
+		t.Error("New 应支持覆盖消息")
+	}
+}
+
+func TestRegister_DefaultHTTPStatus(t *testing.T) {
+	def := Register(CodeDef{Code: 90002, Domain: "ORDER", Message: "未知"})
+	if def.HTTPStatus != http.StatusInternalServerError {
+		t.Errorf("未指定 HTTPStatus 时应为 500, 实际 %d", def.HTTPStatus)
+	}
+}
+
+func TestRegister_Duplicate(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("重复注册应 panic")
+		}
+	}()
+	Register(CodeDef{Code: CodeNotFound, Domain: "USER", Message: "用户不存在"})
+}
+
+func TestRegisteredCodes_Builtin(t *testing.T) {
+	def, ok := LookupCode(CodeRateLimit)
+	if !ok || def.HTTPStatus != http.StatusTooManyRequests {
+		t.Errorf("内置错误码应已注册: %+v", def)
+	}
+
+	codes := RegisteredCodes()
+	for i := 1; i < len(codes); i++ {
+		if codes[i-1].Code >= codes[i].Code {
+			t.Fatal("RegisteredCodes 应按错误码升序排列")
+		}
+	}
+}
+
+func TestExportCodes(t *testing.T) {
+	data, err := ExportCodes()
+	if err != nil {
+		t.Fatalf("ExportCodes 失败: %v", err)
+	}
+	var defs []CodeDef
+	if err := json.Unmarshal(data, &defs); err != nil {
+		t.Fatalf("导出的 JSON 无效: %v", err)
+	}
+	if len(defs) != len(RegisteredCodes()) {
+		t.Errorf("导出数量不匹配: %d", len(defs))
+	}
+	// 与 ExportCodes 文档中的示例一致
+	if !strings.Contains(string(data), `"code": 1000,
+    "domain": "GENERAL",
+    "message": "未知错误",
+    "http_status": 500,
+    "grpc_code": 2`) {
+		t.Errorf("导出内容与文档示例不一致:\n%s", data)
+	}
+}
@@ -0,0 +1,272 @@
+package errorx
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// StackConfig 堆栈捕获配置，通过 ConfigureStack 全局设置
+type StackConfig struct {
+	// Enabled 是否捕获堆栈（默认 true）
+	// 关闭后 WithStack 直接返回原 error，生产环境可据此消除捕获开销
+	Enabled bool
+
+	// Depth 最大捕获帧数（默认 32）
+	Depth int
+
+	// Skip 额外跳过的调用帧数（默认 0），用于在封装函数中调用 WithStack 时隐藏封装层
+	Skip int
+
+	// SourceLines 详细格式（%+v、VerboseStack）输出源码时，出错行前后各展示的行数
+	// 默认 0，不读取源码文件；建议仅在开发环境开启
+	SourceLines int
+}
+
+// StackOption 堆栈配置选项
+type StackOption func(*StackConfig)
+
+// defaultStackDepth 默认最大捕获帧数
+const defaultStackDepth = 32
+
+var stackConfig atomic.Pointer[StackConfig]
+
+func init() {
+	stackConfig.Store(&StackConfig{Enabled: true, Depth: defaultStackDepth})
+}
+
+// StackCapture 设置是否捕获堆栈
+func StackCapture(enabled bool) StackOption {
+	return func(c *StackConfig) { c.Enabled = enabled }
+}
+
+// StackDepth 设置最大捕获帧数，n <= 0 时使用默认值 32
+func StackDepth(n int) StackOption {
+	return func(c *StackConfig) { c.Depth = n }
+}
+
+// StackSkip 设置额外跳过的调用帧数
+func StackSkip(n int) StackOption {
+	return func(c *StackConfig) { c.Skip = n }
+}
+
+// StackSource 设置详细格式中源码上下文的行数，0 表示不输出源码
+func StackSource(lines int) StackOption {
+	return func(c *StackConfig) { c.SourceLines = lines }
+}
+
+// ConfigureStack 修改全局堆栈配置，未指定的选项保持当前值，可并发调用
+//
+// 示例:
+//
+//	// 生产环境关闭堆栈捕获
+//	errorx.ConfigureStack(errorx.StackCapture(false))
+//
+//	// 开发环境输出出错行前后 2 行源码
+//	errorx.ConfigureStack(errorx.StackDepth(64), errorx.StackSource(2))
+func ConfigureStack(opts ...StackOption) {
+	for {
+		old := stackConfig.Load()
+		cfg := *old
+		for _, opt := range opts {
+			if opt != nil {
+				opt(&cfg)
+			}
+		}
+		if cfg.Depth <= 0 {
+			cfg.Depth = defaultStackDepth
+		}
+		cfg.Skip = max(cfg.Skip, 0)
+		cfg.SourceLines = max(cfg.SourceLines, 0)
+		if stackConfig.CompareAndSwap(old, &cfg) {
+			return
+		}
+	}
+}
+
+// GetStackConfig 返回当前堆栈配置
+func GetStackConfig() StackConfig {
+	return *stackConfig.Load()
+}
+
+// StackError 带堆栈信息的 error
+//
+// 创建时只记录程序计数器，函数名和文件行号在首次调用 Stack/Frames 时才解析并缓存。
+// 实现 fmt.Formatter：%v、%s 输出错误信息，%+v 额外输出详细堆栈
+type StackError struct {
+	err   error
+	stack []uintptr
+
+	once   sync.Once
+	frames []runtime.Frame
+}
+
+// WithStack 添加堆栈信息到 error，堆栈捕获关闭时返回原 error
+func WithStack(err error) error {
+	return withStack(err, 1)
+}
+
+// WithStackSkip 添加堆栈信息到 error，额外跳过 skip 个调用帧
+//
+// 用于封装函数，使堆栈从封装函数的调用方开始:
+//
+//	func wrapDBErr(err error) error {
+//	    return errorx.WithStackSkip(err, 1)  // 跳过 wrapDBErr 本身
+//	}
+func WithStackSkip(err error, skip int) error {
+	return withStack(err, 1+max(skip, 0))
+}
+
+// withStack skip 为相对 withStack 调用方的帧数
+func withStack(err error, skip int) error {
+	if err == nil {
+		return nil
+	}
+	cfg := stackConfig.Load()
+	if !cfg.Enabled {
+		return err
+	}
+	pcs := make([]uintptr, cfg.Depth)
+	// 跳过 runtime.Callers 和 withStack 本身
+	n := runtime.Callers(2+skip+cfg.Skip, pcs)
+	return &StackError{
+		err:   err,
+		stack: pcs[:n],
+	}
+}
+
+// Error 实现 error 接口
+func (e *StackError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap 实现 errors.Unwrap 接口
+func (e *StackError) Unwrap() error {
+	return e.err
+}
+
+// Frames 返回解析后的调用帧，结果会被缓存
+func (e *StackError) Frames() []runtime.Frame {
+	e.once.Do(func() {
+		frames := runtime.CallersFrames(e.stack)
+		for {
+			frame, more := frames.Next()
+			e.frames = append(e.frames, frame)
+			if !more {
+				break
+			}
+		}
+	})
+	return e.frames
+}
+
+// Stack 返回堆栈信息
+func (e *StackError) Stack() string {
+	var sb strings.Builder
+	for _, frame := range e.Frames() {
+		fmt.Fprintf(&sb, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+	}
+	return sb.String()
+}
+
+// VerboseStack 返回详细堆栈信息，每帧一行 "file:line function"
+//
+// StackConfig.SourceLines > 0 时在每帧下方附带源码片段，出错行以 ">" 标记；
+// 源码文件不可读时（如生产环境部署的二进制）省略片段
+func (e *StackError) VerboseStack() string {
+	var sb strings.Builder
+	e.writeVerbose(&sb)
+	return sb.String()
+}
+
+func (e *StackError) writeVerbose(w io.Writer) {
+	lines := stackConfig.Load().SourceLines
+	for _, frame := range e.Frames() {
+		fmt.Fprintf(w, "%s:%d %s\n", frame.File, frame.Line, frame.Function)
+		if lines > 0 {
+			writeSource(w, frame.File, frame.Line, lines)
+		}
+	}
+}
+
+// Format 实现 fmt.Formatter 接口
+func (e *StackError) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			fmt.Fprintf(s, "%+v\n", e.err)
+			e.writeVerbose(s)
+			return
+		}
+		io.WriteString(s, e.Error())
+	case 's':
+		io.WriteString(s, e.Error())
+	case 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	}
+}
+
+// StackTrace 获取 error 的堆栈信息（如果有）
+func StackTrace(err error) string {
+	var se *StackError
+	if errors.As(err, &se) {
+		return se.Stack()
+	}
+	return ""
+}
+
+// FormatVerbose 返回错误信息和详细堆栈，error 链中没有 StackError 时只返回错误信息
+//
+// 示例:
+//
+//	errorx.ConfigureStack(errorx.StackSource(2))
+//	log.Println(errorx.FormatVerbose(err))
+func FormatVerbose(err error) string {
+	if err == nil {
+		return ""
+	}
+	var se *StackError
+	if !errors.As(err, &se) {
+		return err.Error()
+	}
+	var sb strings.Builder
+	sb.WriteString(err.Error())
+	sb.WriteByte('\n')
+	se.writeVerbose(&sb)
+	return sb.String()
+}
+
+// sourceCache 源码文件缓存，只在开启 SourceLines 时使用；读取失败时缓存 nil
+var sourceCache sync.Map // map[string][][]byte
+
+// writeSource 输出 file 第 line 行前后各 context 行源码
+func writeSource(w io.Writer, file string, line, context int) {
+	v, ok := sourceCache.Load(file)
+	if !ok {
+		var lines [][]byte
+		if data, err := os.ReadFile(file); err == nil {
+			lines = bytes.Split(data, []byte("\n"))
+		}
+		v, _ = sourceCache.LoadOrStore(file, lines)
+	}
+	src := v.([][]byte)
+	if line <= 0 || line > len(src) {
+		return
+	}
+
+	start := max(line-context, 1)
+	end := min(line+context, len(src))
+	for i := start; i <= end; i++ {
+		marker := " "
+		if i == line {
+			marker = ">"
+		}
+		fmt.Fprintf(w, "\t%s %4d | %s\n", marker, i, bytes.TrimRight(src[i-1], "\r"))
+	}
+}
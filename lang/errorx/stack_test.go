@@ -0,0 +1,118 @@
+package errorx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// withStackConfig 临时修改全局堆栈配置，测试结束后恢复
+func withStackConfig(t *testing.T, opts ...StackOption) {
+	t.Helper()
+	old := GetStackConfig()
+	ConfigureStack(opts...)
+	t.Cleanup(func() {
+		stackConfig.Store(&old)
+	})
+}
+
+func TestConfigureStack_Defaults(t *testing.T) {
+	cfg := GetStackConfig()
+	if !cfg.Enabled || cfg.Depth != 32 || cfg.Skip != 0 || cfg.SourceLines != 0 {
+		t.Errorf("unexpected default config: %+v", cfg)
+	}
+
+	withStackConfig(t, StackDepth(-1), StackSkip(-2), StackSource(-3))
+	cfg = GetStackConfig()
+	if cfg.Depth != 32 || cfg.Skip != 0 || cfg.SourceLines != 0 {
+		t.Errorf("invalid values should be normalized: %+v", cfg)
+	}
+}
+
+func TestWithStack_Disabled(t *testing.T) {
+	withStackConfig(t, StackCapture(false))
+
+	base := errors.New("base")
+	if err := WithStack(base); err != base {
+		t.Errorf("disabled capture should return original error, got %T", err)
+	}
+}
+
+func TestWithStack_Depth(t *testing.T) {
+	withStackConfig(t, StackDepth(2))
+
+	se := WithStack(errors.New("x")).(*StackError)
+	if n := len(se.Frames()); n != 2 {
+		t.Errorf("expected 2 frames, got %d", n)
+	}
+}
+
+func wrapHelper(err error) error {
+	return WithStackSkip(err, 1)
+}
+
+func TestWithStackSkip(t *testing.T) {
+	se := wrapHelper(errors.New("x")).(*StackError)
+	first := se.Frames()[0].Function
+	if strings.Contains(first, "wrapHelper") || !strings.Contains(first, "TestWithStackSkip") {
+		t.Errorf("expected first frame to be the caller of wrapHelper, got %s", first)
+	}
+
+	withStackConfig(t, StackSkip(1))
+	se = WithStack(errors.New("x")).(*StackError)
+	if strings.Contains(se.Frames()[0].Function, "TestWithStackSkip") {
+		t.Error("global Skip should hide the caller frame")
+	}
+}
+
+func TestStackError_Format(t *testing.T) {
+	err := WithStack(errors.New("boom"))
+
+	if got := fmt.Sprintf("%v", err); got != "boom" {
+		t.Errorf("%%v = %q, want boom", got)
+	}
+	if got := fmt.Sprintf("%s", err); got != "boom" {
+		t.Errorf("%%s = %q, want boom", got)
+	}
+	if got := fmt.Sprintf("%q", err); got != `"boom"` {
+		t.Errorf("%%q = %q", got)
+	}
+
+	verbose := fmt.Sprintf("%+v", err)
+	if !strings.HasPrefix(verbose, "boom\n") || !strings.Contains(verbose, "stack_test.go:") ||
+		!strings.Contains(verbose, "TestStackError_Format") {
+		t.Errorf("unexpected %%+v output:\n%s", verbose)
+	}
+	if strings.Contains(verbose, " | ") {
+		t.Error("source snippet should be off by default")
+	}
+}
+
+func TestFormatVerbose_Source(t *testing.T) {
+	withStackConfig(t, StackSource(1))
+
+	err := Wrap(WithStack(errors.New("boom")), "load") // source-marker
+	out := FormatVerbose(err)
+	if !strings.HasPrefix(out, "load: boom\n") {
+		t.Errorf("unexpected header:\n%s", out)
+	}
+	if !strings.Contains(out, "> ") || !strings.Contains(out, "// source-marker") {
+		t.Errorf("expected source snippet with marker line:\n%s", out)
+	}
+
+	if got := FormatVerbose(errors.New("plain")); got != "plain" {
+		t.Errorf("plain error = %q", got)
+	}
+	if FormatVerbose(nil) != "" {
+		t.Error("nil error should format as empty string")
+	}
+}
+
+func TestWriteSource_MissingFile(t *testing.T) {
+	var sb strings.Builder
+	writeSource(&sb, "/nonexistent/file.go", 10, 2)
+	if sb.Len() != 0 {
+		t.Errorf("missing file should produce no output, got %q", sb.String())
+	}
+}
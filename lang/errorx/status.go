@@ -0,0 +1,225 @@
+package errorx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// ============================================================
+// gRPC 状态码映射
+// ============================================================
+
+// GRPCCode gRPC 状态码
+//
+// 取值与 google.golang.org/grpc/codes 一致，本包不依赖 gRPC，
+// 使用时直接转换: codes.Code(err.GRPCCode())
+type GRPCCode uint32
+
+// gRPC 状态码常量
+const (
+	GRPCOK                 GRPCCode = 0
+	GRPCCanceled           GRPCCode = 1
+	GRPCUnknown            GRPCCode = 2
+	GRPCInvalidArgument    GRPCCode = 3
+	GRPCDeadlineExceeded   GRPCCode = 4
+	GRPCNotFound           GRPCCode = 5
+	GRPCAlreadyExists      GRPCCode = 6
+	GRPCPermissionDenied   GRPCCode = 7
+	GRPCResourceExhausted  GRPCCode = 8
+	GRPCFailedPrecondition GRPCCode = 9
+	GRPCAborted            GRPCCode = 10
+	GRPCOutOfRange         GRPCCode = 11
+	GRPCUnimplemented      GRPCCode = 12
+	GRPCInternal           GRPCCode = 13
+	GRPCUnavailable        GRPCCode = 14
+	GRPCDataLoss           GRPCCode = 15
+	GRPCUnauthenticated    GRPCCode = 16
+)
+
+var grpcCodeNames = [...]string{
+	"OK", "Canceled", "Unknown", "InvalidArgument", "DeadlineExceeded",
+	"NotFound", "AlreadyExists", "PermissionDenied", "ResourceExhausted",
+	"FailedPrecondition", "Aborted", "OutOfRange", "Unimplemented",
+	"Internal", "Unavailable", "DataLoss", "Unauthenticated",
+}
+
+// String 返回状态码名称，与 gRPC 的 codes.Code.String 一致
+func (c GRPCCode) String() string {
+	if int(c) < len(grpcCodeNames) {
+		return grpcCodeNames[c]
+	}
+	return "Code(" + strconv.FormatUint(uint64(c), 10) + ")"
+}
+
+// StatusClientClosedRequest 客户端主动断开请求（nginx 约定的非标准状态码）
+const StatusClientClosedRequest = 499
+
+// GRPCCodeFromHTTP 将 HTTP 状态码映射为 gRPC 状态码
+//
+// 未单独列出的 4xx 映射为 FailedPrecondition，5xx 映射为 Internal，其余为 Unknown
+func GRPCCodeFromHTTP(status int) GRPCCode {
+	switch status {
+	case http.StatusOK:
+		return GRPCOK
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return GRPCInvalidArgument
+	case http.StatusUnauthorized:
+		return GRPCUnauthenticated
+	case http.StatusForbidden:
+		return GRPCPermissionDenied
+	case http.StatusNotFound:
+		return GRPCNotFound
+	case http.StatusConflict:
+		return GRPCAlreadyExists
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return GRPCDeadlineExceeded
+	case http.StatusTooManyRequests, http.StatusPaymentRequired, http.StatusRequestEntityTooLarge:
+		return GRPCResourceExhausted
+	case StatusClientClosedRequest:
+		return GRPCCanceled
+	case http.StatusNotImplemented:
+		return GRPCUnimplemented
+	case http.StatusServiceUnavailable, http.StatusBadGateway:
+		return GRPCUnavailable
+	}
+	switch {
+	case status >= 400 && status < 500:
+		return GRPCFailedPrecondition
+	case status >= 500:
+		return GRPCInternal
+	default:
+		return GRPCUnknown
+	}
+}
+
+// GRPCCode 映射到 gRPC 状态码
+//
+// 已注册的错误码使用 Register 时登记（或推导）的状态码，
+// 未注册的错误码根据 HTTPStatus 推导。
+func (e *CodedError) GRPCCode() GRPCCode {
+	if def, ok := LookupCode(e.Code); ok {
+		return def.GRPCCode
+	}
+	return GRPCCodeFromHTTP(e.HTTPStatus())
+}
+
+// ============================================================
+// 任意 error 与状态码互转
+// ============================================================
+
+// NewCode 按已注册的错误码创建 CodedError
+//
+// 错误域取自注册表，msg 为空时使用注册的默认消息；
+// 错误码未注册时错误域为 DomainGeneral。
+//
+// 示例:
+//
+//	return errorx.NewCode(errorx.CodeNotFound, "订单不存在")  // [GENERAL-1002] 订单不存在
+func NewCode(code int, msg string) *CodedError {
+	if def, ok := LookupCode(code); ok {
+		return def.New(msg)
+	}
+	return NewCodedError(code, DomainGeneral, msg)
+}
+
+// WithCode 为任意错误附加错误码，原错误作为 cause 保留在错误链中
+//
+// 消息使用注册的默认消息，err 为 nil 时返回 nil
+//
+// 示例:
+//
+//	if err := repo.Save(order); err != nil {
+//	    return errorx.WithCode(err, errorx.CodeConflict)
+//	    // [GENERAL-1003] 资源冲突: duplicate key ...
+//	}
+func WithCode(err error, code int) *CodedError {
+	if err == nil {
+		return nil
+	}
+	return NewCode(code, "").WithCause(err)
+}
+
+// ToHTTPStatus 获取任意错误对应的 HTTP 状态码
+//
+// 返回:
+//   - int: nil 为 200；错误链中有 CodedError 时使用其 HTTPStatus；
+//     context.DeadlineExceeded 为 504，context.Canceled 为 499；其余为 500
+func ToHTTPStatus(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	if ce, ok := IsCodedError(err); ok {
+		return ce.HTTPStatus()
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, context.Canceled):
+		return StatusClientClosedRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// ToGRPCCode 获取任意错误对应的 gRPC 状态码，规则同 ToHTTPStatus
+func ToGRPCCode(err error) GRPCCode {
+	if err == nil {
+		return GRPCOK
+	}
+	if ce, ok := IsCodedError(err); ok {
+		return ce.GRPCCode()
+	}
+	return GRPCCodeFromHTTP(ToHTTPStatus(err))
+}
+
+// FromHTTPStatus 根据 HTTP 状态码创建通用域的 CodedError，用于转换下游 HTTP 响应
+//
+// 参数:
+//   - status: HTTP 状态码
+//   - msg: 错误消息，为空时使用 http.StatusText
+//
+// 返回:
+//   - *CodedError: status < 400 时返回 nil
+//
+// 示例:
+//
+//	if resp.StatusCode >= 400 {
+//	    return errorx.FromHTTPStatus(resp.StatusCode, "")  // 404 → [GENERAL-1002] Not Found
+//	}
+func FromHTTPStatus(status int, msg string) *CodedError {
+	if status < 400 {
+		return nil
+	}
+	if msg == "" {
+		msg = http.StatusText(status)
+	}
+	return NewCodedError(codeFromHTTP(status), DomainGeneral, msg)
+}
+
+// codeFromHTTP 将 HTTP 状态码映射为通用域错误码
+func codeFromHTTP(status int) int {
+	switch status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return CodeInvalidInput
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return CodeTimeout
+	case http.StatusTooManyRequests:
+		return CodeRateLimit
+	case http.StatusServiceUnavailable, http.StatusBadGateway:
+		return CodeUnavailable
+	}
+	if status >= 500 {
+		return CodeInternal
+	}
+	return CodeUnknown
+}
@@ -0,0 +1,159 @@
+package errorx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCodedError_GRPCCode(t *testing.T) {
+	tests := []struct {
+		code     int
+		expected GRPCCode
+	}{
+		{CodeOK, GRPCOK},
+		{CodeUnknown, GRPCUnknown},
+		{CodeInvalidInput, GRPCInvalidArgument},
+		{CodeNotFound, GRPCNotFound},
+		{CodeConflict, GRPCAlreadyExists},
+		{CodeTimeout, GRPCDeadlineExceeded},
+		{CodeUnavailable, GRPCUnavailable},
+		{CodeUnauthorized, GRPCUnauthenticated},
+		{CodeForbidden, GRPCPermissionDenied},
+		{CodeRateLimit, GRPCResourceExhausted},
+		{CodeInternal, GRPCInternal},
+		{99999, GRPCInternal}, // 未注册
+	}
+	for _, tt := range tests {
+		err := NewCodedError(tt.code, DomainGeneral, "test")
+		if got := err.GRPCCode(); got != tt.expected {
+			t.Errorf("Code %d: 期望 gRPC %v, 实际 %v", tt.code, tt.expected, got)
+		}
+	}
+}
+
+func TestRegister_GRPCCode(t *testing.T) {
+	derived := Register(CodeDef{Code: 90101, Domain: "ORDER", Message: "订单冲突", HTTPStatus: http.StatusConflict})
+	if derived.GRPCCode != GRPCAlreadyExists {
+		t.Errorf("未指定 GRPCCode 时应根据 HTTPStatus 推导, 实际 %v", derived.GRPCCode)
+	}
+
+	explicit := Register(CodeDef{
+		Code:       90102,
+		Domain:     "ORDER",
+		Message:    "订单状态不允许",
+		HTTPStatus: http.StatusConflict,
+		GRPCCode:   GRPCFailedPrecondition,
+	})
+	if got := explicit.New().GRPCCode(); got != GRPCFailedPrecondition {
+		t.Errorf("应使用注册的 GRPCCode, 实际 %v", got)
+	}
+}
+
+func TestGRPCCode_String(t *testing.T) {
+	if GRPCNotFound.String() != "NotFound" || GRPCUnauthenticated.String() != "Unauthenticated" {
+		t.Error("String 应与 gRPC 名称一致")
+	}
+	if GRPCCode(99).String() != "Code(99)" {
+		t.Errorf("未知状态码名称不匹配: %s", GRPCCode(99))
+	}
+}
+
+func TestNewCode(t *testing.T) {
+	err := NewCode(CodeLLMError, "")
+	if err.Domain != DomainAI || err.Message != "LLM 调用失败" {
+		t.Errorf("应使用注册的域和默认消息: %v", err)
+	}
+	if NewCode(CodeNotFound, "订单不存在").Error() != "[GENERAL-1002] 订单不存在" {
+		t.Error("应使用自定义消息")
+	}
+	if NewCode(99998, "x").Domain != DomainGeneral {
+		t.Error("未注册错误码应使用 DomainGeneral")
+	}
+}
+
+func TestWithCode(t *testing.T) {
+	if WithCode(nil, CodeConflict) != nil {
+		t.Error("nil 错误应返回 nil")
+	}
+
+	base := errors.New("duplicate key")
+	err := WithCode(base, CodeConflict)
+	if err.Error() != "[GENERAL-1003] 资源冲突: duplicate key" {
+		t.Errorf("Error() 不匹配: %q", err.Error())
+	}
+	if !errors.Is(err, base) {
+		t.Error("应保留原错误")
+	}
+}
+
+func TestToHTTPStatus(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected int
+	}{
+		{nil, http.StatusOK},
+		{ErrNotFound("x"), http.StatusNotFound},
+		{fmt.Errorf("wrap: %w", ErrForbidden("x")), http.StatusForbidden},
+		{context.DeadlineExceeded, http.StatusGatewayTimeout},
+		{fmt.Errorf("wrap: %w", context.Canceled), StatusClientClosedRequest},
+		{errors.New("boom"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		if got := ToHTTPStatus(tt.err); got != tt.expected {
+			t.Errorf("%v: 期望 %d, 实际 %d", tt.err, tt.expected, got)
+		}
+	}
+}
+
+func TestToGRPCCode(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected GRPCCode
+	}{
+		{nil, GRPCOK},
+		{ErrInvalidInput("x"), GRPCInvalidArgument},
+		{context.DeadlineExceeded, GRPCDeadlineExceeded},
+		{context.Canceled, GRPCCanceled},
+		{errors.New("boom"), GRPCInternal},
+	}
+	for _, tt := range tests {
+		if got := ToGRPCCode(tt.err); got != tt.expected {
+			t.Errorf("%v: 期望 %v, 实际 %v", tt.err, tt.expected, got)
+		}
+	}
+}
+
+func TestFromHTTPStatus(t *testing.T) {
+	if FromHTTPStatus(http.StatusOK, "") != nil {
+		t.Error("非错误状态码应返回 nil")
+	}
+
+	err := FromHTTPStatus(http.StatusNotFound, "")
+	if err.Code != CodeNotFound || err.Message != "Not Found" {
+		t.Errorf("404 映射不匹配: %v", err)
+	}
+	if err.HTTPStatus() != http.StatusNotFound {
+		t.Error("应可映射回原状态码")
+	}
+
+	tests := []struct {
+		status   int
+		expected int
+	}{
+		{http.StatusBadRequest, CodeInvalidInput},
+		{http.StatusUnauthorized, CodeUnauthorized},
+		{http.StatusTooManyRequests, CodeRateLimit},
+		{http.StatusGatewayTimeout, CodeTimeout},
+		{http.StatusBadGateway, CodeUnavailable},
+		{http.StatusInternalServerError, CodeInternal},
+		{http.StatusTeapot, CodeUnknown},
+	}
+	for _, tt := range tests {
+		if got := FromHTTPStatus(tt.status, "msg"); got.Code != tt.expected {
+			t.Errorf("HTTP %d: 期望 %d, 实际 %d", tt.status, tt.expected, got.Code)
+		}
+	}
+}
@@ -18,6 +18,11 @@
 //   - RoundTo: 四舍五入到指定小数位
 //   - Ceil/Floor/Trunc: 取整函数
 //
+// 流式统计:
+//   - Welford: 在线累加器，流式计算计数/均值/方差/标准差，支持 Merge 合并分片
+//   - EMA/SMA: 指数移动平均、简单移动平均
+//   - RateCounter: 滑动窗口速率计算，无需保存全部样本
+//
 // # 使用示例
 //
 //	import "github.com/hexagon-codes/toolkit/lang/mathx"
@@ -51,7 +56,7 @@
 //
 // # 注意事项
 //
-// - 所有函数都是并发安全的（纯函数，无状态）
+// - 所有函数都是并发安全的（纯函数，无状态）；Welford/EMA/SMA 非并发安全，RateCounter 并发安全
 // - 空参数会返回类型的零值
 // - 浮点数运算遵循 IEEE 754 标准
 //
@@ -78,6 +83,11 @@
 //   - RoundTo: round to specified decimal places
 //   - Ceil/Floor/Trunc: rounding functions
 //
+// Streaming statistics:
+//   - Welford: online accumulator for count/mean/variance/stddev, with Merge for sharded stats
+//   - EMA/SMA: exponential and simple moving averages
+//   - RateCounter: sliding-window rate without storing samples
+//
 // # Usage Examples
 //
 //	import "github.com/hexagon-codes/toolkit/lang/mathx"
@@ -111,7 +121,7 @@
 //
 // # Notes
 //
-// - All functions are concurrency-safe (pure functions, stateless)
+// - All functions are concurrency-safe (pure functions, stateless); Welford/EMA/SMA are not, RateCounter is
 // - Empty arguments return the type's zero value
 // - Floating-point arithmetic follows the IEEE 754 standard
 package mathx
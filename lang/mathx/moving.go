@@ -0,0 +1,205 @@
+package mathx
+
+import (
+	"sync"
+	"time"
+)
+
+// EMA 指数移动平均
+//
+// 新值权重为 alpha，历史值权重按 (1-alpha) 指数衰减，第一个样本直接作为初始值。
+// 非并发安全，并发使用时需由调用方加锁
+//
+// 示例:
+//
+//	ema := mathx.NewEMA(0.1)
+//	ema.Add(latency)
+//	ema.Value()
+type EMA struct {
+	alpha float64
+	value float64
+	init  bool
+}
+
+// NewEMA 创建指数移动平均，alpha 取值 (0, 1]，越大对新值越敏感
+//
+// alpha 超出范围时限制到 (0, 1] 内
+func NewEMA(alpha float64) *EMA {
+	if alpha <= 0 {
+		alpha = 1e-9
+	}
+	return &EMA{alpha: min(alpha, 1)}
+}
+
+// NewEMAWithPeriod 按周期创建指数移动平均，alpha = 2 / (period + 1)
+//
+// 与常见的 N 周期 EMA 定义一致，period <= 0 时按 1 处理
+func NewEMAWithPeriod(period int) *EMA {
+	return NewEMA(2 / float64(max(period, 1)+1))
+}
+
+// Add 添加样本并返回更新后的平均值
+func (e *EMA) Add(x float64) float64 {
+	if !e.init {
+		e.value, e.init = x, true
+		return e.value
+	}
+	e.value += e.alpha * (x - e.value)
+	return e.value
+}
+
+// Value 返回当前平均值，无样本时返回 0
+func (e *EMA) Value() float64 {
+	return e.value
+}
+
+// Reset 清空状态
+func (e *EMA) Reset() {
+	e.value, e.init = 0, false
+}
+
+// SMA 简单移动平均，计算最近 size 个样本的均值
+//
+// 使用环形缓冲区，Add 为 O(1)。非并发安全，并发使用时需由调用方加锁
+//
+// 示例:
+//
+//	sma := mathx.NewSMA(10)
+//	sma.Add(qps)
+//	sma.Value()  // 最近 10 个样本的均值
+type SMA struct {
+	window []float64
+	pos    int
+	count  int
+	sum    float64
+}
+
+// NewSMA 创建窗口大小为 size 的简单移动平均，size <= 0 时按 1 处理
+func NewSMA(size int) *SMA {
+	return &SMA{window: make([]float64, max(size, 1))}
+}
+
+// Add 添加样本并返回更新后的平均值
+func (s *SMA) Add(x float64) float64 {
+	if s.count < len(s.window) {
+		s.count++
+	} else {
+		s.sum -= s.window[s.pos]
+	}
+	s.window[s.pos] = x
+	s.sum += x
+	s.pos = (s.pos + 1) % len(s.window)
+
+	// 每轮重新求和，避免增减累积的浮点误差
+	if s.pos == 0 {
+		s.sum = 0
+		for _, v := range s.window[:s.count] {
+			s.sum += v
+		}
+	}
+	return s.Value()
+}
+
+// Value 返回窗口内样本的均值，无样本时返回 0
+func (s *SMA) Value() float64 {
+	if s.count == 0 {
+		return 0
+	}
+	return s.sum / float64(s.count)
+}
+
+// Count 返回窗口内的样本数量
+func (s *SMA) Count() int {
+	return s.count
+}
+
+// Reset 清空状态
+func (s *SMA) Reset() {
+	clear(s.window)
+	s.pos, s.count, s.sum = 0, 0, 0
+}
+
+// RateCounter 滑动窗口速率计算器
+//
+// 将时间窗口划分为若干个桶，只保存每个桶的累计值，按窗口内总和计算每秒速率。
+// 并发安全
+//
+// 示例:
+//
+//	rc := mathx.NewRateCounter(time.Minute, 60)
+//	rc.Incr()
+//	rc.Rate()  // 最近一分钟的每秒速率
+type RateCounter struct {
+	mu       sync.Mutex
+	buckets  []rateBucket
+	interval time.Duration // 每个桶的时长
+	now      func() time.Time
+}
+
+type rateBucket struct {
+	index int64 // 桶对应的时间序号，用于判断是否过期
+	value float64
+}
+
+// NewRateCounter 创建窗口为 window、划分为 buckets 个桶的速率计算器
+//
+// 桶越多精度越高，buckets <= 0 时按 1 处理；window 不足 buckets 纳秒时按每桶 1 纳秒处理
+func NewRateCounter(window time.Duration, buckets int) *RateCounter {
+	buckets = max(buckets, 1)
+	return &RateCounter{
+		buckets:  make([]rateBucket, buckets),
+		interval: max(window/time.Duration(buckets), 1),
+		now:      time.Now,
+	}
+}
+
+// Add 累加 n
+func (rc *RateCounter) Add(n float64) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	idx := rc.now().UnixNano() / int64(rc.interval)
+	b := &rc.buckets[idx%int64(len(rc.buckets))]
+	if b.index != idx {
+		b.index, b.value = idx, 0
+	}
+	b.value += n
+}
+
+// Incr 累加 1
+func (rc *RateCounter) Incr() {
+	rc.Add(1)
+}
+
+// Sum 返回窗口内的累计值
+func (rc *RateCounter) Sum() float64 {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	idx := rc.now().UnixNano() / int64(rc.interval)
+	oldest := idx - int64(len(rc.buckets))
+	var sum float64
+	for _, b := range rc.buckets {
+		if b.index > oldest && b.index <= idx {
+			sum += b.value
+		}
+	}
+	return sum
+}
+
+// Rate 返回窗口内的每秒速率
+func (rc *RateCounter) Rate() float64 {
+	return rc.Sum() / rc.Window().Seconds()
+}
+
+// Window 返回窗口时长
+func (rc *RateCounter) Window() time.Duration {
+	return rc.interval * time.Duration(len(rc.buckets))
+}
+
+// Reset 清空所有桶
+func (rc *RateCounter) Reset() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	clear(rc.buckets)
+}
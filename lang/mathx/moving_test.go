@@ -0,0 +1,139 @@
+package mathx
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestEMA 测试指数移动平均
+func TestEMA(t *testing.T) {
+	ema := NewEMA(0.5)
+	if ema.Value() != 0 {
+		t.Error("empty EMA should be 0")
+	}
+	if got := ema.Add(10); got != 10 {
+		t.Errorf("first sample should initialize EMA, got %v", got)
+	}
+	if got := ema.Add(20); got != 15 {
+		t.Errorf("Add(20) = %v, want 15", got)
+	}
+	if got := ema.Add(15); got != 15 {
+		t.Errorf("Add(15) = %v, want 15", got)
+	}
+
+	ema.Reset()
+	if got := ema.Add(3); got != 3 {
+		t.Errorf("after Reset Add(3) = %v, want 3", got)
+	}
+
+	// alpha 超出范围时被限制
+	if got := NewEMA(5).alpha; got != 1 {
+		t.Errorf("alpha should be clamped to 1, got %v", got)
+	}
+	if got := NewEMA(-1).alpha; got <= 0 {
+		t.Errorf("alpha should be positive, got %v", got)
+	}
+	if got := NewEMAWithPeriod(9).alpha; !almostEqual(got, 0.2) {
+		t.Errorf("period 9 alpha = %v, want 0.2", got)
+	}
+}
+
+// TestSMA 测试简单移动平均
+func TestSMA(t *testing.T) {
+	sma := NewSMA(3)
+	if sma.Value() != 0 {
+		t.Error("empty SMA should be 0")
+	}
+
+	steps := []struct {
+		add, want float64
+	}{
+		{3, 3},
+		{6, 4.5},
+		{9, 6},
+		{12, 9}, // 3 被移出窗口
+		{0, 7},
+	}
+	for _, s := range steps {
+		if got := sma.Add(s.add); !almostEqual(got, s.want) {
+			t.Errorf("Add(%v) = %v, want %v", s.add, got, s.want)
+		}
+	}
+	if sma.Count() != 3 {
+		t.Errorf("Count = %d, want 3", sma.Count())
+	}
+
+	sma.Reset()
+	if sma.Count() != 0 || sma.Value() != 0 {
+		t.Error("Reset should clear state")
+	}
+	if got := NewSMA(0).Add(5); got != 5 {
+		t.Errorf("size 0 should behave as size 1, got %v", got)
+	}
+}
+
+// TestRateCounter 测试滑动窗口速率
+func TestRateCounter(t *testing.T) {
+	now := time.Unix(1000, 0)
+	rc := NewRateCounter(10*time.Second, 10)
+	rc.now = func() time.Time { return now }
+
+	for range 20 {
+		rc.Incr()
+	}
+	now = now.Add(3 * time.Second)
+	rc.Add(10)
+
+	if got := rc.Sum(); got != 30 {
+		t.Errorf("Sum = %v, want 30", got)
+	}
+	if got := rc.Rate(); !almostEqual(got, 3) {
+		t.Errorf("Rate = %v, want 3", got)
+	}
+	if rc.Window() != 10*time.Second {
+		t.Errorf("Window = %v", rc.Window())
+	}
+
+	// 第一批样本移出窗口
+	now = now.Add(8 * time.Second)
+	if got := rc.Sum(); got != 10 {
+		t.Errorf("Sum after slide = %v, want 10", got)
+	}
+
+	// 整个窗口过期后，复用的桶被重置
+	now = now.Add(time.Minute)
+	if got := rc.Sum(); got != 0 {
+		t.Errorf("Sum after expiry = %v, want 0", got)
+	}
+	rc.Incr()
+	if got := rc.Sum(); got != 1 {
+		t.Errorf("Sum = %v, want 1", got)
+	}
+
+	rc.Reset()
+	if rc.Sum() != 0 {
+		t.Error("Reset should clear buckets")
+	}
+}
+
+// TestRateCounterConcurrent 测试并发安全
+func TestRateCounterConcurrent(t *testing.T) {
+	rc := NewRateCounter(time.Minute, 6)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				rc.Incr()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := rc.Sum(); got != 1000 {
+		t.Errorf("Sum = %v, want 1000", got)
+	}
+}
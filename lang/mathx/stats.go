@@ -0,0 +1,120 @@
+package mathx
+
+import "math"
+
+// Welford 在线统计累加器
+//
+// 使用 Welford 算法流式更新计数、均值和方差，无需保存样本，数值稳定。
+// 零值可直接使用；非并发安全，并发使用时需由调用方加锁
+//
+// 示例:
+//
+//	var w mathx.Welford
+//	for _, latency := range samples {
+//	    w.Add(latency)
+//	}
+//	w.Mean()    // 均值
+//	w.StdDev()  // 总体标准差
+type Welford struct {
+	n        int64
+	mean     float64
+	m2       float64 // 与均值之差的平方和
+	min, max float64
+}
+
+// Add 添加一个样本
+func (w *Welford) Add(x float64) {
+	w.n++
+	if w.n == 1 {
+		w.mean, w.m2, w.min, w.max = x, 0, x, x
+		return
+	}
+
+	delta := x - w.mean
+	w.mean += delta / float64(w.n)
+	w.m2 += delta * (x - w.mean)
+	w.min = math.Min(w.min, x)
+	w.max = math.Max(w.max, x)
+}
+
+// AddAll 批量添加样本
+func (w *Welford) AddAll(values ...float64) {
+	for _, x := range values {
+		w.Add(x)
+	}
+}
+
+// Merge 合并另一个累加器的统计结果，用于分片统计后汇总
+func (w *Welford) Merge(other Welford) {
+	if other.n == 0 {
+		return
+	}
+	if w.n == 0 {
+		*w = other
+		return
+	}
+
+	n := w.n + other.n
+	delta := other.mean - w.mean
+	w.m2 += other.m2 + delta*delta*float64(w.n)*float64(other.n)/float64(n)
+	w.mean += delta * float64(other.n) / float64(n)
+	w.n = n
+	w.min = math.Min(w.min, other.min)
+	w.max = math.Max(w.max, other.max)
+}
+
+// Count 返回样本数量
+func (w *Welford) Count() int64 {
+	return w.n
+}
+
+// Mean 返回均值，无样本时返回 0
+func (w *Welford) Mean() float64 {
+	return w.mean
+}
+
+// Sum 返回样本总和
+func (w *Welford) Sum() float64 {
+	return w.mean * float64(w.n)
+}
+
+// Min 返回最小值，无样本时返回 0
+func (w *Welford) Min() float64 {
+	return w.min
+}
+
+// Max 返回最大值，无样本时返回 0
+func (w *Welford) Max() float64 {
+	return w.max
+}
+
+// Variance 返回总体方差（除以 n），无样本时返回 0
+func (w *Welford) Variance() float64 {
+	if w.n == 0 {
+		return 0
+	}
+	return w.m2 / float64(w.n)
+}
+
+// SampleVariance 返回样本方差（除以 n-1），样本数少于 2 时返回 0
+func (w *Welford) SampleVariance() float64 {
+	if w.n < 2 {
+		return 0
+	}
+	return w.m2 / float64(w.n-1)
+}
+
+// StdDev 返回总体标准差
+func (w *Welford) StdDev() float64 {
+	return math.Sqrt(w.Variance())
+}
+
+// SampleStdDev 返回样本标准差
+func (w *Welford) SampleStdDev() float64 {
+	return math.Sqrt(w.SampleVariance())
+}
+
+// Reset 清空统计结果
+func (w *Welford) Reset() {
+	*w = Welford{}
+}
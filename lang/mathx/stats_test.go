@@ -0,0 +1,80 @@
+package mathx
+
+import (
+	"math"
+	"testing"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+// TestWelford 测试在线统计累加器
+func TestWelford(t *testing.T) {
+	var w Welford
+	if w.Count() != 0 || w.Mean() != 0 || w.Variance() != 0 || w.SampleVariance() != 0 {
+		t.Error("zero value should report zeros")
+	}
+
+	w.AddAll(2, 4, 4, 4, 5, 5, 7, 9)
+	if w.Count() != 8 {
+		t.Errorf("Count = %d, want 8", w.Count())
+	}
+	if !almostEqual(w.Mean(), 5) {
+		t.Errorf("Mean = %v, want 5", w.Mean())
+	}
+	if !almostEqual(w.Sum(), 40) {
+		t.Errorf("Sum = %v, want 40", w.Sum())
+	}
+	if !almostEqual(w.Variance(), 4) || !almostEqual(w.StdDev(), 2) {
+		t.Errorf("Variance = %v, StdDev = %v, want 4, 2", w.Variance(), w.StdDev())
+	}
+	if !almostEqual(w.SampleVariance(), 32.0/7) || !almostEqual(w.SampleStdDev(), math.Sqrt(32.0/7)) {
+		t.Errorf("SampleVariance = %v, want %v", w.SampleVariance(), 32.0/7)
+	}
+	if w.Min() != 2 || w.Max() != 9 {
+		t.Errorf("Min/Max = %v/%v, want 2/9", w.Min(), w.Max())
+	}
+
+	w.Reset()
+	if w.Count() != 0 || w.Mean() != 0 {
+		t.Error("Reset should clear state")
+	}
+}
+
+// TestWelfordMerge 测试分片合并
+func TestWelfordMerge(t *testing.T) {
+	values := []float64{1.5, 2.5, 10, -3, 7, 7, 0.25, 12}
+
+	var all, a, b Welford
+	all.AddAll(values...)
+	a.AddAll(values[:3]...)
+	b.AddAll(values[3:]...)
+	a.Merge(b)
+
+	if a.Count() != all.Count() || !almostEqual(a.Mean(), all.Mean()) ||
+		!almostEqual(a.Variance(), all.Variance()) || a.Min() != all.Min() || a.Max() != all.Max() {
+		t.Errorf("merged = %+v, want %+v", a, all)
+	}
+
+	var empty Welford
+	empty.Merge(all)
+	if empty != all {
+		t.Error("merging into empty should copy")
+	}
+	all.Merge(Welford{})
+	if all.Count() != int64(len(values)) {
+		t.Error("merging empty should be a no-op")
+	}
+}
+
+// TestWelfordStability 测试大偏移量下的数值稳定性
+func TestWelfordStability(t *testing.T) {
+	var w Welford
+	for _, x := range []float64{4, 7, 13, 16} {
+		w.Add(1e9 + x)
+	}
+	if !almostEqual(w.SampleVariance(), 30) {
+		t.Errorf("SampleVariance = %v, want 30", w.SampleVariance())
+	}
+}
@@ -0,0 +1,126 @@
+package optional
+
+// CollectSome 收集切片中所有 Some 的值，丢弃 None
+//
+// CatOptions 是它的别名（Haskell 风格命名）
+//
+// 参数:
+//   - opts: Option 切片
+//
+// 返回:
+//   - []T: 所有 Some 的值，保持原有顺序（全部为 None 时返回空切片）
+//
+// 示例:
+//
+//	opts := []optional.Option[int]{optional.Some(1), optional.None[int](), optional.Some(3)}
+//	optional.CollectSome(opts)  // [1, 3]
+func CollectSome[T any](opts []Option[T]) []T {
+	result := make([]T, 0, len(opts))
+	for _, o := range opts {
+		if o.present {
+			result = append(result, o.value)
+		}
+	}
+	return result
+}
+
+// CatOptions 是 CollectSome 的别名，语义完全相同，详见 CollectSome
+func CatOptions[T any](opts []Option[T]) []T {
+	return CollectSome(opts)
+}
+
+// MapMaybe 对每个元素调用 fn，只保留返回 Some 的结果（映射与过滤合为一步）
+//
+// 参数:
+//   - slice: 输入切片
+//   - fn: 转换函数，返回 None 表示丢弃该元素
+//
+// 返回:
+//   - []U: 所有 Some 的结果，保持原有顺序
+//
+// 示例:
+//
+//	ports := optional.MapMaybe([]string{"80", "x", "443"}, func(s string) optional.Option[int] {
+//	    return optional.Try(strconv.Atoi(s)).Option()
+//	})
+//	// [80, 443]
+func MapMaybe[T, U any](slice []T, fn func(T) Option[U]) []U {
+	result := make([]U, 0, len(slice))
+	for _, v := range slice {
+		if o := fn(v); o.present {
+			result = append(result, o.value)
+		}
+	}
+	return result
+}
+
+// Sequence 全部为 Some 时返回包含所有值的 Some，任一为 None 时返回 None
+//
+// 参数:
+//   - opts: Option 切片
+//
+// 返回:
+//   - Option[[]T]: 全部存在时为 Some(values)，否则为 None（空切片返回 Some([])）
+//
+// 示例:
+//
+//	optional.Sequence([]optional.Option[int]{optional.Some(1), optional.Some(2)})  // Some([1, 2])
+//	optional.Sequence([]optional.Option[int]{optional.Some(1), optional.None[int]()})  // None
+func Sequence[T any](opts []Option[T]) Option[[]T] {
+	result := make([]T, 0, len(opts))
+	for _, o := range opts {
+		if !o.present {
+			return None[[]T]()
+		}
+		result = append(result, o.value)
+	}
+	return Some(result)
+}
+
+// Traverse 对每个元素调用 fn，全部返回 Some 时返回包含所有结果的 Some，否则返回 None
+//
+// 遇到第一个 None 时立即停止，不再调用后续元素
+//
+// 参数:
+//   - slice: 输入切片
+//   - fn: 转换函数
+//
+// 返回:
+//   - Option[[]U]: 全部成功时为 Some(results)，否则为 None
+//
+// 示例:
+//
+//	lookup := func(id int) optional.Option[User] { ... }
+//	users := optional.Traverse([]int{1, 2, 3}, lookup)  // 任一用户不存在则为 None
+func Traverse[T, U any](slice []T, fn func(T) Option[U]) Option[[]U] {
+	result := make([]U, 0, len(slice))
+	for _, v := range slice {
+		o := fn(v)
+		if !o.present {
+			return None[[]U]()
+		}
+		result = append(result, o.value)
+	}
+	return Some(result)
+}
+
+// FirstSome 返回第一个 Some，全部为 None 时返回 None
+//
+// 参数:
+//   - opts: 按优先级排列的 Option
+//
+// 返回:
+//   - Option[T]: 第一个 Some
+//
+// 示例:
+//
+//	// 依次从参数、环境变量、配置文件中取值
+//	port := optional.FirstSome(flagPort, envPort, filePort).UnwrapOr(8080)
+func FirstSome[T any](opts ...Option[T]) Option[T] {
+	for _, o := range opts {
+		if o.present {
+			return o
+		}
+	}
+	return None[T]()
+}
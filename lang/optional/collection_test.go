@@ -0,0 +1,84 @@
+package optional
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestCollectSome(t *testing.T) {
+	opts := []Option[int]{Some(1), None[int](), Some(3), None[int]()}
+	if got := CollectSome(opts); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("expected [1 3], got %v", got)
+	}
+	if got := CollectSome([]Option[int]{None[int]()}); got == nil || len(got) != 0 {
+		t.Errorf("expected empty non-nil slice, got %#v", got)
+	}
+	// CatOptions 是别名
+	if got := CatOptions(opts); !slices.Equal(got, CollectSome(opts)) {
+		t.Errorf("CatOptions should match CollectSome, got %v", got)
+	}
+}
+
+func TestMapMaybe(t *testing.T) {
+	got := MapMaybe([]string{"80", "x", "443"}, func(s string) Option[int] {
+		return Try(strconv.Atoi(s)).Option()
+	})
+	if !slices.Equal(got, []int{80, 443}) {
+		t.Errorf("expected [80 443], got %v", got)
+	}
+	if got := MapMaybe(nil, func(int) Option[int] { return None[int]() }); len(got) != 0 {
+		t.Errorf("expected empty result, got %v", got)
+	}
+}
+
+func TestSequence(t *testing.T) {
+	all := Sequence([]Option[string]{Some("a"), Some("b")})
+	if !all.IsSome() || !slices.Equal(all.Unwrap(), []string{"a", "b"}) {
+		t.Errorf("expected Some([a b]), got %v", all.Unwrap())
+	}
+	if Sequence([]Option[string]{Some("a"), None[string]()}).IsSome() {
+		t.Error("Sequence should return None if any element is None")
+	}
+	if empty := Sequence[int](nil); !empty.IsSome() || len(empty.Unwrap()) != 0 {
+		t.Error("Sequence of empty slice should be Some([])")
+	}
+}
+
+func TestTraverse(t *testing.T) {
+	half := func(n int) Option[int] {
+		if n%2 != 0 {
+			return None[int]()
+		}
+		return Some(n / 2)
+	}
+
+	got := Traverse([]int{2, 4, 6}, half)
+	if !got.IsSome() || !slices.Equal(got.Unwrap(), []int{1, 2, 3}) {
+		t.Errorf("expected Some([1 2 3]), got %v", got.Unwrap())
+	}
+
+	calls := 0
+	result := Traverse([]int{2, 3, 4}, func(n int) Option[int] {
+		calls++
+		return half(n)
+	})
+	if result.IsSome() {
+		t.Error("Traverse should return None if fn returns None")
+	}
+	if calls != 2 {
+		t.Errorf("Traverse should stop at first None, got %d calls", calls)
+	}
+}
+
+func TestFirstSome(t *testing.T) {
+	if got := FirstSome(None[int](), Some(2), Some(3)); got != Some(2) {
+		t.Errorf("expected Some(2), got %v", got.Unwrap())
+	}
+	if FirstSome(None[int](), None[int]()).IsSome() {
+		t.Error("expected None when all are None")
+	}
+	if FirstSome[int]().IsSome() {
+		t.Error("expected None with no arguments")
+	}
+}
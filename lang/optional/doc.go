@@ -5,6 +5,7 @@
 //
 // 主要类型:
 //   - Option[T]: 可能包含值的容器
+//   - Result[T]: 成功值或错误，可与 (T, error) 互相转换
 //
 // 主要函数:
 //   - Some: 创建包含值的 Option
@@ -12,6 +13,15 @@
 //   - FromPtr: 从指针创建 Option
 //   - Map: 转换 Option 中的值
 //   - FlatMap: 链式转换 Option
+//   - Match/IfSome/IfNone: 模式匹配风格的分支处理
+//   - Zip/Zip3/Map2/Map3: 组合多个 Option，全部为 Some 时才计算
+//   - Ok/Err/Try: 创建 Result，Try 接收 (T, error)
+//   - MapResult/AndThen/TryMap: 链式处理 Result，失败时短路
+//   - CollectSome（别名 CatOptions）/MapMaybe/Sequence/Traverse/FirstSome: 处理 []Option[T]
+//
+// 序列化:
+//   - json.Marshaler/Unmarshaler: None 与 null 互相转换，配合 omitzero 可省略 None 字段
+//   - sql.Scanner/driver.Valuer: None 与数据库 NULL 互相转换
 //
 // 示例:
 //
@@ -42,6 +52,7 @@
 //
 // Main types:
 //   - Option[T]: a container that may or may not hold a value
+//   - Result[T]: a success value or an error, convertible to and from (T, error)
 //
 // Main functions:
 //   - Some: create an Option containing a value
@@ -49,6 +60,15 @@
 //   - FromPtr: create an Option from a pointer
 //   - Map: transform the value inside an Option
 //   - FlatMap: chain Option transformations
+//   - Match/IfSome/IfNone: pattern-matching style branching
+//   - Zip/Zip3/Map2/Map3: combine several Options, computed only when all are Some
+//   - Ok/Err/Try: create a Result; Try takes a (T, error) pair
+//   - MapResult/AndThen/TryMap: chain Result operations, short-circuiting on error
+//   - CollectSome (alias CatOptions)/MapMaybe/Sequence/Traverse/FirstSome: work with []Option[T]
+//
+// Serialization:
+//   - json.Marshaler/Unmarshaler: None maps to and from null; use omitzero to omit None fields
+//   - sql.Scanner/driver.Valuer: None maps to and from database NULL
 //
 // Example:
 //
@@ -0,0 +1,85 @@
+package optional
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// 编译期检查接口实现
+var (
+	_ json.Marshaler   = Option[int]{}
+	_ json.Unmarshaler = (*Option[int])(nil)
+	_ sql.Scanner      = (*Option[int])(nil)
+	_ driver.Valuer    = Option[int]{}
+)
+
+// jsonNull JSON 中的 null
+var jsonNull = []byte("null")
+
+// IsZero 检查 Option 是否为 None
+//
+// 配合 encoding/json 的 omitzero 标签使用，None 字段在序列化时被省略
+//
+// 示例:
+//
+//	type User struct {
+//	    Nickname optional.Option[string] `json:"nickname,omitzero"`
+//	}
+func (o Option[T]) IsZero() bool {
+	return !o.present
+}
+
+// MarshalJSON 实现 json.Marshaler，None 序列化为 null，Some 序列化为内部值
+//
+// 示例:
+//
+//	json.Marshal(optional.Some(42))       // 42
+//	json.Marshal(optional.None[int]())    // null
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.present {
+		return jsonNull, nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler，null 反序列化为 None，其他值反序列化为 Some
+//
+// 注意: 字段在 JSON 中缺失时不会调用此方法，字段保持原值（零值即 None）
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*o = None[T]()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = Some(value)
+	return nil
+}
+
+// Scan 实现 sql.Scanner，数据库 NULL 扫描为 None，其他值扫描为 Some
+//
+// 值的转换规则与 database/sql 的 Rows.Scan 一致，*T 实现 sql.Scanner 时使用其 Scan
+//
+// 示例:
+//
+//	var email optional.Option[string]
+//	row.Scan(&email)
+func (o *Option[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	*o = FromValue(n.V, n.Valid)
+	return nil
+}
+
+// Value 实现 driver.Valuer，None 写入为数据库 NULL
+//
+// T 实现 driver.Valuer 时使用其 Value，否则使用默认的参数转换规则
+func (o Option[T]) Value() (driver.Value, error) {
+	return sql.Null[T]{V: o.value, Valid: o.present}.Value()
+}
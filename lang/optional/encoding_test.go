@@ -0,0 +1,109 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type profile struct {
+	Name     string           `json:"name"`
+	Age      Option[int]      `json:"age"`
+	Nickname Option[string]   `json:"nickname,omitzero"`
+	Tags     Option[[]string] `json:"tags"`
+}
+
+func TestJSON_Marshal(t *testing.T) {
+	p := profile{
+		Name: "Alice",
+		Age:  Some(30),
+		Tags: None[[]string](),
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	expected := `{"name":"Alice","age":30,"tags":null}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+}
+
+func TestJSON_Unmarshal(t *testing.T) {
+	var p profile
+	err := json.Unmarshal([]byte(`{"name":"Bob","age":null,"nickname":"b","tags":["x"]}`), &p)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if p.Age.IsSome() {
+		t.Error("null should unmarshal to None")
+	}
+	if p.Nickname.UnwrapOr("") != "b" {
+		t.Errorf("expected Some(b), got %v", p.Nickname)
+	}
+	if tags := p.Tags.UnwrapOrZero(); len(tags) != 1 || tags[0] != "x" {
+		t.Errorf("unexpected tags: %v", tags)
+	}
+
+	var opt Option[int]
+	if err := json.Unmarshal([]byte(`"oops"`), &opt); err == nil {
+		t.Error("expected error for mismatched type")
+	}
+}
+
+func TestJSON_RoundTrip(t *testing.T) {
+	for _, opt := range []Option[string]{Some("hi"), Some(""), None[string]()} {
+		data, err := json.Marshal(opt)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		var got Option[string]
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if got != opt {
+			t.Errorf("round trip mismatch: %v -> %s -> %v", opt, data, got)
+		}
+	}
+}
+
+func TestSQL_Scan(t *testing.T) {
+	var s Option[string]
+	if err := s.Scan(nil); err != nil || s.IsSome() {
+		t.Errorf("NULL should scan to None, got %v, %v", s, err)
+	}
+	if err := s.Scan([]byte("abc")); err != nil || s.UnwrapOr("") != "abc" {
+		t.Errorf("expected Some(abc), got %v, %v", s, err)
+	}
+
+	var n Option[int]
+	if err := n.Scan(int64(7)); err != nil || n.UnwrapOr(0) != 7 {
+		t.Errorf("expected Some(7), got %v, %v", n, err)
+	}
+	if err := n.Scan("not a number"); err == nil {
+		t.Error("expected conversion error")
+	}
+
+	var ts Option[time.Time]
+	now := time.Now()
+	if err := ts.Scan(now); err != nil || !ts.UnwrapOrZero().Equal(now) {
+		t.Errorf("expected Some(now), got %v, %v", ts, err)
+	}
+}
+
+func TestSQL_Value(t *testing.T) {
+	v, err := None[string]().Value()
+	if err != nil || v != nil {
+		t.Errorf("None should be NULL, got %v, %v", v, err)
+	}
+
+	v, err = Some(42).Value()
+	if err != nil || v != int64(42) {
+		t.Errorf("expected int64(42), got %#v, %v", v, err)
+	}
+
+	v, err = Some("x").Value()
+	if err != nil || v != "x" {
+		t.Errorf("expected x, got %#v, %v", v, err)
+	}
+}
@@ -1,6 +1,10 @@
 package optional
 
-import "reflect"
+import (
+	"reflect"
+
+	"github.com/hexagon-codes/toolkit/lang/tuple"
+)
 
 // Option 表示一个可能存在也可能不存在的值
 type Option[T any] struct {
@@ -282,6 +286,70 @@ func (o Option[T]) OrElse(fn func() Option[T]) Option[T] {
 	return fn()
 }
 
+// OrElseGet 获取值，如果为 None 则调用函数获取默认值（同 UnwrapOrElse）
+//
+// 默认值只在需要时计算，适合代价较高的默认值
+//
+// 示例:
+//
+//	cfg := cached.OrElseGet(loadConfigFromDisk)
+func (o Option[T]) OrElseGet(fn func() T) T {
+	return o.UnwrapOrElse(fn)
+}
+
+// IfSome 如果为 Some，则以值调用 fn
+//
+// 返回:
+//   - Option[T]: 原 Option，便于链式调用
+//
+// 示例:
+//
+//	user.IfSome(func(u User) {
+//	    log.Printf("found user %s", u.Name)
+//	}).IfNone(func() {
+//	    log.Print("user not found")
+//	})
+func (o Option[T]) IfSome(fn func(T)) Option[T] {
+	if o.present {
+		fn(o.value)
+	}
+	return o
+}
+
+// IfNone 如果为 None，则调用 fn
+//
+// 返回:
+//   - Option[T]: 原 Option，便于链式调用
+func (o Option[T]) IfNone(fn func()) Option[T] {
+	if !o.present {
+		fn()
+	}
+	return o
+}
+
+// Match 根据是否存在值调用对应的函数并返回其结果
+//
+// 参数:
+//   - o: Option
+//   - onSome: Some 时以值调用
+//   - onNone: None 时调用
+//
+// 返回:
+//   - U: 被调用函数的返回值
+//
+// 示例:
+//
+//	greeting := optional.Match(name,
+//	    func(n string) string { return "Hello, " + n },
+//	    func() string { return "Hello, guest" },
+//	)
+func Match[T, U any](o Option[T], onSome func(T) U, onNone func() U) U {
+	if o.present {
+		return onSome(o.value)
+	}
+	return onNone()
+}
+
 // And 如果当前 Option 为 Some，则返回另一个 Option
 //
 // 参数:
@@ -398,23 +466,55 @@ func Flatten[T any](o Option[Option[T]]) Option[T] {
 //   - o2: 第二个 Option
 //
 // 返回:
-//   - Option[[2]any]: 如果两个都是 Some，返回包含两个值的 Option
+//   - Option[tuple.Tuple2[T, U]]: 如果两个都是 Some，返回包含两个值的二元组
+//
+// 示例:
 //
-// 注意: 由于 Go 泛型限制，返回数组类型。可以用 ZipWith 获得更好的类型
-func Zip[T, U any](o1 Option[T], o2 Option[U]) Option[struct {
-	First  T
-	Second U
-}] {
+//	pair := optional.Zip(host, port)  // Some(Tuple2{"localhost", 8080})
+//	if pair.IsSome() {
+//	    h, p := pair.Unwrap().Unpack()
+//	}
+func Zip[T, U any](o1 Option[T], o2 Option[U]) Option[tuple.Tuple2[T, U]] {
 	if o1.present && o2.present {
-		return Some(struct {
-			First  T
-			Second U
-		}{o1.value, o2.value})
+		return Some(tuple.T2(o1.value, o2.value))
 	}
-	return None[struct {
-		First  T
-		Second U
-	}]()
+	return None[tuple.Tuple2[T, U]]()
+}
+
+// Zip3 将三个 Option 组合成三元组 Option
+//
+// 返回:
+//   - Option[tuple.Tuple3[A, B, C]]: 如果三个都是 Some，返回包含三个值的三元组
+func Zip3[A, B, C any](o1 Option[A], o2 Option[B], o3 Option[C]) Option[tuple.Tuple3[A, B, C]] {
+	if o1.present && o2.present && o3.present {
+		return Some(tuple.T3(o1.value, o2.value, o3.value))
+	}
+	return None[tuple.Tuple3[A, B, C]]()
+}
+
+// Map2 两个 Option 都为 Some 时，以两个值调用 fn（同 ZipWith）
+//
+// 示例:
+//
+//	addr := optional.Map2(host, port, func(h string, p int) string {
+//	    return net.JoinHostPort(h, strconv.Itoa(p))
+//	})
+func Map2[A, B, R any](o1 Option[A], o2 Option[B], fn func(A, B) R) Option[R] {
+	return ZipWith(o1, o2, fn)
+}
+
+// Map3 三个 Option 都为 Some 时，以三个值调用 fn，否则返回 None
+//
+// 示例:
+//
+//	dsn := optional.Map3(user, host, db, func(u, h, d string) string {
+//	    return fmt.Sprintf("%s@tcp(%s)/%s", u, h, d)
+//	})
+func Map3[A, B, C, R any](o1 Option[A], o2 Option[B], o3 Option[C], fn func(A, B, C) R) Option[R] {
+	if o1.present && o2.present && o3.present {
+		return Some(fn(o1.value, o2.value, o3.value))
+	}
+	return None[R]()
 }
 
 // ZipWith 使用函数组合两个 Option
@@ -344,3 +344,70 @@ func TestUnwrapOrZero(t *testing.T) {
 		t.Error("UnwrapOrZero should return zero value for None")
 	}
 }
+
+func TestOrElseGet(t *testing.T) {
+	if Some(1).OrElseGet(func() int { t.Error("should not be called"); return 0 }) != 1 {
+		t.Error("OrElseGet should return value for Some")
+	}
+	if None[int]().OrElseGet(func() int { return 5 }) != 5 {
+		t.Error("OrElseGet should call fn for None")
+	}
+}
+
+func TestIfSomeIfNone(t *testing.T) {
+	var got []string
+	Some("a").
+		IfSome(func(v string) { got = append(got, "some:"+v) }).
+		IfNone(func() { got = append(got, "none") })
+	None[string]().
+		IfSome(func(v string) { got = append(got, "some:"+v) }).
+		IfNone(func() { got = append(got, "none") })
+
+	if len(got) != 2 || got[0] != "some:a" || got[1] != "none" {
+		t.Errorf("unexpected calls: %v", got)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	onSome := func(n int) string { return "some" }
+	onNone := func() string { return "none" }
+
+	if Match(Some(1), onSome, onNone) != "some" {
+		t.Error("Match should call onSome for Some")
+	}
+	if Match(None[int](), onSome, onNone) != "none" {
+		t.Error("Match should call onNone for None")
+	}
+}
+
+func TestZip3(t *testing.T) {
+	z := Zip3(Some(1), Some("a"), Some(true))
+	if !z.IsSome() {
+		t.Fatal("Zip3 should return Some when all are Some")
+	}
+	a, b, c := z.Unwrap().Unpack()
+	if a != 1 || b != "a" || !c {
+		t.Errorf("unexpected values: %v %v %v", a, b, c)
+	}
+	if Zip3(Some(1), None[string](), Some(true)).IsSome() {
+		t.Error("Zip3 should return None when any is None")
+	}
+}
+
+func TestMap2Map3(t *testing.T) {
+	sum2 := func(a, b int) int { return a + b }
+	if got := Map2(Some(1), Some(2), sum2); got.UnwrapOr(0) != 3 {
+		t.Errorf("expected Some(3), got %v", got.Unwrap())
+	}
+	if Map2(None[int](), Some(2), sum2).IsSome() {
+		t.Error("Map2 should return None when any is None")
+	}
+
+	sum3 := func(a, b, c int) int { return a + b + c }
+	if got := Map3(Some(1), Some(2), Some(3), sum3); got.UnwrapOr(0) != 6 {
+		t.Errorf("expected Some(6), got %v", got.Unwrap())
+	}
+	if Map3(Some(1), Some(2), None[int](), sum3).IsSome() {
+		t.Error("Map3 should return None when any is None")
+	}
+}
@@ -0,0 +1,192 @@
+package optional
+
+// Result 表示可能失败的操作结果，包含值（Ok）或错误（Err）
+//
+// 与 Option 配套使用：Option 表示值可能缺失，Result 表示操作可能失败并携带原因。
+// 适合在回调链中传递 (value, error)，避免层层 if err != nil
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok 创建成功的 Result
+//
+// 示例:
+//
+//	r := optional.Ok(42)
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+// Err 创建失败的 Result
+//
+// 参数:
+//   - err: 失败原因，为 nil 时得到值为零值的 Ok
+//
+// 示例:
+//
+//	r := optional.Err[int](errors.New("not found"))
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// Try 从 (T, error) 创建 Result，err 非 nil 时为 Err
+//
+// 示例:
+//
+//	r := optional.Try(strconv.Atoi("42"))  // Ok(42)
+//	r := optional.Try(os.ReadFile(path))
+func Try[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(value)
+}
+
+// IsOk 检查是否成功
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr 检查是否失败
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Get 转换回 (T, error)，失败时值为零值
+//
+// 示例:
+//
+//	value, err := r.Get()
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// Err 返回错误，成功时为 nil
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Unwrap 获取值
+//
+// 返回:
+//   - T: 成功时的值（失败时返回零值）
+//
+// 注意: 与 Option.Unwrap 一致，失败时不会 panic。需要 panic 时使用 Expect
+func (r Result[T]) Unwrap() T {
+	return r.value
+}
+
+// UnwrapOr 获取值，失败时返回默认值
+//
+// 示例:
+//
+//	port := optional.Try(strconv.Atoi(s)).UnwrapOr(8080)
+func (r Result[T]) UnwrapOr(defaultVal T) T {
+	if r.err != nil {
+		return defaultVal
+	}
+	return r.value
+}
+
+// UnwrapOrElse 获取值，失败时根据错误计算默认值
+func (r Result[T]) UnwrapOrElse(fn func(error) T) T {
+	if r.err != nil {
+		return fn(r.err)
+	}
+	return r.value
+}
+
+// Expect 获取值，失败时 panic
+//
+// 参数:
+//   - msg: panic 时的错误消息，后面附加原始错误
+func (r Result[T]) Expect(msg string) T {
+	if r.err != nil {
+		panic(msg + ": " + r.err.Error())
+	}
+	return r.value
+}
+
+// Option 转换为 Option，失败时为 None（丢弃错误）
+func (r Result[T]) Option() Option[T] {
+	return FromValue(r.value, r.err == nil)
+}
+
+// OrElse 失败时调用 fn 进行恢复，成功时返回自身
+//
+// 示例:
+//
+//	r := loadFromCache(key).OrElse(func(error) optional.Result[User] {
+//	    return optional.Try(loadFromDB(key))
+//	})
+func (r Result[T]) OrElse(fn func(error) Result[T]) Result[T] {
+	if r.err != nil {
+		return fn(r.err)
+	}
+	return r
+}
+
+// MapErr 失败时转换错误（如添加上下文），成功时返回自身
+//
+// 示例:
+//
+//	r = r.MapErr(func(err error) error {
+//	    return fmt.Errorf("load user: %w", err)
+//	})
+func (r Result[T]) MapErr(fn func(error) error) Result[T] {
+	if r.err != nil {
+		return Err[T](fn(r.err))
+	}
+	return r
+}
+
+// OkOr 将 Option 转换为 Result，None 时使用 err
+//
+// 示例:
+//
+//	r := optional.OkOr(cache.Get(key), ErrNotFound)
+func OkOr[T any](o Option[T], err error) Result[T] {
+	if o.present {
+		return Ok(o.value)
+	}
+	return Err[T](err)
+}
+
+// MapResult 转换成功的值，失败时传递错误
+//
+// 示例:
+//
+//	name := optional.MapResult(userResult, func(u User) string { return u.Name })
+func MapResult[T, U any](r Result[T], fn func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(fn(r.value))
+}
+
+// AndThen 链式执行可能失败的操作，失败时短路
+//
+// 示例:
+//
+//	order := optional.AndThen(userResult, func(u User) optional.Result[Order] {
+//	    return optional.Try(repo.LatestOrder(u.ID))
+//	})
+func AndThen[T, U any](r Result[T], fn func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return fn(r.value)
+}
+
+// TryMap 链式执行返回 (U, error) 的函数，失败时短路
+//
+// 示例:
+//
+//	n := optional.TryMap(optional.Ok("42"), strconv.Atoi)  // Ok(42)
+func TryMap[T, U any](r Result[T], fn func(T) (U, error)) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Try(fn(r.value))
+}
@@ -0,0 +1,128 @@
+package optional
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+var errTest = errors.New("test error")
+
+func TestResult_OkErr(t *testing.T) {
+	ok := Ok(42)
+	if !ok.IsOk() || ok.IsErr() || ok.Unwrap() != 42 || ok.Err() != nil {
+		t.Error("unexpected Ok state")
+	}
+
+	e := Err[int](errTest)
+	if e.IsOk() || !e.IsErr() || e.Unwrap() != 0 || !errors.Is(e.Err(), errTest) {
+		t.Error("unexpected Err state")
+	}
+}
+
+func TestTry(t *testing.T) {
+	if r := Try(strconv.Atoi("42")); !r.IsOk() || r.Unwrap() != 42 {
+		t.Errorf("expected Ok(42), got %v", r.Err())
+	}
+	if r := Try(strconv.Atoi("x")); !r.IsErr() {
+		t.Error("expected Err")
+	}
+
+	v, err := Try(7, nil).Get()
+	if v != 7 || err != nil {
+		t.Errorf("Get returned %d, %v", v, err)
+	}
+	v, err = Try(7, errTest).Get()
+	if v != 0 || !errors.Is(err, errTest) {
+		t.Errorf("Get should return zero value on error, got %d, %v", v, err)
+	}
+}
+
+func TestResult_Unwrap(t *testing.T) {
+	e := Err[int](errTest)
+	if e.UnwrapOr(5) != 5 || Ok(1).UnwrapOr(5) != 1 {
+		t.Error("UnwrapOr failed")
+	}
+	if e.UnwrapOrElse(func(err error) int { return len(err.Error()) }) != len("test error") {
+		t.Error("UnwrapOrElse should receive the error")
+	}
+
+	if Ok(1).Expect("must") != 1 {
+		t.Error("Expect should return value for Ok")
+	}
+	defer func() {
+		if r := recover(); r != "must: test error" {
+			t.Errorf("unexpected panic: %v", r)
+		}
+	}()
+	e.Expect("must")
+}
+
+func TestResult_OrElseMapErr(t *testing.T) {
+	recovered := Err[int](errTest).OrElse(func(error) Result[int] { return Ok(1) })
+	if recovered.Unwrap() != 1 {
+		t.Error("OrElse should recover from Err")
+	}
+	if Ok(2).OrElse(func(error) Result[int] { return Ok(1) }).Unwrap() != 2 {
+		t.Error("OrElse should not be called for Ok")
+	}
+
+	wrapped := Err[int](errTest).MapErr(func(err error) error {
+		return fmt.Errorf("load: %w", err)
+	})
+	if wrapped.Err().Error() != "load: test error" || !errors.Is(wrapped.Err(), errTest) {
+		t.Errorf("unexpected error: %v", wrapped.Err())
+	}
+}
+
+func TestResult_OptionConversion(t *testing.T) {
+	if Ok(1).Option() != Some(1) || Err[int](errTest).Option() != None[int]() {
+		t.Error("Option conversion failed")
+	}
+	if OkOr(Some(1), errTest).Unwrap() != 1 {
+		t.Error("OkOr should return Ok for Some")
+	}
+	if !errors.Is(OkOr(None[int](), errTest).Err(), errTest) {
+		t.Error("OkOr should return Err for None")
+	}
+}
+
+func TestResult_Combinators(t *testing.T) {
+	length := MapResult(Ok("hello"), func(s string) int { return len(s) })
+	if length.Unwrap() != 5 {
+		t.Error("MapResult failed")
+	}
+
+	half := func(n int) Result[int] {
+		if n%2 != 0 {
+			return Err[int](errTest)
+		}
+		return Ok(n / 2)
+	}
+	if AndThen(Ok(8), half).Unwrap() != 4 {
+		t.Error("AndThen failed")
+	}
+	if !AndThen(Ok(3), half).IsErr() {
+		t.Error("AndThen should propagate Err")
+	}
+
+	called := false
+	r := AndThen(Err[int](errTest), func(n int) Result[int] {
+		called = true
+		return Ok(n)
+	})
+	if called || !errors.Is(r.Err(), errTest) {
+		t.Error("AndThen should short-circuit on Err")
+	}
+
+	if TryMap(Ok("42"), strconv.Atoi).Unwrap() != 42 {
+		t.Error("TryMap failed")
+	}
+	if !TryMap(Ok("x"), strconv.Atoi).IsErr() {
+		t.Error("TryMap should return Err on failure")
+	}
+	if !errors.Is(TryMap(Err[string](errTest), strconv.Atoi).Err(), errTest) {
+		t.Error("TryMap should propagate Err")
+	}
+}
@@ -12,6 +12,7 @@
 // 转换和映射:
 //   - Map: 映射转换
 //   - Filter: 过滤
+//   - MapErr/FilterErr/ForEachErr: 可能失败的映射/过滤/遍历，遇到错误立即停止
 //   - Unique: 去重
 //
 // 聚合:
@@ -79,6 +80,7 @@
 // Transform and map:
 //   - Map: map/transform elements
 //   - Filter: filter elements
+//   - MapErr/FilterErr/ForEachErr: fallible map/filter/iterate, stopping at the first error
 //   - Unique: deduplicate elements
 //
 // Aggregate:
@@ -51,3 +51,32 @@ func Reject[T any](slice []T, fn func(T) bool) []T {
 	}
 	return result
 }
+
+// FilterErr 过滤切片，过滤函数返回错误时立即停止
+//
+// 参数:
+//   - slice: 要过滤的切片
+//   - fn: 可能失败的过滤函数，返回 true 的元素会被保留
+//
+// 返回:
+//   - []T: 过滤后的新切片，出错时为 nil
+//   - error: 第一个过滤错误
+//
+// 示例:
+//
+//	allowed, err := slicex.FilterErr(ids, func(id int64) (bool, error) {
+//	    return acl.CanRead(ctx, id)
+//	})
+func FilterErr[T any](slice []T, fn func(T) (bool, error)) ([]T, error) {
+	result := make([]T, 0, len(slice))
+	for _, item := range slice {
+		ok, err := fn(item)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
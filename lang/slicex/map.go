@@ -69,3 +69,52 @@ func FlatMap[T any, R any](slice []T, fn func(T) []R) []R {
 	}
 	return result
 }
+
+// MapErr 映射切片，转换函数返回错误时立即停止
+//
+// 参数:
+//   - slice: 要映射的切片
+//   - fn: 可能失败的转换函数
+//
+// 返回:
+//   - []R: 映射后的新切片，出错时为 nil
+//   - error: 第一个转换错误（原样返回，不做包装）
+//
+// 示例:
+//
+//	nums, err := slicex.MapErr([]string{"1", "2", "x"}, strconv.Atoi)
+//	// nums = nil, err = strconv.Atoi: parsing "x": invalid syntax
+func MapErr[T any, R any](slice []T, fn func(T) (R, error)) ([]R, error) {
+	result := make([]R, len(slice))
+	for i, item := range slice {
+		r, err := fn(item)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = r
+	}
+	return result, nil
+}
+
+// ForEachErr 依次对每个元素执行函数，返回错误时立即停止
+//
+// 参数:
+//   - slice: 要遍历的切片
+//   - fn: 可能失败的处理函数
+//
+// 返回:
+//   - error: 第一个处理错误，之后的元素不再处理
+//
+// 示例:
+//
+//	err := slicex.ForEachErr(users, func(u User) error {
+//	    return db.Save(u)
+//	})
+func ForEachErr[T any](slice []T, fn func(T) error) error {
+	for _, item := range slice {
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
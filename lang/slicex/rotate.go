@@ -0,0 +1,150 @@
+package slicex
+
+// Rotate 向左旋转切片 k 位（创建新切片）
+//
+// k 为负数时向右旋转，k 超过长度时按长度取模
+//
+// 参数:
+//   - slice: 要旋转的切片
+//   - k: 旋转位数
+//
+// 返回:
+//   - []T: 旋转后的新切片
+//
+// 示例:
+//
+//	slicex.Rotate([]int{1, 2, 3, 4, 5}, 2)   // [3, 4, 5, 1, 2]
+//	slicex.Rotate([]int{1, 2, 3, 4, 5}, -1)  // [5, 1, 2, 3, 4]
+func Rotate[T any](slice []T, k int) []T {
+	result := make([]T, len(slice))
+	if len(slice) == 0 {
+		return result
+	}
+	k = normalizeRotation(k, len(slice))
+	n := copy(result, slice[k:])
+	copy(result[n:], slice[:k])
+	return result
+}
+
+// RotateInPlace 原地向左旋转切片 k 位（修改原切片）
+//
+// 使用三次反转实现，不分配额外内存。k 为负数时向右旋转
+//
+// 示例:
+//
+//	nums := []int{1, 2, 3, 4, 5}
+//	slicex.RotateInPlace(nums, 2)  // nums 变为 [3, 4, 5, 1, 2]
+func RotateInPlace[T any](slice []T, k int) {
+	if len(slice) == 0 {
+		return
+	}
+	k = normalizeRotation(k, len(slice))
+	if k == 0 {
+		return
+	}
+	ReverseInPlace(slice[:k])
+	ReverseInPlace(slice[k:])
+	ReverseInPlace(slice)
+}
+
+// normalizeRotation 将旋转位数规范化到 [0, n)
+func normalizeRotation(k, n int) int {
+	k %= n
+	if k < 0 {
+		k += n
+	}
+	return k
+}
+
+// MoveElement 将 from 位置的元素移动到 to 位置（创建新切片）
+//
+// 其他元素依次前移或后移，适用于拖拽排序。索引越界时返回原切片的副本
+//
+// 参数:
+//   - slice: 原切片
+//   - from: 元素当前位置
+//   - to: 元素目标位置
+//
+// 返回:
+//   - []T: 移动后的新切片
+//
+// 示例:
+//
+//	slicex.MoveElement([]string{"a", "b", "c", "d"}, 0, 2)  // [b, c, a, d]
+//	slicex.MoveElement([]string{"a", "b", "c", "d"}, 3, 1)  // [a, d, b, c]
+func MoveElement[T any](slice []T, from, to int) []T {
+	result := make([]T, len(slice))
+	copy(result, slice)
+	MoveElementInPlace(result, from, to)
+	return result
+}
+
+// MoveElementInPlace 原地将 from 位置的元素移动到 to 位置（修改原切片）
+//
+// 返回:
+//   - bool: 索引越界时返回 false，切片不变
+//
+// 示例:
+//
+//	items := []string{"a", "b", "c", "d"}
+//	slicex.MoveElementInPlace(items, 0, 2)  // items 变为 [b, c, a, d]
+func MoveElementInPlace[T any](slice []T, from, to int) bool {
+	if from < 0 || from >= len(slice) || to < 0 || to >= len(slice) {
+		return false
+	}
+	switch {
+	case from < to:
+		RotateInPlace(slice[from:to+1], 1)
+	case from > to:
+		RotateInPlace(slice[to:from+1], -1)
+	}
+	return true
+}
+
+// SwapRange 交换两段长度为 n 的区间 [i, i+n) 和 [j, j+n)（创建新切片）
+//
+// 区间重叠或越界时返回原切片的副本
+//
+// 参数:
+//   - slice: 原切片
+//   - i: 第一段的起始位置
+//   - j: 第二段的起始位置
+//   - n: 区间长度
+//
+// 返回:
+//   - []T: 交换后的新切片
+//
+// 示例:
+//
+//	slicex.SwapRange([]int{1, 2, 3, 4, 5, 6}, 0, 4, 2)  // [5, 6, 3, 4, 1, 2]
+func SwapRange[T any](slice []T, i, j, n int) []T {
+	result := make([]T, len(slice))
+	copy(result, slice)
+	SwapRangeInPlace(result, i, j, n)
+	return result
+}
+
+// SwapRangeInPlace 原地交换两段长度为 n 的区间（修改原切片）
+//
+// 返回:
+//   - bool: 区间重叠或越界时返回 false，切片不变
+//
+// 示例:
+//
+//	nums := []int{1, 2, 3, 4, 5, 6}
+//	slicex.SwapRangeInPlace(nums, 0, 4, 2)  // nums 变为 [5, 6, 3, 4, 1, 2]
+func SwapRangeInPlace[T any](slice []T, i, j, n int) bool {
+	if n < 0 || i < 0 || j < 0 || i > len(slice)-n || j > len(slice)-n {
+		return false
+	}
+	if i > j {
+		i, j = j, i
+	}
+	if i+n > j {
+		return i == j // 同一区间视为成功，无需交换
+	}
+	for k := range n {
+		slice[i+k], slice[j+k] = slice[j+k], slice[i+k]
+	}
+	return true
+}
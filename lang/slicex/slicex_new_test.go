@@ -1,6 +1,9 @@
 package slicex
 
 import (
+	"errors"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -442,3 +445,141 @@ func TestNth(t *testing.T) {
 		t.Error("expected false for out of bounds")
 	}
 }
+
+// MapErr/FilterErr/ForEachErr tests
+func TestMapErr(t *testing.T) {
+	nums, err := MapErr([]string{"1", "2", "3"}, strconv.Atoi)
+	if err != nil || len(nums) != 3 || nums[2] != 3 {
+		t.Errorf("expected [1 2 3], nil, got %v, %v", nums, err)
+	}
+
+	calls := 0
+	nums, err = MapErr([]string{"1", "x", "3"}, func(s string) (int, error) {
+		calls++
+		return strconv.Atoi(s)
+	})
+	if err == nil || nums != nil {
+		t.Errorf("expected nil, error, got %v, %v", nums, err)
+	}
+	if calls != 2 {
+		t.Errorf("expected to stop after 2 calls, got %d", calls)
+	}
+}
+
+func TestFilterErr(t *testing.T) {
+	even, err := FilterErr([]int{1, 2, 3, 4}, func(n int) (bool, error) {
+		return n%2 == 0, nil
+	})
+	if err != nil || len(even) != 2 || even[1] != 4 {
+		t.Errorf("expected [2 4], nil, got %v, %v", even, err)
+	}
+
+	errBoom := errors.New("boom")
+	got, err := FilterErr([]int{1, 2, 3}, func(n int) (bool, error) {
+		if n == 2 {
+			return false, errBoom
+		}
+		return true, nil
+	})
+	if !errors.Is(err, errBoom) || got != nil {
+		t.Errorf("expected nil, boom, got %v, %v", got, err)
+	}
+}
+
+func TestForEachErr(t *testing.T) {
+	var seen []int
+	errStop := errors.New("stop")
+	err := ForEachErr([]int{1, 2, 3}, func(n int) error {
+		seen = append(seen, n)
+		if n == 2 {
+			return errStop
+		}
+		return nil
+	})
+	if !errors.Is(err, errStop) || len(seen) != 2 {
+		t.Errorf("expected stop after 2 elements, got %v, %v", seen, err)
+	}
+	if err := ForEachErr([]int{}, func(int) error { return errStop }); err != nil {
+		t.Errorf("expected nil for empty slice, got %v", err)
+	}
+}
+
+// Rotate tests
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		k    int
+		want []int
+	}{
+		{0, []int{1, 2, 3, 4, 5}},
+		{2, []int{3, 4, 5, 1, 2}},
+		{-1, []int{5, 1, 2, 3, 4}},
+		{7, []int{3, 4, 5, 1, 2}},
+		{-6, []int{5, 1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		src := []int{1, 2, 3, 4, 5}
+		if got := Rotate(src, tt.k); !slices.Equal(got, tt.want) {
+			t.Errorf("Rotate(%d) = %v, want %v", tt.k, got, tt.want)
+		}
+		if !slices.Equal(src, []int{1, 2, 3, 4, 5}) {
+			t.Error("Rotate should not modify the original slice")
+		}
+		RotateInPlace(src, tt.k)
+		if !slices.Equal(src, tt.want) {
+			t.Errorf("RotateInPlace(%d) = %v, want %v", tt.k, src, tt.want)
+		}
+	}
+
+	if got := Rotate([]int{}, 3); len(got) != 0 {
+		t.Errorf("expected empty, got %v", got)
+	}
+	RotateInPlace([]int(nil), 1)
+}
+
+func TestMoveElement(t *testing.T) {
+	tests := []struct {
+		from, to int
+		want     []string
+		ok       bool
+	}{
+		{0, 2, []string{"b", "c", "a", "d"}, true},
+		{3, 1, []string{"a", "d", "b", "c"}, true},
+		{1, 1, []string{"a", "b", "c", "d"}, true},
+		{0, 3, []string{"b", "c", "d", "a"}, true},
+		{-1, 2, []string{"a", "b", "c", "d"}, false},
+		{0, 4, []string{"a", "b", "c", "d"}, false},
+	}
+	for _, tt := range tests {
+		src := []string{"a", "b", "c", "d"}
+		if got := MoveElement(src, tt.from, tt.to); !slices.Equal(got, tt.want) {
+			t.Errorf("MoveElement(%d, %d) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+		if ok := MoveElementInPlace(src, tt.from, tt.to); ok != tt.ok || !slices.Equal(src, tt.want) {
+			t.Errorf("MoveElementInPlace(%d, %d) = %v, %v, want %v, %v", tt.from, tt.to, src, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestSwapRange(t *testing.T) {
+	tests := []struct {
+		i, j, n int
+		want    []int
+		ok      bool
+	}{
+		{0, 4, 2, []int{5, 6, 3, 4, 1, 2}, true},
+		{3, 0, 3, []int{4, 5, 6, 1, 2, 3}, true},
+		{1, 1, 2, []int{1, 2, 3, 4, 5, 6}, true},
+		{0, 1, 2, []int{1, 2, 3, 4, 5, 6}, false}, // 重叠
+		{0, 5, 2, []int{1, 2, 3, 4, 5, 6}, false}, // 越界
+		{0, 2, -1, []int{1, 2, 3, 4, 5, 6}, false},
+	}
+	for _, tt := range tests {
+		src := []int{1, 2, 3, 4, 5, 6}
+		if got := SwapRange(src, tt.i, tt.j, tt.n); !slices.Equal(got, tt.want) {
+			t.Errorf("SwapRange(%d, %d, %d) = %v, want %v", tt.i, tt.j, tt.n, got, tt.want)
+		}
+		if ok := SwapRangeInPlace(src, tt.i, tt.j, tt.n); ok != tt.ok || !slices.Equal(src, tt.want) {
+			t.Errorf("SwapRangeInPlace(%d, %d, %d) = %v, %v, want %v, %v", tt.i, tt.j, tt.n, src, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package stream
+
+import (
+	"time"
+)
+
+// FromChan 从 channel 创建 Stream
+//
+// 参数:
+//   - ch: 源 channel
+//
+// 返回:
+//   - Stream[T]: 新的 Stream
+//
+// 注意: Stream 在 channel 关闭后结束；channel 只能被消费一次，
+// 因此该 Stream 不应重复求值
+//
+// 示例:
+//
+//	events := make(chan Event)
+//	stream.FromChan(events).
+//	    Filter(func(e Event) bool { return e.Level >= Warn }).
+//	    ForEach(handle)
+func FromChan[T any](ch <-chan T) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			for v := range ch {
+				if !yield(v) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Ticker 创建按固定间隔产生当前时间的无限 Stream
+//
+// 参数:
+//   - interval: 间隔时间
+//
+// 返回:
+//   - Stream[time.Time]: 新的 Stream
+//
+// 注意: 该 Stream 是无限的，必须配合 Limit/TakeWhile/First 等短路操作使用
+//
+// 示例:
+//
+//	stream.Ticker(time.Second).Limit(3).ForEach(func(t time.Time) {
+//	    fmt.Println("tick", t)
+//	})
+func Ticker(interval time.Duration) Stream[time.Time] {
+	return Stream[time.Time]{
+		seq: func(yield func(time.Time) bool) {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for t := range ticker.C {
+				if !yield(t) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Throttle 节流：放行一个元素后，在 minInterval 内到达的后续元素被丢弃
+//
+// 参数:
+//   - minInterval: 两次放行之间的最小间隔
+//
+// 返回:
+//   - Stream[T]: 节流后的 Stream
+//
+// 注意: 以元素到达时间为准，适用于 FromChan/Ticker 等随时间产生元素的 Stream
+//
+// 示例:
+//
+//	// 每 100ms 最多处理一次鼠标移动事件
+//	stream.FromChan(moves).Throttle(100 * time.Millisecond).ForEach(render)
+func (s Stream[T]) Throttle(minInterval time.Duration) Stream[T] {
+	return s.throttle(minInterval, time.Now)
+}
+
+// throttle 使用 now 作为元素到达时间的 Throttle，便于测试注入时钟
+func (s Stream[T]) throttle(minInterval time.Duration, now func() time.Time) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			var last time.Time
+			for v := range s.seq {
+				now := now()
+				if !last.IsZero() && now.Sub(last) < minInterval {
+					continue
+				}
+				last = now
+				if !yield(v) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Debounce 防抖：元素到达后静默 quiet 时间内没有新元素才放行，
+// 期间到达的新元素会替换旧元素；上游结束时立即放行最后一个待发送元素
+//
+// 参数:
+//   - quiet: 静默时间
+//
+// 返回:
+//   - Stream[T]: 防抖后的 Stream
+//
+// 注意: 上游在独立的 goroutine 中求值。下游提前结束时，若上游正阻塞在
+// channel 接收上，该 goroutine 会在上游产生下一个元素或关闭后退出
+//
+// 示例:
+//
+//	// 输入停止 300ms 后才触发搜索
+//	stream.FromChan(keystrokes).Debounce(300 * time.Millisecond).ForEach(search)
+func (s Stream[T]) Debounce(quiet time.Duration) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			ch, stop := s.toChan()
+			defer stop()
+
+			timer := time.NewTimer(quiet)
+			timer.Stop()
+			defer timer.Stop()
+
+			var (
+				pending    T
+				hasPending bool
+			)
+			for {
+				select {
+				case v, ok := <-ch:
+					if !ok {
+						if hasPending {
+							yield(pending)
+						}
+						return
+					}
+					pending, hasPending = v, true
+					timer.Reset(quiet)
+				case <-timer.C:
+					if hasPending {
+						hasPending = false
+						if !yield(pending) {
+							return
+						}
+					}
+				}
+			}
+		},
+	}
+}
+
+// toChan 在独立 goroutine 中求值 Stream 并写入 channel
+// 返回的 stop 函数通知 goroutine 停止
+func (s Stream[T]) toChan() (<-chan T, func()) {
+	ch := make(chan T)
+	done := make(chan struct{})
+	go func() {
+		defer close(ch)
+		for v := range s.seq {
+			select {
+			case ch <- v:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return ch, func() { close(done) }
+}
@@ -0,0 +1,117 @@
+package stream
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	result := FromChan(ch).Map(func(n int) int { return n * 10 }).Collect()
+	if len(result) != 3 || result[0] != 10 || result[2] != 30 {
+		t.Errorf("unexpected result: %v", result)
+	}
+}
+
+func TestTicker(t *testing.T) {
+	start := time.Now()
+	ticks := Ticker(5 * time.Millisecond).Limit(3).Collect()
+	if len(ticks) != 3 {
+		t.Fatalf("expected 3 ticks, got %d", len(ticks))
+	}
+	for i := 1; i < len(ticks); i++ {
+		if !ticks[i].After(ticks[i-1]) {
+			t.Errorf("ticks out of order: %v", ticks)
+		}
+	}
+	// 计时器不会提前触发，只断言下界
+	if time.Since(start) < 15*time.Millisecond {
+		t.Errorf("ticks arrived too fast: %v", time.Since(start))
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	// 注入时钟：每个元素的到达时间（毫秒）
+	arrivals := []int{0, 10, 20, 49, 50, 60, 120, 130}
+	base := time.Now()
+	i := 0
+	clock := func() time.Time {
+		at := base.Add(time.Duration(arrivals[i]) * time.Millisecond)
+		i++
+		return at
+	}
+
+	result := Range(0, len(arrivals)).throttle(50*time.Millisecond, clock).Collect()
+	// 0 放行；10/20/49 在 50ms 内被丢弃；50 放行；60 丢弃；120 放行；130 丢弃
+	want := []int{0, 4, 6}
+	if len(result) != len(want) {
+		t.Fatalf("expected %v, got %v", want, result)
+	}
+	for i := range want {
+		if result[i] != want[i] {
+			t.Errorf("expected %v, got %v", want, result)
+		}
+	}
+}
+
+func TestThrottle_RealClock(t *testing.T) {
+	// 真实时钟下只断言边界：首个元素一定放行，结果保持顺序
+	result := Range(0, 100).Throttle(time.Hour).Collect()
+	if len(result) != 1 || result[0] != 0 {
+		t.Errorf("expected [0], got %v", result)
+	}
+	if got := Range(0, 5).Throttle(0).Collect(); len(got) != 5 {
+		t.Errorf("zero interval should not drop elements, got %v", got)
+	}
+}
+
+func TestDebounce(t *testing.T) {
+	// 静默时间远大于测试时长，计时器不会触发：快速连发的元素只保留最后一个，上游结束时放行
+	result := Range(1, 6).Debounce(time.Hour).Collect()
+	if len(result) != 1 || result[0] != 5 {
+		t.Errorf("expected [5], got %v", result)
+	}
+}
+
+func TestDebounce_Quiet(t *testing.T) {
+	ch := make(chan int)
+	emitted := make(chan int, 10)
+	go func() {
+		defer close(ch)
+		ch <- 1
+		// 上游在下游放行 1 之前不再发送，1 只能由静默计时器放行
+		<-emitted
+		ch <- 2
+	}()
+
+	var result []int
+	FromChan(ch).Debounce(5 * time.Millisecond).ForEach(func(v int) {
+		result = append(result, v)
+		emitted <- v
+	})
+	if len(result) != 2 || result[0] != 1 || result[1] != 2 {
+		t.Errorf("expected [1 2], got %v", result)
+	}
+}
+
+func TestDebounce_EarlyStop(t *testing.T) {
+	ch := make(chan int)
+	release := make(chan struct{})
+	go func() {
+		defer close(ch)
+		ch <- 0
+		<-release
+		ch <- 1
+	}()
+
+	first, ok := FromChan(ch).Debounce(5 * time.Millisecond).First()
+	close(release)
+	if !ok || first != 0 {
+		t.Errorf("expected first debounced value 0, got %d (ok=%v)", first, ok)
+	}
+}
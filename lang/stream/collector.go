@@ -0,0 +1,330 @@
+package stream
+
+import (
+	"strings"
+)
+
+// Collector 定义可复用的累积策略
+//
+// 每次收集都会通过 Begin 创建独立的累加器，因此同一个 Collector
+// 可以安全地在多个 Stream 之间复用
+type Collector[T, R any] interface {
+	// Begin 创建新的累加器
+	Begin() Accumulator[T, R]
+}
+
+// Accumulator 单次收集过程中的累加器
+type Accumulator[T, R any] interface {
+	// Add 累加一个元素
+	Add(v T)
+
+	// Result 返回最终结果
+	Result() R
+}
+
+// NewCollector 由三个函数构造 Collector
+//
+// 参数:
+//   - supplier: 创建初始累积状态
+//   - accumulator: 将元素合并到累积状态
+//   - finisher: 将累积状态转换为最终结果
+//
+// 返回:
+//   - Collector[T, R]: 新的 Collector
+//
+// 示例:
+//
+//	sum := stream.NewCollector(
+//	    func() int { return 0 },
+//	    func(acc int, n int) int { return acc + n },
+//	    func(acc int) int { return acc },
+//	)
+//	total := stream.CollectWith(stream.Of(1, 2, 3), sum) // 6
+func NewCollector[T, A, R any](supplier func() A, accumulator func(A, T) A, finisher func(A) R) Collector[T, R] {
+	return funcCollector[T, A, R]{
+		supplier:    supplier,
+		accumulator: accumulator,
+		finisher:    finisher,
+	}
+}
+
+// funcCollector 基于函数的 Collector 实现
+type funcCollector[T, A, R any] struct {
+	supplier    func() A
+	accumulator func(A, T) A
+	finisher    func(A) R
+}
+
+// Begin 实现 Collector 接口
+func (c funcCollector[T, A, R]) Begin() Accumulator[T, R] {
+	return &funcAccumulator[T, A, R]{c: c, state: c.supplier()}
+}
+
+// funcAccumulator 基于函数的累加器
+type funcAccumulator[T, A, R any] struct {
+	c     funcCollector[T, A, R]
+	state A
+}
+
+// Add 实现 Accumulator 接口
+func (a *funcAccumulator[T, A, R]) Add(v T) {
+	a.state = a.c.accumulator(a.state, v)
+}
+
+// Result 实现 Accumulator 接口
+func (a *funcAccumulator[T, A, R]) Result() R {
+	return a.c.finisher(a.state)
+}
+
+// CollectWith 使用 Collector 收集 Stream
+//
+// 参数:
+//   - s: 源 Stream
+//   - c: 收集器
+//
+// 返回:
+//   - R: 收集结果
+//
+// 示例:
+//
+//	s := stream.CollectWith(stream.Of("a", "b", "c"), stream.Joining(", "))
+//	// "a, b, c"
+func CollectWith[T, R any](s Stream[T], c Collector[T, R]) R {
+	acc := c.Begin()
+	for v := range s.seq {
+		acc.Add(v)
+	}
+	return acc.Result()
+}
+
+// Joining 使用分隔符连接字符串
+//
+// 参数:
+//   - sep: 分隔符
+//
+// 返回:
+//   - Collector[string, string]: 字符串连接收集器
+//
+// 示例:
+//
+//	stream.CollectWith(stream.Of("a", "b"), stream.Joining("-")) // "a-b"
+func Joining(sep string) Collector[string, string] {
+	return NewCollector(
+		func() *joining { return &joining{} },
+		func(j *joining, s string) *joining {
+			if j.started {
+				j.sb.WriteString(sep)
+			}
+			j.started = true
+			j.sb.WriteString(s)
+			return j
+		},
+		func(j *joining) string { return j.sb.String() },
+	)
+}
+
+// joining 字符串连接累积状态
+type joining struct {
+	sb      strings.Builder
+	started bool
+}
+
+// CountingBy 按键计数
+//
+// 参数:
+//   - keyFn: 提取键的函数
+//
+// 返回:
+//   - Collector[T, map[K]int]: 计数收集器
+//
+// 示例:
+//
+//	counts := stream.CollectWith(stream.Of("a", "bb", "cc"), stream.CountingBy(func(s string) int {
+//	    return len(s)
+//	}))
+//	// map[1:1 2:2]
+func CountingBy[T any, K comparable](keyFn func(T) K) Collector[T, map[K]int] {
+	return NewCollector(
+		func() map[K]int { return make(map[K]int) },
+		func(m map[K]int, v T) map[K]int {
+			m[keyFn(v)]++
+			return m
+		},
+		func(m map[K]int) map[K]int { return m },
+	)
+}
+
+// averaging 平均值累积状态
+type averaging struct {
+	sum   float64
+	count int
+}
+
+// AveragingBy 计算平均值，空 Stream 返回 0
+//
+// 参数:
+//   - fn: 提取数值的函数
+//
+// 返回:
+//   - Collector[T, float64]: 平均值收集器
+//
+// 示例:
+//
+//	avg := stream.CollectWith(stream.Of(1, 2, 3, 4), stream.AveragingBy(func(n int) float64 {
+//	    return float64(n)
+//	}))
+//	// 2.5
+func AveragingBy[T any](fn func(T) float64) Collector[T, float64] {
+	return NewCollector(
+		func() averaging { return averaging{} },
+		func(a averaging, v T) averaging {
+			a.sum += fn(v)
+			a.count++
+			return a
+		},
+		func(a averaging) float64 {
+			if a.count == 0 {
+				return 0
+			}
+			return a.sum / float64(a.count)
+		},
+	)
+}
+
+// ToSet 收集为集合（以 map[T]struct{} 表示）
+//
+// 返回:
+//   - Collector[T, map[T]struct{}]: 集合收集器
+//
+// 示例:
+//
+//	set := stream.CollectWith(stream.Of(1, 2, 2, 3), stream.ToSet[int]())
+//	// map[1:{} 2:{} 3:{}]
+func ToSet[T comparable]() Collector[T, map[T]struct{}] {
+	return NewCollector(
+		func() map[T]struct{} { return make(map[T]struct{}) },
+		func(m map[T]struct{}, v T) map[T]struct{} {
+			m[v] = struct{}{}
+			return m
+		},
+		func(m map[T]struct{}) map[T]struct{} { return m },
+	)
+}
+
+// ToMapBy 按键收集为 map，键重复时后出现的元素覆盖先出现的
+//
+// 与 ToMap 相同，但以 Collector 形式提供，可复用并组合到 GroupingByWith 中
+//
+// 参数:
+//   - keyFn: 提取键的函数
+//
+// 返回:
+//   - Collector[T, map[K]T]: map 收集器
+//
+// 示例:
+//
+//	byID := stream.CollectWith(stream.FromSlice(users), stream.ToMapBy(func(u User) int {
+//	    return u.ID
+//	}))
+func ToMapBy[T any, K comparable](keyFn func(T) K) Collector[T, map[K]T] {
+	return NewCollector(
+		func() map[K]T { return make(map[K]T) },
+		func(m map[K]T, v T) map[K]T {
+			m[keyFn(v)] = v
+			return m
+		},
+		func(m map[K]T) map[K]T { return m },
+	)
+}
+
+// GroupingBy 按键分组
+//
+// 参数:
+//   - keyFn: 提取键的函数
+//
+// 返回:
+//   - Collector[T, map[K][]T]: 分组收集器，组内保持原顺序
+//
+// 示例:
+//
+//	groups := stream.CollectWith(stream.Of(1, 2, 3, 4), stream.GroupingBy(func(n int) bool {
+//	    return n%2 == 0
+//	}))
+//	// map[false:[1 3] true:[2 4]]
+func GroupingBy[T any, K comparable](keyFn func(T) K) Collector[T, map[K][]T] {
+	return NewCollector(
+		func() map[K][]T { return make(map[K][]T) },
+		func(m map[K][]T, v T) map[K][]T {
+			key := keyFn(v)
+			m[key] = append(m[key], v)
+			return m
+		},
+		func(m map[K][]T) map[K][]T { return m },
+	)
+}
+
+// GroupingByWith 按键分组，并用 downstream 收集每个分组
+//
+// 参数:
+//   - keyFn: 提取键的函数
+//   - downstream: 分组内使用的收集器
+//
+// 返回:
+//   - Collector[T, map[K]R]: 分组收集器
+//
+// 示例:
+//
+//	joined := stream.CollectWith(stream.Of("a", "bb", "c"), stream.GroupingByWith(
+//	    func(s string) int { return len(s) },
+//	    stream.Joining(","),
+//	))
+//	// map[1:"a,c" 2:"bb"]
+func GroupingByWith[T any, K comparable, R any](keyFn func(T) K, downstream Collector[T, R]) Collector[T, map[K]R] {
+	return NewCollector(
+		func() map[K]Accumulator[T, R] { return make(map[K]Accumulator[T, R]) },
+		func(m map[K]Accumulator[T, R], v T) map[K]Accumulator[T, R] {
+			key := keyFn(v)
+			acc, ok := m[key]
+			if !ok {
+				acc = downstream.Begin()
+				m[key] = acc
+			}
+			acc.Add(v)
+			return m
+		},
+		func(m map[K]Accumulator[T, R]) map[K]R {
+			result := make(map[K]R, len(m))
+			for k, acc := range m {
+				result[k] = acc.Result()
+			}
+			return result
+		},
+	)
+}
+
+// ToChan 将元素依次发送到 ch，返回发送的元素数量
+//
+// 发送是阻塞的，ch 需要有消费者或足够的缓冲；收集结束后不会关闭 ch，
+// 由调用方负责关闭
+//
+// 参数:
+//   - ch: 目标 channel
+//
+// 返回:
+//   - Collector[T, int]: channel 收集器
+//
+// 示例:
+//
+//	ch := make(chan int, 3)
+//	n := stream.CollectWith(stream.Of(1, 2, 3), stream.ToChan(ch))  // 3
+//	close(ch)
+func ToChan[T any](ch chan<- T) Collector[T, int] {
+	return NewCollector(
+		func() int { return 0 },
+		func(n int, v T) int {
+			ch <- v
+			return n + 1
+		},
+		func(n int) int { return n },
+	)
+}
@@ -0,0 +1,106 @@
+package stream
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCollectWith_Joining(t *testing.T) {
+	got := CollectWith(Of("a", "b", "c"), Joining(", "))
+	if got != "a, b, c" {
+		t.Errorf("expected 'a, b, c', got %q", got)
+	}
+	if got := CollectWith(Of("", "b"), Joining("-")); got != "-b" {
+		t.Errorf("expected '-b', got %q", got)
+	}
+	if got := CollectWith(Of[string](), Joining("-")); got != "" {
+		t.Errorf("expected empty string, got %q", got)
+	}
+}
+
+func TestCollectWith_CountingBy(t *testing.T) {
+	counts := CollectWith(Of("a", "bb", "cc", "ddd"), CountingBy(func(s string) int { return len(s) }))
+	if counts[1] != 1 || counts[2] != 2 || counts[3] != 1 {
+		t.Errorf("unexpected counts: %v", counts)
+	}
+}
+
+func TestCollectWith_AveragingBy(t *testing.T) {
+	avg := CollectWith(Of(1, 2, 3, 4), AveragingBy(func(n int) float64 { return float64(n) }))
+	if avg != 2.5 {
+		t.Errorf("expected 2.5, got %v", avg)
+	}
+	if avg := CollectWith(Of[int](), AveragingBy(func(n int) float64 { return float64(n) })); avg != 0 {
+		t.Errorf("expected 0 for empty stream, got %v", avg)
+	}
+}
+
+func TestCollectWith_ToSet(t *testing.T) {
+	set := CollectWith(Of(1, 2, 2, 3, 3, 3), ToSet[int]())
+	if len(set) != 3 {
+		t.Errorf("expected 3 elements, got %d", len(set))
+	}
+	for _, v := range []int{1, 2, 3} {
+		if _, ok := set[v]; !ok {
+			t.Errorf("expected set to contain %d", v)
+		}
+	}
+}
+
+func TestCollector_Reusable(t *testing.T) {
+	upper := NewCollector(
+		func() []string { return nil },
+		func(acc []string, s string) []string { return append(acc, strings.ToUpper(s)) },
+		func(acc []string) string { return strings.Join(acc, "") },
+	)
+	if got := CollectWith(Of("a", "b"), upper); got != "AB" {
+		t.Errorf("expected 'AB', got %q", got)
+	}
+	if got := CollectWith(Of("c"), upper); got != "C" {
+		t.Errorf("collector state leaked between runs, got %q", got)
+	}
+}
+
+func TestCollectWith_ToMapBy(t *testing.T) {
+	m := CollectWith(Of("a", "bb", "cc"), ToMapBy(func(s string) int { return len(s) }))
+	if len(m) != 2 || m[1] != "a" || m[2] != "cc" {
+		t.Errorf("unexpected map: %v", m)
+	}
+}
+
+func TestCollectWith_GroupingBy(t *testing.T) {
+	groups := CollectWith(Of(1, 2, 3, 4, 5), GroupingBy(func(n int) bool { return n%2 == 0 }))
+	if got := groups[false]; len(got) != 3 || got[0] != 1 || got[1] != 3 || got[2] != 5 {
+		t.Errorf("unexpected odd group: %v", got)
+	}
+	if got := groups[true]; len(got) != 2 || got[0] != 2 || got[1] != 4 {
+		t.Errorf("unexpected even group: %v", got)
+	}
+}
+
+func TestCollectWith_GroupingByWith(t *testing.T) {
+	byLen := GroupingByWith(func(s string) int { return len(s) }, Joining(","))
+	got := CollectWith(Of("a", "bb", "c", "dd", "eee"), byLen)
+	if len(got) != 3 || got[1] != "a,c" || got[2] != "bb,dd" || got[3] != "eee" {
+		t.Errorf("unexpected groups: %v", got)
+	}
+	// 每次收集都应创建新的下游累加器
+	if got := CollectWith(Of("x"), byLen); len(got) != 1 || got[1] != "x" {
+		t.Errorf("collector state leaked between runs: %v", got)
+	}
+}
+
+func TestCollectWith_ToChan(t *testing.T) {
+	ch := make(chan int, 3)
+	if n := CollectWith(Of(1, 2, 3), ToChan(ch)); n != 3 {
+		t.Errorf("expected 3 elements sent, got %d", n)
+	}
+	close(ch)
+	var sum int
+	for v := range ch {
+		sum += v
+	}
+	if sum != 6 {
+		t.Errorf("expected sum 6, got %d", sum)
+	}
+}
@@ -5,6 +5,7 @@
 //
 // 主要特性:
 //   - 延迟求值：中间操作不会立即执行
+//   - 阶段融合：各中间操作逐元素串联执行，First/Any/Limit 等可提前终止上游计算
 //   - 链式调用：流畅的 API 设计
 //   - 类型安全：使用泛型保证类型安全
 //
@@ -13,6 +14,7 @@
 //   - FromSlice: 从切片创建
 //   - Generate: 使用生成函数创建
 //   - Range: 创建数字范围
+//   - FromChan/Ticker: 从 channel 或定时器创建（用于事件处理）
 //
 // 中间操作（返回新 Stream）:
 //   - Filter: 过滤
@@ -22,6 +24,9 @@
 //   - Sorted: 排序
 //   - Limit: 限制数量
 //   - Skip: 跳过元素
+//   - TakeWhile/DropWhile: 按条件截取/跳过前缀
+//   - StepBy: 按步长取元素
+//   - Throttle/Debounce: 基于时间的节流与防抖
 //
 // 终端操作（返回结果）:
 //   - Collect: 收集到切片
@@ -30,6 +35,7 @@
 //   - Count: 计数
 //   - First/Last: 获取首尾元素
 //   - Any/All/None: 条件检查
+//   - CollectWith: 使用 Collector 收集（Joining/CountingBy/AveragingBy/ToSet/ToMapBy/GroupingBy/GroupingByWith/ToChan 或自定义）
 //
 // 示例:
 //
@@ -55,6 +61,7 @@
 //
 // Main features:
 //   - Lazy evaluation: intermediate operations are not executed immediately
+//   - Stage fusion: operations run element by element, so First/Any/Limit stop upstream work early
 //   - Fluent chaining: clean and expressive API design
 //   - Type-safe: uses generics to guarantee type safety
 //
@@ -63,6 +70,7 @@
 //   - FromSlice: create from a slice
 //   - Generate: create using a generator function
 //   - Range: create a numeric range
+//   - FromChan/Ticker: create from a channel or a ticker (for event processing)
 //
 // Intermediate operations (return a new Stream):
 //   - Filter: filter elements
@@ -72,6 +80,9 @@
 //   - Sorted: sort elements
 //   - Limit: limit the number of elements
 //   - Skip: skip elements
+//   - TakeWhile/DropWhile: take/drop a prefix while a predicate holds
+//   - StepBy: take every n-th element
+//   - Throttle/Debounce: time-based rate shaping
 //
 // Terminal operations (return a result):
 //   - Collect: collect into a slice
@@ -80,6 +91,7 @@
 //   - Count: count elements
 //   - First/Last: get the first/last element
 //   - Any/All/None: conditional checks
+//   - CollectWith: collect via a Collector (Joining/CountingBy/AveragingBy/ToSet/ToMapBy/GroupingBy/GroupingByWith/ToChan or custom)
 //
 // Examples:
 //
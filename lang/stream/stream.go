@@ -1,12 +1,17 @@
 package stream
 
 import (
+	"iter"
 	"sort"
 )
 
 // Stream 表示一个元素序列，支持链式操作
+//
+// 内部以推送式迭代器（iter.Seq）表示：各中间操作在终端操作触发时逐元素融合执行，
+// First/Any/Limit 等在结果确定后立即停止上游的 Map/Filter 计算。
+// Sorted/Reverse 等需要全量数据的操作会在该阶段物化。
 type Stream[T any] struct {
-	source func() []T
+	seq iter.Seq[T]
 }
 
 // Of 从多个值创建 Stream
@@ -21,11 +26,7 @@ type Stream[T any] struct {
 //
 //	s := stream.Of(1, 2, 3, 4, 5)
 func Of[T any](values ...T) Stream[T] {
-	return Stream[T]{
-		source: func() []T {
-			return values
-		},
-	}
+	return FromSlice(values)
 }
 
 // FromSlice 从切片创建 Stream
@@ -42,8 +43,12 @@ func Of[T any](values ...T) Stream[T] {
 //	s := stream.FromSlice(nums)
 func FromSlice[T any](slice []T) Stream[T] {
 	return Stream[T]{
-		source: func() []T {
-			return slice
+		seq: func(yield func(T) bool) {
+			for _, v := range slice {
+				if !yield(v) {
+					return
+				}
+			}
 		},
 	}
 }
@@ -63,15 +68,12 @@ func FromSlice[T any](slice []T) Stream[T] {
 //	// [0, 2, 4, 6, 8]
 func Generate[T any](n int, generator func(int) T) Stream[T] {
 	return Stream[T]{
-		source: func() []T {
-			if n <= 0 {
-				return nil
-			}
-			result := make([]T, n)
-			for i := range result {
-				result[i] = generator(i)
+		seq: func(yield func(T) bool) {
+			for i := 0; i < n; i++ {
+				if !yield(generator(i)) {
+					return
+				}
 			}
-			return result
 		},
 	}
 }
@@ -90,15 +92,12 @@ func Generate[T any](n int, generator func(int) T) Stream[T] {
 //	s := stream.Range(0, 5)  // [0, 1, 2, 3, 4]
 func Range(start, end int) Stream[int] {
 	return Stream[int]{
-		source: func() []int {
-			if end <= start {
-				return nil
-			}
-			result := make([]int, end-start)
-			for i := range result {
-				result[i] = start + i
+		seq: func(yield func(int) bool) {
+			for i := start; i < end; i++ {
+				if !yield(i) {
+					return
+				}
 			}
-			return result
 		},
 	}
 }
@@ -117,15 +116,12 @@ func Range(start, end int) Stream[int] {
 //	s := stream.Repeat("hello", 3)  // ["hello", "hello", "hello"]
 func Repeat[T any](value T, n int) Stream[T] {
 	return Stream[T]{
-		source: func() []T {
-			if n <= 0 {
-				return nil
-			}
-			result := make([]T, n)
-			for i := range result {
-				result[i] = value
+		seq: func(yield func(T) bool) {
+			for i := 0; i < n; i++ {
+				if !yield(value) {
+					return
+				}
 			}
-			return result
 		},
 	}
 }
@@ -144,15 +140,12 @@ func Repeat[T any](value T, n int) Stream[T] {
 //	// [2, 4]
 func (s Stream[T]) Filter(predicate func(T) bool) Stream[T] {
 	return Stream[T]{
-		source: func() []T {
-			src := s.source()
-			result := make([]T, 0)
-			for _, v := range src {
-				if predicate(v) {
-					result = append(result, v)
+		seq: func(yield func(T) bool) {
+			for v := range s.seq {
+				if predicate(v) && !yield(v) {
+					return
 				}
 			}
-			return result
 		},
 	}
 }
@@ -172,16 +165,7 @@ func (s Stream[T]) Filter(predicate func(T) bool) Stream[T] {
 //	s := stream.Of(1, 2, 3).Map(func(n int) int { return n * 2 })
 //	// [2, 4, 6]
 func (s Stream[T]) Map(mapper func(T) T) Stream[T] {
-	return Stream[T]{
-		source: func() []T {
-			src := s.source()
-			result := make([]T, len(src))
-			for i, v := range src {
-				result[i] = mapper(v)
-			}
-			return result
-		},
-	}
+	return MapTo(s, mapper)
 }
 
 // Distinct 去除重复元素
@@ -198,32 +182,31 @@ func (s Stream[T]) Map(mapper func(T) T) Stream[T] {
 //	// [1, 2, 3]
 func (s Stream[T]) Distinct() Stream[T] {
 	return Stream[T]{
-		source: func() []T {
-			src := s.source()
-			if len(src) == 0 {
-				return nil
-			}
-
-			// 先检测类型是否可比较（只检查一次），避免每个元素都 defer/recover
-			comparable := isComparable(src[0])
-
-			if !comparable {
-				// 不可比较的类型无法去重，直接返回副本
-				result := make([]T, len(src))
-				copy(result, src)
-				return result
-			}
-
-			seen := make(map[any]bool, len(src))
-			result := make([]T, 0, len(src))
-			for _, v := range src {
-				key := any(v)
-				if !seen[key] {
+		seq: func(yield func(T) bool) {
+			var (
+				seen       map[any]bool
+				checked    bool
+				comparable bool
+			)
+			for v := range s.seq {
+				// 先检测类型是否可比较（只检查一次），避免每个元素都 defer/recover
+				if !checked {
+					checked = true
+					comparable = isComparable(v)
+					seen = make(map[any]bool)
+				}
+				if comparable {
+					key := any(v)
+					if seen[key] {
+						continue
+					}
 					seen[key] = true
-					result = append(result, v)
+				}
+				// 不可比较的类型无法去重，直接保留
+				if !yield(v) {
+					return
 				}
 			}
-			return result
 		},
 	}
 }
@@ -254,14 +237,16 @@ func isComparable(v any) (ok bool) {
 //	// [1, 1, 3, 4, 5]
 func (s Stream[T]) Sorted(less func(a, b T) bool) Stream[T] {
 	return Stream[T]{
-		source: func() []T {
-			src := s.source()
-			result := make([]T, len(src))
-			copy(result, src)
+		seq: func(yield func(T) bool) {
+			result := s.Collect()
 			sort.Slice(result, func(i, j int) bool {
 				return less(result[i], result[j])
 			})
-			return result
+			for _, v := range result {
+				if !yield(v) {
+					return
+				}
+			}
 		},
 	}
 }
@@ -280,18 +265,21 @@ func (s Stream[T]) Sorted(less func(a, b T) bool) Stream[T] {
 //	// [1, 2, 3]
 func (s Stream[T]) Limit(n int) Stream[T] {
 	return Stream[T]{
-		source: func() []T {
-			src := s.source()
+		seq: func(yield func(T) bool) {
 			if n <= 0 {
-				return nil
+				return
 			}
-			if n >= len(src) {
-				return src
+			count := 0
+			for v := range s.seq {
+				if !yield(v) {
+					return
+				}
+				count++
+				// 达到数量后立即停止上游
+				if count >= n {
+					return
+				}
 			}
-			// 返回副本，避免共享底层数组
-			result := make([]T, n)
-			copy(result, src[:n])
-			return result
 		},
 	}
 }
@@ -310,18 +298,17 @@ func (s Stream[T]) Limit(n int) Stream[T] {
 //	// [3, 4, 5]
 func (s Stream[T]) Skip(n int) Stream[T] {
 	return Stream[T]{
-		source: func() []T {
-			src := s.source()
-			if n <= 0 {
-				return src
-			}
-			if n >= len(src) {
-				return nil
+		seq: func(yield func(T) bool) {
+			skipped := 0
+			for v := range s.seq {
+				if skipped < n {
+					skipped++
+					continue
+				}
+				if !yield(v) {
+					return
+				}
 			}
-			// 返回副本，避免共享底层数组
-			result := make([]T, len(src)-n)
-			copy(result, src[n:])
-			return result
 		},
 	}
 }
@@ -339,12 +326,13 @@ func (s Stream[T]) Skip(n int) Stream[T] {
 //	s := stream.Of(1, 2, 3).Peek(func(n int) { fmt.Println(n) }).Collect()
 func (s Stream[T]) Peek(action func(T)) Stream[T] {
 	return Stream[T]{
-		source: func() []T {
-			src := s.source()
-			for _, v := range src {
+		seq: func(yield func(T) bool) {
+			for v := range s.seq {
 				action(v)
+				if !yield(v) {
+					return
+				}
 			}
-			return src
 		},
 	}
 }
@@ -360,13 +348,13 @@ func (s Stream[T]) Peek(action func(T)) Stream[T] {
 //	// [3, 2, 1]
 func (s Stream[T]) Reverse() Stream[T] {
 	return Stream[T]{
-		source: func() []T {
-			src := s.source()
-			result := make([]T, len(src))
-			for i := range src {
-				result[len(src)-1-i] = src[i]
+		seq: func(yield func(T) bool) {
+			src := s.Collect()
+			for i := len(src) - 1; i >= 0; i-- {
+				if !yield(src[i]) {
+					return
+				}
 			}
-			return result
 		},
 	}
 }
@@ -385,16 +373,12 @@ func (s Stream[T]) Reverse() Stream[T] {
 //	// [1, 2, 3]
 func (s Stream[T]) TakeWhile(predicate func(T) bool) Stream[T] {
 	return Stream[T]{
-		source: func() []T {
-			src := s.source()
-			result := make([]T, 0)
-			for _, v := range src {
-				if !predicate(v) {
-					break
+		seq: func(yield func(T) bool) {
+			for v := range s.seq {
+				if !predicate(v) || !yield(v) {
+					return
 				}
-				result = append(result, v)
 			}
-			return result
 		},
 	}
 }
@@ -413,18 +397,45 @@ func (s Stream[T]) TakeWhile(predicate func(T) bool) Stream[T] {
 //	// [3, 4, 5]
 func (s Stream[T]) DropWhile(predicate func(T) bool) Stream[T] {
 	return Stream[T]{
-		source: func() []T {
-			src := s.source()
-			i := 0
-			for ; i < len(src) && predicate(src[i]); i++ {
+		seq: func(yield func(T) bool) {
+			dropping := true
+			for v := range s.seq {
+				if dropping && predicate(v) {
+					continue
+				}
+				dropping = false
+				if !yield(v) {
+					return
+				}
 			}
-			if i >= len(src) {
-				return nil
+		},
+	}
+}
+
+// StepBy 按固定步长取元素（保留第 0、n、2n... 个元素）
+//
+// 参数:
+//   - n: 步长，小于等于 0 时按 1 处理
+//
+// 返回:
+//   - Stream[T]: 新的 Stream
+//
+// 示例:
+//
+//	s := stream.Range(0, 10).StepBy(3)
+//	// [0, 3, 6, 9]
+func (s Stream[T]) StepBy(n int) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			i := 0
+			for v := range s.seq {
+				if n <= 1 || i%n == 0 {
+					if !yield(v) {
+						return
+					}
+				}
+				i++
 			}
-			// 复制结果，避免返回原始切片的子切片引用
-			result := make([]T, len(src)-i)
-			copy(result, src[i:])
-			return result
 		},
 	}
 }
@@ -432,13 +443,17 @@ func (s Stream[T]) DropWhile(predicate func(T) bool) Stream[T] {
 // Collect 收集所有元素到切片
 //
 // 返回:
-//   - []T: 结果切片
+//   - []T: 结果切片，空 Stream 返回空切片而非 nil
 //
 // 示例:
 //
 //	result := stream.Of(1, 2, 3).Collect()
 func (s Stream[T]) Collect() []T {
-	return s.source()
+	result := make([]T, 0)
+	for v := range s.seq {
+		result = append(result, v)
+	}
+	return result
 }
 
 // ForEach 遍历每个元素
@@ -450,7 +465,7 @@ func (s Stream[T]) Collect() []T {
 //
 //	stream.Of(1, 2, 3).ForEach(func(n int) { fmt.Println(n) })
 func (s Stream[T]) ForEach(action func(T)) {
-	for _, v := range s.source() {
+	for v := range s.seq {
 		action(v)
 	}
 }
@@ -470,7 +485,7 @@ func (s Stream[T]) ForEach(action func(T)) {
 //	// 15
 func (s Stream[T]) Reduce(initial T, accumulator func(T, T) T) T {
 	result := initial
-	for _, v := range s.source() {
+	for v := range s.seq {
 		result = accumulator(result, v)
 	}
 	return result
@@ -486,7 +501,11 @@ func (s Stream[T]) Reduce(initial T, accumulator func(T, T) T) T {
 //	count := stream.Of(1, 2, 3, 4, 5).Count()
 //	// 5
 func (s Stream[T]) Count() int {
-	return len(s.source())
+	count := 0
+	for range s.seq {
+		count++
+	}
+	return count
 }
 
 // First 返回第一个元素
@@ -500,12 +519,11 @@ func (s Stream[T]) Count() int {
 //	first, ok := stream.Of(1, 2, 3).First()
 //	// 1, true
 func (s Stream[T]) First() (T, bool) {
-	src := s.source()
-	if len(src) == 0 {
-		var zero T
-		return zero, false
+	for v := range s.seq {
+		return v, true
 	}
-	return src[0], true
+	var zero T
+	return zero, false
 }
 
 // Last 返回最后一个元素
@@ -519,12 +537,14 @@ func (s Stream[T]) First() (T, bool) {
 //	last, ok := stream.Of(1, 2, 3).Last()
 //	// 3, true
 func (s Stream[T]) Last() (T, bool) {
-	src := s.source()
-	if len(src) == 0 {
-		var zero T
-		return zero, false
+	var (
+		last T
+		ok   bool
+	)
+	for v := range s.seq {
+		last, ok = v, true
 	}
-	return src[len(src)-1], true
+	return last, ok
 }
 
 // Any 检查是否有任意元素满足条件
@@ -540,7 +560,7 @@ func (s Stream[T]) Last() (T, bool) {
 //	hasEven := stream.Of(1, 2, 3).Any(func(n int) bool { return n%2 == 0 })
 //	// true
 func (s Stream[T]) Any(predicate func(T) bool) bool {
-	for _, v := range s.source() {
+	for v := range s.seq {
 		if predicate(v) {
 			return true
 		}
@@ -561,7 +581,7 @@ func (s Stream[T]) Any(predicate func(T) bool) bool {
 //	allPositive := stream.Of(1, 2, 3).All(func(n int) bool { return n > 0 })
 //	// true
 func (s Stream[T]) All(predicate func(T) bool) bool {
-	for _, v := range s.source() {
+	for v := range s.seq {
 		if !predicate(v) {
 			return false
 		}
@@ -599,7 +619,7 @@ func (s Stream[T]) None(predicate func(T) bool) bool {
 //	even, ok := stream.Of(1, 2, 3).FindFirst(func(n int) bool { return n%2 == 0 })
 //	// 2, true
 func (s Stream[T]) FindFirst(predicate func(T) bool) (T, bool) {
-	for _, v := range s.source() {
+	for v := range s.seq {
 		if predicate(v) {
 			return v, true
 		}
@@ -621,7 +641,7 @@ func (s Stream[T]) FindFirst(predicate func(T) bool) (T, bool) {
 //	m := stream.Of(User{ID: 1}, User{ID: 2}).ToMap(func(u User) int { return u.ID })
 func ToMap[T any, K comparable](s Stream[T], keyFn func(T) K) map[K]T {
 	result := make(map[K]T)
-	for _, v := range s.source() {
+	for v := range s.seq {
 		result[keyFn(v)] = v
 	}
 	return result
@@ -643,7 +663,7 @@ func ToMap[T any, K comparable](s Stream[T], keyFn func(T) K) map[K]T {
 //	})
 func GroupBy[T any, K comparable](s Stream[T], keyFn func(T) K) map[K][]T {
 	result := make(map[K][]T)
-	for _, v := range s.source() {
+	for v := range s.seq {
 		key := keyFn(v)
 		result[key] = append(result[key], v)
 	}
@@ -666,13 +686,12 @@ func GroupBy[T any, K comparable](s Stream[T], keyFn func(T) K) map[K][]T {
 //	})
 func MapTo[T, R any](s Stream[T], mapper func(T) R) Stream[R] {
 	return Stream[R]{
-		source: func() []R {
-			src := s.source()
-			result := make([]R, len(src))
-			for i, v := range src {
-				result[i] = mapper(v)
+		seq: func(yield func(R) bool) {
+			for v := range s.seq {
+				if !yield(mapper(v)) {
+					return
+				}
 			}
-			return result
 		},
 	}
 }
@@ -693,13 +712,14 @@ func MapTo[T, R any](s Stream[T], mapper func(T) R) Stream[R] {
 //	})
 func FlatMapTo[T, R any](s Stream[T], mapper func(T) []R) Stream[R] {
 	return Stream[R]{
-		source: func() []R {
-			src := s.source()
-			result := make([]R, 0)
-			for _, v := range src {
-				result = append(result, mapper(v)...)
+		seq: func(yield func(R) bool) {
+			for v := range s.seq {
+				for _, r := range mapper(v) {
+					if !yield(r) {
+						return
+					}
+				}
 			}
-			return result
 		},
 	}
 }
@@ -722,7 +742,7 @@ func FlatMapTo[T, R any](s Stream[T], mapper func(T) []R) Stream[R] {
 //	// 6
 func ReduceTo[T, R any](s Stream[T], initial R, accumulator func(R, T) R) R {
 	result := initial
-	for _, v := range s.source() {
+	for v := range s.seq {
 		result = accumulator(result, v)
 	}
 	return result
@@ -733,7 +753,8 @@ func ReduceTo[T, R any](s Stream[T], initial R, accumulator func(R, T) R) R {
 // 返回:
 //   - bool: 如果为空返回 true
 func (s Stream[T]) IsEmpty() bool {
-	return len(s.source()) == 0
+	_, ok := s.First()
+	return !ok
 }
 
 // Concat 连接多个 Stream
@@ -744,7 +765,7 @@ func (s Stream[T]) IsEmpty() bool {
 // 返回:
 //   - Stream[T]: 连接后的 Stream
 //
-// 注意: 结果会被缓存，避免重复计算
+// 注意: 各 Stream 按顺序惰性拉取，下游提前结束时后续 Stream 不会被求值
 //
 // 示例:
 //
@@ -752,12 +773,14 @@ func (s Stream[T]) IsEmpty() bool {
 //	// [1, 2, 3, 4]
 func Concat[T any](streams ...Stream[T]) Stream[T] {
 	return Stream[T]{
-		source: func() []T {
-			var result []T
+		seq: func(yield func(T) bool) {
 			for _, s := range streams {
-				result = append(result, s.source()...)
+				for v := range s.seq {
+					if !yield(v) {
+						return
+					}
+				}
 			}
-			return result
 		},
 	}
 }
@@ -53,6 +53,20 @@ func TestRange_Empty(t *testing.T) {
 	}
 }
 
+func TestCollect_EmptyNotNil(t *testing.T) {
+	cases := map[string][]int{
+		"Of":        Of[int]().Collect(),
+		"FromSlice": FromSlice[int](nil).Collect(),
+		"Filter":    Of(1, 2, 3).Filter(func(n int) bool { return n > 5 }).Collect(),
+		"Range":     Range(5, 0).Collect(),
+	}
+	for name, result := range cases {
+		if result == nil || len(result) != 0 {
+			t.Errorf("%s: expected non-nil empty slice, got %#v", name, result)
+		}
+	}
+}
+
 func TestRepeat(t *testing.T) {
 	result := Repeat("hello", 3).Collect()
 	if len(result) != 3 {
@@ -184,6 +198,26 @@ func TestDropWhile(t *testing.T) {
 	}
 }
 
+func TestStepBy(t *testing.T) {
+	result := Range(0, 10).StepBy(3).Collect()
+	expected := []int{0, 3, 6, 9}
+	if len(result) != len(expected) {
+		t.Fatalf("expected %d elements, got %d", len(expected), len(result))
+	}
+	for i := range result {
+		if result[i] != expected[i] {
+			t.Errorf("index %d: expected %d, got %d", i, expected[i], result[i])
+		}
+	}
+
+	if got := Of(1, 2, 3).StepBy(0).Collect(); len(got) != 3 {
+		t.Errorf("StepBy(0) should keep all elements, got %v", got)
+	}
+	if got := Of[int]().StepBy(2).Collect(); len(got) != 0 {
+		t.Errorf("expected empty, got %v", got)
+	}
+}
+
 func TestReduce(t *testing.T) {
 	sum := Of(1, 2, 3, 4, 5).Reduce(0, func(acc, n int) int { return acc + n })
 	if sum != 15 {
@@ -381,3 +415,50 @@ func TestForEach(t *testing.T) {
 		t.Errorf("expected 6, got %d", sum)
 	}
 }
+
+func TestShortCircuit_First(t *testing.T) {
+	calls := 0
+	v, ok := Range(0, 1_000_000).
+		Map(func(n int) int { calls++; return n * 2 }).
+		Filter(func(n int) bool { return n > 10 }).
+		First()
+	if !ok || v != 12 {
+		t.Errorf("expected 12, got %d (ok=%v)", v, ok)
+	}
+	if calls != 7 {
+		t.Errorf("expected mapper to run 7 times, ran %d", calls)
+	}
+}
+
+func TestShortCircuit_Any(t *testing.T) {
+	calls := 0
+	found := Generate(1_000_000, func(i int) int { calls++; return i }).
+		Any(func(n int) bool { return n == 3 })
+	if !found {
+		t.Error("expected Any to find 3")
+	}
+	if calls != 4 {
+		t.Errorf("expected generator to run 4 times, ran %d", calls)
+	}
+}
+
+func TestShortCircuit_Limit(t *testing.T) {
+	var peeked []int
+	result := Range(0, 1_000_000).
+		Peek(func(n int) { peeked = append(peeked, n) }).
+		Limit(3).
+		Collect()
+	if len(result) != 3 || len(peeked) != 3 {
+		t.Errorf("expected 3 results and 3 upstream calls, got %v / %v", result, peeked)
+	}
+}
+
+func TestStream_Reusable(t *testing.T) {
+	s := Of(1, 2, 3).Map(func(n int) int { return n + 1 })
+	if s.Count() != 3 || s.Count() != 3 {
+		t.Error("stream should be re-evaluable")
+	}
+	if first, _ := s.First(); first != 2 {
+		t.Errorf("expected 2, got %d", first)
+	}
+}
@@ -3,33 +3,87 @@ package stringx
 import (
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
+// ToCamel 转换为小驼峰格式
+//
+// 连续大写的缩写词按一个单词处理，字母和数字以外的字符都是分隔符，支持 Unicode 字母
+//
+// 示例:
+//
+//	stringx.ToCamel("hello_world")  // "helloWorld"
+//	stringx.ToCamel("Hello World")  // "helloWorld"
+//	stringx.ToCamel("HTTPServer")   // "httpServer"
+//	stringx.ToCamel("über_cool")    // "überCool"
+func ToCamel(s string) string {
+	return joinCamel(splitIdentWords(s), false)
+}
+
+// ToPascal 转换为大驼峰格式
+//
+// 示例:
+//
+//	stringx.ToPascal("hello_world")  // "HelloWorld"
+//	stringx.ToPascal("hello-world")  // "HelloWorld"
+//	stringx.ToPascal("HTTPServer")   // "HttpServer"
+func ToPascal(s string) string {
+	return joinCamel(splitIdentWords(s), true)
+}
+
+// ToSnake 转换为蛇形格式
+//
+// 示例:
+//
+//	stringx.ToSnake("HelloWorld")           // "hello_world"
+//	stringx.ToSnake("HTTPServer")           // "http_server"
+//	stringx.ToSnake("getHTTPResponseCode")  // "get_http_response_code"
+//	stringx.ToSnake("user.name")            // "user_name"
+func ToSnake(s string) string {
+	return joinWords(splitIdentWords(s), "_")
+}
+
+// ToScreamingSnake 转换为全大写蛇形格式
+//
+// 示例:
+//
+//	stringx.ToScreamingSnake("HTTPServer")  // "HTTP_SERVER"
+//	stringx.ToScreamingSnake("max.retries") // "MAX_RETRIES"
+func ToScreamingSnake(s string) string {
+	return strings.ToUpper(ToSnake(s))
+}
+
+// ToKebab 转换为短横线格式
+//
+// 示例:
+//
+//	stringx.ToKebab("HelloWorld")   // "hello-world"
+//	stringx.ToKebab("hello_world")  // "hello-world"
+//	stringx.ToKebab("HTTPServer")   // "http-server"
+func ToKebab(s string) string {
+	return joinWords(splitIdentWords(s), "-")
+}
+
+// ToTitle 转换为标题格式（单词首字母大写，空格分隔）
+//
+// 示例:
+//
+//	stringx.ToTitle("hello_world")  // "Hello World"
+//	stringx.ToTitle("helloWorld")   // "Hello World"
+//	stringx.ToTitle("HTTPServer")   // "Http Server"
+func ToTitle(s string) string {
+	return joinTitle(splitIdentWords(s))
+}
+
 // CamelCase 转换为小驼峰格式
 // "hello_world" → "helloWorld"
 // "Hello World" → "helloWorld"
 // "hello-world" → "helloWorld"
+//
+// 只以 "_"、"-"、空格和制表符分隔单词，其他字符保留在单词中；
+// 需要按所有非字母数字字符分隔时使用 ToCamel
 func CamelCase(s string) string {
-	if s == "" {
-		return ""
-	}
-
-	words := splitWords(s)
-	if len(words) == 0 {
-		return ""
-	}
-
-	var builder strings.Builder
-	builder.WriteString(strings.ToLower(words[0]))
-
-	for i := 1; i < len(words); i++ {
-		if len(words[i]) > 0 {
-			builder.WriteString(strings.ToUpper(words[i][:1]))
-			builder.WriteString(strings.ToLower(words[i][1:]))
-		}
-	}
-
-	return builder.String()
+	return joinCamel(splitWords(s), false)
 }
 
 // PascalCase 转换为大驼峰格式
@@ -37,21 +91,7 @@ func CamelCase(s string) string {
 // "hello world" → "HelloWorld"
 // "hello-world" → "HelloWorld"
 func PascalCase(s string) string {
-	if s == "" {
-		return ""
-	}
-
-	words := splitWords(s)
-	var builder strings.Builder
-
-	for _, word := range words {
-		if len(word) > 0 {
-			builder.WriteString(strings.ToUpper(word[:1]))
-			builder.WriteString(strings.ToLower(word[1:]))
-		}
-	}
-
-	return builder.String()
+	return joinCamel(splitWords(s), true)
 }
 
 // SnakeCase 转换为蛇形格式
@@ -81,77 +121,100 @@ func ScreamingSnakeCase(s string) string {
 // "hello_world" → "Hello World"
 // "helloWorld" → "Hello World"
 func TitleCase(s string) string {
-	words := splitWords(s)
-	if len(words) == 0 {
-		return ""
-	}
+	return joinTitle(splitWords(s))
+}
 
-	var builder strings.Builder
-	for i, word := range words {
-		if i > 0 {
-			builder.WriteString(" ")
-		}
-		if len(word) > 0 {
-			builder.WriteString(strings.ToUpper(word[:1]))
-			builder.WriteString(strings.ToLower(word[1:]))
-		}
-	}
+// splitWords 将字符串分割为单词列表，只以 "_"、"-"、空格和制表符作为分隔符
+func splitWords(s string) []string {
+	return splitWordsFunc(s, func(r rune) bool {
+		return r == '_' || r == '-' || r == ' ' || r == '\t'
+	})
+}
 
-	return builder.String()
+// splitIdentWords 将字符串分割为单词列表，字母和数字以外的字符都是分隔符
+func splitIdentWords(s string) []string {
+	return splitWordsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
 }
 
-// splitWords 将字符串分割为单词列表
-// 正确处理 UTF-8 多字节字符
-func splitWords(s string) []string {
+// splitWordsFunc 按 isSep 分隔并按大小写边界将字符串分割为单词列表
+//
+// 分割规则（按 rune 处理，支持 Unicode）:
+//   - isSep 返回 true 的字符是分隔符
+//   - 小写字母或数字后接大写字母时开始新单词: "helloWorld" → hello, World
+//   - 连续大写后接小写时，最后一个大写字母属于新单词: "HTTPServer" → HTTP, Server
+//   - 数字跟随前面的单词: "version2Beta" → version2, Beta
+func splitWordsFunc(s string, isSep func(rune) bool) []string {
 	var words []string
-	var currentWord strings.Builder
-	var prevRune rune
-	var hasPrev bool
+	var current []rune
+
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, string(current))
+			current = current[:0]
+		}
+	}
 
 	for _, r := range s {
-		if r == '_' || r == '-' || r == ' ' || r == '\t' {
-			// 分隔符
-			if currentWord.Len() > 0 {
-				words = append(words, currentWord.String())
-				currentWord.Reset()
+		switch {
+		case isSep(r):
+			flush()
+		case unicode.IsUpper(r):
+			if n := len(current); n > 0 && !unicode.IsUpper(current[n-1]) {
+				flush()
 			}
-			hasPrev = false
-		} else if unicode.IsUpper(r) {
-			// 大写字母：可能是新单词的开始
-			if currentWord.Len() > 0 && hasPrev {
-				// 检查是否是连续大写（如 "XMLParser"）
-				if !unicode.IsUpper(prevRune) {
-					words = append(words, currentWord.String())
-					currentWord.Reset()
-				}
+			current = append(current, r)
+		default:
+			// 缩写词结尾: "HTTPServer" 中遇到 "e" 时，把 "S" 移到新单词
+			if n := len(current); unicode.IsLower(r) && n > 1 &&
+				unicode.IsUpper(current[n-1]) && unicode.IsUpper(current[n-2]) {
+				last := current[n-1]
+				current = current[:n-1]
+				flush()
+				current = append(current, last)
 			}
-			currentWord.WriteRune(r)
-			prevRune = r
-			hasPrev = true
-		} else {
-			// 检查前一个是大写且当前是小写（如 "XMLParser" 中的 "L" 和 "P"）
-			if hasPrev && currentWord.Len() > 1 {
-				str := currentWord.String()
-				runes := []rune(str)
-				lastRune := runes[len(runes)-1]
-				if unicode.IsUpper(lastRune) && unicode.IsLower(r) {
-					// 把最后一个大写字母移到新单词
-					words = append(words, string(runes[:len(runes)-1]))
-					currentWord.Reset()
-					currentWord.WriteRune(lastRune)
-				}
-			}
-			currentWord.WriteRune(r)
-			prevRune = r
-			hasPrev = true
+			current = append(current, r)
 		}
 	}
+	flush()
 
-	if currentWord.Len() > 0 {
-		words = append(words, currentWord.String())
+	return words
+}
+
+// joinCamel 拼接为驼峰格式，upperFirst 为 true 时首个单词也大写开头
+func joinCamel(words []string, upperFirst bool) string {
+	var builder strings.Builder
+	for i, word := range words {
+		if i == 0 && !upperFirst {
+			builder.WriteString(strings.ToLower(word))
+			continue
+		}
+		writeCapitalized(&builder, word)
 	}
+	return builder.String()
+}
 
-	return words
+// joinTitle 拼接为空格分隔、首字母大写的标题格式
+func joinTitle(words []string) string {
+	var builder strings.Builder
+	for i, word := range words {
+		if i > 0 {
+			builder.WriteByte(' ')
+		}
+		writeCapitalized(&builder, word)
+	}
+	return builder.String()
+}
+
+// writeCapitalized 写入首字母大写、其余小写的单词
+func writeCapitalized(builder *strings.Builder, word string) {
+	r, size := utf8.DecodeRuneInString(word)
+	if size == 0 {
+		return
+	}
+	builder.WriteRune(unicode.ToTitle(r))
+	builder.WriteString(strings.ToLower(word[size:]))
 }
 
 // joinWords 用分隔符连接单词（小写）
@@ -162,3 +162,84 @@ func TestSplitWords(t *testing.T) {
 		}
 	}
 }
+
+func TestToCase(t *testing.T) {
+	tests := []struct {
+		input  string
+		camel  string
+		pascal string
+		snake  string
+		kebab  string
+		title  string
+		scream string
+	}{
+		{"HTTPServer", "httpServer", "HttpServer", "http_server", "http-server", "Http Server", "HTTP_SERVER"},
+		{"getHTTPResponseCode", "getHttpResponseCode", "GetHttpResponseCode", "get_http_response_code", "get-http-response-code", "Get Http Response Code", "GET_HTTP_RESPONSE_CODE"},
+		{"userID", "userId", "UserId", "user_id", "user-id", "User Id", "USER_ID"},
+		{"HTTP2Server", "http2Server", "Http2Server", "http2_server", "http2-server", "Http2 Server", "HTTP2_SERVER"},
+		{"version2Beta", "version2Beta", "Version2Beta", "version2_beta", "version2-beta", "Version2 Beta", "VERSION2_BETA"},
+		{"über_cool", "überCool", "ÜberCool", "über_cool", "über-cool", "Über Cool", "ÜBER_COOL"},
+		{"ÉcoleNormale", "écoleNormale", "ÉcoleNormale", "école_normale", "école-normale", "École Normale", "ÉCOLE_NORMALE"},
+		{"user.name", "userName", "UserName", "user_name", "user-name", "User Name", "USER_NAME"},
+		{"用户_名称", "用户名称", "用户名称", "用户_名称", "用户-名称", "用户 名称", "用户_名称"},
+		{"", "", "", "", "", "", ""},
+		{"__", "", "", "", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		if got := ToCamel(tt.input); got != tt.camel {
+			t.Errorf("ToCamel(%q) = %q, want %q", tt.input, got, tt.camel)
+		}
+		if got := ToPascal(tt.input); got != tt.pascal {
+			t.Errorf("ToPascal(%q) = %q, want %q", tt.input, got, tt.pascal)
+		}
+		if got := ToSnake(tt.input); got != tt.snake {
+			t.Errorf("ToSnake(%q) = %q, want %q", tt.input, got, tt.snake)
+		}
+		if got := ToKebab(tt.input); got != tt.kebab {
+			t.Errorf("ToKebab(%q) = %q, want %q", tt.input, got, tt.kebab)
+		}
+		if got := ToTitle(tt.input); got != tt.title {
+			t.Errorf("ToTitle(%q) = %q, want %q", tt.input, got, tt.title)
+		}
+		if got := ToScreamingSnake(tt.input); got != tt.scream {
+			t.Errorf("ToScreamingSnake(%q) = %q, want %q", tt.input, got, tt.scream)
+		}
+	}
+}
+
+func TestCaseLegacySeparators(t *testing.T) {
+	// 原有函数只以 "_"、"-"、空格和制表符分隔，其他字符保留在单词中
+	tests := []struct {
+		input  string
+		camel  string
+		pascal string
+		snake  string
+		kebab  string
+		title  string
+	}{
+		{"a.b", "a.b", "A.b", "a.b", "a.b", "A.b"},
+		{"user.name", "user.name", "User.name", "user.name", "user.name", "User.name"},
+		{"user.firstName", "user.firstName", "User.firstName", "user.first_name", "user.first-name", "User.first Name"},
+		{"a/b_c", "a/bC", "A/bC", "a/b_c", "a/b-c", "A/b C"},
+		{"hello\tworld", "helloWorld", "HelloWorld", "hello_world", "hello-world", "Hello World"},
+	}
+
+	for _, tt := range tests {
+		if got := CamelCase(tt.input); got != tt.camel {
+			t.Errorf("CamelCase(%q) = %q, want %q", tt.input, got, tt.camel)
+		}
+		if got := PascalCase(tt.input); got != tt.pascal {
+			t.Errorf("PascalCase(%q) = %q, want %q", tt.input, got, tt.pascal)
+		}
+		if got := SnakeCase(tt.input); got != tt.snake {
+			t.Errorf("SnakeCase(%q) = %q, want %q", tt.input, got, tt.snake)
+		}
+		if got := KebabCase(tt.input); got != tt.kebab {
+			t.Errorf("KebabCase(%q) = %q, want %q", tt.input, got, tt.kebab)
+		}
+		if got := TitleCase(tt.input); got != tt.title {
+			t.Errorf("TitleCase(%q) = %q, want %q", tt.input, got, tt.title)
+		}
+	}
+}
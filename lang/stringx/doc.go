@@ -11,6 +11,20 @@
 // 通用转换:
 //   - StringToSlice: 字符串转任意类型切片（使用反射）
 //
+// 命名风格转换（正确处理缩写词和 Unicode，如 "HTTPServer" → "http_server"）:
+//   - ToCamel/ToPascal/ToSnake/ToScreamingSnake/ToKebab/ToTitle
+//
+// 命名占位符:
+//   - Format/FormatWith: "Hello {name}" 风格的简单插值，缺失占位符可保留、置空或报错
+//
+// 截断与填充:
+//   - Truncate/TruncateWithSuffix/PadLeft/PadRight/PadCenter: 按字符（rune）计算，不会拆分多字节字符
+//   - Width/TruncateWidth/PadLeftWidth/PadRightWidth/PadCenterWidth: 按显示宽度计算，中文等全角字符宽度为 2
+//
+// 脱敏（按字符计算，不会拆分多字节字符）:
+//   - Mask: 遮盖指定范围的字符
+//   - MaskPhone/MaskEmail/MaskIDCard/MaskBankCard: 常见敏感信息脱敏，只遮盖字母和数字、保留分隔符，可通过 WithMaskChar/WithMaskKeep 配置
+//
 // # 使用示例
 //
 //	import "github.com/hexagon-codes/toolkit/lang/stringx"
@@ -85,6 +99,20 @@
 // General conversions:
 //   - StringToSlice: convert a string to a slice of any type (using reflection)
 //
+// Case conversions (acronym- and Unicode-aware, e.g. "HTTPServer" → "http_server"):
+//   - ToCamel/ToPascal/ToSnake/ToScreamingSnake/ToKebab/ToTitle
+//
+// Named placeholders:
+//   - Format/FormatWith: "Hello {name}" style interpolation; missing keys can be kept, emptied or reported as errors
+//
+// Truncation and padding:
+//   - Truncate/TruncateWithSuffix/PadLeft/PadRight/PadCenter: rune-based, never split multi-byte characters
+//   - Width/TruncateWidth/PadLeftWidth/PadRightWidth/PadCenterWidth: display-width based, full-width (e.g. CJK) characters count as 2
+//
+// Masking (rune-based, never splits multi-byte characters):
+//   - Mask: mask a range of characters
+//   - MaskPhone/MaskEmail/MaskIDCard/MaskBankCard: mask common PII; only letters and digits are masked and separators are kept; configurable via WithMaskChar/WithMaskKeep
+//
 // # Usage Examples
 //
 //	import "github.com/hexagon-codes/toolkit/lang/stringx"
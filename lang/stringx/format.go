@@ -0,0 +1,131 @@
+package stringx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrMissingKey 占位符在参数中不存在（MissingKeyError 策略）
+var ErrMissingKey = errors.New("stringx: missing placeholder key")
+
+// MissingKeyPolicy 占位符缺失时的处理策略
+type MissingKeyPolicy int
+
+const (
+	// MissingKeyLeave 保留原占位符，如 "{name}"（默认）
+	MissingKeyLeave MissingKeyPolicy = iota
+	// MissingKeyEmpty 替换为空字符串
+	MissingKeyEmpty
+	// MissingKeyError 返回 ErrMissingKey
+	MissingKeyError
+)
+
+// Format 使用命名占位符格式化字符串，缺失的占位符原样保留
+//
+// 语法:
+//   - {name}: 替换为 args["name"]，名称由字母、数字、"_"、"."、"-" 组成
+//   - {{ 和 }}: 输出字面量 "{" 和 "}"
+//   - 其他花括号（如 JSON 片段 {"a":1}）原样输出
+//
+// 值的格式化与 fmt.Sprint 相同
+//
+// 示例:
+//
+//	stringx.Format("Hello {name}, you have {count} items", map[string]any{
+//	    "name":  "Alice",
+//	    "count": 3,
+//	})
+//	// "Hello Alice, you have 3 items"
+//
+//	stringx.Format("Hi {name}", nil)  // "Hi {name}"
+func Format(tmpl string, args map[string]any) string {
+	s, _ := FormatWith(tmpl, args, MissingKeyLeave)
+	return s
+}
+
+// FormatWith 使用命名占位符格式化字符串，并指定缺失占位符的处理策略
+//
+// 示例:
+//
+//	s, err := stringx.FormatWith("Hi {name}", nil, stringx.MissingKeyEmpty)  // "Hi ", nil
+//	s, err := stringx.FormatWith("Hi {name}", nil, stringx.MissingKeyError)  // "", ErrMissingKey
+func FormatWith(tmpl string, args map[string]any, policy MissingKeyPolicy) (string, error) {
+	if !strings.ContainsAny(tmpl, "{}") {
+		return tmpl, nil
+	}
+
+	var builder strings.Builder
+	builder.Grow(len(tmpl))
+
+	for i := 0; i < len(tmpl); {
+		c := tmpl[i]
+		switch {
+		case c == '{' && i+1 < len(tmpl) && tmpl[i+1] == '{':
+			builder.WriteByte('{')
+			i += 2
+			continue
+		case c == '}' && i+1 < len(tmpl) && tmpl[i+1] == '}':
+			builder.WriteByte('}')
+			i += 2
+			continue
+		case c != '{':
+			builder.WriteByte(c)
+			i++
+			continue
+		}
+
+		end := strings.IndexByte(tmpl[i+1:], '}')
+		if end < 0 || !isPlaceholderName(tmpl[i+1:i+1+end]) {
+			builder.WriteByte(c)
+			i++
+			continue
+		}
+
+		name := tmpl[i+1 : i+1+end]
+		placeholder := tmpl[i : i+2+end]
+		i += 2 + end
+
+		v, ok := args[name]
+		if !ok {
+			switch policy {
+			case MissingKeyEmpty:
+			case MissingKeyError:
+				return "", fmt.Errorf("%w: %q", ErrMissingKey, name)
+			default:
+				builder.WriteString(placeholder)
+			}
+			continue
+		}
+		writeValue(&builder, v)
+	}
+
+	return builder.String(), nil
+}
+
+// isPlaceholderName 检查是否为合法的占位符名称
+func isPlaceholderName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+			c == '_' || c == '.' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// writeValue 写入占位符的值
+func writeValue(builder *strings.Builder, v any) {
+	switch value := v.(type) {
+	case string:
+		builder.WriteString(value)
+	case fmt.Stringer:
+		builder.WriteString(value.String())
+	default:
+		fmt.Fprint(builder, value)
+	}
+}
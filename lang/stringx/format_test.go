@@ -0,0 +1,63 @@
+package stringx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	args := map[string]any{
+		"name":      "Alice",
+		"count":     3,
+		"user.id":   42,
+		"ratio":     0.5,
+		"empty":     "",
+		"nil_value": nil,
+	}
+
+	tests := []struct {
+		tmpl     string
+		expected string
+	}{
+		{"Hello {name}, you have {count} items", "Hello Alice, you have 3 items"},
+		{"{user.id}/{ratio}", "42/0.5"},
+		{"no placeholders", "no placeholders"},
+		{"missing {unknown} kept", "missing {unknown} kept"},
+		{"escaped {{name}} and }}", "escaped {name} and }"},
+		{`json {"a":1} untouched`, `json {"a":1} untouched`},
+		{"unclosed {name", "unclosed {name"},
+		{"{ name }", "{ name }"},
+		{"[{empty}]", "[]"},
+		{"{nil_value}", "<nil>"},
+		{"中文 {name} 你好", "中文 Alice 你好"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := Format(tt.tmpl, args); got != tt.expected {
+			t.Errorf("Format(%q) = %q, want %q", tt.tmpl, got, tt.expected)
+		}
+	}
+}
+
+func TestFormatWith(t *testing.T) {
+	got, err := FormatWith("Hi {name}!", nil, MissingKeyEmpty)
+	if err != nil || got != "Hi !" {
+		t.Errorf("MissingKeyEmpty = %q, %v, want %q", got, err, "Hi !")
+	}
+
+	got, err = FormatWith("Hi {name}!", nil, MissingKeyLeave)
+	if err != nil || got != "Hi {name}!" {
+		t.Errorf("MissingKeyLeave = %q, %v", got, err)
+	}
+
+	_, err = FormatWith("Hi {name}!", map[string]any{}, MissingKeyError)
+	if !errors.Is(err, ErrMissingKey) {
+		t.Errorf("MissingKeyError error = %v, want ErrMissingKey", err)
+	}
+
+	got, err = FormatWith("Hi {name}!", map[string]any{"name": "Bob"}, MissingKeyError)
+	if err != nil || got != "Hi Bob!" {
+		t.Errorf("FormatWith() = %q, %v", got, err)
+	}
+}
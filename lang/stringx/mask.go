@@ -0,0 +1,154 @@
+package stringx
+
+import (
+	"strings"
+	"unicode"
+)
+
+// MaskOption 脱敏选项
+type MaskOption func(*maskOptions)
+
+type maskOptions struct {
+	char rune
+	head int
+	tail int
+}
+
+// WithMaskChar 设置遮盖字符，默认 '*'
+func WithMaskChar(char rune) MaskOption {
+	return func(o *maskOptions) {
+		o.char = char
+	}
+}
+
+// WithMaskKeep 设置保留的前后字符数，覆盖各函数的默认值
+//
+// 负数视为 0
+func WithMaskKeep(head, tail int) MaskOption {
+	return func(o *maskOptions) {
+		o.head = max(head, 0)
+		o.tail = max(tail, 0)
+	}
+}
+
+// applyMaskOptions 在默认保留长度上应用选项
+func applyMaskOptions(head, tail int, opts []MaskOption) maskOptions {
+	o := maskOptions{char: '*', head: head, tail: tail}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// Mask 将 [start, end) 范围内的字符替换为 char
+//
+// 按字符（rune）计数，不会拆分多字节字符；越界的 start/end 会被裁剪到有效范围，
+// start >= end 时原样返回
+//
+// 示例:
+//
+//	stringx.Mask("13812345678", 3, 7, '*')  // "138****5678"
+//	stringx.Mask("张三丰", 1, 3, '*')         // "张**"
+//	stringx.Mask("abc", 1, 100, '#')        // "a##"
+func Mask(s string, start, end int, char rune) string {
+	runes := []rune(s)
+	start = max(start, 0)
+	end = min(end, len(runes))
+	if start >= end {
+		return s
+	}
+	for i := start; i < end; i++ {
+		runes[i] = char
+	}
+	return string(runes)
+}
+
+// MaskPhone 手机号脱敏，默认保留前 3 位和后 4 位
+//
+// 只遮盖字母和数字，空格、'-'、'+' 等分隔符原样保留
+//
+// 示例:
+//
+//	stringx.MaskPhone("13812345678")    // "138****5678"
+//	stringx.MaskPhone("138-1234-5678")  // "138-****-5678"
+func MaskPhone(s string, opts ...MaskOption) string {
+	return maskKeep(s, applyMaskOptions(3, 4, opts))
+}
+
+// MaskEmail 邮箱脱敏，默认保留用户名的首尾各 1 个字符，域名不变
+//
+// 不含 '@' 时按普通字符串处理
+//
+// 示例:
+//
+//	stringx.MaskEmail("zhangsan@example.com")  // "z******n@example.com"
+//	stringx.MaskEmail("ab@example.com")        // "a*@example.com"
+func MaskEmail(s string, opts ...MaskOption) string {
+	o := applyMaskOptions(1, 1, opts)
+	at := strings.LastIndexByte(s, '@')
+	if at < 0 {
+		return maskKeep(s, o)
+	}
+	return maskKeep(s[:at], o) + s[at:]
+}
+
+// MaskIDCard 身份证号脱敏，默认保留前 6 位（地区码）和后 4 位
+//
+// 示例:
+//
+//	stringx.MaskIDCard("11010119900307123X")  // "110101********123X"
+func MaskIDCard(s string, opts ...MaskOption) string {
+	return maskKeep(s, applyMaskOptions(6, 4, opts))
+}
+
+// MaskBankCard 银行卡号脱敏，默认保留前 6 位（发卡行标识）和后 4 位
+//
+// 分隔卡号的空格会原样保留
+//
+// 示例:
+//
+//	stringx.MaskBankCard("6222021234567890123")      // "622202*********0123"
+//	stringx.MaskBankCard("6222 0212 3456 7890")      // "6222 02** **** 7890"
+func MaskBankCard(s string, opts ...MaskOption) string {
+	return maskKeep(s, applyMaskOptions(6, 4, opts))
+}
+
+// maskKeep 保留前 head 个和后 tail 个有效字符（字母和数字），遮盖中间的有效字符
+//
+// 有效字符数不足 head+tail 时，最多保留一半（优先保留开头），保证短字符串也不会被完整暴露
+func maskKeep(s string, o maskOptions) string {
+	runes := []rune(s)
+	n := 0
+	for _, r := range runes {
+		if isMaskable(r) {
+			n++
+		}
+	}
+	if n == 0 {
+		return s
+	}
+
+	head, tail := o.head, o.tail
+	if head+tail >= n {
+		visible := n / 2
+		head = min(head, visible)
+		tail = min(tail, visible-head)
+	}
+
+	idx := 0
+	for i, r := range runes {
+		if !isMaskable(r) {
+			continue
+		}
+		if idx >= head && idx < n-tail {
+			runes[i] = o.char
+		}
+		idx++
+	}
+	return string(runes)
+}
+
+// isMaskable 判断字符是否参与脱敏（字母和数字，包括中文等 Unicode 字母）
+func isMaskable(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,95 @@
+package stringx
+
+import "testing"
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		input      string
+		start, end int
+		expected   string
+	}{
+		{"13812345678", 3, 7, "138****5678"},
+		{"张三丰", 1, 3, "张**"},
+		{"abc", 1, 100, "a##"},
+		{"abc", -5, 1, "#bc"},
+		{"abc", 2, 1, "abc"},
+		{"", 0, 3, ""},
+	}
+
+	for _, tt := range tests {
+		char := '*'
+		if tt.input == "abc" {
+			char = '#'
+		}
+		if got := Mask(tt.input, tt.start, tt.end, char); got != tt.expected {
+			t.Errorf("Mask(%q, %d, %d) = %q, want %q", tt.input, tt.start, tt.end, got, tt.expected)
+		}
+	}
+}
+
+func TestMaskPhone(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"13812345678", "138****5678"},
+		{"138-1234-5678", "138-****-5678"},
+		{"1234567", "123****"}, // 长度不足时最多保留一半
+		{"12", "1*"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := MaskPhone(tt.input); got != tt.expected {
+			t.Errorf("MaskPhone(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+
+	if got := MaskPhone("13812345678", WithMaskChar('#'), WithMaskKeep(0, 4)); got != "#######5678" {
+		t.Errorf("MaskPhone with options = %q", got)
+	}
+}
+
+func TestMaskEmail(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"zhangsan@example.com", "z******n@example.com"},
+		{"ab@example.com", "a*@example.com"},
+		{"a@example.com", "*@example.com"},
+		{"张三丰@example.com", "张*丰@example.com"},
+		{"not-an-email", "n**-**-****l"},
+	}
+
+	for _, tt := range tests {
+		if got := MaskEmail(tt.input); got != tt.expected {
+			t.Errorf("MaskEmail(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestMaskIDCard(t *testing.T) {
+	if got := MaskIDCard("11010119900307123X"); got != "110101********123X" {
+		t.Errorf("MaskIDCard = %q", got)
+	}
+	if got := MaskIDCard("11010119900307123X", WithMaskKeep(1, 1)); got != "1****************X" {
+		t.Errorf("MaskIDCard with keep = %q", got)
+	}
+}
+
+func TestMaskBankCard(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"6222021234567890123", "622202*********0123"},
+		{"6222 0212 3456 7890", "6222 02** **** 7890"},
+	}
+
+	for _, tt := range tests {
+		if got := MaskBankCard(tt.input); got != tt.expected {
+			t.Errorf("MaskBankCard(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package stringx
+
+import (
+	"strings"
+	"unicode"
+)
+
+// wideRanges 显示宽度为 2 的 Unicode 区间（东亚宽字符、全角字符和常见 emoji）
+var wideRanges = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1100, Hi: 0x115F, Stride: 1}, // 谚文字母
+		{Lo: 0x2E80, Hi: 0x303E, Stride: 1}, // CJK 部首、符号和标点
+		{Lo: 0x3041, Hi: 0x33FF, Stride: 1}, // 平假名、片假名、注音、CJK 兼容
+		{Lo: 0x3400, Hi: 0x4DBF, Stride: 1}, // CJK 扩展 A
+		{Lo: 0x4E00, Hi: 0x9FFF, Stride: 1}, // CJK 统一表意文字
+		{Lo: 0xA000, Hi: 0xA4CF, Stride: 1}, // 彝文
+		{Lo: 0xAC00, Hi: 0xD7A3, Stride: 1}, // 谚文音节
+		{Lo: 0xF900, Hi: 0xFAFF, Stride: 1}, // CJK 兼容表意文字
+		{Lo: 0xFE30, Hi: 0xFE4F, Stride: 1}, // CJK 兼容形式
+		{Lo: 0xFF00, Hi: 0xFF60, Stride: 1}, // 全角 ASCII
+		{Lo: 0xFFE0, Hi: 0xFFE6, Stride: 1}, // 全角符号
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1F300, Hi: 0x1F64F, Stride: 1}, // 符号、emoji 表情
+		{Lo: 0x1F900, Hi: 0x1F9FF, Stride: 1}, // 补充符号和象形文字
+		{Lo: 0x20000, Hi: 0x3FFFD, Stride: 1}, // CJK 扩展 B 及以后
+	},
+}
+
+// RuneWidth 返回字符的显示宽度
+//
+// 规则:
+//   - 中日韩文字、全角字符、常见 emoji: 2
+//   - 组合用字符、零宽字符、控制字符: 0
+//   - 其他: 1
+func RuneWidth(r rune) int {
+	switch {
+	case r == 0 || unicode.IsControl(r):
+		return 0
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case r >= 0xFE00 && r <= 0xFE0F: // 变体选择符
+		return 0
+	case unicode.Is(wideRanges, r):
+		return 2
+	default:
+		return 1
+	}
+}
+
+// Width 返回字符串在等宽终端或表格中的显示宽度
+//
+// 示例:
+//
+//	stringx.Width("hello")   // 5
+//	stringx.Width("你好")     // 4
+//	stringx.Width("Go语言")   // 6
+func Width(s string) int {
+	w := 0
+	for _, r := range s {
+		w += RuneWidth(r)
+	}
+	return w
+}
+
+// TruncateWidth 按显示宽度截断字符串，超出时追加 suffix
+//
+// 参数:
+//   - s: 原字符串
+//   - maxWidth: 最大显示宽度（包含 suffix）
+//   - suffix: 截断后追加的后缀，如 "..." 或 "…"；宽度不小于 maxWidth 时不追加
+//
+// 不会拆分多字节字符；宽字符放不下时结果宽度可能比 maxWidth 小 1
+//
+// 示例:
+//
+//	stringx.TruncateWidth("你好世界", 6, "...")    // "你..."
+//	stringx.TruncateWidth("你好世界", 5, "")       // "你好"
+//	stringx.TruncateWidth("hello", 10, "...")     // "hello"
+func TruncateWidth(s string, maxWidth int, suffix string) string {
+	if maxWidth <= 0 {
+		return ""
+	}
+	if Width(s) <= maxWidth {
+		return s
+	}
+
+	suffixWidth := Width(suffix)
+	if suffixWidth >= maxWidth {
+		suffix = ""
+		suffixWidth = 0
+	}
+	return cutWidth(s, maxWidth-suffixWidth) + suffix
+}
+
+// cutWidth 返回 s 中显示宽度不超过 limit 的最长前缀
+func cutWidth(s string, limit int) string {
+	w := 0
+	for i, r := range s {
+		rw := RuneWidth(r)
+		if w+rw > limit {
+			return s[:i]
+		}
+		w += rw
+	}
+	return s
+}
+
+// PadLeftWidth 按显示宽度左填充字符串
+//
+// 填充字符放不下时（如用宽字符填充奇数宽度），剩余部分用空格补齐
+//
+// 示例:
+//
+//	stringx.PadLeftWidth("你好", 6, " ")  // "  你好"
+func PadLeftWidth(s string, width int, pad string) string {
+	return fillWidth(width-Width(s), pad) + s
+}
+
+// PadRightWidth 按显示宽度右填充字符串，适合对齐包含中文的表格列
+//
+// 示例:
+//
+//	stringx.PadRightWidth("你好", 6, " ")  // "你好  "
+//	stringx.PadRightWidth("ab", 6, " ")    // "ab    "
+func PadRightWidth(s string, width int, pad string) string {
+	return s + fillWidth(width-Width(s), pad)
+}
+
+// PadCenterWidth 按显示宽度居中填充字符串
+//
+// 示例:
+//
+//	stringx.PadCenterWidth("你好", 8, "*")  // "**你好**"
+func PadCenterWidth(s string, width int, pad string) string {
+	total := width - Width(s)
+	if total <= 0 {
+		return s
+	}
+	left := total / 2
+	return fillWidth(left, pad) + s + fillWidth(total-left, pad)
+}
+
+// fillWidth 生成显示宽度为 width 的填充字符串
+func fillWidth(width int, pad string) string {
+	if width <= 0 {
+		return ""
+	}
+	if Width(pad) == 0 {
+		pad = " "
+	}
+
+	var builder strings.Builder
+	w := 0
+	for w < width {
+		for _, r := range pad {
+			rw := RuneWidth(r)
+			if w+rw > width {
+				// 填充字符放不下，用空格补齐
+				builder.WriteString(strings.Repeat(" ", width-w))
+				return builder.String()
+			}
+			builder.WriteRune(r)
+			w += rw
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,88 @@
+package stringx
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestWidth(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"", 0},
+		{"hello", 5},
+		{"你好", 4},
+		{"Go语言", 6},
+		{"こんにちは", 10},
+		{"한국어", 6},
+		{"ＡＢ", 4}, // 全角字母
+		{"é", 1}, // e + 组合重音符
+		{"😀", 2},
+		{"a\tb", 2}, // 控制字符宽度为 0
+	}
+
+	for _, tt := range tests {
+		if got := Width(tt.input); got != tt.expected {
+			t.Errorf("Width(%q) = %d, want %d", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestTruncateWidth(t *testing.T) {
+	tests := []struct {
+		input    string
+		maxWidth int
+		suffix   string
+		expected string
+	}{
+		{"hello", 10, "...", "hello"},
+		{"hello world", 8, "...", "hello..."},
+		{"你好世界", 8, "...", "你好世界"},
+		{"你好世界", 6, "...", "你..."},
+		{"你好世界", 7, "…", "你好世…"},
+		{"你好世界", 5, "", "你好"},
+		{"Go语言编程", 5, "", "Go语"},
+		{"你好", 2, "...", "你"},
+		{"hello", 0, "...", ""},
+	}
+
+	for _, tt := range tests {
+		got := TruncateWidth(tt.input, tt.maxWidth, tt.suffix)
+		if got != tt.expected {
+			t.Errorf("TruncateWidth(%q, %d, %q) = %q, want %q", tt.input, tt.maxWidth, tt.suffix, got, tt.expected)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("TruncateWidth(%q, %d, %q) produced invalid UTF-8", tt.input, tt.maxWidth, tt.suffix)
+		}
+	}
+}
+
+func TestPadWidth(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(string, int, string) string
+		input    string
+		width    int
+		pad      string
+		expected string
+	}{
+		{"left cjk", PadLeftWidth, "你好", 6, " ", "  你好"},
+		{"right cjk", PadRightWidth, "你好", 6, " ", "你好  "},
+		{"right ascii", PadRightWidth, "ab", 6, "-", "ab----"},
+		{"center cjk", PadCenterWidth, "你好", 8, "*", "**你好**"},
+		{"center odd", PadCenterWidth, "你", 5, "*", "*你**"},
+		{"wide pad", PadRightWidth, "a", 4, "中", "a中 "},
+		{"empty pad", PadLeftWidth, "a", 3, "", "  a"},
+		{"already wide", PadRightWidth, "你好世界", 4, " ", "你好世界"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fn(tt.input, tt.width, tt.pad)
+			if got != tt.expected {
+				t.Errorf("got %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
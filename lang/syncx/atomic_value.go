@@ -0,0 +1,161 @@
+package syncx
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Value 泛型原子值，基于 atomic.Pointer 实现，无需类型断言
+//
+// 与 atomic.Value 相比，Value[T] 类型安全，且允许存储 nil 接口和不同的具体类型。
+// 零值可直接使用，未存储时 Load 返回零值。不可复制
+//
+// 示例:
+//
+//	var cfg syncx.Value[*Config]
+//	cfg.Store(loadConfig())
+//	c := cfg.Load()  // 热路径无锁读取
+type Value[T any] struct {
+	p atomic.Pointer[T]
+}
+
+// NewValue 创建并初始化原子值
+func NewValue[T any](v T) *Value[T] {
+	var av Value[T]
+	av.Store(v)
+	return &av
+}
+
+// Load 读取值，未存储时返回零值
+func (v *Value[T]) Load() T {
+	if p := v.p.Load(); p != nil {
+		return *p
+	}
+	var zero T
+	return zero
+}
+
+// LoadOk 读取值，并返回是否已存储过
+func (v *Value[T]) LoadOk() (T, bool) {
+	if p := v.p.Load(); p != nil {
+		return *p, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Store 存储值
+func (v *Value[T]) Store(val T) {
+	v.p.Store(&val)
+}
+
+// Swap 存储新值并返回旧值（未存储时为零值）
+func (v *Value[T]) Swap(val T) T {
+	if p := v.p.Swap(&val); p != nil {
+		return *p
+	}
+	var zero T
+	return zero
+}
+
+// CompareAndSwap 当前值等于 old 时存储 new 并返回 true
+//
+// 按值比较（==），未存储时当前值视为零值。T 的动态类型不可比较时 panic，与 atomic.Value 一致
+func (v *Value[T]) CompareAndSwap(old, new T) bool {
+	for {
+		p := v.p.Load()
+		var cur T
+		if p != nil {
+			cur = *p
+		}
+		if any(cur) != any(old) {
+			return false
+		}
+		if v.p.CompareAndSwap(p, &new) {
+			return true
+		}
+		// 期间被其他 goroutine 修改，重新比较
+	}
+}
+
+// Update 使用 fn 原子地更新值并返回新值
+//
+// fn 可能因并发修改被多次调用，应为无副作用的纯函数
+//
+// 示例:
+//
+//	counter.Update(func(old Stats) Stats {
+//	    old.Requests++
+//	    return old
+//	})
+func (v *Value[T]) Update(fn func(old T) T) T {
+	for {
+		p := v.p.Load()
+		var cur T
+		if p != nil {
+			cur = *p
+		}
+		next := fn(cur)
+		if v.p.CompareAndSwap(p, &next) {
+			return next
+		}
+	}
+}
+
+// LazyInit 延迟初始化的原子快照，首次 Get 时执行 init，之后为无锁的原子读取
+//
+// 与 Lazy 不同，初始化后仍可通过 Store 替换快照（如配置热更新），
+// 所有读取都是一次原子加载，适合热路径。并发安全
+//
+// 示例:
+//
+//	var cfg = syncx.NewLazyInit(loadConfig)
+//
+//	func handler() {
+//	    c := cfg.Get()  // 首次调用时加载，之后无锁读取
+//	}
+//
+//	func onReload(newCfg *Config) {
+//	    cfg.Store(newCfg)
+//	}
+type LazyInit[T any] struct {
+	mu   sync.Mutex
+	init func() T
+	p    atomic.Pointer[T]
+}
+
+// NewLazyInit 创建延迟初始化的原子快照
+func NewLazyInit[T any](init func() T) *LazyInit[T] {
+	return &LazyInit[T]{init: init}
+}
+
+// Get 返回当前快照，未初始化时执行 init（并发调用只执行一次）
+func (l *LazyInit[T]) Get() T {
+	if p := l.p.Load(); p != nil {
+		return *p
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if p := l.p.Load(); p != nil {
+		return *p
+	}
+	var v T
+	if l.init != nil {
+		v = l.init()
+	}
+	l.p.Store(&v)
+	return v
+}
+
+// Store 替换快照，在首次 Get 之前调用时不再执行 init
+func (l *LazyInit[T]) Store(v T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.p.Store(&v)
+}
+
+// IsInitialized 检查是否已初始化，不会触发初始化
+func (l *LazyInit[T]) IsInitialized() bool {
+	return l.p.Load() != nil
+}
@@ -0,0 +1,107 @@
+package syncx
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestValue_Basic(t *testing.T) {
+	var v Value[string]
+	if got := v.Load(); got != "" {
+		t.Errorf("expected zero value, got %q", got)
+	}
+	if _, ok := v.LoadOk(); ok {
+		t.Error("expected LoadOk false before Store")
+	}
+
+	v.Store("a")
+	if got, ok := v.LoadOk(); !ok || got != "a" {
+		t.Errorf("expected a, got %q, %v", got, ok)
+	}
+	if old := v.Swap("b"); old != "a" || v.Load() != "b" {
+		t.Errorf("Swap returned %q, current %q", old, v.Load())
+	}
+
+	if NewValue(42).Load() != 42 {
+		t.Error("NewValue should store initial value")
+	}
+}
+
+func TestValue_CompareAndSwap(t *testing.T) {
+	var v Value[int]
+	if !v.CompareAndSwap(0, 1) {
+		t.Error("CAS from zero value should succeed on empty Value")
+	}
+	if v.CompareAndSwap(0, 2) {
+		t.Error("CAS with wrong old should fail")
+	}
+	if !v.CompareAndSwap(1, 2) || v.Load() != 2 {
+		t.Errorf("CAS 1->2 failed, current %d", v.Load())
+	}
+
+	// 接口类型允许存储不同的具体类型和 nil
+	var e Value[error]
+	errA := errors.New("a")
+	e.Store(errA)
+	if !e.CompareAndSwap(errA, nil) || e.Load() != nil {
+		t.Error("CAS to nil interface should succeed")
+	}
+}
+
+func TestValue_Update(t *testing.T) {
+	var v Value[int]
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Go(func() {
+			for range 100 {
+				v.Update(func(old int) int { return old + 1 })
+			}
+		})
+	}
+	wg.Wait()
+	if got := v.Load(); got != 5000 {
+		t.Errorf("expected 5000, got %d", got)
+	}
+}
+
+func TestLazyInit(t *testing.T) {
+	var calls atomic.Int32
+	l := NewLazyInit(func() map[string]int {
+		calls.Add(1)
+		return map[string]int{"v": 1}
+	})
+	if l.IsInitialized() {
+		t.Error("should not be initialized before Get")
+	}
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			if l.Get()["v"] != 1 {
+				t.Error("unexpected snapshot")
+			}
+		})
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Errorf("init should run once, got %d", calls.Load())
+	}
+
+	l.Store(map[string]int{"v": 2})
+	if l.Get()["v"] != 2 {
+		t.Error("Store should replace the snapshot")
+	}
+}
+
+func TestLazyInit_StoreBeforeGet(t *testing.T) {
+	l := NewLazyInit(func() int {
+		t.Error("init should not run after Store")
+		return 1
+	})
+	l.Store(5)
+	if !l.IsInitialized() || l.Get() != 5 {
+		t.Errorf("expected 5, got %d", l.Get())
+	}
+}
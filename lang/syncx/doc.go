@@ -11,6 +11,29 @@
 //   - Pool: sync.Pool 的简单封装
 //   - TypedPool: 类型安全的对象池（泛型）
 //
+// 并发控制:
+//   - Semaphore: 基于 channel 的信号量
+//   - WeightedSemaphore: 带权重的 FIFO 信号量，支持 AcquireN 和 context 取消
+//   - WithSemaphore: 获取令牌后执行函数，自动释放
+//   - KeyedMutex/KeyedRWMutex: 按 key 加锁，空闲 key 自动清理
+//
+// 协调与通知:
+//   - WaitContext/WaitTimeout/WaitGroup: 支持 context 和超时的等待
+//   - Notifier: 一次性通知，唤醒所有等待者
+//   - Broadcaster: 可重复使用的广播，可与 select 组合
+//
+// 并发 Map:
+//   - ConcurrentMap: 基于 sync.Map 的泛型封装
+//   - RWMap: 基于读写锁的泛型 Map，支持分片和 Compute 原子更新，零值可用
+//
+// 一次性初始化:
+//   - Once/OnceErr/OnceValue/OnceValues: 泛型版 sync.Once
+//   - OnceE: 只缓存成功结果，失败可重试，支持 Reset（适合客户端连接初始化）
+//
+// 原子值:
+//   - Value: 基于 atomic.Pointer 的泛型原子值，无需类型断言
+//   - LazyInit: 延迟初始化的原子快照，热路径无锁读取，可通过 Store 热更新
+//
 // # 使用示例
 //
 //	import "github.com/hexagon-codes/toolkit/lang/syncx"
@@ -75,6 +98,29 @@
 //   - Pool: a simple wrapper around sync.Pool
 //   - TypedPool: a type-safe object pool (generics)
 //
+// Concurrency limiting:
+//   - Semaphore: a channel-based semaphore
+//   - WeightedSemaphore: a weighted FIFO semaphore with AcquireN and context cancellation
+//   - WithSemaphore: runs a function while holding a token and releases it afterwards
+//   - KeyedMutex/KeyedRWMutex: per-key locks with automatic cleanup of idle keys
+//
+// Coordination and notification:
+//   - WaitContext/WaitTimeout/WaitGroup: waiting with context and timeout support
+//   - Notifier: a one-shot notification that wakes all waiters
+//   - Broadcaster: a reusable broadcast usable in select
+//
+// Concurrent maps:
+//   - ConcurrentMap: a generic wrapper around sync.Map
+//   - RWMap: a generic RWMutex-protected map with optional sharding and atomic Compute; the zero value is ready to use
+//
+// One-time initialization:
+//   - Once/OnceErr/OnceValue/OnceValues: generic versions of sync.Once
+//   - OnceE: caches only success, retries after failure and supports Reset (for client connection setup)
+//
+// Atomic values:
+//   - Value: a generic atomic value built on atomic.Pointer, no type assertions needed
+//   - LazyInit: a lazily initialized atomic snapshot with lock-free reads, replaceable via Store
+//
 // # Usage Examples
 //
 //	import "github.com/hexagon-codes/toolkit/lang/syncx"
@@ -0,0 +1,191 @@
+package syncx
+
+import "sync"
+
+// KeyedMutex 按 key 加锁的互斥锁
+//
+// 不同 key 之间互不阻塞，相同 key 串行执行。每个 key 的锁按引用计数管理，
+// 没有持有者和等待者时自动删除，内存占用只与同时活跃的 key 数量相关
+//
+// 示例:
+//
+//	var km syncx.KeyedMutex[int64]
+//	km.Lock(orderID)
+//	defer km.Unlock(orderID)
+//	// 同一订单的处理串行执行
+type KeyedMutex[K comparable] struct {
+	mu    sync.Mutex
+	locks map[K]*keyedLock[sync.Mutex]
+}
+
+// keyedLock 单个 key 的锁和引用计数（持有者 + 等待者）
+type keyedLock[M any] struct {
+	mu  M
+	ref int
+}
+
+// acquireEntry 获取 key 对应的锁并增加引用计数，调用方需持有 mu
+func acquireEntry[K comparable, M any](locks *map[K]*keyedLock[M], key K) *keyedLock[M] {
+	if *locks == nil {
+		*locks = make(map[K]*keyedLock[M])
+	}
+	l, ok := (*locks)[key]
+	if !ok {
+		l = &keyedLock[M]{}
+		(*locks)[key] = l
+	}
+	l.ref++
+	return l
+}
+
+// releaseEntry 减少引用计数，归零时删除，调用方需持有 mu
+func releaseEntry[K comparable, M any](locks map[K]*keyedLock[M], key K) *keyedLock[M] {
+	l, ok := locks[key]
+	if !ok {
+		panic("syncx: unlock of unlocked key")
+	}
+	l.ref--
+	if l.ref == 0 {
+		delete(locks, key)
+	}
+	return l
+}
+
+// Lock 锁定 key，key 已被锁定时阻塞
+func (m *KeyedMutex[K]) Lock(key K) {
+	m.mu.Lock()
+	l := acquireEntry(&m.locks, key)
+	m.mu.Unlock()
+
+	l.mu.Lock()
+}
+
+// TryLock 尝试锁定 key（非阻塞）
+//
+// 返回:
+//   - bool: 如果锁定成功返回 true
+func (m *KeyedMutex[K]) TryLock(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	l := acquireEntry(&m.locks, key)
+	if l.mu.TryLock() {
+		return true
+	}
+	releaseEntry(m.locks, key)
+	return false
+}
+
+// Unlock 解锁 key
+//
+// 注意: key 未被锁定时 panic
+func (m *KeyedMutex[K]) Unlock(key K) {
+	m.mu.Lock()
+	l := releaseEntry(m.locks, key)
+	m.mu.Unlock()
+
+	l.mu.Unlock()
+}
+
+// WithLock 锁定 key 后执行 fn，执行完毕后解锁
+//
+// 示例:
+//
+//	km.WithLock(userID, func() {
+//	    updateBalance(userID)
+//	})
+func (m *KeyedMutex[K]) WithLock(key K, fn func()) {
+	m.Lock(key)
+	defer m.Unlock(key)
+	fn()
+}
+
+// Len 返回当前被持有或等待的 key 数量
+func (m *KeyedMutex[K]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.locks)
+}
+
+// KeyedRWMutex 按 key 加锁的读写锁
+//
+// 与 KeyedMutex 相同，空闲的 key 自动删除
+//
+// 示例:
+//
+//	var km syncx.KeyedRWMutex[string]
+//	km.RLock(path)
+//	defer km.RUnlock(path)
+type KeyedRWMutex[K comparable] struct {
+	mu    sync.Mutex
+	locks map[K]*keyedLock[sync.RWMutex]
+}
+
+// Lock 以写模式锁定 key
+func (m *KeyedRWMutex[K]) Lock(key K) {
+	m.mu.Lock()
+	l := acquireEntry(&m.locks, key)
+	m.mu.Unlock()
+
+	l.mu.Lock()
+}
+
+// TryLock 尝试以写模式锁定 key（非阻塞）
+func (m *KeyedRWMutex[K]) TryLock(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	l := acquireEntry(&m.locks, key)
+	if l.mu.TryLock() {
+		return true
+	}
+	releaseEntry(m.locks, key)
+	return false
+}
+
+// Unlock 解除 key 的写锁
+func (m *KeyedRWMutex[K]) Unlock(key K) {
+	m.mu.Lock()
+	l := releaseEntry(m.locks, key)
+	m.mu.Unlock()
+
+	l.mu.Unlock()
+}
+
+// RLock 以读模式锁定 key
+func (m *KeyedRWMutex[K]) RLock(key K) {
+	m.mu.Lock()
+	l := acquireEntry(&m.locks, key)
+	m.mu.Unlock()
+
+	l.mu.RLock()
+}
+
+// TryRLock 尝试以读模式锁定 key（非阻塞）
+func (m *KeyedRWMutex[K]) TryRLock(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	l := acquireEntry(&m.locks, key)
+	if l.mu.TryRLock() {
+		return true
+	}
+	releaseEntry(m.locks, key)
+	return false
+}
+
+// RUnlock 解除 key 的读锁
+func (m *KeyedRWMutex[K]) RUnlock(key K) {
+	m.mu.Lock()
+	l := releaseEntry(m.locks, key)
+	m.mu.Unlock()
+
+	l.mu.RUnlock()
+}
+
+// Len 返回当前被持有或等待的 key 数量
+func (m *KeyedRWMutex[K]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.locks)
+}
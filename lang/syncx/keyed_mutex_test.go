@@ -0,0 +1,134 @@
+package syncx
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestKeyedMutex_SameKeySerialized(t *testing.T) {
+	var km KeyedMutex[string]
+	var wg sync.WaitGroup
+	counter := 0
+
+	for range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			km.WithLock("k", func() {
+				counter++ // 无锁竞争时 -race 会报错
+			})
+		}()
+	}
+	wg.Wait()
+
+	if counter != 100 {
+		t.Errorf("expected 100, got %d", counter)
+	}
+	if km.Len() != 0 {
+		t.Errorf("idle keys should be cleaned up, got %d", km.Len())
+	}
+}
+
+func TestKeyedMutex_DifferentKeysIndependent(t *testing.T) {
+	var km KeyedMutex[int]
+	km.Lock(1)
+	defer km.Unlock(1)
+
+	done := make(chan struct{})
+	go func() {
+		km.Lock(2)
+		km.Unlock(2)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("different key should not be blocked")
+	}
+}
+
+func TestKeyedMutex_TryLock(t *testing.T) {
+	var km KeyedMutex[string]
+	if !km.TryLock("a") {
+		t.Fatal("TryLock on free key should succeed")
+	}
+	if km.TryLock("a") {
+		t.Error("TryLock on held key should fail")
+	}
+	if km.Len() != 1 {
+		t.Errorf("failed TryLock should not leak entries, got %d", km.Len())
+	}
+	km.Unlock("a")
+	if km.Len() != 0 {
+		t.Errorf("expected 0 keys, got %d", km.Len())
+	}
+}
+
+func TestKeyedMutex_UnlockUnlockedPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	var km KeyedMutex[string]
+	km.Unlock("missing")
+}
+
+func TestKeyedRWMutex(t *testing.T) {
+	var km KeyedRWMutex[string]
+
+	km.RLock("a")
+	if !km.TryRLock("a") {
+		t.Error("multiple readers should be allowed")
+	}
+	if km.TryLock("a") {
+		t.Error("writer should be blocked by readers")
+	}
+	km.RUnlock("a")
+	km.RUnlock("a")
+
+	if !km.TryLock("a") {
+		t.Fatal("writer should acquire after readers release")
+	}
+	if km.TryRLock("a") {
+		t.Error("reader should be blocked by writer")
+	}
+	km.Unlock("a")
+
+	if km.Len() != 0 {
+		t.Errorf("idle keys should be cleaned up, got %d", km.Len())
+	}
+}
+
+func TestKeyedRWMutex_Concurrent(t *testing.T) {
+	var km KeyedRWMutex[int]
+	var wg sync.WaitGroup
+	values := make([]int, 4)
+
+	for i := range 40 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			key := i % 4
+			if i%2 == 0 {
+				km.Lock(key)
+				values[key]++
+				km.Unlock(key)
+			} else {
+				km.RLock(key)
+				_ = values[key]
+				km.RUnlock(key)
+			}
+		}()
+	}
+	wg.Wait()
+
+	total := 0
+	for _, v := range values {
+		total += v
+	}
+	if total != 20 {
+		t.Errorf("expected 20 writes, got %d", total)
+	}
+}
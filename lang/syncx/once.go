@@ -74,6 +74,32 @@ func OnceValue[T any](fn func() T) func() T {
 	}
 }
 
+// OnceValues 创建一个只执行一次的函数，返回两个值
+//
+// 参数:
+//   - fn: 要执行的函数
+//
+// 返回:
+//   - func() (T1, T2): 包装后的函数，多次调用返回相同结果
+//
+// 示例:
+//
+//	getEndpoint := syncx.OnceValues(func() (string, int) {
+//	    return resolveHost(), resolvePort()
+//	})
+//	host, port := getEndpoint()
+func OnceValues[T1, T2 any](fn func() (T1, T2)) func() (T1, T2) {
+	var once sync.Once
+	var v1 T1
+	var v2 T2
+	return func() (T1, T2) {
+		once.Do(func() {
+			v1, v2 = fn()
+		})
+		return v1, v2
+	}
+}
+
 // OnceValueErr 创建一个只执行一次的函数（可能返回错误）
 //
 // 参数:
@@ -179,3 +205,92 @@ func (o *OnceErr[T]) Value() (T, error, bool) {
 func (o *OnceErr[T]) IsInitialized() bool {
 	return o.initialized.Load()
 }
+
+// OnceE 可重试的一次性初始化，只缓存成功的结果
+//
+// 与 OnceErr 不同，fn 返回错误时不会缓存，下次调用 Do 会重新执行；
+// 成功后的值可通过 Reset 丢弃，用于连接断开后重建客户端等场景。
+// 零值可直接使用，所有方法并发安全
+//
+// 示例:
+//
+//	var client syncx.OnceE[*redis.Client]
+//	c, err := client.Do(func() (*redis.Client, error) {
+//	    return connectRedis()  // 失败后下次调用会重试
+//	})
+type OnceE[T any] struct {
+	mu    sync.Mutex
+	value atomic.Pointer[T] // 非 nil 表示已初始化，Reset 与并发读之间无数据竞争
+}
+
+// Do 执行初始化函数，直到成功一次为止
+//
+// 参数:
+//   - fn: 初始化函数
+//
+// 返回:
+//   - T: 初始化的值（失败时为零值）
+//   - error: 本次执行的错误，已初始化时为 nil
+//
+// 注意: 并发调用时同一时刻只有一个 fn 在执行，其他调用等待其结果；
+// fn 失败时等待中的调用会依次重试
+func (o *OnceE[T]) Do(fn func() (T, error)) (T, error) {
+	if p := o.value.Load(); p != nil {
+		return *p, nil
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if p := o.value.Load(); p != nil {
+		return *p, nil
+	}
+	v, err := fn()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	o.value.Store(&v)
+	return v, nil
+}
+
+// Value 返回已初始化的值
+//
+// 返回:
+//   - T: 值
+//   - bool: 是否已初始化
+func (o *OnceE[T]) Value() (T, bool) {
+	if p := o.value.Load(); p != nil {
+		return *p, true
+	}
+	var zero T
+	return zero, false
+}
+
+// IsInitialized 检查是否已初始化
+func (o *OnceE[T]) IsInitialized() bool {
+	return o.value.Load() != nil
+}
+
+// Reset 丢弃已初始化的值，下次调用 Do 重新执行初始化
+//
+// 返回:
+//   - T: 被丢弃的值，调用方可据此释放资源（如关闭连接）
+//   - bool: 重置前是否已初始化
+//
+// 注意: 此方法是并发安全的，会等待正在执行的 Do 完成
+//
+// 示例:
+//
+//	if old, ok := client.Reset(); ok {
+//	    old.Close()
+//	}
+func (o *OnceE[T]) Reset() (T, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if p := o.value.Swap(nil); p != nil {
+		return *p, true
+	}
+	var zero T
+	return zero, false
+}
@@ -0,0 +1,291 @@
+package syncx
+
+import (
+	"hash/maphash"
+	"sync"
+)
+
+// RWMap 基于读写锁的泛型并发安全 Map
+//
+// 与基于 sync.Map 的 ConcurrentMap 相比，RWMap 直接存储类型化的值，写入性能稳定，
+// 适合读写混合的场景。可通过 NewShardedRWMap 按 key 哈希分片，降低锁竞争
+//
+// 零值可直接使用（等同于 NewRWMap，单分片）。不可复制
+//
+// 示例:
+//
+//	m := syncx.NewShardedRWMap[string, *User](16)
+//	m.Set("u1", user)
+//	u, ok := m.Get("u1")
+type RWMap[K comparable, V any] struct {
+	once   sync.Once
+	shards []*rwShard[K, V]
+	mask   uint64
+	seed   maphash.Seed
+}
+
+type rwShard[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  map[K]V
+}
+
+// NewRWMap 创建单分片的 RWMap
+//
+// 示例:
+//
+//	m := syncx.NewRWMap[string, int]()
+func NewRWMap[K comparable, V any]() *RWMap[K, V] {
+	return NewShardedRWMap[K, V](1)
+}
+
+// NewShardedRWMap 创建分片的 RWMap
+//
+// 参数:
+//   - shards: 分片数，向上取整为 2 的幂，<= 0 时按 1 处理
+//
+// 示例:
+//
+//	m := syncx.NewShardedRWMap[string, int](32)
+func NewShardedRWMap[K comparable, V any](shards int) *RWMap[K, V] {
+	m := &RWMap[K, V]{}
+	m.once.Do(func() { m.initShards(shards) })
+	return m
+}
+
+// initShards 创建分片，分片数向上取整为 2 的幂
+func (m *RWMap[K, V]) initShards(shards int) {
+	n := 1
+	for n < shards {
+		n <<= 1
+	}
+	m.shards = make([]*rwShard[K, V], n)
+	m.mask = uint64(n - 1)
+	m.seed = maphash.MakeSeed()
+	for i := range m.shards {
+		m.shards[i] = &rwShard[K, V]{m: make(map[K]V)}
+	}
+}
+
+// allShards 返回所有分片，零值 RWMap 首次使用时初始化为单分片
+func (m *RWMap[K, V]) allShards() []*rwShard[K, V] {
+	m.once.Do(func() { m.initShards(1) })
+	return m.shards
+}
+
+// shard 返回 key 所在的分片
+func (m *RWMap[K, V]) shard(key K) *rwShard[K, V] {
+	shards := m.allShards()
+	if m.mask == 0 {
+		return shards[0]
+	}
+	return shards[maphash.Comparable(m.seed, key)&m.mask]
+}
+
+// Get 获取指定键的值
+//
+// 示例:
+//
+//	value, ok := m.Get("key")
+func (m *RWMap[K, V]) Get(key K) (V, bool) {
+	s := m.shard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.m[key]
+	return v, ok
+}
+
+// Has 检查键是否存在
+func (m *RWMap[K, V]) Has(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Set 存储键值对
+//
+// 示例:
+//
+//	m.Set("key", 1)
+func (m *RWMap[K, V]) Set(key K, value V) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m[key] = value
+}
+
+// Delete 删除指定键，返回被删除的值和键是否存在
+//
+// 示例:
+//
+//	old, ok := m.Delete("key")
+func (m *RWMap[K, V]) Delete(key K) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.m[key]
+	if ok {
+		delete(s.m, key)
+	}
+	return v, ok
+}
+
+// GetOrSet 获取值，如果不存在则存储 value
+//
+// 返回:
+//   - V: 实际的值（已存在的值或新存储的值）
+//   - bool: 如果值是已存在的返回 true，新存储的返回 false
+//
+// 示例:
+//
+//	actual, loaded := m.GetOrSet("key", 1)
+func (m *RWMap[K, V]) GetOrSet(key K, value V) (V, bool) {
+	s := m.shard(key)
+	s.mu.RLock()
+	if v, ok := s.m[key]; ok {
+		s.mu.RUnlock()
+		return v, true
+	}
+	s.mu.RUnlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.m[key]; ok {
+		return v, true
+	}
+	s.m[key] = value
+	return value, false
+}
+
+// GetOrSetFunc 获取值，如果不存在则调用 fn 计算并存储
+//
+// fn 在分片写锁内执行，同一个 key 只会计算一次；fn 中不能再访问同一个 RWMap
+//
+// 示例:
+//
+//	conn, loaded := m.GetOrSetFunc(addr, func() *Conn {
+//	    return dial(addr)
+//	})
+func (m *RWMap[K, V]) GetOrSetFunc(key K, fn func() V) (V, bool) {
+	s := m.shard(key)
+	s.mu.RLock()
+	if v, ok := s.m[key]; ok {
+		s.mu.RUnlock()
+		return v, true
+	}
+	s.mu.RUnlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.m[key]; ok {
+		return v, true
+	}
+	v := fn()
+	s.m[key] = v
+	return v, false
+}
+
+// Compute 原子地读取并修改指定键的值
+//
+// fn 接收当前值和键是否存在，返回新值和是否保留；keep 为 false 时删除该键。
+// fn 在分片写锁内执行，fn 中不能再访问同一个 RWMap
+//
+// 返回:
+//   - V: 计算后的值（删除时为 fn 返回的值）
+//   - bool: 计算后键是否存在
+//
+// 示例:
+//
+//	// 计数器，减到 0 时删除
+//	m.Compute("k", func(old int, loaded bool) (int, bool) {
+//	    return old - 1, old-1 > 0
+//	})
+func (m *RWMap[K, V]) Compute(key K, fn func(old V, loaded bool) (value V, keep bool)) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old, loaded := s.m[key]
+	v, keep := fn(old, loaded)
+	if keep {
+		s.m[key] = v
+	} else if loaded {
+		delete(s.m, key)
+	}
+	return v, keep
+}
+
+// Range 遍历所有键值对
+//
+// 按分片复制快照后调用 fn，fn 中可以安全地读写同一个 RWMap，
+// 遍历期间的修改不一定可见。fn 返回 false 停止遍历
+//
+// 示例:
+//
+//	m.Range(func(key string, value int) bool {
+//	    fmt.Printf("%s: %d\n", key, value)
+//	    return true
+//	})
+func (m *RWMap[K, V]) Range(fn func(K, V) bool) {
+	type entry struct {
+		k K
+		v V
+	}
+	var entries []entry
+	for _, s := range m.allShards() {
+		s.mu.RLock()
+		entries = entries[:0]
+		for k, v := range s.m {
+			entries = append(entries, entry{k, v})
+		}
+		s.mu.RUnlock()
+
+		for _, e := range entries {
+			if !fn(e.k, e.v) {
+				return
+			}
+		}
+	}
+}
+
+// Len 返回元素数量
+func (m *RWMap[K, V]) Len() int {
+	n := 0
+	for _, s := range m.allShards() {
+		s.mu.RLock()
+		n += len(s.m)
+		s.mu.RUnlock()
+	}
+	return n
+}
+
+// Clear 清空 Map
+func (m *RWMap[K, V]) Clear() {
+	for _, s := range m.allShards() {
+		s.mu.Lock()
+		clear(s.m)
+		s.mu.Unlock()
+	}
+}
+
+// Keys 返回所有键
+//
+// 注意: 在高并发场景下，返回的键可能与当前状态不完全一致
+func (m *RWMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	m.Range(func(k K, _ V) bool {
+		keys = append(keys, k)
+		return true
+	})
+	return keys
+}
+
+// ToMap 转换为普通 map
+//
+// 注意: 在高并发场景下，返回的 map 可能与当前状态不完全一致
+func (m *RWMap[K, V]) ToMap() map[K]V {
+	result := make(map[K]V, m.Len())
+	m.Range(func(k K, v V) bool {
+		result[k] = v
+		return true
+	})
+	return result
+}
@@ -0,0 +1,182 @@
+package syncx
+
+import (
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestRWMap_Basic(t *testing.T) {
+	m := NewRWMap[string, int]()
+
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	if v, ok := m.Get("a"); !ok || v != 1 {
+		t.Errorf("expected 1, got %v, ok=%v", v, ok)
+	}
+	if _, ok := m.Get("c"); ok {
+		t.Error("expected not found")
+	}
+	if !m.Has("b") || m.Len() != 2 {
+		t.Errorf("expected 2 entries, got %d", m.Len())
+	}
+
+	if v, ok := m.Delete("a"); !ok || v != 1 {
+		t.Errorf("expected deleted 1, got %v, ok=%v", v, ok)
+	}
+	if _, ok := m.Delete("a"); ok {
+		t.Error("expected second delete to report missing")
+	}
+
+	m.Clear()
+	if m.Len() != 0 {
+		t.Errorf("expected empty after Clear, got %d", m.Len())
+	}
+}
+
+func TestRWMap_GetOrSet(t *testing.T) {
+	m := NewShardedRWMap[string, int](4)
+
+	v, loaded := m.GetOrSet("a", 1)
+	if loaded || v != 1 {
+		t.Errorf("expected loaded=false, v=1, got loaded=%v, v=%v", loaded, v)
+	}
+	v, loaded = m.GetOrSet("a", 2)
+	if !loaded || v != 1 {
+		t.Errorf("expected loaded=true, v=1, got loaded=%v, v=%v", loaded, v)
+	}
+
+	calls := 0
+	v, loaded = m.GetOrSetFunc("b", func() int { calls++; return 3 })
+	if loaded || v != 3 {
+		t.Errorf("expected loaded=false, v=3, got loaded=%v, v=%v", loaded, v)
+	}
+	v, loaded = m.GetOrSetFunc("b", func() int { calls++; return 4 })
+	if !loaded || v != 3 || calls != 1 {
+		t.Errorf("expected cached 3 with 1 call, got v=%v, calls=%d", v, calls)
+	}
+}
+
+func TestRWMap_Compute(t *testing.T) {
+	m := NewRWMap[string, int]()
+
+	incr := func(old int, _ bool) (int, bool) { return old + 1, true }
+	m.Compute("k", incr)
+	v, ok := m.Compute("k", incr)
+	if !ok || v != 2 {
+		t.Errorf("expected 2, got %v, ok=%v", v, ok)
+	}
+
+	_, ok = m.Compute("k", func(old int, loaded bool) (int, bool) {
+		if !loaded || old != 2 {
+			t.Errorf("expected loaded old=2, got %v, loaded=%v", old, loaded)
+		}
+		return 0, false
+	})
+	if ok || m.Has("k") {
+		t.Error("expected key to be deleted when keep=false")
+	}
+
+	// 不存在且不保留时不写入
+	m.Compute("none", func(int, bool) (int, bool) { return 0, false })
+	if m.Len() != 0 {
+		t.Errorf("expected empty map, got %d", m.Len())
+	}
+}
+
+func TestRWMap_Range(t *testing.T) {
+	m := NewShardedRWMap[int, int](8)
+	for i := range 100 {
+		m.Set(i, i*i)
+	}
+
+	keys := m.Keys()
+	sort.Ints(keys)
+	if len(keys) != 100 || keys[0] != 0 || keys[99] != 99 {
+		t.Errorf("unexpected keys: %v", keys)
+	}
+	if got := m.ToMap(); len(got) != 100 || got[9] != 81 {
+		t.Errorf("unexpected map: len=%d", len(got))
+	}
+
+	count := 0
+	m.Range(func(k, v int) bool {
+		count++
+		return count < 10
+	})
+	if count != 10 {
+		t.Errorf("expected Range to stop at 10, got %d", count)
+	}
+
+	// fn 中修改 map 不会死锁
+	m.Range(func(k, _ int) bool {
+		m.Delete(k)
+		return true
+	})
+	if m.Len() != 0 {
+		t.Errorf("expected all keys deleted, got %d", m.Len())
+	}
+}
+
+func TestRWMap_ShardCount(t *testing.T) {
+	for _, tt := range []struct{ in, want int }{{0, 1}, {1, 1}, {3, 4}, {16, 16}, {17, 32}} {
+		if got := len(NewShardedRWMap[string, int](tt.in).shards); got != tt.want {
+			t.Errorf("NewShardedRWMap(%d) shards = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestRWMap_ZeroValue(t *testing.T) {
+	var m RWMap[string, int]
+	if _, ok := m.Get("a"); ok || m.Len() != 0 {
+		t.Error("zero-value RWMap should be empty")
+	}
+	m.Set("a", 1)
+	if v, ok := m.Get("a"); !ok || v != 1 {
+		t.Errorf("expected 1, got %d (%v)", v, ok)
+	}
+	if len(m.shards) != 1 {
+		t.Errorf("zero-value RWMap should have 1 shard, got %d", len(m.shards))
+	}
+
+	// 并发首次使用
+	var z RWMap[int, int]
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			z.Set(i, i)
+		}()
+	}
+	wg.Wait()
+	if z.Len() != 8 {
+		t.Errorf("expected 8 entries, got %d", z.Len())
+	}
+}
+
+func TestRWMap_Concurrent(t *testing.T) {
+	m := NewShardedRWMap[int, int](16)
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				m.Compute(i%50, func(old int, _ bool) (int, bool) { return old + 1, true })
+				m.Get(g)
+			}
+		}()
+	}
+	wg.Wait()
+
+	total := 0
+	m.Range(func(_, v int) bool {
+		total += v
+		return true
+	})
+	if total != 8000 {
+		t.Errorf("expected total 8000, got %d", total)
+	}
+}
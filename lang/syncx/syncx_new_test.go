@@ -447,3 +447,102 @@ func TestLazyValueErr(t *testing.T) {
 		t.Errorf("expected count=1, got %d", count)
 	}
 }
+
+func TestOnceValues(t *testing.T) {
+	count := 0
+	fn := OnceValues(func() (string, int) {
+		count++
+		return "host", 8080
+	})
+
+	h1, p1 := fn()
+	h2, p2 := fn()
+	if h1 != "host" || p1 != 8080 || h2 != "host" || p2 != 8080 {
+		t.Errorf("unexpected values: %s:%d, %s:%d", h1, p1, h2, p2)
+	}
+	if count != 1 {
+		t.Errorf("expected count=1, got %d", count)
+	}
+}
+
+func TestOnceE_RetryOnError(t *testing.T) {
+	var o OnceE[int]
+	count := 0
+	fn := func() (int, error) {
+		count++
+		if count < 3 {
+			return 0, errors.New("connect failed")
+		}
+		return 42, nil
+	}
+
+	for range 2 {
+		if _, err := o.Do(fn); err == nil {
+			t.Fatal("expected error")
+		}
+		if o.IsInitialized() {
+			t.Error("failure should not mark initialized")
+		}
+	}
+
+	v, err := o.Do(fn)
+	if v != 42 || err != nil {
+		t.Fatalf("expected 42, nil; got %d, %v", v, err)
+	}
+	v, err = o.Do(fn)
+	if v != 42 || err != nil || count != 3 {
+		t.Errorf("expected cached 42 with count=3, got %d, %v, count=%d", v, err, count)
+	}
+	if v, ok := o.Value(); !ok || v != 42 {
+		t.Errorf("expected Value 42, got %d, %v", v, ok)
+	}
+}
+
+func TestOnceE_Reset(t *testing.T) {
+	var o OnceE[int]
+	if _, ok := o.Reset(); ok {
+		t.Error("Reset before init should return false")
+	}
+
+	n := 0
+	fn := func() (int, error) { n++; return n, nil }
+	o.Do(fn)
+
+	old, ok := o.Reset()
+	if !ok || old != 1 {
+		t.Errorf("expected reset old=1, got %d, %v", old, ok)
+	}
+	if _, ok := o.Value(); ok {
+		t.Error("expected uninitialized after Reset")
+	}
+	if v, _ := o.Do(fn); v != 2 {
+		t.Errorf("expected re-init value 2, got %d", v)
+	}
+}
+
+func TestOnceE_Concurrent(t *testing.T) {
+	var o OnceE[int]
+	var calls atomic.Int32
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := o.Do(func() (int, error) {
+				calls.Add(1)
+				return 7, nil
+			})
+			if v != 7 || err != nil {
+				t.Errorf("expected 7, nil; got %d, %v", v, err)
+			}
+			if v, ok := o.Value(); ok && v != 7 {
+				t.Errorf("unexpected value %d", v)
+			}
+			o.Reset()
+		}()
+	}
+	wg.Wait()
+	if calls.Load() < 1 {
+		t.Error("expected at least one call")
+	}
+}
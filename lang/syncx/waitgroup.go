@@ -0,0 +1,214 @@
+package syncx
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WaitContext 等待 wg 完成或 ctx 结束
+//
+// 返回:
+//   - error: wg 完成时返回 nil，ctx 先结束时返回 ctx.Err()
+//
+// 注意: ctx 先结束时内部等待的 goroutine 会继续存在，直到 wg 完成
+//
+// 示例:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+//	defer cancel()
+//	if err := syncx.WaitContext(ctx, &wg); err != nil {
+//	    log.Println("shutdown timed out")
+//	}
+func WaitContext(ctx context.Context, wg *sync.WaitGroup) error {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// WaitTimeout 等待 wg 完成，最多等待 d
+//
+// 返回:
+//   - bool: 在超时前完成返回 true
+//
+// 示例:
+//
+//	if !syncx.WaitTimeout(&wg, 5*time.Second) {
+//	    log.Println("workers did not stop in time")
+//	}
+func WaitTimeout(wg *sync.WaitGroup, d time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return WaitContext(ctx, wg) == nil
+}
+
+// WaitGroup sync.WaitGroup 的封装，等待时支持 context 和超时
+//
+// 零值可直接使用
+//
+// 示例:
+//
+//	var wg syncx.WaitGroup
+//	for _, w := range workers {
+//	    wg.Go(w.Run)
+//	}
+//	if err := wg.WaitContext(shutdownCtx); err != nil {
+//	    // 超时，强制退出
+//	}
+type WaitGroup struct {
+	wg sync.WaitGroup
+}
+
+// Add 增加计数
+func (g *WaitGroup) Add(delta int) {
+	g.wg.Add(delta)
+}
+
+// Done 减少计数
+func (g *WaitGroup) Done() {
+	g.wg.Done()
+}
+
+// Go 在新的 goroutine 中执行 fn，自动 Add/Done
+func (g *WaitGroup) Go(fn func()) {
+	g.wg.Go(fn)
+}
+
+// Wait 阻塞直到计数归零
+func (g *WaitGroup) Wait() {
+	g.wg.Wait()
+}
+
+// WaitContext 等待计数归零或 ctx 结束
+func (g *WaitGroup) WaitContext(ctx context.Context) error {
+	return WaitContext(ctx, &g.wg)
+}
+
+// WaitTimeout 等待计数归零，最多等待 d，在超时前完成返回 true
+func (g *WaitGroup) WaitTimeout(d time.Duration) bool {
+	return WaitTimeout(&g.wg, d)
+}
+
+// Notifier 一次性通知，Notify 后唤醒所有等待者，之后的等待立即返回
+//
+// 适合"关闭信号"等只触发一次的场景。零值可直接使用，并发安全
+//
+// 示例:
+//
+//	var stopping syncx.Notifier
+//	go func() {
+//	    <-stopping.Done()
+//	    // 清理...
+//	}()
+//	stopping.Notify()
+type Notifier struct {
+	once sync.Once
+	mu   sync.Mutex
+	ch   chan struct{}
+}
+
+// channel 返回内部 channel，首次调用时创建
+func (n *Notifier) channel() chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.ch == nil {
+		n.ch = make(chan struct{})
+	}
+	return n.ch
+}
+
+// Notify 触发通知，唤醒所有等待者，多次调用安全
+func (n *Notifier) Notify() {
+	n.once.Do(func() {
+		close(n.channel())
+	})
+}
+
+// Done 返回通知后关闭的 channel，可用于 select
+func (n *Notifier) Done() <-chan struct{} {
+	return n.channel()
+}
+
+// IsNotified 检查是否已通知
+func (n *Notifier) IsNotified() bool {
+	select {
+	case <-n.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+// Wait 等待通知或 ctx 结束
+func (n *Notifier) Wait(ctx context.Context) error {
+	select {
+	case <-n.Done():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Broadcaster 可重复使用的广播，每次 Broadcast 唤醒当时所有的等待者
+//
+// 与 sync.Cond 相比，等待可以与 context、定时器一起 select。零值可直接使用，并发安全
+//
+// 示例:
+//
+//	var configChanged syncx.Broadcaster
+//	go func() {
+//	    for {
+//	        select {
+//	        case <-configChanged.Wait():
+//	            reload()
+//	        case <-ctx.Done():
+//	            return
+//	        }
+//	    }
+//	}()
+//	configChanged.Broadcast()
+type Broadcaster struct {
+	mu sync.Mutex
+	ch chan struct{}
+}
+
+// Wait 返回在下一次 Broadcast 时关闭的 channel
+//
+// 注意: 应在检查条件之前获取 channel，避免错过检查与等待之间发生的广播
+func (b *Broadcaster) Wait() <-chan struct{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.ch == nil {
+		b.ch = make(chan struct{})
+	}
+	return b.ch
+}
+
+// WaitContext 等待下一次广播或 ctx 结束
+func (b *Broadcaster) WaitContext(ctx context.Context) error {
+	select {
+	case <-b.Wait():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Broadcast 唤醒当前所有等待者，之后调用 Wait 的等待下一次广播
+func (b *Broadcaster) Broadcast() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.ch != nil {
+		close(b.ch)
+		b.ch = nil
+	}
+}
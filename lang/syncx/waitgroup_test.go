@@ -0,0 +1,132 @@
+package syncx
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWaitTimeout(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		wg.Done()
+	}()
+	if !WaitTimeout(&wg, time.Second) {
+		t.Error("expected wait to complete")
+	}
+
+	wg.Add(1)
+	if WaitTimeout(&wg, 10*time.Millisecond) {
+		t.Error("expected timeout")
+	}
+	wg.Done()
+}
+
+func TestWaitContext(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	defer wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := WaitContext(ctx, &wg); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected Canceled, got %v", err)
+	}
+}
+
+func TestWaitGroup(t *testing.T) {
+	var wg WaitGroup
+	var count atomic.Int32
+	for range 10 {
+		wg.Go(func() { count.Add(1) })
+	}
+	if err := wg.WaitContext(context.Background()); err != nil {
+		t.Fatalf("WaitContext failed: %v", err)
+	}
+	if count.Load() != 10 {
+		t.Errorf("expected 10, got %d", count.Load())
+	}
+
+	wg.Add(1)
+	if wg.WaitTimeout(10 * time.Millisecond) {
+		t.Error("expected timeout")
+	}
+	wg.Done()
+	wg.Wait()
+}
+
+func TestNotifier(t *testing.T) {
+	var n Notifier
+	if n.IsNotified() {
+		t.Error("should not be notified initially")
+	}
+
+	var woken atomic.Int32
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Go(func() {
+			if err := n.Wait(context.Background()); err == nil {
+				woken.Add(1)
+			}
+		})
+	}
+	time.Sleep(10 * time.Millisecond)
+	n.Notify()
+	n.Notify() // 重复调用安全
+	wg.Wait()
+
+	if woken.Load() != 5 {
+		t.Errorf("expected 5 waiters woken, got %d", woken.Load())
+	}
+	if !n.IsNotified() {
+		t.Error("should be notified")
+	}
+	select {
+	case <-n.Done():
+	default:
+		t.Error("Done should be closed after Notify")
+	}
+}
+
+func TestNotifier_WaitContext(t *testing.T) {
+	var n Notifier
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := n.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestBroadcaster(t *testing.T) {
+	var b Broadcaster
+	b.Broadcast() // 无等待者时安全
+
+	for round := range 3 {
+		var woken atomic.Int32
+		var wg sync.WaitGroup
+		for range 4 {
+			ch := b.Wait()
+			wg.Go(func() {
+				<-ch
+				woken.Add(1)
+			})
+		}
+		b.Broadcast()
+		wg.Wait()
+		if woken.Load() != 4 {
+			t.Errorf("round %d: expected 4 woken, got %d", round, woken.Load())
+		}
+	}
+
+	// 广播后新的等待者等待下一次广播
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.WaitContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected DeadlineExceeded, got %v", err)
+	}
+}
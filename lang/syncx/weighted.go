@@ -0,0 +1,196 @@
+package syncx
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// WeightedSemaphore 带权重的信号量，每次可获取任意数量的令牌
+//
+// 与基于 channel 的 Semaphore 不同，WeightedSemaphore 支持一次获取/释放多个令牌
+// （如按请求大小限流），等待者按 FIFO 顺序获得令牌，大请求不会被小请求饿死。
+// 行为与 golang.org/x/sync/semaphore.Weighted 一致，无外部依赖
+type WeightedSemaphore struct {
+	mu      sync.Mutex
+	size    int64
+	cur     int64
+	waiters list.List // *weightedWaiter
+}
+
+type weightedWaiter struct {
+	n     int64
+	ready chan struct{} // 获取成功时关闭
+}
+
+// NewWeightedSemaphore 创建带权重的信号量
+//
+// 参数:
+//   - n: 令牌总数（<= 0 时按 1 处理）
+//
+// 示例:
+//
+//	sem := syncx.NewWeightedSemaphore(10)
+//	if err := sem.AcquireN(ctx, 3); err != nil {
+//	    return err
+//	}
+//	defer sem.ReleaseN(3)
+func NewWeightedSemaphore(n int64) *WeightedSemaphore {
+	return &WeightedSemaphore{size: max(n, 1)}
+}
+
+// Acquire 获取 1 个令牌，阻塞直到成功或 ctx 结束
+func (s *WeightedSemaphore) Acquire(ctx context.Context) error {
+	return s.AcquireN(ctx, 1)
+}
+
+// AcquireN 获取 n 个令牌，阻塞直到成功或 ctx 结束
+//
+// 返回:
+//   - error: ctx 结束时返回 ctx.Err()，此时不持有任何令牌
+//
+// 注意: n 大于令牌总数时会一直阻塞到 ctx 结束
+func (s *WeightedSemaphore) AcquireN(ctx context.Context, n int64) error {
+	done := ctx.Done()
+
+	s.mu.Lock()
+	select {
+	case <-done:
+		// ctx 已结束时不获取令牌，即使有空余
+		s.mu.Unlock()
+		return ctx.Err()
+	default:
+	}
+	if s.size-s.cur >= n && s.waiters.Len() == 0 {
+		s.cur += n
+		s.mu.Unlock()
+		return nil
+	}
+	if n > s.size {
+		s.mu.Unlock()
+		<-done
+		return ctx.Err()
+	}
+
+	w := &weightedWaiter{n: n, ready: make(chan struct{})}
+	elem := s.waiters.PushBack(w)
+	s.mu.Unlock()
+
+	select {
+	case <-done:
+		s.mu.Lock()
+		select {
+		case <-w.ready:
+			// 取消的同时已获取成功，视为成功，避免泄漏令牌
+			s.mu.Unlock()
+			return nil
+		default:
+		}
+		isFront := s.waiters.Front() == elem
+		s.waiters.Remove(elem)
+		// 队首取消后，后面的等待者可能已经可以获取
+		if isFront && s.size > s.cur {
+			s.notifyWaiters()
+		}
+		s.mu.Unlock()
+		return ctx.Err()
+
+	case <-w.ready:
+		return nil
+	}
+}
+
+// TryAcquire 尝试获取 1 个令牌（非阻塞）
+func (s *WeightedSemaphore) TryAcquire() bool {
+	return s.TryAcquireN(1)
+}
+
+// TryAcquireN 尝试获取 n 个令牌（非阻塞），有等待者时返回 false 以保证公平
+func (s *WeightedSemaphore) TryAcquireN(n int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.size-s.cur >= n && s.waiters.Len() == 0 {
+		s.cur += n
+		return true
+	}
+	return false
+}
+
+// Release 释放 1 个令牌
+func (s *WeightedSemaphore) Release() {
+	s.ReleaseN(1)
+}
+
+// ReleaseN 释放 n 个令牌
+//
+// 注意: 释放数量超过持有数量时 panic
+func (s *WeightedSemaphore) ReleaseN(n int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cur -= n
+	if s.cur < 0 {
+		panic("syncx: weighted semaphore released more than held")
+	}
+	s.notifyWaiters()
+}
+
+// notifyWaiters 按 FIFO 顺序唤醒可以获取令牌的等待者，调用方需持有锁
+func (s *WeightedSemaphore) notifyWaiters() {
+	for {
+		next := s.waiters.Front()
+		if next == nil {
+			return
+		}
+		w := next.Value.(*weightedWaiter)
+		if s.size-s.cur < w.n {
+			// 队首令牌不足时停止，保证大请求不被饿死
+			return
+		}
+		s.cur += w.n
+		s.waiters.Remove(next)
+		close(w.ready)
+	}
+}
+
+// Available 返回当前可用的令牌数量
+//
+// 注意: 返回值可能在获取后立即过期（竞态条件）
+func (s *WeightedSemaphore) Available() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.size - s.cur
+}
+
+// Capacity 返回令牌总数
+func (s *WeightedSemaphore) Capacity() int64 {
+	return s.size
+}
+
+// WithSemaphore 获取 1 个令牌后执行 fn，执行完毕后释放
+//
+// 参数:
+//   - ctx: 用于取消等待
+//   - sem: 信号量
+//   - fn: 要执行的函数
+//
+// 返回:
+//   - error: 获取令牌失败时返回 ctx.Err()，否则返回 fn 的结果
+//
+// 示例:
+//
+//	sem := syncx.NewWeightedSemaphore(5)  // 下游 API 最多 5 个并发
+//	err := syncx.WithSemaphore(ctx, sem, func() error {
+//	    return callAPI(ctx)
+//	})
+func WithSemaphore(ctx context.Context, sem *WeightedSemaphore, fn func() error) error {
+	return WithSemaphoreN(ctx, sem, 1, fn)
+}
+
+// WithSemaphoreN 获取 n 个令牌后执行 fn，执行完毕后释放（fn panic 时也会释放）
+func WithSemaphoreN(ctx context.Context, sem *WeightedSemaphore, n int64, fn func() error) error {
+	if err := sem.AcquireN(ctx, n); err != nil {
+		return err
+	}
+	defer sem.ReleaseN(n)
+	return fn()
+}
@@ -0,0 +1,171 @@
+package syncx
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWeightedSemaphore_Basic(t *testing.T) {
+	sem := NewWeightedSemaphore(5)
+	ctx := context.Background()
+
+	if err := sem.AcquireN(ctx, 3); err != nil {
+		t.Fatalf("AcquireN failed: %v", err)
+	}
+	if got := sem.Available(); got != 2 {
+		t.Errorf("expected 2 available, got %d", got)
+	}
+	if sem.TryAcquireN(3) {
+		t.Error("TryAcquireN(3) should fail with 2 available")
+	}
+	if !sem.TryAcquire() || !sem.TryAcquire() {
+		t.Error("TryAcquire should succeed twice")
+	}
+	if sem.TryAcquire() {
+		t.Error("TryAcquire should fail when exhausted")
+	}
+
+	sem.ReleaseN(3)
+	sem.Release()
+	sem.Release()
+	if got := sem.Available(); got != 5 || sem.Capacity() != 5 {
+		t.Errorf("expected 5 available, got %d", got)
+	}
+}
+
+func TestWeightedSemaphore_ZeroCapacity(t *testing.T) {
+	if got := NewWeightedSemaphore(0).Capacity(); got != 1 {
+		t.Errorf("expected capacity 1, got %d", got)
+	}
+}
+
+func TestWeightedSemaphore_ReleasePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic on over-release")
+		}
+	}()
+	NewWeightedSemaphore(1).Release()
+}
+
+func TestWeightedSemaphore_ContextCancel(t *testing.T) {
+	sem := NewWeightedSemaphore(2)
+	_ = sem.AcquireN(context.Background(), 2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := sem.Acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected DeadlineExceeded, got %v", err)
+	}
+
+	// 请求超过总数时阻塞到 ctx 结束
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel2()
+	if err := sem.AcquireN(ctx2, 3); err == nil {
+		t.Error("AcquireN larger than capacity should fail")
+	}
+
+	// 已取消的 ctx 即使有空余也不获取
+	sem.ReleaseN(2)
+	canceled, c := context.WithCancel(context.Background())
+	c()
+	if err := sem.Acquire(canceled); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected Canceled, got %v", err)
+	}
+	if got := sem.Available(); got != 2 {
+		t.Errorf("canceled waiters should not hold tokens, available=%d", got)
+	}
+}
+
+func TestWeightedSemaphore_FIFO(t *testing.T) {
+	sem := NewWeightedSemaphore(3)
+	ctx := context.Background()
+	_ = sem.AcquireN(ctx, 3)
+
+	// 大请求排在队首，小请求不能插队
+	bigDone := make(chan struct{})
+	go func() {
+		_ = sem.AcquireN(ctx, 3)
+		close(bigDone)
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	sem.Release()
+	if sem.TryAcquire() {
+		t.Error("TryAcquire should not jump the queue")
+	}
+	sem.ReleaseN(2)
+
+	select {
+	case <-bigDone:
+	case <-time.After(time.Second):
+		t.Fatal("big waiter was not woken")
+	}
+	sem.ReleaseN(3)
+}
+
+func TestWeightedSemaphore_CancelFrontWakesNext(t *testing.T) {
+	sem := NewWeightedSemaphore(2)
+	ctx := context.Background()
+	_ = sem.AcquireN(ctx, 1)
+
+	bigCtx, cancelBig := context.WithCancel(ctx)
+	go func() { _ = sem.AcquireN(bigCtx, 2) }()
+	time.Sleep(20 * time.Millisecond)
+
+	smallDone := make(chan struct{})
+	go func() {
+		_ = sem.Acquire(ctx)
+		close(smallDone)
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	cancelBig()
+	select {
+	case <-smallDone:
+	case <-time.After(time.Second):
+		t.Fatal("small waiter should proceed after front waiter is canceled")
+	}
+}
+
+func TestWithSemaphore(t *testing.T) {
+	sem := NewWeightedSemaphore(2)
+	ctx := context.Background()
+
+	var running, peak atomic.Int32
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = WithSemaphore(ctx, sem, func() error {
+				n := running.Add(1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				running.Add(-1)
+				return nil
+			})
+		}()
+	}
+	wg.Wait()
+	if peak.Load() > 2 {
+		t.Errorf("expected at most 2 concurrent, got %d", peak.Load())
+	}
+
+	fnErr := errors.New("fn failed")
+	if err := WithSemaphoreN(ctx, sem, 2, func() error { return fnErr }); err != fnErr {
+		t.Errorf("expected fn error, got %v", err)
+	}
+	if sem.Available() != 2 {
+		t.Errorf("tokens should be released, available=%d", sem.Available())
+	}
+}
@@ -0,0 +1,286 @@
+package timex
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DayType 日期类型，由 HolidayProvider 给出
+type DayType int
+
+const (
+	// DayNormal 普通日期，按周一至周五上班、周末休息处理
+	DayNormal DayType = iota
+	// DayHoliday 节假日，不上班（即使是工作日）
+	DayHoliday
+	// DayWorkday 调休上班日（即使是周末）
+	DayWorkday
+)
+
+// HolidayProvider 节假日数据源
+type HolidayProvider interface {
+	// Lookup 返回日期类型，无特殊安排时返回 DayNormal
+	Lookup(date time.Time) DayType
+}
+
+// HolidayProviderFunc 函数形式的 HolidayProvider
+type HolidayProviderFunc func(date time.Time) DayType
+
+// Lookup 实现 HolidayProvider 接口
+func (f HolidayProviderFunc) Lookup(date time.Time) DayType {
+	return f(date)
+}
+
+// Calendar 工作日日历
+//
+// 依次查询各 HolidayProvider，第一个返回非 DayNormal 的结果生效；
+// 都未命中时周一至周五为工作日。可并发使用
+//
+// 示例:
+//
+//	cal := timex.NewCalendar(timex.NewChinaHolidayTable())
+//	cal.IsWorkday(t)
+//	deadline := cal.AddWorkdays(time.Now(), 3)  // 3 个工作日后，跳过节假日和周末
+type Calendar struct {
+	providers []HolidayProvider
+}
+
+// NewCalendar 创建工作日日历，不传 provider 时只跳过周末
+func NewCalendar(providers ...HolidayProvider) *Calendar {
+	return &Calendar{providers: providers}
+}
+
+// Lookup 返回日期类型
+func (c *Calendar) Lookup(date time.Time) DayType {
+	for _, p := range c.providers {
+		if kind := p.Lookup(date); kind != DayNormal {
+			return kind
+		}
+	}
+	return DayNormal
+}
+
+// IsWorkday 判断是否为工作日（含调休上班日）
+func (c *Calendar) IsWorkday(t time.Time) bool {
+	switch c.Lookup(t) {
+	case DayHoliday:
+		return false
+	case DayWorkday:
+		return true
+	default:
+		return !IsWeekend(t)
+	}
+}
+
+// IsHoliday 判断是否为休息日（节假日或非调休的周末）
+func (c *Calendar) IsHoliday(t time.Time) bool {
+	return !c.IsWorkday(t)
+}
+
+// NextWorkday 返回 t 之后（不含当天）的第一个工作日，时刻保持不变
+func (c *Calendar) NextWorkday(t time.Time) time.Time {
+	return c.AddWorkdays(t, 1)
+}
+
+// PrevWorkday 返回 t 之前（不含当天）的最后一个工作日，时刻保持不变
+func (c *Calendar) PrevWorkday(t time.Time) time.Time {
+	return c.AddWorkdays(t, -1)
+}
+
+// AddWorkdays 添加工作日，跳过周末和节假日，days 为负数时向前计算
+//
+// 与 AddBusinessDays 相同，从休息日开始计算时，第 1 个工作日为随后（或之前）的第一个工作日。
+// 时刻保持不变；days 为 0 时返回 t
+func (c *Calendar) AddWorkdays(t time.Time, days int) time.Time {
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+	for days > 0 {
+		t = t.AddDate(0, 0, step)
+		if c.IsWorkday(t) {
+			days--
+		}
+	}
+	return t
+}
+
+// WorkdaysBetween 计算 [start, end) 之间的工作日天数（按日历日），end 早于 start 时返回负数
+func (c *Calendar) WorkdaysBetween(start, end time.Time) int {
+	sign := 1
+	if end.Before(start) {
+		start, end, sign = end, start, -1
+	}
+
+	count := 0
+	endDay := StartOfDay(end.In(start.Location()))
+	for d := StartOfDay(start); d.Before(endDay); d = d.AddDate(0, 0, 1) {
+		if c.IsWorkday(d) {
+			count++
+		}
+	}
+	return sign * count
+}
+
+// HolidayTable 基于日期表的 HolidayProvider，可并发读写
+//
+// 节假日安排每年由官方公布，可在运行时通过 Set/Load 注入新数据
+type HolidayTable struct {
+	mu   sync.RWMutex
+	days map[string]DayType // key: 2006-01-02
+}
+
+// NewHolidayTable 创建空的节假日表
+func NewHolidayTable() *HolidayTable {
+	return &HolidayTable{days: make(map[string]DayType)}
+}
+
+// Lookup 实现 HolidayProvider 接口，按 date 所在时区的日期查询
+func (h *HolidayTable) Lookup(date time.Time) DayType {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.days[date.Format(time.DateOnly)]
+}
+
+// Set 设置日期类型，kind 为 DayNormal 时删除该日期的特殊安排
+func (h *HolidayTable) Set(kind DayType, dates ...time.Time) *HolidayTable {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, d := range dates {
+		h.set(d.Format(time.DateOnly), kind)
+	}
+	return h
+}
+
+// SetRange 将 [start, end] 闭区间内的每一天设置为 kind
+func (h *HolidayTable) SetRange(kind DayType, start, end time.Time) *HolidayTable {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for d := StartOfDay(start); !d.After(end); d = d.AddDate(0, 0, 1) {
+		h.set(d.Format(time.DateOnly), kind)
+	}
+	return h
+}
+
+// Load 批量导入日期数据，key 为 "2006-01-02" 或 "2006-01-01~2006-01-03"（闭区间）
+//
+// 任一 key 格式错误时返回错误且不修改表
+//
+// 示例:
+//
+//	table.Load(map[string]timex.DayType{
+//	    "2027-02-05~2027-02-11": timex.DayHoliday,
+//	    "2027-02-14":            timex.DayWorkday,
+//	})
+func (h *HolidayTable) Load(data map[string]DayType) error {
+	parsed := make(map[string]DayType)
+	for key, kind := range data {
+		start, end, err := parseDateRange(key)
+		if err != nil {
+			return err
+		}
+		for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+			parsed[d.Format(time.DateOnly)] = kind
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for key, kind := range parsed {
+		h.set(key, kind)
+	}
+	return nil
+}
+
+// set 设置单个日期，调用方需持有写锁
+func (h *HolidayTable) set(key string, kind DayType) {
+	if kind == DayNormal {
+		delete(h.days, key)
+		return
+	}
+	h.days[key] = kind
+}
+
+// parseDateRange 解析 "2006-01-02" 或 "2006-01-02~2006-01-05"
+func parseDateRange(s string) (time.Time, time.Time, error) {
+	a, b, isRange := strings.Cut(s, "~")
+	start, err := time.Parse(time.DateOnly, strings.TrimSpace(a))
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid holiday date %q: %w", s, err)
+	}
+	if !isRange {
+		return start, start, nil
+	}
+	end, err := time.Parse(time.DateOnly, strings.TrimSpace(b))
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid holiday date %q: %w", s, err)
+	}
+	if end.Before(start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid holiday date %q: end before start", s)
+	}
+	return start, end, nil
+}
+
+// chinaHolidayData 中国法定节假日安排（国务院办公厅发布）
+//
+// 新年度安排公布后在此追加，或在运行时通过 HolidayTable.Load 注入
+var chinaHolidayData = map[string]DayType{
+	// 2024
+	"2024-01-01":            DayHoliday, // 元旦
+	"2024-02-10~2024-02-17": DayHoliday, // 春节
+	"2024-02-04":            DayWorkday,
+	"2024-02-18":            DayWorkday,
+	"2024-04-04~2024-04-06": DayHoliday, // 清明节
+	"2024-04-07":            DayWorkday,
+	"2024-05-01~2024-05-05": DayHoliday, // 劳动节
+	"2024-04-28":            DayWorkday,
+	"2024-05-11":            DayWorkday,
+	"2024-06-10":            DayHoliday, // 端午节
+	"2024-09-15~2024-09-17": DayHoliday, // 中秋节
+	"2024-09-14":            DayWorkday,
+	"2024-10-01~2024-10-07": DayHoliday, // 国庆节
+	"2024-09-29":            DayWorkday,
+	"2024-10-12":            DayWorkday,
+
+	// 2025
+	"2025-01-01":            DayHoliday, // 元旦
+	"2025-01-28~2025-02-04": DayHoliday, // 春节
+	"2025-01-26":            DayWorkday,
+	"2025-02-08":            DayWorkday,
+	"2025-04-04~2025-04-06": DayHoliday, // 清明节
+	"2025-05-01~2025-05-05": DayHoliday, // 劳动节
+	"2025-04-27":            DayWorkday,
+	"2025-05-31~2025-06-02": DayHoliday, // 端午节
+	"2025-10-01~2025-10-08": DayHoliday, // 国庆节、中秋节
+	"2025-09-28":            DayWorkday,
+	"2025-10-11":            DayWorkday,
+
+	// 2026
+	"2026-01-01~2026-01-03": DayHoliday, // 元旦
+	"2026-01-04":            DayWorkday,
+	"2026-02-15~2026-02-23": DayHoliday, // 春节
+	"2026-02-14":            DayWorkday,
+	"2026-02-28":            DayWorkday,
+	"2026-04-04~2026-04-06": DayHoliday, // 清明节
+	"2026-05-01~2026-05-05": DayHoliday, // 劳动节
+	"2026-05-09":            DayWorkday,
+	"2026-06-19~2026-06-21": DayHoliday, // 端午节
+	"2026-09-25~2026-09-27": DayHoliday, // 中秋节
+	"2026-10-01~2026-10-07": DayHoliday, // 国庆节
+	"2026-09-20":            DayWorkday,
+	"2026-10-10":            DayWorkday,
+}
+
+// NewChinaHolidayTable 返回内置中国法定节假日和调休安排的节假日表
+//
+// 内置 2024-2026 年数据，其他年份按普通周末处理，可通过 Load 追加。
+// 每次调用返回新的表，修改互不影响
+func NewChinaHolidayTable() *HolidayTable {
+	h := NewHolidayTable()
+	if err := h.Load(chinaHolidayData); err != nil {
+		panic(err) // 内置数据格式错误，由测试保证不会发生
+	}
+	return h
+}
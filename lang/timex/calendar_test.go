@@ -0,0 +1,138 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 10, 0, 0, 0, time.UTC)
+}
+
+func TestCalendar_WeekendOnly(t *testing.T) {
+	cal := NewCalendar()
+
+	if !cal.IsWorkday(date(2024, 1, 5)) || cal.IsWorkday(date(2024, 1, 6)) {
+		t.Error("without providers only weekends should be holidays")
+	}
+	if got := cal.NextWorkday(date(2024, 1, 5)); !got.Equal(date(2024, 1, 8)) {
+		t.Errorf("NextWorkday(Fri) = %v, want Mon", got)
+	}
+	if got := cal.PrevWorkday(date(2024, 1, 8)); !got.Equal(date(2024, 1, 5)) {
+		t.Errorf("PrevWorkday(Mon) = %v, want Fri", got)
+	}
+}
+
+func TestCalendar_China(t *testing.T) {
+	cal := NewCalendar(NewChinaHolidayTable())
+
+	tests := []struct {
+		day     time.Time
+		workday bool
+	}{
+		{date(2024, 10, 1), false}, // 国庆（周二）
+		{date(2024, 10, 7), false},
+		{date(2024, 10, 8), true},
+		{date(2024, 9, 29), true},  // 调休（周日）
+		{date(2024, 10, 12), true}, // 调休（周六）
+		{date(2024, 10, 13), false},
+		{date(2025, 1, 28), false}, // 春节
+		{date(2025, 2, 8), true},   // 调休（周六）
+		{date(2026, 2, 16), false}, // 春节
+	}
+	for _, tt := range tests {
+		if got := cal.IsWorkday(tt.day); got != tt.workday {
+			t.Errorf("IsWorkday(%s) = %v, want %v", tt.day.Format(time.DateOnly), got, tt.workday)
+		}
+		if cal.IsHoliday(tt.day) == tt.workday {
+			t.Errorf("IsHoliday(%s) should be the inverse of IsWorkday", tt.day.Format(time.DateOnly))
+		}
+	}
+
+	// 9/30（周一）之后的下一个工作日是国庆后的 10/8
+	if got := cal.NextWorkday(date(2024, 9, 30)); !got.Equal(date(2024, 10, 8)) {
+		t.Errorf("NextWorkday = %v", got)
+	}
+	// 10/8 的前一个工作日是 9/30
+	if got := cal.PrevWorkday(date(2024, 10, 8)); !got.Equal(date(2024, 9, 30)) {
+		t.Errorf("PrevWorkday = %v", got)
+	}
+	// 9/27（周五）+ 2 个工作日: 9/29（周日调休）、9/30
+	if got := cal.AddWorkdays(date(2024, 9, 27), 2); !got.Equal(date(2024, 9, 30)) {
+		t.Errorf("AddWorkdays = %v", got)
+	}
+	if got := cal.AddWorkdays(date(2024, 9, 27), 0); !got.Equal(date(2024, 9, 27)) {
+		t.Errorf("AddWorkdays(0) = %v", got)
+	}
+
+	// 2024-10: 23 个日历工作日 - 5 个国庆工作日 + 1 个调休(10/12) = 19
+	if got := cal.WorkdaysBetween(date(2024, 10, 1), date(2024, 11, 1)); got != 19 {
+		t.Errorf("WorkdaysBetween = %d, want 19", got)
+	}
+	if got := cal.WorkdaysBetween(date(2024, 11, 1), date(2024, 10, 1)); got != -19 {
+		t.Errorf("WorkdaysBetween reversed = %d, want -19", got)
+	}
+}
+
+func TestCalendar_ProviderOrder(t *testing.T) {
+	override := HolidayProviderFunc(func(d time.Time) DayType {
+		if d.Format(time.DateOnly) == "2024-10-01" {
+			return DayWorkday
+		}
+		return DayNormal
+	})
+
+	cal := NewCalendar(override, NewChinaHolidayTable())
+	if !cal.IsWorkday(date(2024, 10, 1)) {
+		t.Error("first provider should take precedence")
+	}
+	if cal.IsWorkday(date(2024, 10, 2)) {
+		t.Error("later providers should apply when earlier ones return DayNormal")
+	}
+}
+
+func TestHolidayTable(t *testing.T) {
+	h := NewHolidayTable()
+	h.Set(DayHoliday, date(2030, 1, 1)).
+		SetRange(DayHoliday, date(2030, 2, 1), date(2030, 2, 3)).
+		Set(DayWorkday, date(2030, 2, 9))
+
+	if h.Lookup(date(2030, 1, 1)) != DayHoliday || h.Lookup(date(2030, 2, 3)) != DayHoliday {
+		t.Error("expected holidays to be set")
+	}
+	if h.Lookup(date(2030, 2, 9)) != DayWorkday {
+		t.Error("expected workday to be set")
+	}
+	if h.Lookup(date(2030, 2, 4)) != DayNormal {
+		t.Error("expected normal day outside range")
+	}
+
+	h.Set(DayNormal, date(2030, 1, 1))
+	if h.Lookup(date(2030, 1, 1)) != DayNormal {
+		t.Error("DayNormal should clear the entry")
+	}
+
+	err := h.Load(map[string]DayType{
+		"2031-01-01~2031-01-03": DayHoliday,
+		"2031-01-05":            DayWorkday,
+	})
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if h.Lookup(date(2031, 1, 2)) != DayHoliday || h.Lookup(date(2031, 1, 5)) != DayWorkday {
+		t.Error("expected loaded data")
+	}
+
+	for _, bad := range []string{"2031/01/01", "2031-01-05~2031-01-01", "2031-01-01~x"} {
+		if err := h.Load(map[string]DayType{bad: DayHoliday}); err == nil {
+			t.Errorf("Load(%q) expected error", bad)
+		}
+	}
+
+	// 内置表互不影响
+	a, b := NewChinaHolidayTable(), NewChinaHolidayTable()
+	a.Set(DayNormal, date(2024, 10, 1))
+	if b.Lookup(date(2024, 10, 1)) != DayHoliday {
+		t.Error("built-in tables should be independent")
+	}
+}
@@ -0,0 +1,310 @@
+package timex
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule 定时计划，由 ParseCron 创建
+type Schedule interface {
+	// Next 返回晚于 t 的下一次执行时间，找不到时返回零值
+	Next(t time.Time) time.Time
+
+	// Prev 返回早于 t 的上一次执行时间，找不到时返回零值
+	Prev(t time.Time) time.Time
+}
+
+// cronSearchYears Next/Prev 的最大搜索年限，超过视为永不执行（如 "0 0 30 2 *"）
+const cronSearchYears = 5
+
+// cronShortcuts 预定义的快捷表达式
+var cronShortcuts = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronField 单个字段的取值范围和名称别名
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronDom    = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 星期允许 0-7，0 和 7 都表示周日
+	cronDow = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// ParseCron 解析 cron 表达式
+//
+// 支持标准 5 字段格式: 分 时 日 月 周
+//   - 通配: *（日和周也可用 ?）
+//   - 列表: 1,15,30
+//   - 范围: 1-5、MON-FRI
+//   - 步长: */15、0-30/10、5/20
+//   - 月份和星期支持英文缩写（JAN-DEC、SUN-SAT，不区分大小写），星期 0 和 7 都表示周日
+//
+// 日和周同时被限定时，满足其一即执行（与标准 cron 一致）。
+//
+// 也支持快捷表达式: @yearly、@annually、@monthly、@weekly、@daily、@midnight、@hourly，
+// 以及 "@every <duration>"，duration 使用 ParseDuration 解析（支持 "1d"、"90s" 等）。
+//
+// 时间按传入 Next/Prev 的 t 所在时区计算。
+//
+// 示例:
+//
+//	s, err := timex.ParseCron("*/15 9-18 * * MON-FRI")
+//	next := s.Next(time.Now())  // 工作日 9:00-18:45 每 15 分钟
+//
+//	s, _ = timex.ParseCron("@every 1h30m")
+func ParseCron(expr string) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, fmt.Errorf("empty cron expression")
+	}
+
+	if strings.HasPrefix(expr, "@every") {
+		d, err := ParseDuration(strings.TrimPrefix(expr, "@every"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("invalid cron expression %q: duration must be positive", expr)
+		}
+		return everySchedule{d: d}, nil
+	}
+	if strings.HasPrefix(expr, "@") {
+		spec, ok := cronShortcuts[strings.ToLower(expr)]
+		if !ok {
+			return nil, fmt.Errorf("invalid cron expression %q: unknown shortcut", expr)
+		}
+		expr = spec
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields, got %d", expr, len(fields))
+	}
+
+	var s cronSchedule
+	var err error
+	if s.minute, err = parseCronField(fields[0], cronMinute); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+	}
+	if s.hour, err = parseCronField(fields[1], cronHour); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+	}
+	if s.dom, err = parseCronField(fields[2], cronDom); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+	}
+	if s.month, err = parseCronField(fields[3], cronMonth); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+	}
+	if s.dow, err = parseCronField(fields[4], cronDow); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+	}
+	// 7 与 0 同为周日
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	s.domStar = isCronWildcard(fields[2])
+	s.dowStar = isCronWildcard(fields[4])
+	return &s, nil
+}
+
+// MustParseCron 解析 cron 表达式，解析失败时 panic
+func MustParseCron(expr string) Schedule {
+	s, err := ParseCron(expr)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func isCronWildcard(field string) bool {
+	return field == "*" || field == "?" || strings.HasPrefix(field, "*/")
+}
+
+// parseCronField 解析单个字段，返回取值的位图
+func parseCronField(field string, f cronField) (uint64, error) {
+	var bits uint64
+	for part := range strings.SplitSeq(field, ",") {
+		lo, hi, step := f.min, f.max, 1
+
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid %s step %q", f.name, stepPart)
+			}
+			step = n
+		}
+
+		switch {
+		case rangePart == "*" || rangePart == "?":
+			// 使用完整范围；星期的 */n 不包含 7，避免与 0 重复
+			if f.max == 7 {
+				hi = 6
+			}
+		case strings.Contains(rangePart, "-"):
+			a, b, _ := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = f.value(a); err != nil {
+				return 0, err
+			}
+			if hi, err = f.value(b); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid %s range %q", f.name, rangePart)
+			}
+		default:
+			v, err := f.value(rangePart)
+			if err != nil {
+				return 0, err
+			}
+			lo = v
+			// "5/20" 表示从 5 开始每 20 个单位
+			if !hasStep {
+				hi = v
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+// value 解析单个取值，支持名称别名
+func (f cronField) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value %q", f.name, s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s value %d out of range [%d, %d]", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
+
+// cronSchedule 5 字段 cron 计划，各字段用位图表示
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+}
+
+// dayMatches 判断日期是否满足日和周字段
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<t.Day()) != 0
+	dowMatch := s.dow&(1<<t.Weekday()) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// Next 返回晚于 t 的下一次执行时间
+func (s *cronSchedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	// Truncate 按绝对时间截断，夏令时回拨的重复小时内不会回到第一次出现的时刻
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Year() + cronSearchYears
+
+	for t.Year() <= limit {
+		if s.month&(1<<t.Month()) == 0 {
+			t = forward(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc))
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = forward(t, time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc))
+			continue
+		}
+		if s.hour&(1<<t.Hour()) == 0 {
+			// 按绝对时间推进到下一个整点，夏令时切换时 time.Date 可能回退
+			t = t.Add(time.Hour - time.Duration(t.Minute())*time.Minute)
+			continue
+		}
+		if s.minute&(1<<t.Minute()) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// forward 返回 next，next 不晚于 t 时（time.Date 落在夏令时空档被回退）改为推进一小时
+func forward(t, next time.Time) time.Time {
+	if next.After(t) {
+		return next
+	}
+	return t.Add(time.Hour)
+}
+
+// Prev 返回早于 t 的上一次执行时间
+func (s *cronSchedule) Prev(t time.Time) time.Time {
+	loc := t.Location()
+	orig := t
+	t = t.Truncate(time.Minute)
+	if !t.Before(orig) {
+		t = t.Add(-time.Minute)
+	}
+	limit := t.Year() - cronSearchYears
+
+	for t.Year() >= limit {
+		if s.month&(1<<t.Month()) == 0 {
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc).Add(-time.Minute)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc).Add(-time.Minute)
+			continue
+		}
+		if s.hour&(1<<t.Hour()) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc).Add(-time.Minute)
+			continue
+		}
+		if s.minute&(1<<t.Minute()) == 0 {
+			t = t.Add(-time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// everySchedule "@every <duration>" 固定间隔计划
+type everySchedule struct {
+	d time.Duration
+}
+
+// Next 返回 t 之后间隔 d 的时间
+func (s everySchedule) Next(t time.Time) time.Time {
+	return t.Add(s.d)
+}
+
+// Prev 返回 t 之前间隔 d 的时间
+func (s everySchedule) Prev(t time.Time) time.Time {
+	return t.Add(-s.d)
+}
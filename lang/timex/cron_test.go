@@ -0,0 +1,170 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron_Next(t *testing.T) {
+	// 2024-01-15 为周一
+	from := time.Date(2024, 1, 15, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2024, 1, 15, 10, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 1, 15, 10, 15, 0, 0, time.UTC)},
+		{"0 * * * *", time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{"30 9 * * *", time.Date(2024, 1, 16, 9, 30, 0, 0, time.UTC)},
+		{"0 9-18/3 * * *", time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * SAT,SUN", time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, 1, 21, 0, 0, 0, 0, time.UTC)},
+		{"0 12 * JUN mon-fri", time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2024, 1, 15, 10, 25, 0, 0, time.UTC)},
+		// 日和周同时限定时满足其一即可: 20 号或周三
+		{"0 0 20 * 3", time.Date(2024, 1, 17, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2024, 1, 21, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		s, err := ParseCron(tt.expr)
+		if err != nil {
+			t.Errorf("ParseCron(%q) error: %v", tt.expr, err)
+			continue
+		}
+		if got := s.Next(from); !got.Equal(tt.want) {
+			t.Errorf("ParseCron(%q).Next = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestParseCron_Prev(t *testing.T) {
+	from := time.Date(2024, 1, 15, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2024, 1, 15, 10, 7, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)},
+		{"30 9 * * *", time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * FRI", time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC)},
+		{"59 23 31 12 *", time.Date(2023, 12, 31, 23, 59, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		s := MustParseCron(tt.expr)
+		if got := s.Prev(from); !got.Equal(tt.want) {
+			t.Errorf("ParseCron(%q).Prev = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+
+	// 恰好在执行时间点上时，Prev 返回更早的一次
+	at := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	if got := MustParseCron("0 * * * *").Prev(at); !got.Equal(at.Add(-time.Hour)) {
+		t.Errorf("Prev at exact match = %v", got)
+	}
+}
+
+func TestParseCron_Every(t *testing.T) {
+	from := time.Date(2024, 1, 15, 10, 7, 30, 0, time.UTC)
+
+	s, err := ParseCron("@every 1h30m")
+	if err != nil {
+		t.Fatalf("ParseCron error: %v", err)
+	}
+	if got := s.Next(from); !got.Equal(from.Add(90 * time.Minute)) {
+		t.Errorf("Next = %v", got)
+	}
+	if got := s.Prev(from); !got.Equal(from.Add(-90 * time.Minute)) {
+		t.Errorf("Prev = %v", got)
+	}
+
+	s = MustParseCron("@every 1d")
+	if got := s.Next(from); !got.Equal(from.Add(24 * time.Hour)) {
+		t.Errorf("Next = %v", got)
+	}
+}
+
+func TestParseCron_Never(t *testing.T) {
+	s := MustParseCron("0 0 30 2 *")
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got := s.Next(from); !got.IsZero() {
+		t.Errorf("expected zero time for impossible schedule, got %v", got)
+	}
+	if got := s.Prev(from); !got.IsZero() {
+		t.Errorf("expected zero time for impossible schedule, got %v", got)
+	}
+}
+
+func TestParseCron_DST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("timezone data not available")
+	}
+
+	// 2024-03-10 02:00 跳到 03:00，02:30 不存在
+	s := MustParseCron("30 2 * * *")
+	got := s.Next(time.Date(2024, 3, 9, 12, 0, 0, 0, loc))
+	if got.Day() != 11 || got.Hour() != 2 || got.Minute() != 30 {
+		t.Errorf("expected to skip nonexistent time, got %v", got)
+	}
+
+	// 2024-11-03 01:00-02:00 重复一次，不应死循环
+	s = MustParseCron("0 3 * * *")
+	got = s.Next(time.Date(2024, 11, 3, 0, 30, 0, 0, loc))
+	if !got.Equal(time.Date(2024, 11, 3, 3, 0, 0, 0, loc)) {
+		t.Errorf("unexpected next across fall back: %v", got)
+	}
+	if prev := s.Prev(got.Add(time.Minute)); !prev.Equal(got) {
+		t.Errorf("unexpected prev across fall back: %v", prev)
+	}
+
+	// 从重复小时的第二次出现开始，Next 不能早于起点
+	second := time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC).In(loc) // 01:30 EST
+	if next := MustParseCron("* * * * *").Next(second); !next.Equal(second.Add(time.Minute)) {
+		t.Errorf("expected %v, got %v", second.Add(time.Minute), next)
+	}
+}
+
+func TestParseCron_Invalid(t *testing.T) {
+	invalid := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"* * * FOO *",
+		"@often",
+		"@every",
+		"@every -1h",
+		"@every abc",
+	}
+	for _, expr := range invalid {
+		if _, err := ParseCron(expr); err == nil {
+			t.Errorf("ParseCron(%q) expected error", expr)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseCron expected panic")
+		}
+	}()
+	MustParseCron("bad")
+}
@@ -12,6 +12,38 @@
 //   - SecFormat: 秒级时间戳转 "Y-m-d H:i:s" 格式
 //   - SecFormatWithLayout: 秒级时间戳转自定义格式
 //
+// 指定时区:
+//   - MsecFormatIn/SecFormatIn: 按指定时区格式化（另有 WithLayout 版本）
+//   - SetDefaultLocation: 设置格式化函数的默认时区，如 UTC 容器中输出北京时间
+//
+// 日期计算:
+//   - StartOf/EndOf: 天/周/月/季度/年的开始和结束时间（也可使用 StartOfDay、EndOfQuarter 等）
+//   - AddBusinessDays: 添加工作日，跳过周末
+//   - DaysBetween/MonthsBetween: 相差的日历天数和完整月数
+//
+// 工作日日历:
+//   - Calendar: IsWorkday/NextWorkday/AddWorkdays/WorkdaysBetween，跳过周末和节假日
+//   - HolidayProvider: 可插拔节假日数据源；NewChinaHolidayTable 内置中国法定节假日和调休，可通过 Load 注入新年度数据
+//
+// 时间区间:
+//   - Range: 半开区间 [Start, End)，支持 Contains/Overlaps/Intersect/Union
+//   - Split/SplitBy: 按天/小时等单位边界或固定时长拆分
+//   - MergeRanges/TotalDuration: 合并重叠区间、计算覆盖总时长
+//
+// Cron 表达式:
+//   - ParseCron: 解析标准 5 字段表达式及 @daily、@every 1h 等快捷方式，返回 Schedule
+//   - Schedule.Next/Prev: 计算下一次/上一次执行时间
+//
+// Duration:
+//   - ParseDuration: 支持周、天和中文单位（"2w"、"3d12h"、"3天"）的 Duration 解析
+//   - FormatDuration/FormatDurationZH: 格式化为 "1d2h3m" 或 "2天3小时5分"
+//   - DurationBuckets: 指数分桶，预置 Fast/Default/SlowLatencyBuckets，BucketsSeconds 转换为 Prometheus 桶
+//   - SinceSeconds/SinceMillis: 经过时间的浮点数，便于上报指标
+//   - RoundDuration: 按有效数字四舍五入，便于展示
+//
+// 相对时间:
+//   - Humanize: "3 分钟前"、"2 小时后"，内置 LocaleZH/LocaleEN，可实现 Locale 接口扩展其他语言
+//
 // # 使用示例
 //
 //	import "github.com/hexagon-codes/toolkit/lang/timex"
@@ -56,8 +88,8 @@
 //
 // # 注意事项
 //
-// - 所有函数都使用本地时区
-// - 时间戳为 0 会返回 "1970-01-01 08:00:00"（北京时间）
+// - 格式化函数默认使用本地时区，可通过 SetDefaultLocation 修改
+// - 时间戳为 0 在北京时间下返回 "1970-01-01 08:00:00"
 // - 所有函数都是并发安全的
 //
 // --- English ---
@@ -77,6 +109,38 @@
 //   - SecFormat: format second-level timestamp to "Y-m-d H:i:s"
 //   - SecFormatWithLayout: format second-level timestamp with custom layout
 //
+// Explicit time zone:
+//   - MsecFormatIn/SecFormatIn: format in the given location (WithLayout variants available)
+//   - SetDefaultLocation: set the default location for formatting, e.g. render Asia/Shanghai in UTC containers
+//
+// Date arithmetic:
+//   - StartOf/EndOf: start and end of a day/week/month/quarter/year (StartOfDay, EndOfQuarter etc. are also available)
+//   - AddBusinessDays: add business days, skipping weekends
+//   - DaysBetween/MonthsBetween: calendar days and whole months between two times
+//
+// Workday calendar:
+//   - Calendar: IsWorkday/NextWorkday/AddWorkdays/WorkdaysBetween, skipping weekends and holidays
+//   - HolidayProvider: pluggable holiday source; NewChinaHolidayTable ships Chinese statutory holidays and make-up workdays, with Load for new years
+//
+// Time ranges:
+//   - Range: half-open interval [Start, End) with Contains/Overlaps/Intersect/Union
+//   - Split/SplitBy: split at day/hour/... boundaries or into fixed-length chunks
+//   - MergeRanges/TotalDuration: merge overlapping ranges and compute covered duration
+//
+// Cron expressions:
+//   - ParseCron: parse standard 5-field expressions plus @daily, @every 1h etc. into a Schedule
+//   - Schedule.Next/Prev: compute the next/previous run time
+//
+// Durations:
+//   - ParseDuration: parse durations with weeks, days and Chinese units ("2w", "3d12h", "3天")
+//   - FormatDuration/FormatDurationZH: format as "1d2h3m" or "2天3小时5分"
+//   - DurationBuckets: exponential buckets with Fast/Default/SlowLatencyBuckets presets; BucketsSeconds converts for Prometheus
+//   - SinceSeconds/SinceMillis: elapsed time as float for metrics
+//   - RoundDuration: round to significant digits for display
+//
+// Relative time:
+//   - Humanize: "3 minutes ago", "in 2 hours"; LocaleZH/LocaleEN are built in, other languages implement Locale
+//
 // # Usage Examples
 //
 //	import "github.com/hexagon-codes/toolkit/lang/timex"
@@ -121,7 +185,7 @@
 //
 // # Notes
 //
-// - All functions use the local timezone
-// - A timestamp of 0 returns "1970-01-01 08:00:00" (Beijing time)
+// - Formatting functions use the local timezone by default; change it with SetDefaultLocation
+// - A timestamp of 0 returns "1970-01-01 08:00:00" in Beijing time
 // - All functions are concurrency-safe
 package timex
@@ -2,8 +2,7 @@ package timex
 
 import (
 	"fmt"
-	"regexp"
-	"strconv"
+	"math"
 	"strings"
 	"time"
 )
@@ -133,72 +132,210 @@ func FormatDurationShort(d time.Duration) string {
 	return result
 }
 
-// durationPattern 匹配 duration 字符串的正则表达式
-var durationPattern = regexp.MustCompile(`^(-?)(?:(\d+)d)?(?:(\d+)h)?(?:(\d+)m)?(?:(\d+)s)?(?:(\d+)ms)?$`)
+// durationUnits ParseDuration 支持的单位，同前缀的单位长的在前（如 "ms" 在 "m" 前）
+var durationUnits = []struct {
+	name string
+	unit time.Duration
+}{
+	{"毫秒", time.Millisecond},
+	{"小时", time.Hour},
+	{"分钟", time.Minute},
+	{"星期", 7 * 24 * time.Hour},
+	{"ms", time.Millisecond},
+	{"us", time.Microsecond},
+	{"µs", time.Microsecond}, // U+00B5
+	{"μs", time.Microsecond}, // U+03BC
+	{"ns", time.Nanosecond},
+	{"周", 7 * 24 * time.Hour},
+	{"天", 24 * time.Hour},
+	{"日", 24 * time.Hour},
+	{"时", time.Hour},
+	{"分", time.Minute},
+	{"秒", time.Second},
+	{"w", 7 * 24 * time.Hour},
+	{"d", 24 * time.Hour},
+	{"h", time.Hour},
+	{"m", time.Minute},
+	{"s", time.Second},
+}
 
-// ParseDuration 解析 duration 字符串，支持天数
+// ParseDuration 解析 duration 字符串，支持周、天和中文单位
+//
+// 在标准 Go duration 格式基础上增加:
+//   - 周和天: "2w"、"3d12h"、"1.5d"
+//   - 中文单位: "3天"、"2小时30分钟"、"1周2天"、"500毫秒"
+//   - 各段之间允许空格: "3天 12小时"
 //
-// 支持的格式: "1d", "2h", "3m", "4s", "5ms", "1d2h3m4s" 等
-// 也支持标准 Go duration 格式
+// 支持的单位: w/周/星期, d/天/日, h/小时/时, m/分钟/分, s/秒, ms/毫秒, us/µs, ns
 //
 // 参数:
 //   - s: 要解析的字符串
 //
 // 返回:
 //   - time.Duration: 解析后的 Duration
-//   - error: 解析错误
+//   - error: 解析错误或结果溢出
 //
 // 示例:
 //
-//	timex.ParseDuration("1d")      // 24h0m0s
-//	timex.ParseDuration("1d2h")    // 26h0m0s
-//	timex.ParseDuration("1h30m")   // 1h30m0s
-//	timex.ParseDuration("90s")     // 1m30s
+//	timex.ParseDuration("1d")         // 24h0m0s
+//	timex.ParseDuration("2w")         // 336h0m0s
+//	timex.ParseDuration("3d12h")      // 84h0m0s
+//	timex.ParseDuration("3天")         // 72h0m0s
+//	timex.ParseDuration("1小时30分")    // 1h30m0s
 func ParseDuration(s string) (time.Duration, error) {
 	s = strings.TrimSpace(s)
 	if s == "" {
 		return 0, fmt.Errorf("empty duration string")
 	}
 
-	// 如果包含 'd' 则使用自定义解析
-	if strings.Contains(s, "d") {
-		matches := durationPattern.FindStringSubmatch(s)
-		if matches == nil {
-			return 0, fmt.Errorf("invalid duration format: %s", s)
+	// 标准格式优先交给标准库
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+
+	orig := s
+	negative := false
+	if s[0] == '-' || s[0] == '+' {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+	if strings.TrimSpace(s) == "" {
+		return 0, fmt.Errorf("invalid duration format: %s", orig)
+	}
+
+	var total time.Duration
+	for {
+		s = strings.TrimLeft(s, " \t")
+		if s == "" {
+			break
 		}
 
-		var d time.Duration
-		negative := matches[1] == "-"
+		// 整数部分和小数部分
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		intPart := s[:i]
+		fracPart := ""
+		if i < len(s) && s[i] == '.' {
+			j := i + 1
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+			fracPart = s[i+1 : j]
+			i = j
+		}
+		if intPart == "" && fracPart == "" {
+			return 0, fmt.Errorf("invalid duration format: %s", orig)
+		}
+		s = strings.TrimLeft(s[i:], " \t")
 
-		if matches[2] != "" {
-			days, _ := strconv.ParseInt(matches[2], 10, 64)
-			d += time.Duration(days) * 24 * time.Hour
+		unit, n := matchDurationUnit(s)
+		if n == 0 {
+			return 0, fmt.Errorf("invalid duration format: %s", orig)
 		}
-		if matches[3] != "" {
-			hours, _ := strconv.ParseInt(matches[3], 10, 64)
-			d += time.Duration(hours) * time.Hour
+		s = s[n:]
+
+		v, ok := durationValue(intPart, fracPart, unit)
+		if !ok || total > math.MaxInt64-v {
+			return 0, fmt.Errorf("duration out of range: %s", orig)
 		}
-		if matches[4] != "" {
-			minutes, _ := strconv.ParseInt(matches[4], 10, 64)
-			d += time.Duration(minutes) * time.Minute
+		total += v
+	}
+
+	if negative {
+		total = -total
+	}
+	return total, nil
+}
+
+// matchDurationUnit 匹配 s 开头的单位，返回单位和匹配的字节数
+func matchDurationUnit(s string) (time.Duration, int) {
+	for _, u := range durationUnits {
+		if strings.HasPrefix(s, u.name) {
+			return u.unit, len(u.name)
 		}
-		if matches[5] != "" {
-			seconds, _ := strconv.ParseInt(matches[5], 10, 64)
-			d += time.Duration(seconds) * time.Second
+	}
+	return 0, 0
+}
+
+// durationValue 计算 intPart.fracPart 个 unit 的时长，溢出时返回 false
+func durationValue(intPart, fracPart string, unit time.Duration) (time.Duration, bool) {
+	var v time.Duration
+	for _, c := range intPart {
+		if v > (math.MaxInt64-9)/10 {
+			return 0, false
 		}
-		if matches[6] != "" {
-			millis, _ := strconv.ParseInt(matches[6], 10, 64)
-			d += time.Duration(millis) * time.Millisecond
+		v = v*10 + time.Duration(c-'0')
+	}
+	if v > math.MaxInt64/unit {
+		return 0, false
+	}
+	v *= unit
+
+	// 小数部分按位累加，超出纳秒精度的位数忽略
+	scale := unit
+	for _, c := range fracPart {
+		scale /= 10
+		if scale == 0 {
+			break
+		}
+		v += time.Duration(c-'0') * scale
+	}
+	return v, v >= 0
+}
+
+// FormatDurationZH 将 Duration 格式化为中文
+//
+// 只显示非零部分，单位为天、小时、分、秒、毫秒；不足 1 毫秒时显示微秒或纳秒
+//
+// 示例:
+//
+//	timex.FormatDurationZH(51*time.Hour + 5*time.Minute)  // "2天3小时5分"
+//	timex.FormatDurationZH(90 * time.Second)              // "1分30秒"
+//	timex.FormatDurationZH(0)                             // "0秒"
+func FormatDurationZH(d time.Duration) string {
+	if d == 0 {
+		return "0秒"
+	}
+
+	var result strings.Builder
+	if d < 0 {
+		result.WriteByte('-')
+		if d == math.MinInt64 {
+			// -MinInt64 溢出，多出的 1 纳秒对显示没有影响
+			d++
 		}
+		d = -d
+	}
 
-		if negative {
-			d = -d
+	parts := []struct {
+		unit time.Duration
+		name string
+	}{
+		{24 * time.Hour, "天"},
+		{time.Hour, "小时"},
+		{time.Minute, "分"},
+		{time.Second, "秒"},
+		{time.Millisecond, "毫秒"},
+	}
+	written := false
+	for _, p := range parts {
+		if n := d / p.unit; n > 0 {
+			fmt.Fprintf(&result, "%d%s", n, p.name)
+			d %= p.unit
+			written = true
 		}
-		return d, nil
 	}
 
-	// 使用标准库解析
-	return time.ParseDuration(s)
+	if !written {
+		if d >= time.Microsecond {
+			fmt.Fprintf(&result, "%d微秒", d/time.Microsecond)
+		} else {
+			fmt.Fprintf(&result, "%d纳秒", d)
+		}
+	}
+	return result.String()
 }
 
 // MustParseDuration 解析 duration 字符串，解析失败时 panic
@@ -253,3 +390,106 @@ func DurationTruncate(d, precision time.Duration) time.Duration {
 	}
 	return d.Truncate(precision)
 }
+
+// 预置的延迟分桶，按指数增长，适合作为直方图的桶边界
+var (
+	// FastLatencyBuckets 适合缓存、内存操作等快速调用: 100µs ~ 约 400ms
+	FastLatencyBuckets = DurationBuckets(100*time.Microsecond, 2, 13)
+
+	// DefaultLatencyBuckets 适合一般 RPC/HTTP 请求: 1ms ~ 约 16s
+	DefaultLatencyBuckets = DurationBuckets(time.Millisecond, 2, 15)
+
+	// SlowLatencyBuckets 适合批处理、外部 API 等慢调用: 10ms ~ 约 30min
+	SlowLatencyBuckets = DurationBuckets(10*time.Millisecond, 3, 12)
+)
+
+// DurationBuckets 生成指数增长的 Duration 分桶
+//
+// 第一个桶为 start，之后每个桶是前一个的 factor 倍，共 count 个。
+// start <= 0、factor <= 1 或 count <= 0 时返回 nil
+//
+// 示例:
+//
+//	timex.DurationBuckets(time.Millisecond, 2, 4)  // [1ms 2ms 4ms 8ms]
+func DurationBuckets(start time.Duration, factor float64, count int) []time.Duration {
+	if start <= 0 || factor <= 1 || count <= 0 {
+		return nil
+	}
+
+	buckets := make([]time.Duration, count)
+	cur := float64(start)
+	for i := range buckets {
+		buckets[i] = time.Duration(cur)
+		cur *= factor
+	}
+	return buckets
+}
+
+// BucketsSeconds 将 Duration 分桶转换为秒（float64），可直接用于 Prometheus Histogram
+//
+// 示例:
+//
+//	prometheus.HistogramOpts{Buckets: timex.BucketsSeconds(timex.DefaultLatencyBuckets)}
+func BucketsSeconds(buckets []time.Duration) []float64 {
+	result := make([]float64, len(buckets))
+	for i, b := range buckets {
+		result[i] = b.Seconds()
+	}
+	return result
+}
+
+// BucketsMillis 将 Duration 分桶转换为毫秒（float64）
+func BucketsMillis(buckets []time.Duration) []float64 {
+	result := make([]float64, len(buckets))
+	for i, b := range buckets {
+		result[i] = float64(b) / float64(time.Millisecond)
+	}
+	return result
+}
+
+// SinceSeconds 返回从 t 到现在经过的秒数（含小数）
+//
+// 示例:
+//
+//	start := time.Now()
+//	// ...
+//	histogram.Observe(timex.SinceSeconds(start))
+func SinceSeconds(t time.Time) float64 {
+	return Now().Sub(t).Seconds()
+}
+
+// SinceMillis 返回从 t 到现在经过的毫秒数（含小数）
+func SinceMillis(t time.Time) float64 {
+	return float64(Now().Sub(t)) / float64(time.Millisecond)
+}
+
+// RoundDuration 将 Duration 按有效数字位数四舍五入，便于展示
+//
+// 与按固定精度处理的 DurationRound 不同，RoundDuration 根据 d 的量级保留
+// digits 位有效数字；digits <= 0 时返回 d 本身
+//
+// 示例:
+//
+//	timex.RoundDuration(1234567*time.Microsecond, 3)  // 1.23s
+//	timex.RoundDuration(123456*time.Microsecond, 2)   // 120ms
+//	timex.RoundDuration(1500*time.Nanosecond, 1)      // 2µs
+func RoundDuration(d time.Duration, digits int) time.Duration {
+	if digits <= 0 || d == 0 {
+		return d
+	}
+
+	abs := d
+	if abs < 0 {
+		abs = -abs
+	}
+
+	// 计算保留 digits 位有效数字对应的精度
+	precision := time.Duration(1)
+	for n := abs; n >= 10; n /= 10 {
+		precision *= 10
+	}
+	for i := 1; i < digits && precision > 1; i++ {
+		precision /= 10
+	}
+	return d.Round(precision)
+}
@@ -103,25 +103,151 @@ func EndOfYear(t time.Time) time.Time {
 	return time.Date(t.Year(), 12, 31, 23, 59, 59, 999999999, t.Location())
 }
 
+// StartOfQuarter 获取本季度开始时间
+func StartOfQuarter(t time.Time) time.Time {
+	month := (t.Month()-1)/3*3 + 1
+	return time.Date(t.Year(), month, 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfQuarter 获取本季度结束时间
+func EndOfQuarter(t time.Time) time.Time {
+	return StartOfQuarter(t).AddDate(0, 3, 0).Add(-time.Nanosecond)
+}
+
+// Unit 时间单位，用于 StartOf/EndOf 和 Humanize
+type Unit int
+
+const (
+	// UnitDay 天
+	UnitDay Unit = iota
+	// UnitWeek 周（周一为一周开始）
+	UnitWeek
+	// UnitMonth 月
+	UnitMonth
+	// UnitQuarter 季度
+	UnitQuarter
+	// UnitYear 年
+	UnitYear
+	// UnitSecond 秒
+	UnitSecond
+	// UnitMinute 分钟
+	UnitMinute
+	// UnitHour 小时
+	UnitHour
+)
+
+// StartOf 获取 t 所在秒/分钟/小时/天/周/月/季度/年的开始时间
+//
+// 未知单位返回 t 本身
+//
+// 示例:
+//
+//	timex.StartOf(t, timex.UnitQuarter)  // 2024-05-20 → 2024-04-01 00:00:00
+func StartOf(t time.Time, unit Unit) time.Time {
+	switch unit {
+	case UnitSecond:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, t.Location())
+	case UnitMinute:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, t.Location())
+	case UnitHour:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	case UnitDay:
+		return StartOfDay(t)
+	case UnitWeek:
+		return StartOfWeek(t)
+	case UnitMonth:
+		return StartOfMonth(t)
+	case UnitQuarter:
+		return StartOfQuarter(t)
+	case UnitYear:
+		return StartOfYear(t)
+	default:
+		return t
+	}
+}
+
+// EndOf 获取 t 所在秒/分钟/小时/天/周/月/季度/年的结束时间（最后一纳秒）
+//
+// 未知单位返回 t 本身
+//
+// 示例:
+//
+//	timex.EndOf(t, timex.UnitMonth)  // 2024-02-10 → 2024-02-29 23:59:59.999999999
+func EndOf(t time.Time, unit Unit) time.Time {
+	switch unit {
+	case UnitSecond:
+		return StartOf(t, UnitSecond).Add(time.Second - time.Nanosecond)
+	case UnitMinute:
+		return StartOf(t, UnitMinute).Add(time.Minute - time.Nanosecond)
+	case UnitHour:
+		return StartOf(t, UnitHour).Add(time.Hour - time.Nanosecond)
+	case UnitDay:
+		return EndOfDay(t)
+	case UnitWeek:
+		return EndOfWeek(t)
+	case UnitMonth:
+		return EndOfMonth(t)
+	case UnitQuarter:
+		return EndOfQuarter(t)
+	case UnitYear:
+		return EndOfYear(t)
+	default:
+		return t
+	}
+}
+
 // Between 判断时间是否在范围内（包含边界）
 func Between(t, start, end time.Time) bool {
 	return (t.Equal(start) || t.After(start)) && (t.Equal(end) || t.Before(end))
 }
 
 // DaysBetween 计算两个时间之间的天数差（绝对值）
+//
+// 按日历日计算，与时刻无关；夏令时切换日（23 或 25 小时）也按 1 天计
 func DaysBetween(t1, t2 time.Time) int {
-	// 归一化到当天 00:00:00
-	t1 = StartOfDay(t1)
-	t2 = StartOfDay(t2)
+	// 按各自时区的日期换算到 UTC 零点，避免夏令时导致的小时数偏差
+	d1 := time.Date(t1.Year(), t1.Month(), t1.Day(), 0, 0, 0, 0, time.UTC)
+	d2 := time.Date(t2.Year(), t2.Month(), t2.Day(), 0, 0, 0, 0, time.UTC)
 
-	duration := t2.Sub(t1)
-	days := int(duration.Hours() / 24)
+	days := int(d2.Sub(d1).Hours() / 24)
 	if days < 0 {
 		days = -days
 	}
 	return days
 }
 
+// MonthsBetween 计算两个时间之间相差的完整月数（绝对值）
+//
+// 较晚时间的日期和时刻未达到较早时间的日期和时刻时，不足一个月的部分不计
+//
+// 示例:
+//
+//	timex.MonthsBetween(jan15, mar15)  // 2
+//	timex.MonthsBetween(jan15, mar14)  // 1
+//	timex.MonthsBetween(jan31, feb29)  // 0
+func MonthsBetween(t1, t2 time.Time) int {
+	if t2.Before(t1) {
+		t1, t2 = t2, t1
+	}
+	// 统一到 t1 的时区比较日期
+	t2 = t2.In(t1.Location())
+
+	months := (t2.Year()-t1.Year())*12 + int(t2.Month()-t1.Month())
+	if months > 0 && timeOfMonth(t2) < timeOfMonth(t1) {
+		months--
+	}
+	return months
+}
+
+// timeOfMonth 返回月内偏移（日、时、分、秒、纳秒），用于比较月内先后
+func timeOfMonth(t time.Time) time.Duration {
+	return time.Duration(t.Day()-1)*24*time.Hour +
+		time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second +
+		time.Duration(t.Nanosecond())
+}
+
 // HoursBetween 计算两个时间之间的小时数差（绝对值）
 func HoursBetween(t1, t2 time.Time) int {
 	duration := t2.Sub(t1)
@@ -162,6 +288,36 @@ func AddYears(t time.Time, years int) time.Time {
 	return t.AddDate(years, 0, 0)
 }
 
+// AddBusinessDays 添加工作日，跳过周六和周日
+//
+// days 为负数时向前计算；时刻保持不变。从周末开始计算时，
+// 第 1 个工作日为随后（或之前）的第一个工作日。
+//
+// 示例:
+//
+//	timex.AddBusinessDays(friday, 1)    // 下周一
+//	timex.AddBusinessDays(monday, -1)   // 上周五
+//	timex.AddBusinessDays(saturday, 1)  // 下周一
+func AddBusinessDays(t time.Time, days int) time.Time {
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+
+	// 从工作日出发时，每 7 天恰好包含 5 个工作日
+	if !IsWeekend(t) {
+		t = t.AddDate(0, 0, days/5*7*step)
+		days %= 5
+	}
+	for days > 0 {
+		t = t.AddDate(0, 0, step)
+		if !IsWeekend(t) {
+			days--
+		}
+	}
+	return t
+}
+
 // DaysInMonth 获取指定月份的天数
 func DaysInMonth(year int, month time.Month) int {
 	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
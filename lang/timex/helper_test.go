@@ -281,6 +281,40 @@ func TestDaysBetween(t *testing.T) {
 	}
 }
 
+func TestDaysBetween_DST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone data not available")
+	}
+	// 2024-03-10 夏令时开始，当天只有 23 小时
+	t1 := time.Date(2024, 3, 10, 0, 0, 0, 0, loc)
+	t2 := time.Date(2024, 3, 11, 0, 0, 0, 0, loc)
+	if days := DaysBetween(t1, t2); days != 1 {
+		t.Errorf("expected 1 day across DST change, got %d", days)
+	}
+}
+
+func TestMonthsBetween(t *testing.T) {
+	tests := []struct {
+		t1, t2   time.Time
+		expected int
+	}{
+		{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), 2},
+		{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC), 1},
+		{time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC), time.Date(2024, 2, 15, 11, 0, 0, 0, time.UTC), 0},
+		{time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), 0},
+		{time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 14},
+		{time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), 2},
+		{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC), 0},
+	}
+
+	for _, tt := range tests {
+		if got := MonthsBetween(tt.t1, tt.t2); got != tt.expected {
+			t.Errorf("MonthsBetween(%v, %v) = %d, want %d", tt.t1, tt.t2, got, tt.expected)
+		}
+	}
+}
+
 func TestHoursBetween(t *testing.T) {
 	t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
 	t2 := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
@@ -440,3 +474,91 @@ func TestToUnixMilli(t *testing.T) {
 		t.Errorf("expected 1704067200000, got %d", ts)
 	}
 }
+
+func TestStartOfEndOfQuarter(t *testing.T) {
+	tests := []struct {
+		t          time.Time
+		start, end time.Time
+	}{
+		{
+			time.Date(2024, 2, 10, 15, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 3, 31, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 6, 30, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			time.Date(2024, 12, 31, 23, 0, 0, 0, time.UTC),
+			time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 12, 31, 23, 59, 59, 999999999, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		if got := StartOfQuarter(tt.t); !got.Equal(tt.start) {
+			t.Errorf("StartOfQuarter(%v) = %v, want %v", tt.t, got, tt.start)
+		}
+		if got := EndOfQuarter(tt.t); !got.Equal(tt.end) {
+			t.Errorf("EndOfQuarter(%v) = %v, want %v", tt.t, got, tt.end)
+		}
+	}
+}
+
+func TestStartOfEndOf(t *testing.T) {
+	ts := time.Date(2024, 2, 14, 10, 30, 0, 0, time.UTC) // 周三
+
+	tests := []struct {
+		unit       Unit
+		start, end time.Time
+	}{
+		{UnitDay, time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 14, 23, 59, 59, 999999999, time.UTC)},
+		{UnitWeek, time.Date(2024, 2, 12, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 18, 23, 59, 59, 999999999, time.UTC)},
+		{UnitMonth, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 29, 23, 59, 59, 999999999, time.UTC)},
+		{UnitQuarter, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 31, 23, 59, 59, 999999999, time.UTC)},
+		{UnitYear, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{UnitHour, time.Date(2024, 2, 14, 10, 0, 0, 0, time.UTC), time.Date(2024, 2, 14, 10, 59, 59, 999999999, time.UTC)},
+		{UnitMinute, time.Date(2024, 2, 14, 10, 30, 0, 0, time.UTC), time.Date(2024, 2, 14, 10, 30, 59, 999999999, time.UTC)},
+		{Unit(99), ts, ts},
+	}
+
+	for _, tt := range tests {
+		if got := StartOf(ts, tt.unit); !got.Equal(tt.start) {
+			t.Errorf("StartOf(%d) = %v, want %v", tt.unit, got, tt.start)
+		}
+		if got := EndOf(ts, tt.unit); !got.Equal(tt.end) {
+			t.Errorf("EndOf(%d) = %v, want %v", tt.unit, got, tt.end)
+		}
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 9, 0, 0, 0, time.UTC) }
+	// 2024-01-05 周五, 01-06 周六, 01-07 周日, 01-08 周一
+
+	tests := []struct {
+		from     time.Time
+		days     int
+		expected time.Time
+	}{
+		{day(5), 1, day(8)},
+		{day(8), -1, day(5)},
+		{day(6), 1, day(8)},
+		{day(7), -1, day(5)},
+		{day(1), 5, day(8)},
+		{day(1), 12, day(17)},
+		{day(17), -12, day(1)},
+		{day(3), 0, day(3)},
+		{day(6), 0, day(6)},
+		{day(6), 5, day(12)},
+	}
+
+	for _, tt := range tests {
+		if got := AddBusinessDays(tt.from, tt.days); !got.Equal(tt.expected) {
+			t.Errorf("AddBusinessDays(%s, %d) = %s, want %s",
+				tt.from.Format("2006-01-02 Mon"), tt.days, got.Format("2006-01-02 Mon"), tt.expected.Format("2006-01-02 Mon"))
+		}
+	}
+}
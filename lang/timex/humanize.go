@@ -0,0 +1,193 @@
+package timex
+
+import (
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// Locale 相对时间的本地化文案
+//
+// 实现该接口即可支持新的语言，见 LocaleZH 和 LocaleEN
+type Locale interface {
+	// JustNow 时间差小于 10 秒时的文案，如 "刚刚"
+	JustNow() string
+
+	// Relative 返回 n 个 unit 之前（future 为 false）或之后（future 为 true）的文案，
+	// unit 取值为 UnitSecond/UnitMinute/UnitHour/UnitDay/UnitWeek/UnitMonth/UnitYear
+	Relative(n int, unit Unit, future bool) string
+}
+
+var (
+	// LocaleZH 中文: "3 分钟前"、"2 小时后"
+	LocaleZH Locale = zhLocale{}
+	// LocaleEN 英文: "3 minutes ago"、"in 2 hours"
+	LocaleEN Locale = enLocale{}
+)
+
+var defaultLocale atomic.Value
+
+func init() {
+	defaultLocale.Store(localeHolder{LocaleZH})
+}
+
+// localeHolder 保证 atomic.Value 中存储的具体类型一致
+type localeHolder struct {
+	Locale
+}
+
+// SetDefaultLocale 设置 Humanize 默认使用的语言，初始为 LocaleZH
+func SetDefaultLocale(l Locale) {
+	if l != nil {
+		defaultLocale.Store(localeHolder{l})
+	}
+}
+
+// HumanizeOption Humanize 选项
+type HumanizeOption func(*humanizeOptions)
+
+type humanizeOptions struct {
+	locale Locale
+	now    time.Time
+}
+
+// WithLocale 指定语言，覆盖默认语言
+func WithLocale(l Locale) HumanizeOption {
+	return func(o *humanizeOptions) {
+		if l != nil {
+			o.locale = l
+		}
+	}
+}
+
+// WithReference 指定参照时间，默认为 Now()
+func WithReference(ref time.Time) HumanizeOption {
+	return func(o *humanizeOptions) {
+		o.now = ref
+	}
+}
+
+// Humanize 将时间格式化为相对当前时间的描述
+//
+// t 早于当前时间时为过去时（"3 分钟前"），晚于当前时间时为将来时（"3 分钟后"）。
+//
+// 规则:
+//   - 10 秒以内: 刚刚
+//   - 1 分钟以内: n 秒
+//   - 1 小时以内: n 分钟
+//   - 1 天以内: n 小时
+//   - 7 天以内: n 天
+//   - 1 个月以内: n 周
+//   - 1 年以内: n 个月（按日历月计算）
+//   - 其他: n 年
+//
+// 示例:
+//
+//	timex.Humanize(time.Now().Add(-3 * time.Minute))  // "3 分钟前"
+//	timex.Humanize(time.Now().Add(2 * time.Hour))     // "2 小时后"
+//	timex.Humanize(t, timex.WithLocale(timex.LocaleEN))  // "3 minutes ago"
+func Humanize(t time.Time, opts ...HumanizeOption) string {
+	o := humanizeOptions{
+		locale: defaultLocale.Load().(localeHolder).Locale,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.now.IsZero() {
+		o.now = Now()
+	}
+
+	d := t.Sub(o.now)
+	future := d > 0
+	if d < 0 {
+		d = -d
+	}
+
+	const (
+		day  = 24 * time.Hour
+		week = 7 * day
+	)
+	switch {
+	case d < 10*time.Second:
+		return o.locale.JustNow()
+	case d < time.Minute:
+		return o.locale.Relative(int(d/time.Second), UnitSecond, future)
+	case d < time.Hour:
+		return o.locale.Relative(int(d/time.Minute), UnitMinute, future)
+	case d < day:
+		return o.locale.Relative(int(d/time.Hour), UnitHour, future)
+	case d < week:
+		return o.locale.Relative(int(d/day), UnitDay, future)
+	}
+
+	months := MonthsBetween(t, o.now)
+	switch {
+	case months < 1:
+		return o.locale.Relative(int(d/week), UnitWeek, future)
+	case months < 12:
+		return o.locale.Relative(months, UnitMonth, future)
+	default:
+		return o.locale.Relative(months/12, UnitYear, future)
+	}
+}
+
+type zhLocale struct{}
+
+func (zhLocale) JustNow() string { return "刚刚" }
+
+func (zhLocale) Relative(n int, unit Unit, future bool) string {
+	var name string
+	switch unit {
+	case UnitSecond:
+		name = "秒"
+	case UnitMinute:
+		name = "分钟"
+	case UnitHour:
+		name = "小时"
+	case UnitDay:
+		name = "天"
+	case UnitWeek:
+		name = "周"
+	case UnitMonth:
+		name = "个月"
+	case UnitYear:
+		name = "年"
+	}
+	suffix := "前"
+	if future {
+		suffix = "后"
+	}
+	return strconv.Itoa(n) + " " + name + suffix
+}
+
+type enLocale struct{}
+
+func (enLocale) JustNow() string { return "just now" }
+
+func (enLocale) Relative(n int, unit Unit, future bool) string {
+	var name string
+	switch unit {
+	case UnitSecond:
+		name = "second"
+	case UnitMinute:
+		name = "minute"
+	case UnitHour:
+		name = "hour"
+	case UnitDay:
+		name = "day"
+	case UnitWeek:
+		name = "week"
+	case UnitMonth:
+		name = "month"
+	case UnitYear:
+		name = "year"
+	}
+	if n != 1 {
+		name += "s"
+	}
+	s := strconv.Itoa(n) + " " + name
+	if future {
+		return "in " + s
+	}
+	return s + " ago"
+}
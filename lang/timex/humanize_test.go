@@ -0,0 +1,75 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHumanize(t *testing.T) {
+	ref := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		t  time.Time
+		zh string
+		en string
+	}{
+		{ref, "刚刚", "just now"},
+		{ref.Add(-5 * time.Second), "刚刚", "just now"},
+		{ref.Add(-30 * time.Second), "30 秒前", "30 seconds ago"},
+		{ref.Add(-time.Minute), "1 分钟前", "1 minute ago"},
+		{ref.Add(-3 * time.Minute), "3 分钟前", "3 minutes ago"},
+		{ref.Add(2 * time.Hour), "2 小时后", "in 2 hours"},
+		{ref.Add(-25 * time.Hour), "1 天前", "1 day ago"},
+		{ref.AddDate(0, 0, 10), "1 周后", "in 1 week"},
+		{ref.AddDate(0, 0, -20), "2 周前", "2 weeks ago"},
+		{ref.AddDate(0, -1, 0), "1 个月前", "1 month ago"},
+		{ref.AddDate(0, 5, 0), "5 个月后", "in 5 months"},
+		{ref.AddDate(-1, 0, 0), "1 年前", "1 year ago"},
+		{ref.AddDate(-3, -2, 0), "3 年前", "3 years ago"},
+	}
+
+	for _, tt := range tests {
+		if got := Humanize(tt.t, WithReference(ref)); got != tt.zh {
+			t.Errorf("Humanize(%v) = %q, want %q", tt.t, got, tt.zh)
+		}
+		if got := Humanize(tt.t, WithReference(ref), WithLocale(LocaleEN)); got != tt.en {
+			t.Errorf("Humanize(%v, en) = %q, want %q", tt.t, got, tt.en)
+		}
+	}
+}
+
+func TestHumanize_Now(t *testing.T) {
+	if got := Humanize(time.Now().Add(-3 * time.Minute)); got != "3 分钟前" {
+		t.Errorf("expected '3 分钟前', got %q", got)
+	}
+}
+
+type upperLocale struct{}
+
+func (upperLocale) JustNow() string { return "NOW" }
+
+func (upperLocale) Relative(n int, unit Unit, future bool) string {
+	if future {
+		return "LATER"
+	}
+	return "EARLIER"
+}
+
+func TestSetDefaultLocale(t *testing.T) {
+	defer SetDefaultLocale(LocaleZH)
+
+	SetDefaultLocale(LocaleEN)
+	if got := Humanize(time.Now().Add(-2 * time.Hour)); got != "2 hours ago" {
+		t.Errorf("expected '2 hours ago', got %q", got)
+	}
+
+	SetDefaultLocale(upperLocale{})
+	if got := Humanize(time.Now().Add(time.Hour)); got != "LATER" {
+		t.Errorf("expected custom locale, got %q", got)
+	}
+
+	SetDefaultLocale(nil)
+	if got := Humanize(time.Now()); got != "NOW" {
+		t.Errorf("nil locale should be ignored, got %q", got)
+	}
+}
@@ -2,9 +2,34 @@ package timex
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// defaultLoc MsecFormat/SecFormat 等格式化函数使用的默认时区，nil 表示 time.Local
+var defaultLoc atomic.Pointer[time.Location]
+
+// SetDefaultLocation 设置格式化函数使用的默认时区
+//
+// 影响 MsecFormat、SecFormat 等未显式指定时区的格式化函数，
+// 传入 nil 恢复为进程本地时区。适用于运行在 UTC 容器中但需要输出北京时间的服务
+//
+// 示例:
+//
+//	timex.SetDefaultLocation(timex.Shanghai())
+//	timex.SecFormat(0)  // "1970-01-01 08:00:00"
+func SetDefaultLocation(loc *time.Location) {
+	defaultLoc.Store(loc)
+}
+
+// DefaultLocation 返回格式化函数使用的默认时区，未设置时为 time.Local
+func DefaultLocation() *time.Location {
+	if loc := defaultLoc.Load(); loc != nil {
+		return loc
+	}
+	return time.Local
+}
+
 // 预定义时区（使用懒加载）
 var (
 	shanghaiOnce sync.Once
@@ -0,0 +1,190 @@
+package timex
+
+import (
+	"sort"
+	"time"
+)
+
+// Range 时间区间 [Start, End)，包含开始时间，不包含结束时间
+//
+// 半开区间便于首尾相接地拼接，如按天拆分后相邻区间的 End 等于下一个区间的 Start。
+// End 不晚于 Start 的区间视为空区间
+type Range struct {
+	Start time.Time
+	End   time.Time
+}
+
+// NewRange 创建时间区间，start 晚于 end 时自动交换
+func NewRange(start, end time.Time) Range {
+	if end.Before(start) {
+		start, end = end, start
+	}
+	return Range{Start: start, End: end}
+}
+
+// RangeOf 返回 t 所在的单位区间，如 RangeOf(t, UnitDay) 为 t 当天的 [00:00, 次日 00:00)
+func RangeOf(t time.Time, unit Unit) Range {
+	return Range{Start: StartOf(t, unit), End: nextBoundary(t, unit)}
+}
+
+// IsEmpty 判断是否为空区间
+func (r Range) IsEmpty() bool {
+	return !r.End.After(r.Start)
+}
+
+// Duration 返回区间时长，空区间返回 0
+func (r Range) Duration() time.Duration {
+	if r.IsEmpty() {
+		return 0
+	}
+	return r.End.Sub(r.Start)
+}
+
+// Contains 判断时间点是否在区间内（Start <= t < End）
+func (r Range) Contains(t time.Time) bool {
+	return !t.Before(r.Start) && t.Before(r.End)
+}
+
+// ContainsRange 判断 other 是否完全在区间内
+func (r Range) ContainsRange(other Range) bool {
+	if other.IsEmpty() {
+		return false
+	}
+	return !other.Start.Before(r.Start) && !other.End.After(r.End)
+}
+
+// Overlaps 判断两个区间是否有重叠部分，首尾相接不算重叠
+func (r Range) Overlaps(other Range) bool {
+	return r.Start.Before(other.End) && other.Start.Before(r.End) &&
+		!r.IsEmpty() && !other.IsEmpty()
+}
+
+// Intersect 返回两个区间的交集，无重叠时返回 false
+//
+// 示例:
+//
+//	shift := timex.NewRange(nine, eighteen)
+//	billed, ok := shift.Intersect(timex.RangeOf(t, timex.UnitDay))
+func (r Range) Intersect(other Range) (Range, bool) {
+	if !r.Overlaps(other) {
+		return Range{}, false
+	}
+	return Range{Start: later(r.Start, other.Start), End: earlier(r.End, other.End)}, true
+}
+
+// Union 合并两个重叠或首尾相接的区间，两者之间有空隙时返回 false
+func (r Range) Union(other Range) (Range, bool) {
+	if r.IsEmpty() || other.IsEmpty() ||
+		r.Start.After(other.End) || other.Start.After(r.End) {
+		return Range{}, false
+	}
+	return Range{Start: earlier(r.Start, other.Start), End: later(r.End, other.End)}, true
+}
+
+// Split 按单位边界拆分区间
+//
+// 拆分点为每个单位的开始时间，首尾两段可能不足一个完整单位。
+// 按天拆分时考虑夏令时，每段为当地日历日。空区间返回 nil，未知单位返回区间本身
+//
+// 示例:
+//
+//	r := timex.NewRange(jan1_22h, jan3_02h)
+//	r.Split(timex.UnitDay)
+//	// [jan1 22:00, jan2 00:00) [jan2 00:00, jan3 00:00) [jan3 00:00, jan3 02:00)
+func (r Range) Split(unit Unit) []Range {
+	if r.IsEmpty() {
+		return nil
+	}
+	if unit < UnitDay || unit > UnitHour {
+		return []Range{r}
+	}
+
+	var result []Range
+	for cur := r.Start; cur.Before(r.End); {
+		next := nextBoundary(cur, unit)
+		if next.After(r.End) {
+			next = r.End
+		}
+		result = append(result, Range{Start: cur, End: next})
+		cur = next
+	}
+	return result
+}
+
+// SplitBy 按固定时长拆分区间，最后一段可能不足 d。d <= 0 或空区间返回 nil
+func (r Range) SplitBy(d time.Duration) []Range {
+	if r.IsEmpty() || d <= 0 {
+		return nil
+	}
+
+	var result []Range
+	for cur := r.Start; cur.Before(r.End); cur = cur.Add(d) {
+		result = append(result, Range{Start: cur, End: earlier(cur.Add(d), r.End)})
+	}
+	return result
+}
+
+// String 返回 "[start, end)" 格式的字符串
+func (r Range) String() string {
+	const layout = "2006-01-02 15:04:05"
+	return "[" + r.Start.Format(layout) + ", " + r.End.Format(layout) + ")"
+}
+
+// MergeRanges 合并重叠或首尾相接的区间，返回按开始时间排序的结果，空区间被忽略
+//
+// 示例:
+//
+//	timex.MergeRanges(r1, r2, r3)  // 合并后的不相交区间
+func MergeRanges(ranges ...Range) []Range {
+	sorted := make([]Range, 0, len(ranges))
+	for _, r := range ranges {
+		if !r.IsEmpty() {
+			sorted = append(sorted, r)
+		}
+	}
+	if len(sorted) == 0 {
+		return nil
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	result := []Range{sorted[0]}
+	for _, r := range sorted[1:] {
+		last := &result[len(result)-1]
+		if merged, ok := last.Union(r); ok {
+			*last = merged
+		} else {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// TotalDuration 返回多个区间覆盖的总时长，重叠部分只计算一次
+func TotalDuration(ranges ...Range) time.Duration {
+	var total time.Duration
+	for _, r := range MergeRanges(ranges...) {
+		total += r.Duration()
+	}
+	return total
+}
+
+// nextBoundary 返回 t 之后下一个单位的开始时间
+func nextBoundary(t time.Time, unit Unit) time.Time {
+	return EndOf(t, unit).Add(time.Nanosecond)
+}
+
+func earlier(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
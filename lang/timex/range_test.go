@@ -0,0 +1,168 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRange_Basic(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC)
+
+	r := NewRange(end, start)
+	if !r.Start.Equal(start) || !r.End.Equal(end) {
+		t.Errorf("NewRange should swap reversed bounds: %v", r)
+	}
+	if r.Duration() != 9*time.Hour {
+		t.Errorf("expected 9h, got %v", r.Duration())
+	}
+	if !r.Contains(start) || r.Contains(end) {
+		t.Error("range should include start and exclude end")
+	}
+	if (Range{Start: end, End: start}).Duration() != 0 {
+		t.Error("empty range should have zero duration")
+	}
+	if r.String() != "[2024-01-01 09:00:00, 2024-01-01 18:00:00)" {
+		t.Errorf("unexpected String: %s", r)
+	}
+
+	inner := NewRange(start.Add(time.Hour), end.Add(-time.Hour))
+	if !r.ContainsRange(inner) || inner.ContainsRange(r) {
+		t.Error("unexpected ContainsRange result")
+	}
+}
+
+func TestRange_OverlapsIntersectUnion(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(h int) time.Time { return base.Add(time.Duration(h) * time.Hour) }
+
+	a := NewRange(at(9), at(12))
+	b := NewRange(at(11), at(15))
+	c := NewRange(at(12), at(13))
+	d := NewRange(at(14), at(16))
+
+	if !a.Overlaps(b) || a.Overlaps(c) || a.Overlaps(d) {
+		t.Error("unexpected Overlaps result")
+	}
+
+	got, ok := a.Intersect(b)
+	if !ok || !got.Start.Equal(at(11)) || !got.End.Equal(at(12)) {
+		t.Errorf("unexpected Intersect: %v %v", got, ok)
+	}
+	if _, ok := a.Intersect(c); ok {
+		t.Error("adjacent ranges should not intersect")
+	}
+
+	got, ok = a.Union(c)
+	if !ok || !got.Start.Equal(at(9)) || !got.End.Equal(at(13)) {
+		t.Errorf("adjacent ranges should union: %v %v", got, ok)
+	}
+	if _, ok := a.Union(d); ok {
+		t.Error("ranges with a gap should not union")
+	}
+}
+
+func TestRange_Split(t *testing.T) {
+	r := NewRange(
+		time.Date(2024, 1, 1, 22, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 3, 2, 0, 0, 0, time.UTC),
+	)
+
+	days := r.Split(UnitDay)
+	if len(days) != 3 {
+		t.Fatalf("expected 3 days, got %v", days)
+	}
+	if days[0].Duration() != 2*time.Hour || days[1].Duration() != 24*time.Hour || days[2].Duration() != 2*time.Hour {
+		t.Errorf("unexpected day split: %v", days)
+	}
+	for i := 1; i < len(days); i++ {
+		if !days[i].Start.Equal(days[i-1].End) {
+			t.Errorf("split ranges should be contiguous: %v", days)
+		}
+	}
+
+	hours := NewRange(
+		time.Date(2024, 1, 1, 9, 30, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 11, 15, 0, 0, time.UTC),
+	).Split(UnitHour)
+	if len(hours) != 3 || hours[0].Duration() != 30*time.Minute || hours[2].Duration() != 15*time.Minute {
+		t.Errorf("unexpected hour split: %v", hours)
+	}
+
+	if (Range{}).Split(UnitDay) != nil {
+		t.Error("empty range should split to nil")
+	}
+	if got := r.Split(Unit(100)); len(got) != 1 || got[0] != r {
+		t.Errorf("unknown unit should return range itself: %v", got)
+	}
+}
+
+func TestRange_SplitDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("timezone data not available")
+	}
+
+	// 2024-03-10 美东夏令时开始，当天只有 23 小时
+	r := NewRange(
+		time.Date(2024, 3, 9, 0, 0, 0, 0, loc),
+		time.Date(2024, 3, 12, 0, 0, 0, 0, loc),
+	)
+	days := r.Split(UnitDay)
+	if len(days) != 3 {
+		t.Fatalf("expected 3 days, got %v", days)
+	}
+	if days[1].Duration() != 23*time.Hour {
+		t.Errorf("expected 23h on DST day, got %v", days[1].Duration())
+	}
+}
+
+func TestRange_SplitBy(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := NewRange(start, start.Add(50*time.Minute))
+
+	parts := r.SplitBy(20 * time.Minute)
+	if len(parts) != 3 || parts[2].Duration() != 10*time.Minute {
+		t.Errorf("unexpected SplitBy: %v", parts)
+	}
+	if r.SplitBy(0) != nil {
+		t.Error("SplitBy(0) should return nil")
+	}
+}
+
+func TestRangeOf(t *testing.T) {
+	tm := time.Date(2024, 2, 10, 15, 4, 5, 0, time.UTC)
+	r := RangeOf(tm, UnitMonth)
+	if !r.Start.Equal(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)) ||
+		!r.End.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected RangeOf: %v", r)
+	}
+}
+
+func TestMergeRanges(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(h int) time.Time { return base.Add(time.Duration(h) * time.Hour) }
+
+	merged := MergeRanges(
+		NewRange(at(5), at(7)),
+		NewRange(at(1), at(3)),
+		NewRange(at(2), at(4)),
+		NewRange(at(4), at(5)),
+		NewRange(at(9), at(10)),
+		Range{},
+	)
+	if len(merged) != 2 {
+		t.Fatalf("expected 2 merged ranges, got %v", merged)
+	}
+	if !merged[0].Start.Equal(at(1)) || !merged[0].End.Equal(at(7)) {
+		t.Errorf("unexpected first range: %v", merged[0])
+	}
+
+	total := TotalDuration(NewRange(at(1), at(3)), NewRange(at(2), at(4)))
+	if total != 3*time.Hour {
+		t.Errorf("expected 3h, got %v", total)
+	}
+	if MergeRanges() != nil {
+		t.Error("expected nil for no ranges")
+	}
+}
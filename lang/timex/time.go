@@ -6,6 +6,8 @@ import (
 
 // MsecFormat 将毫秒时间戳转换为 Y-m-d H:i:s 格式
 //
+// 使用 DefaultLocation 时区，默认为进程本地时区，可通过 SetDefaultLocation 修改
+//
 // 参数:
 //   - msectime: 毫秒级 Unix 时间戳
 //
@@ -23,7 +25,7 @@ func MsecFormat(msectime int64) string {
 	sec := msectime / 1000
 	// 转换纳秒余数
 	nsec := (msectime % 1000) * 1e6
-	return time.Unix(sec, nsec).Format("2006-01-02 15:04:05")
+	return time.Unix(sec, nsec).In(DefaultLocation()).Format("2006-01-02 15:04:05")
 }
 
 // MsecFormatWithLayout 将毫秒时间戳转换为自定义格式
@@ -43,7 +45,7 @@ func MsecFormat(msectime int64) string {
 func MsecFormatWithLayout(msectime int64, layout string) string {
 	sec := msectime / 1000
 	nsec := (msectime % 1000) * 1e6
-	return time.Unix(sec, nsec).Format(layout)
+	return time.Unix(sec, nsec).In(DefaultLocation()).Format(layout)
 }
 
 // SecFormat 将秒级时间戳转换为 Y-m-d H:i:s 格式
@@ -54,7 +56,7 @@ func MsecFormatWithLayout(msectime int64, layout string) string {
 // 返回:
 //   - "2006-01-02 15:04:05" 格式的时间字符串
 func SecFormat(sectime int64) string {
-	return time.Unix(sectime, 0).Format("2006-01-02 15:04:05")
+	return time.Unix(sectime, 0).In(DefaultLocation()).Format("2006-01-02 15:04:05")
 }
 
 // SecFormatWithLayout 将秒级时间戳转换为自定义格式
@@ -66,5 +68,46 @@ func SecFormat(sectime int64) string {
 // 返回:
 //   - 格式化的时间字符串
 func SecFormatWithLayout(sectime int64, layout string) string {
-	return time.Unix(sectime, 0).Format(layout)
+	return time.Unix(sectime, 0).In(DefaultLocation()).Format(layout)
+}
+
+// MsecFormatIn 将毫秒时间戳转换为指定时区的 Y-m-d H:i:s 格式
+//
+// 参数:
+//   - msectime: 毫秒级 Unix 时间戳
+//   - loc: 时区，为 nil 时使用 DefaultLocation
+//
+// 示例:
+//
+//	timex.MsecFormatIn(ms, timex.Shanghai())
+//	// 输出: "2024-01-29 15:04:05"（容器时区为 UTC 时同样输出北京时间）
+func MsecFormatIn(msectime int64, loc *time.Location) string {
+	return MsecFormatInWithLayout(msectime, loc, "2006-01-02 15:04:05")
+}
+
+// MsecFormatInWithLayout 将毫秒时间戳转换为指定时区的自定义格式
+//
+// loc 为 nil 时使用 DefaultLocation
+func MsecFormatInWithLayout(msectime int64, loc *time.Location, layout string) string {
+	if loc == nil {
+		loc = DefaultLocation()
+	}
+	return time.UnixMilli(msectime).In(loc).Format(layout)
+}
+
+// SecFormatIn 将秒级时间戳转换为指定时区的 Y-m-d H:i:s 格式
+//
+// loc 为 nil 时使用 DefaultLocation
+func SecFormatIn(sectime int64, loc *time.Location) string {
+	return SecFormatInWithLayout(sectime, loc, "2006-01-02 15:04:05")
+}
+
+// SecFormatInWithLayout 将秒级时间戳转换为指定时区的自定义格式
+//
+// loc 为 nil 时使用 DefaultLocation
+func SecFormatInWithLayout(sectime int64, loc *time.Location, layout string) string {
+	if loc == nil {
+		loc = DefaultLocation()
+	}
+	return time.Unix(sectime, 0).In(loc).Format(layout)
 }
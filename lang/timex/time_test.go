@@ -155,6 +155,59 @@ func TestPrecision(t *testing.T) {
 	}
 }
 
+func TestFormatIn(t *testing.T) {
+	ms := int64(1706423456789) // 2024-01-28 06:30:56.789 UTC
+
+	if got := MsecFormatIn(ms, UTC()); got != "2024-01-28 06:30:56" {
+		t.Errorf("MsecFormatIn(UTC) = %v", got)
+	}
+	if got := MsecFormatIn(ms, Shanghai()); got != "2024-01-28 14:30:56" {
+		t.Errorf("MsecFormatIn(Shanghai) = %v", got)
+	}
+	if got := MsecFormatInWithLayout(ms, Shanghai(), "15:04:05.000"); got != "14:30:56.789" {
+		t.Errorf("MsecFormatInWithLayout = %v", got)
+	}
+	if got := SecFormatIn(ms/1000, Shanghai()); got != "2024-01-28 14:30:56" {
+		t.Errorf("SecFormatIn(Shanghai) = %v", got)
+	}
+	if got := SecFormatInWithLayout(ms/1000, UTC(), "2006-01-02"); got != "2024-01-28" {
+		t.Errorf("SecFormatInWithLayout = %v", got)
+	}
+	// nil 使用默认时区
+	if got, want := SecFormatIn(ms/1000, nil), SecFormat(ms/1000); got != want {
+		t.Errorf("SecFormatIn(nil) = %v, want %v", got, want)
+	}
+}
+
+func TestSetDefaultLocation(t *testing.T) {
+	defer SetDefaultLocation(nil)
+
+	if DefaultLocation() != time.Local {
+		t.Errorf("DefaultLocation() = %v, want Local", DefaultLocation())
+	}
+
+	SetDefaultLocation(Shanghai())
+	if got := SecFormat(0); got != "1970-01-01 08:00:00" {
+		t.Errorf("SecFormat(0) = %v", got)
+	}
+	if got := MsecFormatWithLayout(0, "15:04"); got != "08:00" {
+		t.Errorf("MsecFormatWithLayout(0) = %v", got)
+	}
+
+	SetDefaultLocation(UTC())
+	if got := MsecFormat(0); got != "1970-01-01 00:00:00" {
+		t.Errorf("MsecFormat(0) = %v", got)
+	}
+	if got := SecFormatWithLayout(0, "2006-01-02 15"); got != "1970-01-01 00" {
+		t.Errorf("SecFormatWithLayout(0) = %v", got)
+	}
+
+	SetDefaultLocation(nil)
+	if DefaultLocation() != time.Local {
+		t.Error("SetDefaultLocation(nil) should restore Local")
+	}
+}
+
 func BenchmarkMsecFormat(b *testing.B) {
 	ms := time.Now().UnixMilli()
 	for i := 0; i < b.N; i++ {
@@ -73,6 +73,22 @@ func TestParseDuration(t *testing.T) {
 		{"天和毫秒", "1d500ms", time.Hour*24 + time.Millisecond*500, false},
 		{"负数", "-1d", -time.Hour * 24, false},
 		{"空字符串", "", 0, true},
+		{"周", "2w", 14 * 24 * time.Hour, false},
+		{"天和小时组合", "3d12h", 84 * time.Hour, false},
+		{"小数天", "1.5d", 36 * time.Hour, false},
+		{"中文天", "3天", 72 * time.Hour, false},
+		{"中文组合", "1周2天3小时4分钟5秒", 9*24*time.Hour + 3*time.Hour + 4*time.Minute + 5*time.Second, false},
+		{"中文简写", "2小时30分", 2*time.Hour + 30*time.Minute, false},
+		{"中文毫秒", "1秒500毫秒", 1500 * time.Millisecond, false},
+		{"星期", "1星期", 7 * 24 * time.Hour, false},
+		{"空格分隔", "3天 12小时", 84 * time.Hour, false},
+		{"正号", "+1d", 24 * time.Hour, false},
+		{"微秒", "1d1us", 24*time.Hour + time.Microsecond, false},
+		{"无单位", "1d3", 0, true},
+		{"未知单位", "3x", 0, true},
+		{"只有符号", "-", 0, true},
+		{"只有单位", "d", 0, true},
+		{"溢出", "1000000w", 0, true},
 	}
 
 	for _, tt := range tests {
@@ -95,6 +111,32 @@ func TestParseDuration(t *testing.T) {
 	}
 }
 
+func TestFormatDurationZH(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		expected string
+	}{
+		{0, "0秒"},
+		{51*time.Hour + 5*time.Minute, "2天3小时5分"},
+		{90 * time.Second, "1分30秒"},
+		{time.Hour + 500*time.Millisecond, "1小时500毫秒"},
+		{-48 * time.Hour, "-2天"},
+		{1500 * time.Nanosecond, "1微秒"},
+		{15 * time.Nanosecond, "15纳秒"},
+	}
+	for _, tt := range tests {
+		if got := FormatDurationZH(tt.duration); got != tt.expected {
+			t.Errorf("FormatDurationZH(%v) = %s, want %s", tt.duration, got, tt.expected)
+		}
+	}
+
+	// 格式化结果可以被 ParseDuration 解析回来
+	d := 51*time.Hour + 5*time.Minute + 7*time.Second
+	if got, err := ParseDuration(FormatDurationZH(d)); err != nil || got != d {
+		t.Errorf("round trip = %v, %v; want %v", got, err, d)
+	}
+}
+
 func TestMustParseDuration(t *testing.T) {
 	d := MustParseDuration("1d")
 	if d != time.Hour*24 {
@@ -125,6 +167,80 @@ func TestDurationTruncate(t *testing.T) {
 	}
 }
 
+func TestDurationBuckets(t *testing.T) {
+	got := DurationBuckets(time.Millisecond, 2, 4)
+	want := []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond, 8 * time.Millisecond}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("bucket %d: expected %v, got %v", i, want[i], got[i])
+		}
+	}
+
+	if DurationBuckets(0, 2, 4) != nil || DurationBuckets(time.Second, 1, 4) != nil || DurationBuckets(time.Second, 2, 0) != nil {
+		t.Error("expected nil for invalid arguments")
+	}
+
+	for _, b := range [][]time.Duration{FastLatencyBuckets, DefaultLatencyBuckets, SlowLatencyBuckets} {
+		for i := 1; i < len(b); i++ {
+			if b[i] <= b[i-1] {
+				t.Errorf("buckets not increasing: %v", b)
+				break
+			}
+		}
+	}
+}
+
+func TestBucketsConvert(t *testing.T) {
+	b := []time.Duration{500 * time.Microsecond, 2 * time.Second}
+
+	secs := BucketsSeconds(b)
+	if secs[0] != 0.0005 || secs[1] != 2 {
+		t.Errorf("BucketsSeconds = %v", secs)
+	}
+	millis := BucketsMillis(b)
+	if millis[0] != 0.5 || millis[1] != 2000 {
+		t.Errorf("BucketsMillis = %v", millis)
+	}
+}
+
+func TestSince(t *testing.T) {
+	ref := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	oldNow := Now
+	Now = func() time.Time { return ref.Add(1500 * time.Millisecond) }
+	defer func() { Now = oldNow }()
+
+	if got := SinceSeconds(ref); got != 1.5 {
+		t.Errorf("SinceSeconds = %v, want 1.5", got)
+	}
+	if got := SinceMillis(ref); got != 1500 {
+		t.Errorf("SinceMillis = %v, want 1500", got)
+	}
+}
+
+func TestRoundDuration(t *testing.T) {
+	tests := []struct {
+		d      time.Duration
+		digits int
+		want   time.Duration
+	}{
+		{1234567 * time.Microsecond, 3, 1230 * time.Millisecond},
+		{123456 * time.Microsecond, 2, 120 * time.Millisecond},
+		{1500 * time.Nanosecond, 1, 2 * time.Microsecond},
+		{-1234567 * time.Microsecond, 2, -1200 * time.Millisecond},
+		{7 * time.Nanosecond, 3, 7 * time.Nanosecond},
+		{time.Second, 0, time.Second},
+		{0, 3, 0},
+	}
+	for _, tt := range tests {
+		if got := RoundDuration(tt.d, tt.digits); got != tt.want {
+			t.Errorf("RoundDuration(%v, %d) = %v, want %v", tt.d, tt.digits, got, tt.want)
+		}
+	}
+}
+
 // Location tests
 func TestShanghai(t *testing.T) {
 	loc := Shanghai()
@@ -0,0 +1,90 @@
+package tuple
+
+import (
+	"cmp"
+	"slices"
+)
+
+// 元素均为 cmp.Ordered 的元组按字典序比较：先比较 First，相等时再比较 Second，依此类推。
+// Go 方法不能收紧类型参数约束，因此比较和排序以包级函数提供。
+
+// Compare2 按字典序比较两个二元组
+//
+// 返回:
+//   - int: x < y 返回 -1，x == y 返回 0，x > y 返回 1
+//
+// 示例:
+//
+//	tuple.Compare2(tuple.T2("a", 2), tuple.T2("a", 1))  // 1
+func Compare2[A, B cmp.Ordered](x, y Tuple2[A, B]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	return cmp.Compare(x.Second, y.Second)
+}
+
+// Less2 按字典序判断 x 是否小于 y
+func Less2[A, B cmp.Ordered](x, y Tuple2[A, B]) bool {
+	return Compare2(x, y) < 0
+}
+
+// Compare3 按字典序比较两个三元组
+//
+// 返回:
+//   - int: x < y 返回 -1，x == y 返回 0，x > y 返回 1
+func Compare3[A, B, C cmp.Ordered](x, y Tuple3[A, B, C]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Second, y.Second); c != 0 {
+		return c
+	}
+	return cmp.Compare(x.Third, y.Third)
+}
+
+// Less3 按字典序判断 x 是否小于 y
+func Less3[A, B, C cmp.Ordered](x, y Tuple3[A, B, C]) bool {
+	return Compare3(x, y) < 0
+}
+
+// Compare4 按字典序比较两个四元组
+//
+// 返回:
+//   - int: x < y 返回 -1，x == y 返回 0，x > y 返回 1
+func Compare4[A, B, C, D cmp.Ordered](x, y Tuple4[A, B, C, D]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Second, y.Second); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Third, y.Third); c != 0 {
+		return c
+	}
+	return cmp.Compare(x.Fourth, y.Fourth)
+}
+
+// Less4 按字典序判断 x 是否小于 y
+func Less4[A, B, C, D cmp.Ordered](x, y Tuple4[A, B, C, D]) bool {
+	return Compare4(x, y) < 0
+}
+
+// SortTuples2 按字典序原地排序二元组切片（稳定排序）
+//
+// 示例:
+//
+//	keys := []tuple.Tuple2[string, int]{{"b", 1}, {"a", 2}, {"a", 1}}
+//	tuple.SortTuples2(keys)  // [{a 1} {a 2} {b 1}]
+func SortTuples2[A, B cmp.Ordered](tuples []Tuple2[A, B]) {
+	slices.SortStableFunc(tuples, Compare2[A, B])
+}
+
+// SortTuples3 按字典序原地排序三元组切片（稳定排序）
+func SortTuples3[A, B, C cmp.Ordered](tuples []Tuple3[A, B, C]) {
+	slices.SortStableFunc(tuples, Compare3[A, B, C])
+}
+
+// SortTuples4 按字典序原地排序四元组切片（稳定排序）
+func SortTuples4[A, B, C, D cmp.Ordered](tuples []Tuple4[A, B, C, D]) {
+	slices.SortStableFunc(tuples, Compare4[A, B, C, D])
+}
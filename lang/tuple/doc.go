@@ -4,9 +4,12 @@
 //   - Tuple2[A, B]: 二元组
 //   - Tuple3[A, B, C]: 三元组
 //   - Tuple4[A, B, C, D]: 四元组
+//   - Tuple5 ~ Tuple9: 五元组到九元组
 //
 // 主要功能:
-//   - 构造函数: T2/T3/T4
+//   - 构造函数: T2 ~ T9
+//   - JSON: 所有元组按位置序列化为 JSON 数组，如 ["a",1,true]
+//   - 比较与排序: Compare2/Less2/SortTuples2 等，元素为 cmp.Ordered 时按字典序
 //   - 解包: Unpack 方法
 //   - 交换: Swap 方法（仅 Tuple2）
 //   - Zip/Unzip: 切片配对/拆分
@@ -34,9 +37,12 @@
 //   - Tuple2[A, B]: a 2-element tuple
 //   - Tuple3[A, B, C]: a 3-element tuple
 //   - Tuple4[A, B, C, D]: a 4-element tuple
+//   - Tuple5 to Tuple9: 5- to 9-element tuples
 //
 // Main features:
-//   - Constructors: T2/T3/T4
+//   - Constructors: T2 to T9
+//   - JSON: all tuples marshal positionally as JSON arrays, e.g. ["a",1,true]
+//   - Compare and sort: Compare2/Less2/SortTuples2 etc., lexicographic for cmp.Ordered elements
 //   - Unpack: Unpack method
 //   - Swap: Swap method (Tuple2 only)
 //   - Zip/Unzip: pair/split slices
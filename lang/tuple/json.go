@@ -0,0 +1,110 @@
+package tuple
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// 元组按位置序列化为 JSON 数组，例如 T3("a", 1, true) 序列化为 ["a",1,true]。
+// 反序列化时要求数组长度与元组元数一致。
+
+// unmarshalArray 将 JSON 数组按位置解码到 dst，null 时保持不变
+func unmarshalArray(data []byte, dst ...any) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != len(dst) {
+		return fmt.Errorf("tuple: expected JSON array of %d elements, got %d", len(dst), len(raw))
+	}
+	for i, r := range raw {
+		if err := json.Unmarshal(r, dst[i]); err != nil {
+			return fmt.Errorf("tuple: element %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON 序列化为 JSON 数组
+func (t Tuple2[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second})
+}
+
+// UnmarshalJSON 从长度为 2 的 JSON 数组反序列化
+func (t *Tuple2[A, B]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.First, &t.Second)
+}
+
+// MarshalJSON 序列化为 JSON 数组
+func (t Tuple3[A, B, C]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third})
+}
+
+// UnmarshalJSON 从长度为 3 的 JSON 数组反序列化
+func (t *Tuple3[A, B, C]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.First, &t.Second, &t.Third)
+}
+
+// MarshalJSON 序列化为 JSON 数组
+func (t Tuple4[A, B, C, D]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth})
+}
+
+// UnmarshalJSON 从长度为 4 的 JSON 数组反序列化
+func (t *Tuple4[A, B, C, D]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.First, &t.Second, &t.Third, &t.Fourth)
+}
+
+// MarshalJSON 序列化为 JSON 数组
+func (t Tuple5[A, B, C, D, E]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth, t.Fifth})
+}
+
+// UnmarshalJSON 从长度为 5 的 JSON 数组反序列化
+func (t *Tuple5[A, B, C, D, E]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.First, &t.Second, &t.Third, &t.Fourth, &t.Fifth)
+}
+
+// MarshalJSON 序列化为 JSON 数组
+func (t Tuple6[A, B, C, D, E, F]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth})
+}
+
+// UnmarshalJSON 从长度为 6 的 JSON 数组反序列化
+func (t *Tuple6[A, B, C, D, E, F]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.First, &t.Second, &t.Third, &t.Fourth, &t.Fifth, &t.Sixth)
+}
+
+// MarshalJSON 序列化为 JSON 数组
+func (t Tuple7[A, B, C, D, E, F, G]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh})
+}
+
+// UnmarshalJSON 从长度为 7 的 JSON 数组反序列化
+func (t *Tuple7[A, B, C, D, E, F, G]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.First, &t.Second, &t.Third, &t.Fourth, &t.Fifth, &t.Sixth, &t.Seventh)
+}
+
+// MarshalJSON 序列化为 JSON 数组
+func (t Tuple8[A, B, C, D, E, F, G, H]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh, t.Eighth})
+}
+
+// UnmarshalJSON 从长度为 8 的 JSON 数组反序列化
+func (t *Tuple8[A, B, C, D, E, F, G, H]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.First, &t.Second, &t.Third, &t.Fourth, &t.Fifth, &t.Sixth, &t.Seventh, &t.Eighth)
+}
+
+// MarshalJSON 序列化为 JSON 数组
+func (t Tuple9[A, B, C, D, E, F, G, H, I]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh, t.Eighth, t.Ninth})
+}
+
+// UnmarshalJSON 从长度为 9 的 JSON 数组反序列化
+func (t *Tuple9[A, B, C, D, E, F, G, H, I]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.First, &t.Second, &t.Third, &t.Fourth, &t.Fifth, &t.Sixth, &t.Seventh, &t.Eighth, &t.Ninth)
+}
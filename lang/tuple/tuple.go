@@ -131,6 +131,191 @@ func (t Tuple4[A, B, C, D]) Unpack() (A, B, C, D) {
 	return t.First, t.Second, t.Third, t.Fourth
 }
 
+// Tuple5 五元组，包含五个不同类型的值
+type Tuple5[A, B, C, D, E any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+}
+
+// T5 创建一个五元组
+//
+// 参数:
+//   - a: 第一个值
+//   - b: 第二个值
+//   - c: 第三个值
+//   - d: 第四个值
+//   - e: 第五个值
+//
+// 返回:
+//   - Tuple5[A, B, C, D, E]: 五元组
+//
+// 示例:
+//
+//	t := tuple.T5("id", "name", 18, true, 3.14)
+func T5[A, B, C, D, E any](a A, b B, c C, d D, e E) Tuple5[A, B, C, D, E] {
+	return Tuple5[A, B, C, D, E]{First: a, Second: b, Third: c, Fourth: d, Fifth: e}
+}
+
+// Unpack 解包五元组，返回五个值
+func (t Tuple5[A, B, C, D, E]) Unpack() (A, B, C, D, E) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth
+}
+
+// Tuple6 六元组，包含六个不同类型的值
+type Tuple6[A, B, C, D, E, F any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+	Sixth  F
+}
+
+// T6 创建一个六元组
+//
+// 参数:
+//   - a: 第一个值
+//   - b: 第二个值
+//   - c: 第三个值
+//   - d: 第四个值
+//   - e: 第五个值
+//   - f: 第六个值
+//
+// 返回:
+//   - Tuple6[A, B, C, D, E, F]: 六元组
+//
+// 示例:
+//
+//	t := tuple.T6("id", "name", 18, true, 3.14, int64(7))
+func T6[A, B, C, D, E, F any](a A, b B, c C, d D, e E, f F) Tuple6[A, B, C, D, E, F] {
+	return Tuple6[A, B, C, D, E, F]{First: a, Second: b, Third: c, Fourth: d, Fifth: e, Sixth: f}
+}
+
+// Unpack 解包六元组，返回六个值
+func (t Tuple6[A, B, C, D, E, F]) Unpack() (A, B, C, D, E, F) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth
+}
+
+// Tuple7 七元组，包含七个不同类型的值
+type Tuple7[A, B, C, D, E, F, G any] struct {
+	First   A
+	Second  B
+	Third   C
+	Fourth  D
+	Fifth   E
+	Sixth   F
+	Seventh G
+}
+
+// T7 创建一个七元组
+//
+// 参数:
+//   - a: 第一个值
+//   - b: 第二个值
+//   - c: 第三个值
+//   - d: 第四个值
+//   - e: 第五个值
+//   - f: 第六个值
+//   - g: 第七个值
+//
+// 返回:
+//   - Tuple7[A, B, C, D, E, F, G]: 七元组
+//
+// 示例:
+//
+//	t := tuple.T7("id", "name", 18, true, 3.14, int64(7), "tag")
+func T7[A, B, C, D, E, F, G any](a A, b B, c C, d D, e E, f F, g G) Tuple7[A, B, C, D, E, F, G] {
+	return Tuple7[A, B, C, D, E, F, G]{First: a, Second: b, Third: c, Fourth: d, Fifth: e, Sixth: f, Seventh: g}
+}
+
+// Unpack 解包七元组，返回七个值
+func (t Tuple7[A, B, C, D, E, F, G]) Unpack() (A, B, C, D, E, F, G) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh
+}
+
+// Tuple8 八元组，包含八个不同类型的值
+type Tuple8[A, B, C, D, E, F, G, H any] struct {
+	First   A
+	Second  B
+	Third   C
+	Fourth  D
+	Fifth   E
+	Sixth   F
+	Seventh G
+	Eighth  H
+}
+
+// T8 创建一个八元组
+//
+// 参数:
+//   - a: 第一个值
+//   - b: 第二个值
+//   - c: 第三个值
+//   - d: 第四个值
+//   - e: 第五个值
+//   - f: 第六个值
+//   - g: 第七个值
+//   - h: 第八个值
+//
+// 返回:
+//   - Tuple8[A, B, C, D, E, F, G, H]: 八元组
+//
+// 示例:
+//
+//	t := tuple.T8("id", "name", 18, true, 3.14, int64(7), "tag", []int{1})
+func T8[A, B, C, D, E, F, G, H any](a A, b B, c C, d D, e E, f F, g G, h H) Tuple8[A, B, C, D, E, F, G, H] {
+	return Tuple8[A, B, C, D, E, F, G, H]{First: a, Second: b, Third: c, Fourth: d, Fifth: e, Sixth: f, Seventh: g, Eighth: h}
+}
+
+// Unpack 解包八元组，返回八个值
+func (t Tuple8[A, B, C, D, E, F, G, H]) Unpack() (A, B, C, D, E, F, G, H) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh, t.Eighth
+}
+
+// Tuple9 九元组，包含九个不同类型的值
+type Tuple9[A, B, C, D, E, F, G, H, I any] struct {
+	First   A
+	Second  B
+	Third   C
+	Fourth  D
+	Fifth   E
+	Sixth   F
+	Seventh G
+	Eighth  H
+	Ninth   I
+}
+
+// T9 创建一个九元组
+//
+// 参数:
+//   - a: 第一个值
+//   - b: 第二个值
+//   - c: 第三个值
+//   - d: 第四个值
+//   - e: 第五个值
+//   - f: 第六个值
+//   - g: 第七个值
+//   - h: 第八个值
+//   - i: 第九个值
+//
+// 返回:
+//   - Tuple9[A, B, C, D, E, F, G, H, I]: 九元组
+//
+// 示例:
+//
+//	t := tuple.T9("id", "name", 18, true, 3.14, int64(7), "tag", []int{1}, 'x')
+func T9[A, B, C, D, E, F, G, H, I any](a A, b B, c C, d D, e E, f F, g G, h H, i I) Tuple9[A, B, C, D, E, F, G, H, I] {
+	return Tuple9[A, B, C, D, E, F, G, H, I]{First: a, Second: b, Third: c, Fourth: d, Fifth: e, Sixth: f, Seventh: g, Eighth: h, Ninth: i}
+}
+
+// Unpack 解包九元组，返回九个值
+func (t Tuple9[A, B, C, D, E, F, G, H, I]) Unpack() (A, B, C, D, E, F, G, H, I) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh, t.Eighth, t.Ninth
+}
+
 // FromPair 从键值对创建二元组
 //
 // 参数:
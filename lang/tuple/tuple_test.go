@@ -1,6 +1,7 @@
 package tuple
 
 import (
+	"encoding/json"
 	"testing"
 )
 
@@ -291,3 +292,156 @@ func TestZipUnzipRoundTrip(t *testing.T) {
 		}
 	}
 }
+
+func TestT5ToT9(t *testing.T) {
+	t5 := T5(1, "a", true, 2.5, 'x')
+	a, b, c, d, e := t5.Unpack()
+	if a != 1 || b != "a" || !c || d != 2.5 || e != 'x' {
+		t.Errorf("unexpected Tuple5 values: %+v", t5)
+	}
+
+	t9 := T9(1, 2, 3, 4, 5, 6, 7, 8, "nine")
+	if t9.First != 1 || t9.Eighth != 8 || t9.Ninth != "nine" {
+		t.Errorf("unexpected Tuple9 values: %+v", t9)
+	}
+	_, _, _, _, _, _, _, _, last := t9.Unpack()
+	if last != "nine" {
+		t.Errorf("expected 'nine', got %v", last)
+	}
+
+	if v := T6(1, 2, 3, 4, 5, 6).Sixth; v != 6 {
+		t.Errorf("expected Sixth = 6, got %v", v)
+	}
+	if v := T7(1, 2, 3, 4, 5, 6, 7).Seventh; v != 7 {
+		t.Errorf("expected Seventh = 7, got %v", v)
+	}
+	if v := T8(1, 2, 3, 4, 5, 6, 7, 8).Eighth; v != 8 {
+		t.Errorf("expected Eighth = 8, got %v", v)
+	}
+}
+
+func TestTuple_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		value    any
+		expected string
+	}{
+		{T2("a", 1), `["a",1]`},
+		{T3("a", 1, true), `["a",1,true]`},
+		{T4("a", 1, true, []int{2}), `["a",1,true,[2]]`},
+		{T9(1, 2, 3, 4, 5, 6, 7, 8, "x"), `[1,2,3,4,5,6,7,8,"x"]`},
+		{[]Tuple2[string, int]{T2("a", 1)}, `[["a",1]]`},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.value)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		if string(data) != tt.expected {
+			t.Errorf("expected %s, got %s", tt.expected, data)
+		}
+	}
+}
+
+func TestTuple_UnmarshalJSON(t *testing.T) {
+	var t3 Tuple3[string, int, bool]
+	if err := json.Unmarshal([]byte(`["a", 1, true]`), &t3); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if t3 != T3("a", 1, true) {
+		t.Errorf("unexpected value: %+v", t3)
+	}
+
+	var t5 Tuple5[int, int, int, int, string]
+	if err := json.Unmarshal([]byte(`[1,2,3,4,"five"]`), &t5); err != nil || t5.Fifth != "five" {
+		t.Errorf("unexpected result: %+v, %v", t5, err)
+	}
+
+	// 长度不匹配
+	if err := json.Unmarshal([]byte(`["a", 1]`), &t3); err == nil {
+		t.Error("expected error for wrong array length")
+	}
+	// 元素类型不匹配
+	if err := json.Unmarshal([]byte(`[1, 1, true]`), &t3); err == nil {
+		t.Error("expected error for wrong element type")
+	}
+
+	// null 保持原值
+	t2 := T2("keep", 1)
+	if err := json.Unmarshal([]byte(`null`), &t2); err != nil || t2.First != "keep" {
+		t.Errorf("null should leave tuple unchanged, got %+v, %v", t2, err)
+	}
+}
+
+func TestTuple_JSONRoundTrip(t *testing.T) {
+	rows := []Tuple7[string, int, int64, float64, bool, []string, map[string]int]{
+		T7("2024-01-01", 10, int64(200), 0.5, true, []string{"a"}, map[string]int{"k": 1}),
+	}
+	data, err := json.Marshal(rows)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var got []Tuple7[string, int, int64, float64, bool, []string, map[string]int]
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if len(got) != 1 || got[0].First != "2024-01-01" || got[0].Seventh["k"] != 1 || got[0].Sixth[0] != "a" {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+}
+
+func TestCompare2(t *testing.T) {
+	tests := []struct {
+		x, y     Tuple2[string, int]
+		expected int
+	}{
+		{T2("a", 1), T2("a", 1), 0},
+		{T2("a", 1), T2("a", 2), -1},
+		{T2("b", 1), T2("a", 9), 1},
+	}
+	for _, tt := range tests {
+		if got := Compare2(tt.x, tt.y); got != tt.expected {
+			t.Errorf("Compare2(%v, %v) = %d, expected %d", tt.x, tt.y, got, tt.expected)
+		}
+	}
+	if !Less2(T2("a", 1), T2("a", 2)) || Less2(T2("a", 2), T2("a", 2)) {
+		t.Error("Less2 returned unexpected result")
+	}
+}
+
+func TestCompare3And4(t *testing.T) {
+	if Compare3(T3(1, "a", 2.0), T3(1, "a", 1.5)) != 1 {
+		t.Error("Compare3 should compare the last element when others are equal")
+	}
+	if !Less3(T3(1, "a", 2.0), T3(1, "b", 0.0)) {
+		t.Error("Less3 should compare Second before Third")
+	}
+	if Compare4(T4(1, 2, 3, 4), T4(1, 2, 3, 4)) != 0 {
+		t.Error("Compare4 should return 0 for equal tuples")
+	}
+	if !Less4(T4(1, 2, 3, 4), T4(1, 2, 3, 5)) {
+		t.Error("Less4 should compare Fourth")
+	}
+}
+
+func TestSortTuples(t *testing.T) {
+	keys := []Tuple2[string, int]{T2("b", 1), T2("a", 2), T2("a", 1)}
+	SortTuples2(keys)
+	expected := []Tuple2[string, int]{T2("a", 1), T2("a", 2), T2("b", 1)}
+	for i := range expected {
+		if keys[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, keys)
+		}
+	}
+
+	rows := []Tuple3[int, int, string]{T3(2, 1, "x"), T3(1, 2, "y"), T3(1, 1, "z")}
+	SortTuples3(rows)
+	if rows[0] != T3(1, 1, "z") || rows[2] != T3(2, 1, "x") {
+		t.Errorf("unexpected order: %v", rows)
+	}
+
+	quads := []Tuple4[int, int, int, int]{T4(1, 1, 1, 2), T4(1, 1, 1, 1)}
+	SortTuples4(quads)
+	if quads[0] != T4(1, 1, 1, 1) {
+		t.Errorf("unexpected order: %v", quads)
+	}
+}
@@ -12,12 +12,15 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
+	"github.com/hexagon-codes/toolkit/util/circuit"
 )
 
 var (
 	// ErrSSRFBlocked SSRF 防护拦截错误
 	// 当请求目标为私有/内网 IP 时返回此错误
-	ErrSSRFBlocked = errors.New("httpx: request blocked by SSRF protection (private/internal IP)")
+	ErrSSRFBlocked = errorx.NewSentinel(errorx.CategoryForbidden, "httpx: request blocked by SSRF protection (private/internal IP)")
 )
 
 // Client HTTP 客户端封装
@@ -31,6 +34,9 @@ type Client struct {
 	ssrfProtect  bool     // SSRF 防护开关
 	allowedHosts []string // SSRF 防护：允许的主机白名单（为空则检查所有）
 	maxBodySize  int64    // 最大响应体大小
+
+	breakers    *circuit.BreakerManager   // 按主机熔断（为 nil 则不启用）
+	breakerOpts []circuit.TransportOption // 熔断 Transport 配置
 }
 
 // Option 客户端配置选项
@@ -63,6 +69,13 @@ func NewClient(opts ...Option) *Client {
 		)
 	}
 
+	// 熔断包装在最外层；SSRF 拦截是本地策略拒绝，不反映下游健康状况，不计入熔断统计，
+	// 调用方始终能通过 errors.Is(err, ErrSSRFBlocked) 识别
+	if c.breakers != nil {
+		opts := append([]circuit.TransportOption{circuit.WithIgnoreError(isSSRFBlocked)}, c.breakerOpts...)
+		c.client.Transport = circuit.NewTransport(c.client.Transport, c.breakers, opts...)
+	}
+
 	return c
 }
 
@@ -128,6 +141,26 @@ func WithSSRFProtection(allowedHosts ...string) Option {
 	}
 }
 
+// WithCircuitBreaker 启用熔断
+//
+// 请求按主机（可通过 circuit.WithRequestKey 修改）从 breakers 获取熔断器，
+// 传输错误和 429/5xx 响应计入失败（SSRF 拦截除外）；熔断时请求直接返回包装了 circuit.ErrCircuitOpen 的错误。
+// 每次重试都会单独经过熔断器。
+//
+// 示例:
+//
+//	hosts := circuit.NewBreakerManagerWithOptions(circuit.WithErrorRate(0.5, 20))
+//	client := httpx.NewClient(httpx.WithCircuitBreaker(hosts))
+func WithCircuitBreaker(breakers *circuit.BreakerManager, opts ...circuit.TransportOption) Option {
+	return func(c *Client) {
+		if breakers == nil {
+			breakers = circuit.NewBreakerManager(nil)
+		}
+		c.breakers = breakers
+		c.breakerOpts = opts
+	}
+}
+
 // WithMaxBodySize 设置最大响应体大小（默认 100MB）
 func WithMaxBodySize(size int64) Option {
 	return func(c *Client) {
@@ -329,6 +362,10 @@ func (r *Request) execute() (*Response, error) {
 		if err == nil && resp.StatusCode < 500 {
 			break
 		}
+		// 熔断拒绝时重试没有意义，直接返回
+		if errors.Is(err, circuit.ErrCircuitOpen) || errors.Is(err, circuit.ErrTooManyRequests) {
+			break
+		}
 		// 注意：Response.Body 是 []byte，已在 doRequest 中读取并关闭了原始 http.Response.Body
 		// 所以这里不需要额外关闭操作
 	}
@@ -381,6 +418,11 @@ func (r *Request) doRequest(fullURL string) (*Response, error) {
 	}, nil
 }
 
+// isSSRFBlocked 判断错误是否为 SSRF 防护拦截
+func isSSRFBlocked(err error) bool {
+	return errors.Is(err, ErrSSRFBlocked)
+}
+
 // checkSSRF 检查 URL 是否存在 SSRF 风险
 // 返回 ErrSSRFBlocked 表示请求被拦截
 func (c *Client) checkSSRF(rawURL string) error {
@@ -3,10 +3,16 @@ package httpx
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/hexagon-codes/toolkit/util/circuit"
 )
 
 func TestNewClient(t *testing.T) {
@@ -430,3 +436,60 @@ func TestContextCancellation(t *testing.T) {
 		t.Error("expected error for cancelled context")
 	}
 }
+
+func TestWithCircuitBreaker(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	hosts := circuit.NewBreakerManagerWithOptions(circuit.WithThreshold(2))
+	client := NewClient(WithCircuitBreaker(hosts), WithRetry(3, time.Millisecond))
+
+	// 第一次请求：重试过程中连续两次 503 触发熔断，之后的重试被拒绝且不再继续
+	_, err := client.R().Get(server.URL)
+	if !errors.Is(err, circuit.ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if got := hits.Load(); got != 2 {
+		t.Errorf("expected 2 requests to reach the server, got %d", got)
+	}
+
+	u, _ := url.Parse(server.URL)
+	if hosts.Get(u.Host).State() != circuit.StateOpen {
+		t.Errorf("expected breaker for %s to be open", u.Host)
+	}
+}
+
+func TestWithCircuitBreaker_SSRFBlockedNotCounted(t *testing.T) {
+	// 模拟连接阶段被 SSRF 防护拦截（如 DNS 解析到内网 IP）
+	blocked := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: ErrSSRFBlocked}
+	})
+
+	hosts := circuit.NewBreakerManagerWithOptions(circuit.WithThreshold(1))
+	client := NewClient(WithTransport(blocked), WithCircuitBreaker(hosts))
+
+	for i := 0; i < 3; i++ {
+		_, err := client.R().Get("http://rebind.example.com/")
+		if !errors.Is(err, ErrSSRFBlocked) {
+			t.Fatalf("request %d: expected ErrSSRFBlocked, got %v", i, err)
+		}
+	}
+	b := hosts.Get("rebind.example.com")
+	if b.State() != circuit.StateClosed {
+		t.Errorf("SSRF blocks should not open the breaker, got %v", b.State())
+	}
+	if s := b.Stats(); s.TotalFailures != 0 || s.TotalSuccesses != 0 {
+		t.Errorf("SSRF blocks should not be counted, got %+v", s)
+	}
+}
+
+// roundTripperFunc 函数形式的 http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,194 @@
+package ip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 默认检查参数
+const (
+	defaultCheckTimeout = 5 * time.Second
+	defaultWaitInitial  = 100 * time.Millisecond
+	defaultWaitMax      = 5 * time.Second
+)
+
+// CheckPort 检查 TCP 端口是否可连接
+//
+// 参数:
+//   - host: 主机名或 IP（IPv6 无需加方括号）
+//   - port: 端口号
+//   - timeout: 连接超时，<= 0 时使用默认值 5s
+//
+// 返回:
+//   - error: 可连接时返回 nil，否则返回连接错误
+//
+// 示例:
+//
+//	if err := ip.CheckPort("localhost", 3306, time.Second); err != nil {
+//	    log.Printf("mysql 不可达: %v", err)
+//	}
+func CheckPort(host string, port int, timeout time.Duration) error {
+	if port <= 0 || port > 65535 {
+		return fmt.Errorf("ip: invalid port %d", port)
+	}
+	if timeout <= 0 {
+		timeout = defaultCheckTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return dialTCP(ctx, net.JoinHostPort(host, strconv.Itoa(port)))
+}
+
+// dialTCP 建立 TCP 连接后立即关闭
+func dialTCP(ctx context.Context, addr string) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// CheckHTTP 检查 HTTP 地址是否可用（默认超时 5s）
+//
+// 发送 GET 请求，状态码小于 400 视为可用
+//
+// 示例:
+//
+//	err := ip.CheckHTTP("http://localhost:8080/health")
+func CheckHTTP(url string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultCheckTimeout)
+	defer cancel()
+	return CheckHTTPContext(ctx, url)
+}
+
+// CheckHTTPContext 检查 HTTP 地址是否可用，超时和取消由 ctx 控制
+func CheckHTTPContext(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("ip: %s returned status %d", url, resp.StatusCode)
+	}
+	return nil
+}
+
+// waitOptions WaitForReachable 的配置
+type waitOptions struct {
+	initial time.Duration // 首次重试间隔
+	max     time.Duration // 最大重试间隔
+	timeout time.Duration // 单次检查超时
+	onRetry func(attempt int, err error)
+}
+
+// WaitOption WaitForReachable 选项函数
+type WaitOption func(*waitOptions)
+
+// WithWaitInterval 设置重试间隔范围，间隔从 initial 开始每次翻倍，最大为 maxInterval
+func WithWaitInterval(initial, maxInterval time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		if initial > 0 {
+			o.initial = initial
+		}
+		if maxInterval > 0 {
+			o.max = maxInterval
+		}
+	}
+}
+
+// WithCheckTimeout 设置单次检查的超时时间（默认 5s）
+func WithCheckTimeout(d time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		if d > 0 {
+			o.timeout = d
+		}
+	}
+}
+
+// WithOnRetry 设置每次检查失败时的回调，可用于输出等待日志
+func WithOnRetry(fn func(attempt int, err error)) WaitOption {
+	return func(o *waitOptions) {
+		o.onRetry = fn
+	}
+}
+
+// WaitForReachable 等待地址可达，失败时按指数退避重试，直到成功或 ctx 结束
+//
+// 参数:
+//   - ctx: 控制总等待时间
+//   - addr: "host:port" 检查 TCP 端口；"http://" 或 "https://" 开头时检查 HTTP 地址
+//   - opts: 重试间隔、单次超时等选项
+//
+// 返回:
+//   - error: 可达时返回 nil；ctx 结束时返回包含最后一次失败原因的错误，
+//     可用 errors.Is(err, context.DeadlineExceeded) 判断
+//
+// 示例:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	if err := ip.WaitForReachable(ctx, "localhost:5432"); err != nil {
+//	    log.Fatalf("数据库未就绪: %v", err)
+//	}
+func WaitForReachable(ctx context.Context, addr string, opts ...WaitOption) error {
+	o := &waitOptions{
+		initial: defaultWaitInitial,
+		max:     defaultWaitMax,
+		timeout: defaultCheckTimeout,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	isHTTP := strings.HasPrefix(addr, "http://") || strings.HasPrefix(addr, "https://")
+	if !isHTTP {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return err
+		}
+	}
+
+	interval := o.initial
+	for attempt := 1; ; attempt++ {
+		checkCtx, cancel := context.WithTimeout(ctx, o.timeout)
+		var err error
+		if isHTTP {
+			err = CheckHTTPContext(checkCtx, addr)
+		} else {
+			err = dialTCP(checkCtx, addr)
+		}
+		cancel()
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("ip: %s not reachable: %w", addr, errors.Join(ctx.Err(), err))
+		}
+		if o.onRetry != nil {
+			o.onRetry(attempt, err)
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("ip: %s not reachable: %w", addr, errors.Join(ctx.Err(), err))
+		case <-timer.C:
+		}
+		interval = min(interval*2, o.max)
+	}
+}
@@ -18,6 +18,15 @@
 //	network, err := ip.ParseCIDR("192.168.1.0/24")
 //	network.Contains("192.168.1.100")  // true
 //
+// 可达性检查（集成测试、启动时等待依赖服务）:
+//
+//	err := ip.CheckPort("localhost", 3306, time.Second)
+//	err = ip.CheckHTTP("http://localhost:8080/health")
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	err = ip.WaitForReachable(ctx, "localhost:5432")  // 指数退避重试直到可达
+//
 // --- English ---
 //
 // Package ip provides IP address utilities.
@@ -39,4 +48,13 @@
 //
 //	network, err := ip.ParseCIDR("192.168.1.0/24")
 //	network.Contains("192.168.1.100")  // true
+//
+// Reachability checks (integration tests, waiting for dependencies at startup):
+//
+//	err := ip.CheckPort("localhost", 3306, time.Second)
+//	err = ip.CheckHTTP("http://localhost:8080/health")
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	err = ip.WaitForReachable(ctx, "localhost:5432")  // retries with exponential backoff
 package ip
@@ -1,9 +1,13 @@
 package ip
 
 import (
+	"context"
+	"errors"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestIsValid(t *testing.T) {
@@ -377,3 +381,101 @@ func TestGetMACAddress(t *testing.T) {
 		t.Logf("Unexpected MAC address format: %s", mac)
 	}
 }
+
+func TestCheckPort(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := ln.Addr().(*net.TCPAddr).Port
+
+	if err := CheckPort("127.0.0.1", port, time.Second); err != nil {
+		t.Errorf("CheckPort() on open port = %v, want nil", err)
+	}
+
+	ln.Close()
+	if err := CheckPort("127.0.0.1", port, time.Second); err == nil {
+		t.Error("CheckPort() on closed port should fail")
+	}
+	if err := CheckPort("127.0.0.1", 70000, time.Second); err == nil {
+		t.Error("CheckPort() with invalid port should fail")
+	}
+}
+
+func TestCheckHTTP(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/down" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	if err := CheckHTTP(srv.URL + "/health"); err != nil {
+		t.Errorf("CheckHTTP() = %v, want nil", err)
+	}
+	if err := CheckHTTP(srv.URL + "/down"); err == nil {
+		t.Error("CheckHTTP() on 503 should fail")
+	}
+}
+
+func TestWaitForReachable(t *testing.T) {
+	// 先占用端口再释放，稍后在同一端口上启动监听
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	started := make(chan net.Listener, 1)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		l, err := net.Listen("tcp", addr)
+		if err != nil {
+			started <- nil
+			return
+		}
+		started <- l
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	retries := 0
+	err = WaitForReachable(ctx, addr,
+		WithWaitInterval(10*time.Millisecond, 20*time.Millisecond),
+		WithOnRetry(func(int, error) { retries++ }),
+	)
+	if l := <-started; l != nil {
+		defer l.Close()
+	} else {
+		t.Skip("port was taken by another process")
+	}
+	if err != nil {
+		t.Fatalf("WaitForReachable() = %v, want nil", err)
+	}
+	if retries == 0 {
+		t.Error("expected at least one retry before the listener started")
+	}
+}
+
+func TestWaitForReachable_Timeout(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err = WaitForReachable(ctx, addr, WithWaitInterval(10*time.Millisecond, 10*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitForReachable() = %v, want DeadlineExceeded", err)
+	}
+
+	if err := WaitForReachable(context.Background(), "no-port"); err == nil {
+		t.Error("WaitForReachable() with invalid addr should fail")
+	}
+}
@@ -22,6 +22,14 @@
 //	    fmt.Println(event.Data)
 //	}
 //
+//	// 按事件类型分发，数据自动解码为结构体
+//	router := sse.NewRouter().Use(sse.ExcludeEvents("ping"))
+//	sse.On(router, "delta", func(d Delta) error {
+//	    fmt.Print(d.Text)
+//	    return nil
+//	})
+//	err = router.ServeStream(ctx, stream)
+//
 // --- English ---
 //
 // Package sse provides Server-Sent Events (SSE) handling capabilities.
@@ -48,4 +56,12 @@
 //	for event := range stream.Events() {
 //	    fmt.Println(event.Data)
 //	}
+//
+//	// Dispatch by event type with data decoded into structs
+//	router := sse.NewRouter().Use(sse.ExcludeEvents("ping"))
+//	sse.On(router, "delta", func(d Delta) error {
+//	    fmt.Print(d.Text)
+//	    return nil
+//	})
+//	err = router.ServeStream(ctx, stream)
 package sse
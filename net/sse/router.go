@@ -0,0 +1,233 @@
+package sse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrStop 由处理函数返回，表示正常结束分发（如收到结束事件），Serve 返回 nil
+var ErrStop = errors.New("sse: stop")
+
+// DefaultEventType 未指定 event 字段时的事件类型（SSE 规范）
+const DefaultEventType = "message"
+
+// HandlerFunc 事件处理函数
+type HandlerFunc func(event *Event) error
+
+// EventFilter 事件过滤器，返回 true 表示保留该事件
+type EventFilter func(event *Event) bool
+
+// DecodeError 事件数据 JSON 解码失败
+type DecodeError struct {
+	Event *Event // 解码失败的事件
+	Err   error  // 原始错误
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("sse: decode %q event: %v", eventType(e.Event), e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// ============== Router ==============
+
+// Router 按事件类型分发到对应处理函数，避免对 Data 字符串手写 switch
+//
+// 处理函数应在开始分发之前注册，注册方法不是并发安全的
+//
+// 示例:
+//
+//	router := sse.NewRouter()
+//	sse.On(router, "delta", func(d Delta) error {
+//	    fmt.Print(d.Text)
+//	    return nil
+//	})
+//	sse.On(router, "done", func(Usage) error {
+//	    return sse.ErrStop
+//	})
+//	err := router.Serve(sse.NewReader(resp.Body))
+type Router struct {
+	handlers map[string]HandlerFunc
+	fallback HandlerFunc
+	filters  []EventFilter
+}
+
+// NewRouter 创建事件路由器
+func NewRouter() *Router {
+	return &Router{
+		handlers: make(map[string]HandlerFunc),
+	}
+}
+
+// Handle 注册事件类型对应的处理函数
+//
+// 没有 event 字段的事件按 "message" 类型分发。同一类型重复注册时覆盖之前的处理函数
+func (r *Router) Handle(eventType string, h HandlerFunc) *Router {
+	r.handlers[eventType] = h
+	return r
+}
+
+// Default 设置未注册事件类型的处理函数，未设置时忽略这些事件
+func (r *Router) Default(h HandlerFunc) *Router {
+	r.fallback = h
+	return r
+}
+
+// Use 添加事件过滤器，任一过滤器返回 false 的事件不会被分发
+func (r *Router) Use(filters ...EventFilter) *Router {
+	r.filters = append(r.filters, filters...)
+	return r
+}
+
+// On 注册类型化的处理函数，事件数据按 JSON 解码为 T 后调用 fn
+//
+// 解码失败时返回 *DecodeError
+//
+// 示例:
+//
+//	sse.On(router, "message", func(chunk ChatChunk) error {
+//	    fmt.Print(chunk.Choices[0].Delta.Content)
+//	    return nil
+//	})
+func On[T any](r *Router, eventType string, fn func(T) error) *Router {
+	return r.Handle(eventType, func(event *Event) error {
+		var v T
+		if err := event.JSON(&v); err != nil {
+			return &DecodeError{Event: event, Err: err}
+		}
+		return fn(v)
+	})
+}
+
+// Dispatch 分发单个事件
+//
+// 被过滤或没有匹配的处理函数时返回 nil
+func (r *Router) Dispatch(event *Event) error {
+	if event == nil {
+		return nil
+	}
+	for _, keep := range r.filters {
+		if !keep(event) {
+			return nil
+		}
+	}
+
+	h, ok := r.handlers[eventType(event)]
+	if !ok {
+		h = r.fallback
+	}
+	if h == nil {
+		return nil
+	}
+	return h(event)
+}
+
+// Serve 从 Reader 读取并分发事件，直到 EOF 或处理函数返回错误
+//
+// 处理函数返回 ErrStop 时停止并返回 nil
+func (r *Router) Serve(reader *Reader) error {
+	for {
+		event, err := reader.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := r.Dispatch(event); err != nil {
+			return stopToNil(err)
+		}
+	}
+}
+
+// ServeStream 从 Stream 接收并分发事件，直到流结束、ctx 取消或处理函数返回错误
+//
+// 处理函数返回 ErrStop 时停止并返回 nil。返回时不会关闭 Stream
+func (r *Router) ServeStream(ctx context.Context, s *Stream) error {
+	events := s.Events()
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				// 事件通道关闭后，读取可能存在的错误
+				if err, ok := <-s.Errors(); ok {
+					return err
+				}
+				return nil
+			}
+			if err := r.Dispatch(event); err != nil {
+				return stopToNil(err)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// eventType 返回事件类型，未指定时为 "message"
+func eventType(event *Event) string {
+	if event.Event == "" {
+		return DefaultEventType
+	}
+	return event.Event
+}
+
+// stopToNil 将 ErrStop 转换为 nil
+func stopToNil(err error) error {
+	if errors.Is(err, ErrStop) {
+		return nil
+	}
+	return err
+}
+
+// ============== 事件过滤 ==============
+
+// OnlyEvents 只保留指定类型的事件（未指定 event 字段的按 "message" 匹配）
+func OnlyEvents(types ...string) EventFilter {
+	set := make(map[string]struct{}, len(types))
+	for _, t := range types {
+		set[t] = struct{}{}
+	}
+	return func(event *Event) bool {
+		_, ok := set[eventType(event)]
+		return ok
+	}
+}
+
+// ExcludeEvents 丢弃指定类型的事件（如心跳 "ping"）
+func ExcludeEvents(types ...string) EventFilter {
+	only := OnlyEvents(types...)
+	return func(event *Event) bool {
+		return !only(event)
+	}
+}
+
+// FilterEvents 过滤事件通道，返回只包含所有过滤器都保留的事件的新通道
+//
+// 输入通道关闭后输出通道随之关闭
+//
+// 示例:
+//
+//	for event := range sse.FilterEvents(stream.Events(), sse.ExcludeEvents("ping")) {
+//	    fmt.Println(event.Data)
+//	}
+func FilterEvents(in <-chan *Event, filters ...EventFilter) <-chan *Event {
+	out := make(chan *Event, cap(in))
+	go func() {
+		defer close(out)
+	next:
+		for event := range in {
+			for _, keep := range filters {
+				if !keep(event) {
+					continue next
+				}
+			}
+			out <- event
+		}
+	}()
+	return out
+}
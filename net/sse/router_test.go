@@ -0,0 +1,137 @@
+package sse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type testDelta struct {
+	Text string `json:"text"`
+}
+
+func TestRouter_Serve(t *testing.T) {
+	input := `event: delta
+data: {"text": "Hello"}
+
+event: ping
+data: {}
+
+data: plain message
+
+event: delta
+data: {"text": " World"}
+
+event: done
+data: {}
+
+event: delta
+data: {"text": "ignored"}
+
+`
+	var text strings.Builder
+	var messages []string
+
+	router := NewRouter()
+	On(router, "delta", func(d testDelta) error {
+		text.WriteString(d.Text)
+		return nil
+	})
+	router.Handle("message", func(e *Event) error {
+		messages = append(messages, e.Data)
+		return nil
+	})
+	router.Handle("done", func(*Event) error { return ErrStop })
+
+	if err := router.Serve(NewReader(strings.NewReader(input))); err != nil {
+		t.Fatalf("Serve failed: %v", err)
+	}
+	if text.String() != "Hello World" {
+		t.Errorf("expected 'Hello World', got '%s'", text.String())
+	}
+	if len(messages) != 1 || messages[0] != "plain message" {
+		t.Errorf("unexpected messages: %v", messages)
+	}
+}
+
+func TestRouter_DefaultAndFilter(t *testing.T) {
+	var seen []string
+	router := NewRouter().
+		Use(ExcludeEvents("ping")).
+		Default(func(e *Event) error {
+			seen = append(seen, e.Event)
+			return nil
+		})
+
+	for _, name := range []string{"a", "ping", "b"} {
+		if err := router.Dispatch(&Event{Event: name}); err != nil {
+			t.Fatalf("Dispatch failed: %v", err)
+		}
+	}
+	if fmt.Sprint(seen) != "[a b]" {
+		t.Errorf("expected [a b], got %v", seen)
+	}
+}
+
+func TestRouter_DecodeError(t *testing.T) {
+	router := NewRouter()
+	On(router, "delta", func(testDelta) error { return nil })
+
+	err := router.Serve(NewReader(strings.NewReader("event: delta\ndata: not json\n\n")))
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("expected DecodeError, got %v", err)
+	}
+	if decodeErr.Event.Data != "not json" {
+		t.Errorf("unexpected event data: %s", decodeErr.Event.Data)
+	}
+}
+
+func TestRouter_ServeStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sw := NewWriter(w)
+		sw.Write(&Event{Event: "delta", Data: `{"text": "a"}`})
+		sw.Write(&Event{Event: "delta", Data: `{"text": "b"}`})
+	}))
+	defer server.Close()
+
+	stream, err := NewClient(server.URL).Connect(context.Background())
+	if err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	defer stream.Close()
+
+	var got []string
+	router := NewRouter()
+	On(router, "delta", func(d testDelta) error {
+		got = append(got, d.Text)
+		return nil
+	})
+	if err := router.ServeStream(context.Background(), stream); err != nil {
+		t.Fatalf("ServeStream failed: %v", err)
+	}
+	if fmt.Sprint(got) != "[a b]" {
+		t.Errorf("expected [a b], got %v", got)
+	}
+}
+
+func TestFilterEvents(t *testing.T) {
+	in := make(chan *Event, 4)
+	in <- &Event{Event: "delta"}
+	in <- &Event{Event: "ping"}
+	in <- &Event{Data: "message"}
+	in <- &Event{Event: "error"}
+	close(in)
+
+	var got []string
+	for e := range FilterEvents(in, OnlyEvents("delta", "message")) {
+		got = append(got, eventType(e))
+	}
+	if fmt.Sprint(got) != "[delta message]" {
+		t.Errorf("expected [delta message], got %v", got)
+	}
+}
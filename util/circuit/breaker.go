@@ -3,9 +3,13 @@ package circuit
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
 )
 
 // State 熔断器状态
@@ -35,15 +39,25 @@ func (s State) String() string {
 
 var (
 	// ErrCircuitOpen 熔断器打开
-	ErrCircuitOpen = errors.New("circuit breaker is open")
+	ErrCircuitOpen = errorx.NewSentinel(errorx.CategoryUnavailable, "circuit breaker is open")
 	// ErrTooManyRequests 半开状态下请求过多
-	ErrTooManyRequests = errors.New("too many requests in half-open state")
+	ErrTooManyRequests = errorx.NewSentinel(errorx.CategoryUnavailable, "too many requests in half-open state")
 )
 
 // Config 熔断器配置
 type Config struct {
-	// Threshold 失败阈值，达到后触发熔断
+	// Threshold 连续失败阈值，达到后触发熔断
 	Threshold int
+	// ErrorRate 失败率阈值（0~1），大于 0 时按滑动窗口内的失败率熔断，取代连续失败计数
+	ErrorRate float64
+	// MinRequests 窗口内请求数达到该值后才计算失败率
+	MinRequests int
+	// Window 失败率统计的滑动窗口长度
+	Window time.Duration
+	// SlowCallDuration 慢调用阈值，执行时间超过该值的调用记为慢调用（0 表示不统计）
+	SlowCallDuration time.Duration
+	// SlowCallRate 慢调用率阈值（0~1），窗口内慢调用比例达到该值时熔断
+	SlowCallRate float64
 	// Timeout 熔断持续时间
 	Timeout time.Duration
 	// HalfOpenMaxRequests 半开状态下允许的最大请求数
@@ -56,6 +70,10 @@ type Config struct {
 	OnStateChange func(from, to State)
 	// Now 时间函数（用于测试）
 	Now func() time.Time
+	// Limiter 自适应并发限流器（可选），Execute 时先获取并发许可
+	Limiter *Limiter
+	// Fallback 请求被拒绝时的降级函数（可选），参数为拒绝原因
+	Fallback func(err error) (any, error)
 }
 
 // Option 配置选项
@@ -66,6 +84,51 @@ func WithThreshold(n int) Option {
 	return func(c *Config) { c.Threshold = n }
 }
 
+// WithErrorRate 启用滑动窗口失败率熔断
+//
+// 窗口内请求数不少于 minRequests 且失败率达到 rate 时熔断，
+// 适合突发流量下偶发的连续失败不应触发熔断的场景。启用后 Threshold 不再生效。
+//
+// 示例:
+//
+//	// 10 秒内至少 20 次调用且失败率达到 50% 时熔断
+//	breaker := circuit.New(
+//	    circuit.WithErrorRate(0.5, 20),
+//	    circuit.WithWindow(10*time.Second),
+//	)
+func WithErrorRate(rate float64, minRequests int) Option {
+	return func(c *Config) {
+		c.ErrorRate = rate
+		c.MinRequests = minRequests
+	}
+}
+
+// WithSlowCall 启用慢调用熔断
+//
+// Execute/ExecuteContext 中执行时间超过 d 的调用记为慢调用（无论是否返回错误），
+// 与失败率共用滑动窗口和 MinRequests，窗口内慢调用比例达到 rate 时熔断；
+// 半开状态下的慢调用视为探测失败。用于依赖挂起但不报错时仍能熔断。
+// 手动 API（Allow/Success/Failure）不统计耗时。
+//
+// 示例:
+//
+//	// 10 秒内至少 20 次调用且 80% 超过 2 秒时熔断
+//	breaker := circuit.New(
+//	    circuit.WithSlowCall(2*time.Second, 0.8),
+//	    circuit.WithErrorRate(0.5, 20),
+//	)
+func WithSlowCall(d time.Duration, rate float64) Option {
+	return func(c *Config) {
+		c.SlowCallDuration = d
+		c.SlowCallRate = rate
+	}
+}
+
+// WithWindow 设置失败率统计的滑动窗口长度
+func WithWindow(d time.Duration) Option {
+	return func(c *Config) { c.Window = d }
+}
+
 // WithTimeout 设置熔断超时时间
 func WithTimeout(d time.Duration) Option {
 	return func(c *Config) { c.Timeout = d }
@@ -96,10 +159,35 @@ func WithNow(fn func() time.Time) Option {
 	return func(c *Config) { c.Now = fn }
 }
 
+// WithLimiter 组合自适应并发限流器
+//
+// 被限流器拒绝的请求返回 ErrLimitExceeded，不计入熔断失败。
+// 仅作用于 Execute/ExecuteContext，手动 API（Allow/Success/Failure）不受影响。
+func WithLimiter(l *Limiter) Option {
+	return func(c *Config) { c.Limiter = l }
+}
+
+// WithFallback 设置降级函数
+//
+// Execute/ExecuteContext 因熔断（ErrCircuitOpen、ErrTooManyRequests）或限流（ErrLimitExceeded）
+// 被拒绝时调用 fn，其返回值作为执行结果，可用于统一返回缓存或降级响应。
+// 降级调用不计入熔断统计。手动 API（Allow/Success/Failure）不受影响。
+//
+// 示例:
+//
+//	breaker := circuit.New(circuit.WithFallback(func(err error) (any, error) {
+//	    return cache.Get("last-response")
+//	}))
+func WithFallback(fn func(err error) (any, error)) Option {
+	return func(c *Config) { c.Fallback = fn }
+}
+
 // defaultConfig 默认配置
 func defaultConfig() Config {
 	return Config{
 		Threshold:           5,
+		MinRequests:         20,
+		Window:              10 * time.Second,
 		Timeout:             30 * time.Second,
 		HalfOpenMaxRequests: 3,
 		SuccessThreshold:    2,
@@ -122,12 +210,24 @@ type Breaker struct {
 	lastFailureAt   atomic.Int64
 	openedAt        atomic.Int64
 
+	// window 失败率滑动窗口，仅在 ErrorRate > 0 时创建
+	window *rollingWindow
+
+	// 累计计数（Reset 不清零），用于指标上报
+	totalSuccesses atomic.Uint64
+	totalFailures  atomic.Uint64
+	rejections     atomic.Uint64
+	slowCalls      atomic.Uint64
+	halfOpenProbes atomic.Uint64
+	transitions    atomic.Uint64
+
 	mu             sync.Mutex
 	stateListeners []func(from, to State)
 
 	// notifyCh 用于保序通知状态变更，单一 goroutine 消费确保顺序
 	notifyCh   chan stateChangeEvent
 	notifyOnce sync.Once
+	closeOnce  sync.Once
 }
 
 // stateChangeEvent 状态变更事件
@@ -146,6 +246,9 @@ func New(opts ...Option) *Breaker {
 	b := &Breaker{
 		config: cfg,
 	}
+	if cfg.ErrorRate > 0 || cfg.slowCallEnabled() {
+		b.window = newRollingWindow(cfg.Window)
+	}
 
 	if cfg.OnStateChange != nil {
 		b.stateListeners = append(b.stateListeners, cfg.OnStateChange)
@@ -161,26 +264,122 @@ func (b *Breaker) State() State {
 
 // Execute 执行函数
 func (b *Breaker) Execute(fn func() (any, error)) (any, error) {
-	wasHalfOpen, err := b.beforeExecute()
-	if err != nil {
-		return nil, err
+	result, rejected, err := b.execute(fn)
+	if rejected {
+		return b.reject(err)
 	}
-
-	result, err := fn()
-	b.afterExecute(err, wasHalfOpen)
 	return result, err
 }
 
 // ExecuteContext 执行带上下文的函数
 func (b *Breaker) ExecuteContext(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
+	return b.Execute(func() (any, error) {
+		return fn(ctx)
+	})
+}
+
+// execute 经过限流器和熔断器执行 fn，不调用降级函数
+//
+// 返回的 rejected 标识请求是否被拒绝（fn 未执行），此时 err 为拒绝原因
+func (b *Breaker) execute(fn func() (any, error)) (any, bool, error) {
+	return b.executeOutcome(func() (any, bool, error) {
+		result, err := fn()
+		return result, false, err
+	})
+}
+
+// executeOutcome 同 execute，fn 返回 ignore 为 true 时本次调用不计入熔断和限流统计
+// （如调用方取消的请求），只释放占用的半开探测名额和限流许可
+//
+// fn panic 时按失败计入并释放半开探测名额和限流许可，panic 继续向上传播
+func (b *Breaker) executeOutcome(fn func() (result any, ignore bool, err error)) (any, bool, error) {
+	token, err := b.acquireLimit()
+	if err != nil {
+		return nil, true, err
+	}
+
 	wasHalfOpen, err := b.beforeExecute()
 	if err != nil {
-		return nil, err
+		token.Ignore()
+		return nil, true, err
 	}
 
-	result, err := fn(ctx)
-	b.afterExecute(err, wasHalfOpen)
-	return result, err
+	start := b.config.Now()
+	completed := false
+	defer func() {
+		if !completed {
+			b.afterExecute(errPanicked, b.isSlow(start), wasHalfOpen)
+			token.Release(errPanicked)
+		}
+	}()
+
+	result, ignore, err := fn()
+	completed = true
+	if ignore {
+		b.ignore(wasHalfOpen)
+		token.Ignore()
+		return result, false, err
+	}
+	b.afterExecute(err, b.isSlow(start), wasHalfOpen)
+	token.Release(err)
+	return result, false, err
+}
+
+// errPanicked 记录 fn panic 时使用的失败原因
+var errPanicked = errors.New("circuit: function panicked")
+
+// ExecuteT 执行返回类型为 T 的函数，避免对 any 结果做类型断言
+//
+// 降级函数返回的结果不是 T 类型（且非 nil）时返回错误。
+//
+// 示例:
+//
+//	user, err := circuit.ExecuteT(breaker, func() (*User, error) {
+//	    return client.GetUser(id)
+//	})
+func ExecuteT[T any](b *Breaker, fn func() (T, error)) (T, error) {
+	result, err := b.Execute(func() (any, error) {
+		return fn()
+	})
+	return castResult[T](result, err)
+}
+
+// ExecuteContextT 执行带上下文且返回类型为 T 的函数
+func ExecuteContextT[T any](ctx context.Context, b *Breaker, fn func(context.Context) (T, error)) (T, error) {
+	result, err := b.ExecuteContext(ctx, func(ctx context.Context) (any, error) {
+		return fn(ctx)
+	})
+	return castResult[T](result, err)
+}
+
+// castResult 将执行结果转换为 T
+func castResult[T any](result any, err error) (T, error) {
+	if result == nil {
+		var zero T
+		return zero, err
+	}
+	v, ok := result.(T)
+	if !ok {
+		var zero T
+		return zero, fmt.Errorf("circuit: result type %T is not %T", result, zero)
+	}
+	return v, err
+}
+
+// reject 处理被拒绝的请求，配置了降级函数时返回降级结果
+func (b *Breaker) reject(err error) (any, error) {
+	if b.config.Fallback != nil {
+		return b.config.Fallback(err)
+	}
+	return nil, err
+}
+
+// acquireLimit 获取限流器许可，未配置限流器时返回 nil token
+func (b *Breaker) acquireLimit() (*LimitToken, error) {
+	if b.config.Limiter == nil {
+		return nil, nil
+	}
+	return b.config.Limiter.Acquire()
 }
 
 // Allow 检查是否允许请求通过
@@ -201,13 +400,13 @@ func (b *Breaker) Allow() error {
 // Success 报告成功
 func (b *Breaker) Success() {
 	wasHalfOpen := b.consumePendingHalfOpen()
-	b.afterExecute(nil, wasHalfOpen)
+	b.afterExecute(nil, false, wasHalfOpen)
 }
 
 // Failure 报告失败
 func (b *Breaker) Failure() {
 	wasHalfOpen := b.consumePendingHalfOpen()
-	b.afterExecute(errors.New("manual failure"), wasHalfOpen)
+	b.afterExecute(errors.New("manual failure"), false, wasHalfOpen)
 }
 
 // consumePendingHalfOpen 消费一个待处理的半开请求标记
@@ -225,7 +424,15 @@ func (b *Breaker) consumePendingHalfOpen() bool {
 
 // beforeExecute 执行前检查
 // 返回 wasHalfOpen 标识请求是否在半开状态下被允许（已递增 halfOpenCount）
-func (b *Breaker) beforeExecute() (wasHalfOpen bool, _ error) {
+func (b *Breaker) beforeExecute() (wasHalfOpen bool, err error) {
+	defer func() {
+		if err != nil {
+			b.rejections.Add(1)
+		} else if wasHalfOpen {
+			b.halfOpenProbes.Add(1)
+		}
+	}()
+
 	now := b.config.Now()
 
 	for {
@@ -272,16 +479,25 @@ func (b *Breaker) beforeExecute() (wasHalfOpen bool, _ error) {
 }
 
 // afterExecute 执行后处理
+// slow 标识该请求是否为慢调用
 // wasHalfOpen 标识该请求是否在半开状态下被 beforeExecute 允许（已递增 halfOpenCount）
 // 通过此标记确保 halfOpenCount 的递增/递减严格配对，避免状态转换导致的计数泄漏
-func (b *Breaker) afterExecute(err error, wasHalfOpen bool) {
+func (b *Breaker) afterExecute(err error, slow, wasHalfOpen bool) {
 	isFailure := b.config.IsFailure(err)
+	if isFailure {
+		b.totalFailures.Add(1)
+	} else {
+		b.totalSuccesses.Add(1)
+	}
+	if slow {
+		b.slowCalls.Add(1)
+	}
 
 	if wasHalfOpen {
 		// 请求在半开状态被允许，无论当前状态如何都要递减 halfOpenCount
 		b.halfOpenCount.Add(-1)
-		if isFailure {
-			// 失败，回到打开状态
+		if isFailure || slow {
+			// 失败或慢调用，回到打开状态
 			b.transitionTo(StateOpen)
 		} else {
 			successes := b.successes.Add(1)
@@ -302,13 +518,16 @@ func (b *Breaker) afterExecute(err error, wasHalfOpen bool) {
 		if isFailure {
 			failures := b.failures.Add(1)
 			b.lastFailureAt.Store(now.UnixNano())
-			if failures >= int32(b.config.Threshold) {
+			if b.config.ErrorRate <= 0 && failures >= int32(b.config.Threshold) {
 				b.transitionTo(StateOpen)
 			}
 		} else {
 			// 成功时重置失败计数
 			b.failures.Store(0)
 		}
+		if b.window != nil && b.rateExceeded(b.window.record(now, isFailure, slow)) {
+			b.transitionTo(StateOpen)
+		}
 
 	case StateHalfOpen:
 		// 手动 API（Allow + Success/Failure）走到这里
@@ -324,6 +543,35 @@ func (b *Breaker) afterExecute(err error, wasHalfOpen bool) {
 	}
 }
 
+// ignore 丢弃一次调用的结果：不改变计数和状态，只归还半开探测名额
+func (b *Breaker) ignore(wasHalfOpen bool) {
+	if wasHalfOpen {
+		b.halfOpenCount.Add(-1)
+	}
+}
+
+// rateExceeded 判断窗口内的失败率或慢调用率是否达到熔断条件
+func (b *Breaker) rateExceeded(c windowCounts) bool {
+	if c.total == 0 || c.total < int64(b.config.MinRequests) {
+		return false
+	}
+	total := float64(c.total)
+	if b.config.ErrorRate > 0 && float64(c.failures)/total >= b.config.ErrorRate {
+		return true
+	}
+	return b.config.slowCallEnabled() && float64(c.slow)/total >= b.config.SlowCallRate
+}
+
+// isSlow 判断从 start 开始的调用是否为慢调用
+func (b *Breaker) isSlow(start time.Time) bool {
+	return b.config.slowCallEnabled() && b.config.Now().Sub(start) > b.config.SlowCallDuration
+}
+
+// slowCallEnabled 是否启用慢调用统计
+func (c *Config) slowCallEnabled() bool {
+	return c.SlowCallDuration > 0 && c.SlowCallRate > 0
+}
+
 // transitionTo 状态转换（使用 CAS 保证原子性）
 // CAS 失败时检查新的当前状态，如果不再是预期的 from 状态则放弃转换，
 // 避免意外的状态跳转（如其他 goroutine 已将状态推进到更新的状态）
@@ -352,6 +600,7 @@ func (b *Breaker) transitionTo(to State) {
 			b.failures.Store(0)
 			b.successes.Store(0)
 			b.halfOpenCount.Store(0)
+			b.resetWindow()
 		case StateOpen:
 			b.openedAt.Store(b.config.Now().UnixNano())
 			b.successes.Store(0)
@@ -389,7 +638,11 @@ func (b *Breaker) startNotifier() {
 }
 
 // notifyStateChange 通知状态变更监听器（异步有序执行，通过单一 goroutine + channel 保序）
+//
+// 所有状态转换都经过此处，因此同时负责累计转换次数
 func (b *Breaker) notifyStateChange(from, to State) {
+	b.transitions.Add(1)
+
 	b.mu.Lock()
 	listeners := make([]func(from, to State), len(b.stateListeners))
 	copy(listeners, b.stateListeners)
@@ -422,6 +675,7 @@ func (b *Breaker) Reset() {
 			b.halfOpenCount.Store(0)
 			b.lastFailureAt.Store(0)
 			b.openedAt.Store(0)
+			b.resetWindow()
 			return
 		}
 
@@ -433,6 +687,7 @@ func (b *Breaker) Reset() {
 			b.halfOpenCount.Store(0)
 			b.lastFailureAt.Store(0)
 			b.openedAt.Store(0)
+			b.resetWindow()
 
 			// 通知监听器
 			b.notifyStateChange(oldState, StateClosed)
@@ -442,6 +697,13 @@ func (b *Breaker) Reset() {
 	}
 }
 
+// resetWindow 清空失败率窗口
+func (b *Breaker) resetWindow() {
+	if b.window != nil {
+		b.window.reset()
+	}
+}
+
 // OnStateChange 添加状态变更监听器
 func (b *Breaker) OnStateChange(fn func(from, to State)) {
 	b.mu.Lock()
@@ -450,11 +712,13 @@ func (b *Breaker) OnStateChange(fn func(from, to State)) {
 }
 
 // Close 关闭熔断器，释放 notifier goroutine
-// 调用后不应再使用该 Breaker 实例
+// 调用后不应再使用该 Breaker 实例，重复调用是安全的
 func (b *Breaker) Close() {
-	if b.notifyCh != nil {
-		close(b.notifyCh)
-	}
+	b.closeOnce.Do(func() {
+		if b.notifyCh != nil {
+			close(b.notifyCh)
+		}
+	})
 }
 
 // Stats 统计信息
@@ -464,14 +728,41 @@ type Stats struct {
 	Successes     int
 	LastFailureAt time.Time
 	OpenedAt      time.Time
+	// WindowRequests 滑动窗口内的请求数（仅失败率或慢调用模式）
+	WindowRequests int
+	// WindowFailures 滑动窗口内的失败数（仅失败率模式）
+	WindowFailures int
+	// WindowSlowCalls 滑动窗口内的慢调用数（仅慢调用模式）
+	WindowSlowCalls int
+
+	// 以下为创建以来的累计计数，Reset 不清零
+
+	// TotalSuccesses 成功的请求数
+	TotalSuccesses uint64
+	// TotalFailures 失败的请求数
+	TotalFailures uint64
+	// Rejections 被熔断拒绝的请求数（ErrCircuitOpen、ErrTooManyRequests）
+	Rejections uint64
+	// SlowCalls 慢调用数
+	SlowCalls uint64
+	// HalfOpenProbes 半开状态下放行的探测请求数
+	HalfOpenProbes uint64
+	// StateTransitions 状态转换次数
+	StateTransitions uint64
 }
 
 // Stats 返回统计信息
 func (b *Breaker) Stats() Stats {
 	stats := Stats{
-		State:     b.State(),
-		Failures:  int(b.failures.Load()),
-		Successes: int(b.successes.Load()),
+		State:            b.State(),
+		Failures:         int(b.failures.Load()),
+		Successes:        int(b.successes.Load()),
+		TotalSuccesses:   b.totalSuccesses.Load(),
+		TotalFailures:    b.totalFailures.Load(),
+		Rejections:       b.rejections.Load(),
+		SlowCalls:        b.slowCalls.Load(),
+		HalfOpenProbes:   b.halfOpenProbes.Load(),
+		StateTransitions: b.transitions.Load(),
 	}
 	// 只有在有实际时间值时才设置（避免返回 1970-01-01）
 	if lastFailure := b.lastFailureAt.Load(); lastFailure > 0 {
@@ -480,6 +771,12 @@ func (b *Breaker) Stats() Stats {
 	if opened := b.openedAt.Load(); opened > 0 {
 		stats.OpenedAt = time.Unix(0, opened)
 	}
+	if b.window != nil {
+		c := b.window.counts(b.config.Now())
+		stats.WindowRequests = int(c.total)
+		stats.WindowFailures = int(c.failures)
+		stats.WindowSlowCalls = int(c.slow)
+	}
 	return stats
 }
 
@@ -591,27 +888,135 @@ func IsRateLimitError(err error) bool {
 // ============== 多熔断器管理 ==============
 
 // BreakerManager 熔断器管理器
+//
+// 按 key（如主机、模型、租户）懒创建并管理熔断器，每个 key 一个独立实例，
+// 可枚举所有熔断器用于指标上报。并发安全。
 type BreakerManager struct {
 	breakers sync.Map
 	factory  func() *Breaker
+
+	mu        sync.Mutex // 保护熔断器创建和 listeners
+	listeners []func(name string, from, to State)
 }
 
 // NewBreakerManager 创建熔断器管理器
+//
+// factory 为 nil 时使用默认配置创建熔断器
 func NewBreakerManager(factory func() *Breaker) *BreakerManager {
+	if factory == nil {
+		factory = func() *Breaker { return New() }
+	}
 	return &BreakerManager{
 		factory: factory,
 	}
 }
 
-// Get 获取指定名称的熔断器
+// NewBreakerManagerWithOptions 创建使用共享配置的熔断器管理器
+//
+// 示例:
+//
+//	hosts := circuit.NewBreakerManagerWithOptions(
+//	    circuit.WithErrorRate(0.5, 20),
+//	    circuit.WithTimeout(10*time.Second),
+//	)
+//	result, err := hosts.Execute(req.URL.Host, fn)
+func NewBreakerManagerWithOptions(opts ...Option) *BreakerManager {
+	return NewBreakerManager(func() *Breaker { return New(opts...) })
+}
+
+// Get 获取指定名称的熔断器，不存在时创建
 func (m *BreakerManager) Get(name string) *Breaker {
 	if b, ok := m.breakers.Load(name); ok {
 		return b.(*Breaker)
 	}
 
-	newBreaker := m.factory()
-	actual, _ := m.breakers.LoadOrStore(name, newBreaker)
-	return actual.(*Breaker)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if b, ok := m.breakers.Load(name); ok {
+		return b.(*Breaker)
+	}
+	b := m.factory()
+	if len(m.listeners) > 0 {
+		m.hook(name, b)
+	}
+	m.breakers.Store(name, b)
+	return b
+}
+
+// Lookup 获取指定名称的熔断器，不存在时返回 false 且不创建
+func (m *BreakerManager) Lookup(name string) (*Breaker, bool) {
+	b, ok := m.breakers.Load(name)
+	if !ok {
+		return nil, false
+	}
+	return b.(*Breaker), true
+}
+
+// Remove 移除并关闭指定名称的熔断器，之后的 Get 会重新创建
+func (m *BreakerManager) Remove(name string) {
+	if b, ok := m.breakers.LoadAndDelete(name); ok {
+		b.(*Breaker).Close()
+	}
+}
+
+// Range 遍历所有熔断器，fn 返回 false 时停止
+func (m *BreakerManager) Range(fn func(name string, b *Breaker) bool) {
+	m.breakers.Range(func(key, value any) bool {
+		return fn(key.(string), value.(*Breaker))
+	})
+}
+
+// Names 返回所有熔断器名称（已排序）
+func (m *BreakerManager) Names() []string {
+	var names []string
+	m.Range(func(name string, _ *Breaker) bool {
+		names = append(names, name)
+		return true
+	})
+	slices.Sort(names)
+	return names
+}
+
+// Len 返回熔断器数量
+func (m *BreakerManager) Len() int {
+	n := 0
+	m.Range(func(string, *Breaker) bool {
+		n++
+		return true
+	})
+	return n
+}
+
+// OnStateChange 添加状态变更监听器，对已创建和之后创建的所有熔断器生效
+//
+// 示例:
+//
+//	manager.OnStateChange(func(name string, from, to circuit.State) {
+//	    log.Printf("breaker %s: %s -> %s", name, from, to)
+//	})
+func (m *BreakerManager) OnStateChange(fn func(name string, from, to State)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listeners = append(m.listeners, fn)
+	if len(m.listeners) == 1 {
+		// 首个监听器：为已创建的熔断器挂载转发函数，之后创建的在 Get 中挂载
+		m.Range(func(name string, b *Breaker) bool {
+			m.hook(name, b)
+			return true
+		})
+	}
+}
+
+// hook 为熔断器挂载转发到管理器监听器的函数，调用方需持有 m.mu
+func (m *BreakerManager) hook(name string, b *Breaker) {
+	b.OnStateChange(func(from, to State) {
+		m.mu.Lock()
+		listeners := slices.Clone(m.listeners)
+		m.mu.Unlock()
+		for _, fn := range listeners {
+			fn(name, from, to)
+		}
+	})
 }
 
 // Execute 使用指定名称的熔断器执行函数
@@ -643,3 +1048,21 @@ func (m *BreakerManager) States() map[string]State {
 	})
 	return states
 }
+
+// Stats 返回所有熔断器的统计信息
+func (m *BreakerManager) Stats() map[string]Stats {
+	stats := make(map[string]Stats)
+	m.Range(func(name string, b *Breaker) bool {
+		stats[name] = b.Stats()
+		return true
+	})
+	return stats
+}
+
+// Close 关闭所有熔断器
+func (m *BreakerManager) Close() {
+	m.Range(func(_ string, b *Breaker) bool {
+		b.Close()
+		return true
+	})
+}
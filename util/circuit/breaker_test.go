@@ -610,3 +610,321 @@ func TestBreaker_Concurrent(t *testing.T) {
 		t.Logf("State: %v, successes: %d, errors: %d", b.State(), successCount.Load(), errorCount.Load())
 	}
 }
+
+func TestBreaker_ErrorRate(t *testing.T) {
+	currentTime := time.Now()
+	b := New(
+		WithErrorRate(0.5, 10),
+		WithWindow(10*time.Second),
+		WithNow(func() time.Time { return currentTime }),
+	)
+
+	// 连续失败但请求数不足，不熔断
+	for range 9 {
+		b.Failure()
+	}
+	if b.State() != StateClosed {
+		t.Fatalf("expected StateClosed below MinRequests, got %v", b.State())
+	}
+
+	// 第 10 次调用成功：失败率 90% 且请求数达到 10，熔断
+	b.Success()
+	if b.State() != StateOpen {
+		t.Fatalf("expected StateOpen, got %v", b.State())
+	}
+}
+
+func TestBreaker_ErrorRateBelowThreshold(t *testing.T) {
+	currentTime := time.Now()
+	b := New(
+		WithErrorRate(0.5, 10),
+		WithNow(func() time.Time { return currentTime }),
+	)
+
+	// 失败率 40%，即使出现多次连续失败也不熔断
+	for range 6 {
+		b.Success()
+	}
+	for range 4 {
+		b.Failure()
+	}
+	if b.State() != StateClosed {
+		t.Fatalf("expected StateClosed, got %v", b.State())
+	}
+
+	stats := b.Stats()
+	if stats.WindowRequests != 10 || stats.WindowFailures != 4 {
+		t.Errorf("expected window 10/4, got %d/%d", stats.WindowRequests, stats.WindowFailures)
+	}
+}
+
+func TestBreaker_ErrorRateWindowExpires(t *testing.T) {
+	currentTime := time.Now()
+	b := New(
+		WithErrorRate(0.5, 4),
+		WithWindow(10*time.Second),
+		WithNow(func() time.Time { return currentTime }),
+	)
+
+	for range 3 {
+		b.Failure()
+	}
+
+	// 窗口滚动后旧的失败过期
+	currentTime = currentTime.Add(11 * time.Second)
+	b.Failure()
+	b.Success()
+	b.Success()
+	if b.State() != StateClosed {
+		t.Fatalf("expected StateClosed after window expired, got %v", b.State())
+	}
+	if stats := b.Stats(); stats.WindowRequests != 3 {
+		t.Errorf("expected 3 requests in window, got %d", stats.WindowRequests)
+	}
+
+	b.Failure()
+	if b.State() != StateOpen {
+		t.Fatalf("expected StateOpen, got %v", b.State())
+	}
+
+	// 恢复到关闭状态后窗口清空
+	b.Reset()
+	if stats := b.Stats(); stats.WindowRequests != 0 {
+		t.Errorf("expected empty window after Reset, got %d", stats.WindowRequests)
+	}
+}
+
+func TestBreakerManager_WithOptions(t *testing.T) {
+	manager := NewBreakerManagerWithOptions(WithThreshold(1))
+	defer manager.Close()
+
+	_, _ = manager.Execute("host-b", func() (any, error) {
+		return nil, errors.New("error")
+	})
+	manager.Get("host-a")
+
+	if got := manager.Names(); len(got) != 2 || got[0] != "host-a" || got[1] != "host-b" {
+		t.Errorf("expected [host-a host-b], got %v", got)
+	}
+	if manager.Len() != 2 {
+		t.Errorf("expected 2 breakers, got %d", manager.Len())
+	}
+
+	stats := manager.Stats()
+	if stats["host-b"].State != StateOpen || stats["host-a"].State != StateClosed {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+
+	if _, ok := manager.Lookup("host-c"); ok {
+		t.Error("Lookup should not create breakers")
+	}
+	manager.Remove("host-b")
+	if b, ok := manager.Lookup("host-b"); ok {
+		t.Errorf("expected host-b removed, got %v", b)
+	}
+	if manager.Get("host-b").State() != StateClosed {
+		t.Error("expected a fresh breaker after Remove")
+	}
+}
+
+func TestBreakerManager_OnStateChange(t *testing.T) {
+	manager := NewBreakerManager(nil)
+	defer manager.Close()
+
+	existing := manager.Get("existing")
+
+	var mu sync.Mutex
+	changes := make(map[string]State)
+	done := make(chan struct{}, 2)
+	manager.OnStateChange(func(name string, from, to State) {
+		mu.Lock()
+		changes[name] = to
+		mu.Unlock()
+		done <- struct{}{}
+	})
+
+	for range 5 {
+		existing.Failure()
+		manager.Get("created").Failure()
+	}
+
+	for range 2 {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for state change")
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if changes["existing"] != StateOpen || changes["created"] != StateOpen {
+		t.Errorf("expected both breakers open, got %v", changes)
+	}
+}
+
+func TestExecuteT(t *testing.T) {
+	b := New(WithThreshold(1))
+
+	n, err := ExecuteT(b, func() (int, error) {
+		return 42, nil
+	})
+	if err != nil || n != 42 {
+		t.Errorf("expected 42, got %d, %v", n, err)
+	}
+
+	_, _ = ExecuteT(b, func() (int, error) {
+		return 0, errors.New("error")
+	})
+	n, err = ExecuteT(b, func() (int, error) {
+		return 1, nil
+	})
+	if err != ErrCircuitOpen || n != 0 {
+		t.Errorf("expected ErrCircuitOpen, got %d, %v", n, err)
+	}
+
+	s, err := ExecuteContextT(context.Background(), New(), func(ctx context.Context) (string, error) {
+		return "ok", nil
+	})
+	if err != nil || s != "ok" {
+		t.Errorf("expected ok, got %q, %v", s, err)
+	}
+}
+
+func TestBreaker_Fallback(t *testing.T) {
+	var reason error
+	b := New(
+		WithThreshold(1),
+		WithFallback(func(err error) (any, error) {
+			reason = err
+			return "cached", nil
+		}),
+	)
+
+	_, _ = b.Execute(func() (any, error) {
+		return nil, errors.New("error")
+	})
+
+	called := false
+	result, err := b.Execute(func() (any, error) {
+		called = true
+		return "live", nil
+	})
+	if called {
+		t.Error("fn should not be called when open")
+	}
+	if err != nil || result != "cached" {
+		t.Errorf("expected fallback result, got %v, %v", result, err)
+	}
+	if reason != ErrCircuitOpen {
+		t.Errorf("expected fallback reason ErrCircuitOpen, got %v", reason)
+	}
+
+	s, err := ExecuteT(b, func() (string, error) {
+		return "live", nil
+	})
+	if err != nil || s != "cached" {
+		t.Errorf("expected typed fallback result, got %q, %v", s, err)
+	}
+
+	// 降级结果类型不匹配
+	if _, err := ExecuteT(b, func() (int, error) { return 1, nil }); err == nil {
+		t.Error("expected type mismatch error")
+	}
+
+	// 手动 API 不受降级影响
+	if err := b.Allow(); err != ErrCircuitOpen {
+		t.Errorf("expected ErrCircuitOpen from Allow, got %v", err)
+	}
+}
+
+func TestBreaker_SlowCall(t *testing.T) {
+	var mu sync.Mutex
+	currentTime := time.Now()
+	now := func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return currentTime
+	}
+	advance := func(d time.Duration) {
+		mu.Lock()
+		currentTime = currentTime.Add(d)
+		mu.Unlock()
+	}
+
+	b := New(
+		WithSlowCall(100*time.Millisecond, 0.5),
+		WithErrorRate(0.9, 4),
+		WithWindow(time.Minute),
+		WithNow(now),
+	)
+
+	call := func(d time.Duration) {
+		_, _ = b.Execute(func() (any, error) {
+			advance(d)
+			return "ok", nil
+		})
+	}
+
+	call(10 * time.Millisecond)
+	call(200 * time.Millisecond)
+	call(10 * time.Millisecond)
+	if b.State() != StateClosed {
+		t.Fatalf("expected StateClosed below MinRequests, got %v", b.State())
+	}
+
+	// 4 次调用中 2 次慢调用，达到 50%
+	call(300 * time.Millisecond)
+	if b.State() != StateOpen {
+		t.Fatalf("expected StateOpen, got %v", b.State())
+	}
+	if stats := b.Stats(); stats.WindowSlowCalls != 2 || stats.WindowFailures != 0 {
+		t.Errorf("expected 2 slow calls and no failures, got %+v", stats)
+	}
+
+	// 半开状态下的慢调用视为探测失败
+	advance(31 * time.Second)
+	call(time.Second)
+	if b.State() != StateOpen {
+		t.Fatalf("expected StateOpen after slow probe, got %v", b.State())
+	}
+}
+
+func TestBreaker_StatsCounters(t *testing.T) {
+	currentTime := time.Now()
+	b := New(
+		WithThreshold(2),
+		WithTimeout(time.Second),
+		WithSuccessThreshold(1),
+		WithNow(func() time.Time { return currentTime }),
+	)
+
+	_, _ = b.Execute(func() (any, error) { return "ok", nil })
+	b.Failure()
+	b.Failure() // closed -> open
+	_ = b.Allow()
+	_ = b.Allow()
+
+	currentTime = currentTime.Add(2 * time.Second)
+	_, _ = b.Execute(func() (any, error) { return "ok", nil }) // open -> half-open -> closed
+
+	stats := b.Stats()
+	if stats.TotalSuccesses != 2 || stats.TotalFailures != 2 {
+		t.Errorf("expected 2 successes and 2 failures, got %d/%d", stats.TotalSuccesses, stats.TotalFailures)
+	}
+	if stats.Rejections != 2 {
+		t.Errorf("expected 2 rejections, got %d", stats.Rejections)
+	}
+	if stats.HalfOpenProbes != 1 {
+		t.Errorf("expected 1 half-open probe, got %d", stats.HalfOpenProbes)
+	}
+	if stats.StateTransitions != 3 {
+		t.Errorf("expected 3 transitions, got %d", stats.StateTransitions)
+	}
+
+	// 累计计数不随 Reset 清零
+	b.Reset()
+	if got := b.Stats().TotalFailures; got != 2 {
+		t.Errorf("expected cumulative failures to survive Reset, got %d", got)
+	}
+}
@@ -0,0 +1,140 @@
+// Package circuitprom 将熔断器统计以 Prometheus 指标暴露
+//
+// 独立于 circuit 包，只有需要 Prometheus 指标的程序才会引入 client_golang 依赖。
+//
+// --- English ---
+//
+// Package circuitprom exposes circuit breaker statistics as Prometheus metrics.
+//
+// It is kept separate from package circuit so that only programs that want
+// Prometheus metrics pull in the client_golang dependency.
+package circuitprom
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/hexagon-codes/toolkit/util/circuit"
+)
+
+// Collector 熔断器的 Prometheus 收集器
+//
+// 采集时读取各熔断器的 Stats，不在请求路径上产生额外开销。
+// 名称重复的熔断器只输出一次：Add 添加的优先，其次按 AddManager 的调用顺序，
+// 避免重复序列导致整个 Gather 失败。
+//
+// 暴露的指标（namespace 为空时无前缀）:
+//   - circuit_breaker_state{name}: 当前状态（0 关闭，1 打开，2 半开）
+//   - circuit_breaker_requests_total{name,result}: 执行的请求数，result 为 success/failure
+//   - circuit_breaker_rejections_total{name}: 被熔断拒绝的请求数
+//   - circuit_breaker_slow_calls_total{name}: 慢调用数
+//   - circuit_breaker_half_open_probes_total{name}: 半开状态下放行的探测请求数
+//   - circuit_breaker_state_transitions_total{name}: 状态转换次数
+//
+// 示例:
+//
+//	collector := circuitprom.NewCollector("myapp")
+//	collector.AddManager(hosts)
+//	collector.Add("payment", paymentBreaker)
+//	prometheus.MustRegister(collector)
+type Collector struct {
+	state          *prometheus.Desc
+	requests       *prometheus.Desc
+	rejections     *prometheus.Desc
+	slowCalls      *prometheus.Desc
+	halfOpenProbes *prometheus.Desc
+	transitions    *prometheus.Desc
+
+	mu       sync.RWMutex
+	breakers map[string]*circuit.Breaker
+	managers []*circuit.BreakerManager
+}
+
+// NewCollector 创建收集器
+//
+// 参数:
+//   - namespace: 指标名前缀，可为空
+func NewCollector(namespace string) *Collector {
+	desc := func(name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "circuit_breaker", name),
+			help,
+			append([]string{"name"}, labels...),
+			nil,
+		)
+	}
+
+	return &Collector{
+		state:          desc("state", "Current circuit breaker state (0 closed, 1 open, 2 half-open)"),
+		requests:       desc("requests_total", "Total number of requests executed through the breaker by result", "result"),
+		rejections:     desc("rejections_total", "Total number of requests rejected by the breaker"),
+		slowCalls:      desc("slow_calls_total", "Total number of calls slower than the slow-call threshold"),
+		halfOpenProbes: desc("half_open_probes_total", "Total number of probe requests admitted in half-open state"),
+		transitions:    desc("state_transitions_total", "Total number of breaker state transitions"),
+		breakers:       make(map[string]*circuit.Breaker),
+	}
+}
+
+// Add 添加单个熔断器，同名熔断器会被替换
+func (c *Collector) Add(name string, b *circuit.Breaker) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.breakers[name] = b
+}
+
+// Remove 移除通过 Add 添加的熔断器
+func (c *Collector) Remove(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.breakers, name)
+}
+
+// AddManager 添加熔断器管理器，采集时包含管理器中的所有熔断器（含之后创建的）
+func (c *Collector) AddManager(m *circuit.BreakerManager) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.managers = append(c.managers, m)
+}
+
+// Describe 实现 prometheus.Collector
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.state
+	ch <- c.requests
+	ch <- c.rejections
+	ch <- c.slowCalls
+	ch <- c.halfOpenProbes
+	ch <- c.transitions
+}
+
+// Collect 实现 prometheus.Collector
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	seen := make(map[string]struct{}, len(c.breakers))
+	for name, b := range c.breakers {
+		seen[name] = struct{}{}
+		c.collect(ch, name, b.Stats())
+	}
+	for _, m := range c.managers {
+		m.Range(func(name string, b *circuit.Breaker) bool {
+			if _, dup := seen[name]; !dup {
+				seen[name] = struct{}{}
+				c.collect(ch, name, b.Stats())
+			}
+			return true
+		})
+	}
+}
+
+// collect 输出单个熔断器的指标
+func (c *Collector) collect(ch chan<- prometheus.Metric, name string, s circuit.Stats) {
+	ch <- prometheus.MustNewConstMetric(c.state, prometheus.GaugeValue, float64(s.State), name)
+	ch <- prometheus.MustNewConstMetric(c.requests, prometheus.CounterValue, float64(s.TotalSuccesses), name, "success")
+	ch <- prometheus.MustNewConstMetric(c.requests, prometheus.CounterValue, float64(s.TotalFailures), name, "failure")
+	ch <- prometheus.MustNewConstMetric(c.rejections, prometheus.CounterValue, float64(s.Rejections), name)
+	ch <- prometheus.MustNewConstMetric(c.slowCalls, prometheus.CounterValue, float64(s.SlowCalls), name)
+	ch <- prometheus.MustNewConstMetric(c.halfOpenProbes, prometheus.CounterValue, float64(s.HalfOpenProbes), name)
+	ch <- prometheus.MustNewConstMetric(c.transitions, prometheus.CounterValue, float64(s.StateTransitions), name)
+}
@@ -0,0 +1,141 @@
+package circuitprom
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/hexagon-codes/toolkit/util/circuit"
+)
+
+func TestCollector(t *testing.T) {
+	single := circuit.New(circuit.WithThreshold(1))
+	_, _ = single.Execute(func() (any, error) { return nil, errors.New("error") })
+	_, _ = single.Execute(func() (any, error) { return "ok", nil })
+
+	manager := circuit.NewBreakerManager(nil)
+	_, _ = manager.Execute("host-a", func() (any, error) { return "ok", nil })
+
+	collector := NewCollector("test")
+	collector.Add("single", single)
+	collector.AddManager(manager)
+
+	reg := prometheus.NewRegistry()
+	if err := reg.Register(collector); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	// 管理器中之后创建的熔断器也会被采集
+	manager.Get("host-b")
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+
+	values := make(map[string]float64)
+	for _, mf := range families {
+		for _, m := range mf.GetMetric() {
+			key := mf.GetName() + labelString(m)
+			switch {
+			case m.GetGauge() != nil:
+				values[key] = m.GetGauge().GetValue()
+			case m.GetCounter() != nil:
+				values[key] = m.GetCounter().GetValue()
+			}
+		}
+	}
+
+	tests := map[string]float64{
+		"test_circuit_breaker_state{name=single}":                         float64(circuit.StateOpen),
+		"test_circuit_breaker_requests_total{name=single,result=failure}": 1,
+		"test_circuit_breaker_rejections_total{name=single}":              1,
+		"test_circuit_breaker_state_transitions_total{name=single}":       1,
+		"test_circuit_breaker_requests_total{name=host-a,result=success}": 1,
+		"test_circuit_breaker_state{name=host-b}":                         float64(circuit.StateClosed),
+		"test_circuit_breaker_half_open_probes_total{name=host-b}":        0,
+	}
+	for key, want := range tests {
+		got, ok := values[key]
+		if !ok {
+			t.Errorf("missing metric %s", key)
+			continue
+		}
+		if got != want {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+
+	collector.Remove("single")
+	families, _ = reg.Gather()
+	for _, mf := range families {
+		for _, m := range mf.GetMetric() {
+			if labelString(m) == "{name=single}" {
+				t.Errorf("removed breaker still collected: %s", mf.GetName())
+			}
+		}
+	}
+}
+
+func TestCollector_DuplicateNames(t *testing.T) {
+	explicit := circuit.New()
+	_, _ = explicit.Execute(func() (any, error) { return "ok", nil })
+
+	m1 := circuit.NewBreakerManager(nil)
+	m1.Get("shared")
+	m1.Get("only-m1")
+	m2 := circuit.NewBreakerManager(nil)
+	m2.Get("shared")
+	m2.Get("only-m2")
+
+	collector := NewCollector("")
+	collector.Add("shared", explicit)
+	collector.AddManager(m1)
+	collector.AddManager(m2)
+	collector.AddManager(m1)
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(collector)
+
+	// 重复名称不能导致 Gather 失败
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("gather with overlapping names: %v", err)
+	}
+
+	names := make(map[string]int)
+	for _, mf := range families {
+		if mf.GetName() != "circuit_breaker_requests_total" {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			if labelString(m) == "{name=shared,result=success}" && m.GetCounter().GetValue() != 1 {
+				t.Errorf("expected breaker added via Add to win, got %v", m.GetCounter().GetValue())
+			}
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "name" {
+					names[l.GetValue()]++
+				}
+			}
+		}
+	}
+	for _, name := range []string{"shared", "only-m1", "only-m2"} {
+		if names[name] != 2 {
+			t.Errorf("%s: expected 2 series (success/failure), got %d", name, names[name])
+		}
+	}
+}
+
+// labelString 将标签格式化为 {k=v,...}
+func labelString(m *dto.Metric) string {
+	s := "{"
+	for i, l := range m.GetLabel() {
+		if i > 0 {
+			s += ","
+		}
+		s += l.GetName() + "=" + l.GetValue()
+	}
+	return s + "}"
+}
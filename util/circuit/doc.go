@@ -19,6 +19,31 @@
 //	    return callExternalAPI()
 //	})
 //
+// 泛型执行与降级（熔断或限流拒绝时返回降级结果）：
+//
+//	breaker := circuit.New(circuit.WithFallback(func(err error) (any, error) {
+//	    return cachedUser, nil
+//	}))
+//	user, err := circuit.ExecuteT(breaker, func() (*User, error) {
+//	    return client.GetUser(id)
+//	})
+//
+// 按滑动窗口内的失败率熔断（10 秒内至少 20 次调用且失败率达到 50%）：
+//
+//	breaker := circuit.New(
+//	    circuit.WithErrorRate(0.5, 20),
+//	    circuit.WithWindow(10*time.Second),
+//	)
+//
+// 按慢调用率熔断（依赖挂起但不报错时）：
+//
+//	breaker := circuit.New(circuit.WithSlowCall(2*time.Second, 0.8))
+//
+// 按 key 管理熔断器（每个主机、模型或租户一个实例，共享配置）：
+//
+//	hosts := circuit.NewBreakerManagerWithOptions(circuit.WithThreshold(5))
+//	result, err := hosts.Execute(host, fn)
+//
 // AI API 专用：
 //
 //	breaker := circuit.NewAIBreaker(circuit.OpenAIConfig)
@@ -29,6 +54,22 @@
 //	    log.Printf("breaker state changed: %s -> %s", from, to)
 //	})
 //
+// 接入 HTTP 客户端和数据库（按主机自动区分熔断器，无需修改调用点）：
+//
+//	client := &http.Client{Transport: circuit.NewTransport(nil, hosts)}
+//	db := sql.OpenDB(circuit.WrapDriver(mysql.MySQLDriver{}, dsn, breaker))
+//
+// 指标：Stats 返回累计计数，子包 circuitprom 的 Collector 以 Prometheus 格式暴露：
+//
+//	collector := circuitprom.NewCollector("myapp")
+//	collector.AddManager(hosts)
+//	prometheus.MustRegister(collector)
+//
+// 自适应并发限流（AIMD / Gradient），可单独使用或与熔断器组合：
+//
+//	limiter := circuit.NewLimiter(circuit.WithAlgorithm(circuit.AlgorithmGradient))
+//	breaker := circuit.New(circuit.WithLimiter(limiter))
+//
 // --- English ---
 //
 // Package circuit provides a circuit breaker implementation.
@@ -53,6 +94,31 @@
 //	    return callExternalAPI()
 //	})
 //
+// Generic execution with a fallback (served when rejected by the breaker or limiter):
+//
+//	breaker := circuit.New(circuit.WithFallback(func(err error) (any, error) {
+//	    return cachedUser, nil
+//	}))
+//	user, err := circuit.ExecuteT(breaker, func() (*User, error) {
+//	    return client.GetUser(id)
+//	})
+//
+// Trip on the failure rate over a sliding window (at least 20 calls and 50% failures in 10 seconds):
+//
+//	breaker := circuit.New(
+//	    circuit.WithErrorRate(0.5, 20),
+//	    circuit.WithWindow(10*time.Second),
+//	)
+//
+// Trip on the slow-call rate (a hung dependency that does not return errors):
+//
+//	breaker := circuit.New(circuit.WithSlowCall(2*time.Second, 0.8))
+//
+// Per-key breakers (one per host, model or tenant, sharing the same config):
+//
+//	hosts := circuit.NewBreakerManagerWithOptions(circuit.WithThreshold(5))
+//	result, err := hosts.Execute(host, fn)
+//
 // For AI APIs:
 //
 //	breaker := circuit.NewAIBreaker(circuit.OpenAIConfig)
@@ -62,4 +128,21 @@
 //	breaker.OnStateChange(func(from, to circuit.State) {
 //	    log.Printf("breaker state changed: %s -> %s", from, to)
 //	})
+//
+// HTTP clients and databases (breakers keyed by host automatically, no call-site changes):
+//
+//	client := &http.Client{Transport: circuit.NewTransport(nil, hosts)}
+//	db := sql.OpenDB(circuit.WrapDriver(mysql.MySQLDriver{}, dsn, breaker))
+//
+// Metrics: Stats returns cumulative counters and the Collector in subpackage
+// circuitprom exposes them to Prometheus:
+//
+//	collector := circuitprom.NewCollector("myapp")
+//	collector.AddManager(hosts)
+//	prometheus.MustRegister(collector)
+//
+// Adaptive concurrency limiting (AIMD / Gradient), standalone or combined with the breaker:
+//
+//	limiter := circuit.NewLimiter(circuit.WithAlgorithm(circuit.AlgorithmGradient))
+//	breaker := circuit.New(circuit.WithLimiter(limiter))
 package circuit
@@ -0,0 +1,160 @@
+package circuit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// StatusError 熔断器看到的 HTTP 失败状态码
+//
+// Transport 在响应状态码被判定为失败时，将其作为错误交给熔断器统计，
+// 实现了 HTTPError，因此可与 IsRateLimitOrServerError 等判断函数配合使用。
+// 调用方仍会收到原始响应，不会收到该错误。
+type StatusError struct {
+	Code int
+}
+
+// Error 实现 error 接口
+func (e *StatusError) Error() string {
+	return "http status " + strconv.Itoa(e.Code)
+}
+
+// StatusCode 实现 HTTPError 接口
+func (e *StatusError) StatusCode() int {
+	return e.Code
+}
+
+// transportConfig Transport 配置
+type transportConfig struct {
+	key           func(*http.Request) string
+	failureStatus func(code int) bool
+	ignoreErrors  []func(error) bool
+}
+
+// TransportOption Transport 配置选项
+type TransportOption func(*transportConfig)
+
+// WithRequestKey 设置熔断器 key 的生成函数，默认按 req.URL.Host 区分
+//
+// 示例:
+//
+//	// 按主机 + 路径（接口）区分熔断器
+//	circuit.WithRequestKey(func(req *http.Request) string {
+//	    return req.URL.Host + req.URL.Path
+//	})
+func WithRequestKey(fn func(*http.Request) string) TransportOption {
+	return func(c *transportConfig) { c.key = fn }
+}
+
+// WithFailureStatus 设置哪些响应状态码计入熔断失败，默认 429 和 5xx
+func WithFailureStatus(fn func(code int) bool) TransportOption {
+	return func(c *transportConfig) { c.failureStatus = fn }
+}
+
+// WithIgnoreError 设置不计入熔断统计的传输错误
+//
+// fn 返回 true 的错误既不算失败也不算成功（与调用方取消的请求相同），
+// 适用于本地策略拒绝等不反映下游健康状况的错误。可多次调用，任一函数返回 true 即忽略。
+//
+// 示例:
+//
+//	circuit.WithIgnoreError(func(err error) bool {
+//	    return errors.Is(err, ErrBlockedByPolicy)
+//	})
+func WithIgnoreError(fn func(error) bool) TransportOption {
+	return func(c *transportConfig) { c.ignoreErrors = append(c.ignoreErrors, fn) }
+}
+
+// Transport 经过熔断器的 http.RoundTripper
+//
+// 每个请求按 key（默认主机名）从 BreakerManager 获取熔断器：
+//   - 熔断器拒绝时返回包装了 ErrCircuitOpen/ErrTooManyRequests 的错误，不发出请求
+//   - 传输错误和失败状态码计入熔断失败；调用方取消（context.Canceled）的请求
+//     以及 WithIgnoreError 匹配的错误既不计为失败也不计为成功，半开状态下也不算作成功的探测
+//   - 降级函数（WithFallback）不在 Transport 中生效
+type Transport struct {
+	next     http.RoundTripper
+	breakers *BreakerManager
+	config   transportConfig
+}
+
+// NewTransport 创建经过熔断器的 Transport
+//
+// 参数:
+//   - next: 实际发送请求的 Transport，为 nil 时使用 http.DefaultTransport
+//   - breakers: 熔断器管理器，为 nil 时使用默认配置
+//   - opts: 配置选项
+//
+// 示例:
+//
+//	hosts := circuit.NewBreakerManagerWithOptions(circuit.WithErrorRate(0.5, 20))
+//	client := &http.Client{Transport: circuit.NewTransport(nil, hosts)}
+func NewTransport(next http.RoundTripper, breakers *BreakerManager, opts ...TransportOption) *Transport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if breakers == nil {
+		breakers = NewBreakerManager(nil)
+	}
+	t := &Transport{
+		next:     next,
+		breakers: breakers,
+		config: transportConfig{
+			key: func(req *http.Request) string { return req.URL.Host },
+			failureStatus: func(code int) bool {
+				return code == http.StatusTooManyRequests || code >= 500
+			},
+		},
+	}
+	for _, opt := range opts {
+		opt(&t.config)
+	}
+	return t
+}
+
+// Breakers 返回 Transport 使用的熔断器管理器
+func (t *Transport) Breakers() *BreakerManager {
+	return t.breakers
+}
+
+// ignored 判断传输错误是否不计入熔断统计
+func (t *Transport) ignored(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return true
+	}
+	for _, fn := range t.config.ignoreErrors {
+		if fn(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// RoundTrip 实现 http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := t.config.key(req)
+
+	var resp *http.Response
+	var rtErr error
+	_, rejected, err := t.breakers.Get(key).executeOutcome(func() (any, bool, error) {
+		resp, rtErr = t.next.RoundTrip(req)
+		switch {
+		case rtErr != nil:
+			// 调用方放弃的请求不反映下游状态，既不算失败也不算成功
+			return nil, t.ignored(rtErr), rtErr
+		case t.config.failureStatus(resp.StatusCode):
+			return nil, false, &StatusError{Code: resp.StatusCode}
+		}
+		return nil, false, nil
+	})
+	if rejected {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, fmt.Errorf("circuit %s: %w", key, err)
+	}
+	return resp, rtErr
+}
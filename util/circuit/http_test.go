@@ -0,0 +1,132 @@
+package circuit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestTransport(t *testing.T) {
+	status := http.StatusInternalServerError
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	breakers := NewBreakerManagerWithOptions(WithThreshold(2))
+	client := &http.Client{Transport: NewTransport(nil, breakers)}
+
+	// 失败状态码计入熔断，但调用方仍收到原始响应
+	for range 2 {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusInternalServerError {
+			t.Errorf("expected 500, got %d", resp.StatusCode)
+		}
+	}
+
+	u, _ := url.Parse(server.URL)
+	if breakers.Get(u.Host).State() != StateOpen {
+		t.Fatalf("expected breaker for %s to be open", u.Host)
+	}
+
+	_, err := client.Get(server.URL)
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("expected ErrCircuitOpen, got %v", err)
+	}
+}
+
+func TestTransport_Options(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	transport := NewTransport(nil, nil,
+		WithRequestKey(func(req *http.Request) string { return req.URL.Path }),
+		WithFailureStatus(func(code int) bool { return code == http.StatusNotFound }),
+	)
+	client := &http.Client{Transport: transport}
+
+	for range 5 {
+		resp, err := client.Get(server.URL + "/missing")
+		if err != nil {
+			break
+		}
+		resp.Body.Close()
+	}
+
+	states := transport.Breakers().States()
+	if states["/missing"] != StateOpen {
+		t.Errorf("expected breaker keyed by path to be open, got %v", states)
+	}
+
+	if !IsServerError(&StatusError{Code: 503}) {
+		t.Error("StatusError should satisfy HTTPError")
+	}
+}
+
+// roundTripFunc 测试用 RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestTransport_CanceledProbe(t *testing.T) {
+	currentTime := time.Now()
+	breakers := NewBreakerManagerWithOptions(
+		WithThreshold(1),
+		WithTimeout(time.Second),
+		WithSuccessThreshold(1),
+		WithHalfOpenMaxRequests(1),
+		WithNow(func() time.Time { return currentTime }),
+	)
+
+	var next func(*http.Request) (*http.Response, error)
+	transport := NewTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return next(req)
+	}), breakers)
+	req := httptest.NewRequest(http.MethodGet, "http://backend/", nil)
+
+	next = func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	}
+	transport.RoundTrip(req)
+	breaker := breakers.Get("backend")
+	if breaker.State() != StateOpen {
+		t.Fatalf("expected open, got %v", breaker.State())
+	}
+
+	// 半开探测被调用方取消，不能关闭熔断器，也不能占用探测名额
+	currentTime = currentTime.Add(2 * time.Second)
+	next = func(*http.Request) (*http.Response, error) {
+		return nil, context.Canceled
+	}
+	if _, err := transport.RoundTrip(req); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if breaker.State() != StateHalfOpen {
+		t.Fatalf("canceled probe should keep breaker half-open, got %v", breaker.State())
+	}
+	if s := breaker.Stats(); s.TotalSuccesses != 0 || s.TotalFailures != 1 {
+		t.Errorf("canceled probe should not be counted, got %+v", s)
+	}
+
+	next = func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("probe slot should be released, got %v", err)
+	}
+	if breaker.State() != StateClosed {
+		t.Errorf("expected closed after successful probe, got %v", breaker.State())
+	}
+}
@@ -0,0 +1,320 @@
+package circuit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
+)
+
+// ErrLimitExceeded 并发数超过自适应限制
+var ErrLimitExceeded = errorx.NewSentinel(errorx.CategoryRateLimited, "concurrency limit exceeded")
+
+// LimitAlgorithm 自适应限流算法
+type LimitAlgorithm int
+
+const (
+	// AlgorithmAIMD 加性增、乘性减：无失败时逐步放大限制，失败或超时时按比例收缩
+	AlgorithmAIMD LimitAlgorithm = iota
+	// AlgorithmGradient 梯度算法（gradient2）：根据短期延迟与长期基线延迟之比调整限制
+	AlgorithmGradient
+)
+
+func (a LimitAlgorithm) String() string {
+	switch a {
+	case AlgorithmAIMD:
+		return "aimd"
+	case AlgorithmGradient:
+		return "gradient"
+	default:
+		return "unknown"
+	}
+}
+
+// LimiterConfig 自适应限流器配置
+type LimiterConfig struct {
+	// Algorithm 限流算法
+	Algorithm LimitAlgorithm
+	// InitialLimit 初始并发限制
+	InitialLimit int
+	// MinLimit 最小并发限制
+	MinLimit int
+	// MaxLimit 最大并发限制
+	MaxLimit int
+	// BackoffRatio 失败时的收缩比例（0~1）
+	BackoffRatio float64
+	// LatencyThreshold AIMD 下延迟超过该值视为过载（0 表示不按延迟判断）
+	LatencyThreshold time.Duration
+	// Tolerance Gradient 下允许的延迟放大倍数，超过后开始收缩
+	Tolerance float64
+	// Smoothing Gradient 下新旧限制的平滑系数（0~1）
+	Smoothing float64
+	// LongWindow Gradient 下长期基线延迟的 EWMA 窗口（样本数）
+	LongWindow int
+	// IsFailure 判断是否为失败（默认任何错误都是失败）
+	IsFailure func(error) bool
+	// OnLimitChange 限制变更回调（同步调用，不应阻塞）
+	OnLimitChange func(oldLimit, newLimit int)
+	// Now 时间函数（用于测试）
+	Now func() time.Time
+}
+
+// LimiterOption 限流器配置选项
+type LimiterOption func(*LimiterConfig)
+
+// WithAlgorithm 设置限流算法
+func WithAlgorithm(a LimitAlgorithm) LimiterOption {
+	return func(c *LimiterConfig) { c.Algorithm = a }
+}
+
+// WithInitialLimit 设置初始并发限制
+func WithInitialLimit(n int) LimiterOption {
+	return func(c *LimiterConfig) { c.InitialLimit = n }
+}
+
+// WithLimitRange 设置并发限制的上下界
+func WithLimitRange(minLimit, maxLimit int) LimiterOption {
+	return func(c *LimiterConfig) {
+		c.MinLimit = minLimit
+		c.MaxLimit = maxLimit
+	}
+}
+
+// WithBackoffRatio 设置失败时的收缩比例
+func WithBackoffRatio(r float64) LimiterOption {
+	return func(c *LimiterConfig) { c.BackoffRatio = r }
+}
+
+// WithLatencyThreshold 设置 AIMD 的延迟阈值
+func WithLatencyThreshold(d time.Duration) LimiterOption {
+	return func(c *LimiterConfig) { c.LatencyThreshold = d }
+}
+
+// WithTolerance 设置 Gradient 的延迟容忍倍数
+func WithTolerance(t float64) LimiterOption {
+	return func(c *LimiterConfig) { c.Tolerance = t }
+}
+
+// WithLimiterIsFailure 设置限流器的失败判断函数
+func WithLimiterIsFailure(fn func(error) bool) LimiterOption {
+	return func(c *LimiterConfig) { c.IsFailure = fn }
+}
+
+// WithOnLimitChange 设置限制变更回调
+func WithOnLimitChange(fn func(oldLimit, newLimit int)) LimiterOption {
+	return func(c *LimiterConfig) { c.OnLimitChange = fn }
+}
+
+// WithLimiterNow 设置限流器的时间函数
+func WithLimiterNow(fn func() time.Time) LimiterOption {
+	return func(c *LimiterConfig) { c.Now = fn }
+}
+
+// defaultLimiterConfig 默认限流器配置
+func defaultLimiterConfig() LimiterConfig {
+	return LimiterConfig{
+		Algorithm:    AlgorithmAIMD,
+		InitialLimit: 20,
+		MinLimit:     1,
+		MaxLimit:     200,
+		BackoffRatio: 0.9,
+		Tolerance:    1.5,
+		Smoothing:    0.2,
+		LongWindow:   600,
+		IsFailure: func(err error) bool {
+			return err != nil
+		},
+		Now: time.Now,
+	}
+}
+
+// Limiter 自适应并发限流器
+//
+// 根据观察到的延迟和失败自动调整允许的并发数，无需手工调参即可保护下游。
+// 可单独使用，也可通过 WithLimiter 与 Breaker 组合使用。
+type Limiter struct {
+	config LimiterConfig
+
+	inflight atomic.Int32
+
+	mu      sync.Mutex
+	limit   float64
+	longRTT float64 // 长期基线延迟（纳秒，EWMA）
+}
+
+// NewLimiter 创建自适应并发限流器
+func NewLimiter(opts ...LimiterOption) *Limiter {
+	cfg := defaultLimiterConfig()
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.MinLimit < 1 {
+		cfg.MinLimit = 1
+	}
+	if cfg.MaxLimit < cfg.MinLimit {
+		cfg.MaxLimit = cfg.MinLimit
+	}
+	if cfg.BackoffRatio <= 0 || cfg.BackoffRatio >= 1 {
+		cfg.BackoffRatio = 0.9
+	}
+	if cfg.LongWindow < 1 {
+		cfg.LongWindow = 600
+	}
+
+	l := &Limiter{config: cfg}
+	l.limit = l.clamp(float64(cfg.InitialLimit))
+	return l
+}
+
+// Limit 返回当前并发限制
+func (l *Limiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+// Inflight 返回当前正在执行的请求数
+func (l *Limiter) Inflight() int {
+	return int(l.inflight.Load())
+}
+
+// Acquire 获取执行许可
+//
+// 成功时返回 LimitToken，调用者必须在请求完成后调用其 Release 或 Ignore。
+// 当前并发数已达到限制时返回 ErrLimitExceeded。
+func (l *Limiter) Acquire() (*LimitToken, error) {
+	limit := int32(l.Limit())
+	for {
+		current := l.inflight.Load()
+		if current >= limit {
+			return nil, ErrLimitExceeded
+		}
+		if l.inflight.CompareAndSwap(current, current+1) {
+			return &LimitToken{
+				limiter:  l,
+				start:    l.config.Now(),
+				inflight: int(current + 1),
+			}, nil
+		}
+	}
+}
+
+// Execute 在并发限制内执行函数
+func (l *Limiter) Execute(fn func() (any, error)) (any, error) {
+	token, err := l.Acquire()
+	if err != nil {
+		return nil, err
+	}
+	result, err := fn()
+	token.Release(err)
+	return result, err
+}
+
+// ExecuteContext 在并发限制内执行带上下文的函数
+func (l *Limiter) ExecuteContext(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
+	token, err := l.Acquire()
+	if err != nil {
+		return nil, err
+	}
+	result, err := fn(ctx)
+	if ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+		// 调用方取消的请求不反映下游负载
+		token.Ignore()
+	} else {
+		token.Release(err)
+	}
+	return result, err
+}
+
+// LimitToken 执行许可
+type LimitToken struct {
+	limiter  *Limiter
+	start    time.Time
+	inflight int
+	done     atomic.Bool
+}
+
+// Release 释放许可并记录本次请求的延迟和结果
+func (t *LimitToken) Release(err error) {
+	if t == nil || !t.done.CompareAndSwap(false, true) {
+		return
+	}
+	t.limiter.inflight.Add(-1)
+	rtt := t.limiter.config.Now().Sub(t.start)
+	t.limiter.onSample(rtt, t.inflight, t.limiter.config.IsFailure(err))
+}
+
+// Ignore 释放许可但不记录样本（如请求被调用方取消）
+func (t *LimitToken) Ignore() {
+	if t != nil && t.done.CompareAndSwap(false, true) {
+		t.limiter.inflight.Add(-1)
+	}
+}
+
+// onSample 根据样本调整并发限制
+func (l *Limiter) onSample(rtt time.Duration, inflight int, failed bool) {
+	l.mu.Lock()
+	old := l.limit
+	switch l.config.Algorithm {
+	case AlgorithmGradient:
+		l.gradient(rtt, inflight, failed)
+	default:
+		l.aimd(rtt, inflight, failed)
+	}
+	oldLimit, newLimit := int(old), int(l.limit)
+	l.mu.Unlock()
+
+	if oldLimit != newLimit && l.config.OnLimitChange != nil {
+		l.config.OnLimitChange(oldLimit, newLimit)
+	}
+}
+
+// aimd 加性增、乘性减（调用方持有锁）
+func (l *Limiter) aimd(rtt time.Duration, inflight int, failed bool) {
+	if failed || (l.config.LatencyThreshold > 0 && rtt > l.config.LatencyThreshold) {
+		l.limit = l.clamp(l.limit * l.config.BackoffRatio)
+		return
+	}
+	// 并发未用到一半时说明负载由调用方决定，不扩大限制
+	if float64(inflight)*2 >= l.limit {
+		l.limit = l.clamp(l.limit + 1)
+	}
+}
+
+// gradient gradient2 算法（调用方持有锁）
+func (l *Limiter) gradient(rtt time.Duration, inflight int, failed bool) {
+	if failed {
+		l.limit = l.clamp(l.limit * l.config.BackoffRatio)
+		return
+	}
+
+	short := float64(rtt)
+	if short <= 0 {
+		short = 1
+	}
+	if l.longRTT == 0 {
+		l.longRTT = short
+	} else {
+		alpha := 2 / float64(l.config.LongWindow+1)
+		l.longRTT = l.longRTT*(1-alpha) + short*alpha
+	}
+
+	if float64(inflight)*2 < l.limit {
+		return
+	}
+
+	// 短期延迟高于基线 * 容忍倍数时梯度 < 1，限制收缩
+	grad := math.Max(0.5, math.Min(1, l.config.Tolerance*l.longRTT/short))
+	queue := math.Sqrt(l.limit)
+	next := l.limit*grad + queue
+	l.limit = l.clamp(l.limit*(1-l.config.Smoothing) + next*l.config.Smoothing)
+}
+
+// clamp 将限制约束在 [MinLimit, MaxLimit]
+func (l *Limiter) clamp(v float64) float64 {
+	return math.Max(float64(l.config.MinLimit), math.Min(float64(l.config.MaxLimit), v))
+}
@@ -0,0 +1,276 @@
+package circuit
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock 可手动推进的时钟
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	c.mu.Unlock()
+}
+
+func TestLimiter_RejectsOverLimit(t *testing.T) {
+	l := NewLimiter(WithInitialLimit(2))
+
+	t1, err := l.Acquire()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t2, err := l.Acquire()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.Acquire(); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("expected ErrLimitExceeded, got %v", err)
+	}
+
+	t1.Ignore()
+	t1.Ignore() // 重复释放应无副作用
+	if l.Inflight() != 1 {
+		t.Errorf("expected inflight 1, got %d", l.Inflight())
+	}
+	t2.Release(nil)
+	if l.Inflight() != 0 {
+		t.Errorf("expected inflight 0, got %d", l.Inflight())
+	}
+}
+
+func TestLimiter_AIMD(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	l := NewLimiter(
+		WithInitialLimit(10),
+		WithLimitRange(2, 20),
+		WithBackoffRatio(0.5),
+		WithLatencyThreshold(100*time.Millisecond),
+		WithLimiterNow(clock.Now),
+	)
+
+	// 并发占满一半以上时成功样本增加限制
+	tokens := make([]*LimitToken, 6)
+	for i := range tokens {
+		tokens[i], _ = l.Acquire()
+	}
+	for _, tk := range tokens {
+		tk.Release(nil)
+	}
+	if l.Limit() <= 10 {
+		t.Errorf("expected limit to grow, got %d", l.Limit())
+	}
+
+	// 失败时乘性收缩
+	before := l.Limit()
+	_, _ = l.Execute(func() (any, error) { return nil, errors.New("boom") })
+	if l.Limit() >= before {
+		t.Errorf("expected limit to shrink after failure: %d -> %d", before, l.Limit())
+	}
+
+	// 延迟超阈值同样收缩
+	before = l.Limit()
+	_, _ = l.Execute(func() (any, error) {
+		clock.Advance(200 * time.Millisecond)
+		return nil, nil
+	})
+	if l.Limit() >= before {
+		t.Errorf("expected limit to shrink after slow call: %d -> %d", before, l.Limit())
+	}
+
+	// 不低于最小值
+	for i := 0; i < 20; i++ {
+		_, _ = l.Execute(func() (any, error) { return nil, errors.New("boom") })
+	}
+	if l.Limit() != 2 {
+		t.Errorf("expected limit clamped to 2, got %d", l.Limit())
+	}
+}
+
+func TestLimiter_AIMD_AppLimited(t *testing.T) {
+	l := NewLimiter(WithInitialLimit(10))
+	for i := 0; i < 10; i++ {
+		_, _ = l.Execute(func() (any, error) { return nil, nil })
+	}
+	if l.Limit() != 10 {
+		t.Errorf("low utilization should not grow limit, got %d", l.Limit())
+	}
+}
+
+func TestLimiter_Gradient(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	l := NewLimiter(
+		WithAlgorithm(AlgorithmGradient),
+		WithInitialLimit(4),
+		WithLimitRange(1, 100),
+		WithLimiterNow(clock.Now),
+	)
+
+	run := func(rtt time.Duration) {
+		tokens := make([]*LimitToken, 0, l.Limit())
+		for {
+			tk, err := l.Acquire()
+			if err != nil {
+				break
+			}
+			tokens = append(tokens, tk)
+		}
+		clock.Advance(rtt)
+		for _, tk := range tokens {
+			tk.Release(nil)
+		}
+	}
+
+	// 延迟稳定时限制增长
+	for i := 0; i < 10; i++ {
+		run(10 * time.Millisecond)
+	}
+	grown := l.Limit()
+	if grown <= 4 {
+		t.Fatalf("expected limit to grow with stable latency, got %d", grown)
+	}
+
+	// 延迟大幅上升时限制收缩
+	for i := 0; i < 5; i++ {
+		run(100 * time.Millisecond)
+	}
+	if l.Limit() >= grown {
+		t.Errorf("expected limit to shrink with rising latency: %d -> %d", grown, l.Limit())
+	}
+}
+
+func TestLimiter_OnLimitChange(t *testing.T) {
+	var changes int
+	l := NewLimiter(WithInitialLimit(10), WithOnLimitChange(func(oldLimit, newLimit int) {
+		changes++
+	}))
+	_, _ = l.Execute(func() (any, error) { return nil, errors.New("boom") })
+	if changes != 1 {
+		t.Errorf("expected 1 change notification, got %d", changes)
+	}
+}
+
+func TestBreaker_WithLimiter(t *testing.T) {
+	l := NewLimiter(WithInitialLimit(1), WithLimitRange(1, 1))
+	b := New(WithThreshold(1), WithLimiter(l))
+
+	block := make(chan struct{})
+	started := make(chan struct{})
+	go func() {
+		_, _ = b.Execute(func() (any, error) {
+			close(started)
+			<-block
+			return nil, nil
+		})
+	}()
+	<-started
+
+	_, err := b.Execute(func() (any, error) { return nil, nil })
+	if !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("expected ErrLimitExceeded, got %v", err)
+	}
+	if b.State() != StateClosed {
+		t.Errorf("limiter rejection should not trip breaker, got %v", b.State())
+	}
+	close(block)
+	for l.Inflight() != 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// 熔断后拒绝的请求应释放限流许可
+	_, _ = b.Execute(func() (any, error) { return nil, errors.New("boom") })
+	if b.State() != StateOpen {
+		t.Fatalf("expected StateOpen, got %v", b.State())
+	}
+	if _, err := b.Execute(func() (any, error) { return nil, nil }); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("expected ErrCircuitOpen, got %v", err)
+	}
+	if l.Inflight() != 0 {
+		t.Errorf("expected inflight 0, got %d", l.Inflight())
+	}
+}
+
+func TestBreaker_PanicReleasesLimiter(t *testing.T) {
+	l := NewLimiter(WithInitialLimit(2), WithLimitRange(2, 2))
+	b := New(WithThreshold(10), WithLimiter(l))
+
+	for i := 0; i < 3; i++ {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic to propagate")
+				}
+			}()
+			_, _ = b.Execute(func() (any, error) { panic("boom") })
+		}()
+	}
+	if l.Inflight() != 0 {
+		t.Fatalf("expected inflight 0 after panics, got %d", l.Inflight())
+	}
+	if s := b.Stats(); s.TotalFailures != 3 {
+		t.Errorf("expected panics counted as failures, got %d", s.TotalFailures)
+	}
+
+	// panic 之后限流器仍能放行 max 个并发请求
+	block := make(chan struct{})
+	var started sync.WaitGroup
+	started.Add(2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, _ = b.Execute(func() (any, error) {
+				started.Done()
+				<-block
+				return nil, nil
+			})
+		}()
+	}
+	started.Wait()
+	if _, err := b.Execute(func() (any, error) { return nil, nil }); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("expected ErrLimitExceeded beyond max, got %v", err)
+	}
+	close(block)
+}
+
+func TestBreaker_PanicInHalfOpen(t *testing.T) {
+	now := time.Now()
+	currentTime := now
+	b := New(
+		WithThreshold(1),
+		WithTimeout(100*time.Millisecond),
+		WithSuccessThreshold(1),
+		WithNow(func() time.Time { return currentTime }),
+	)
+
+	_, _ = b.Execute(func() (any, error) { return nil, errors.New("error") })
+	currentTime = now.Add(200 * time.Millisecond)
+
+	// 半开探测 panic 计为失败，熔断器重新打开
+	func() {
+		defer func() { _ = recover() }()
+		_, _ = b.Execute(func() (any, error) { panic("boom") })
+	}()
+	if b.State() != StateOpen {
+		t.Fatalf("expected StateOpen after panicking probe, got %v", b.State())
+	}
+
+	// 探测名额已释放，之后的探测可以使熔断器恢复
+	currentTime = now.Add(400 * time.Millisecond)
+	if _, err := b.Execute(func() (any, error) { return "ok", nil }); err != nil {
+		t.Fatalf("expected probe to be admitted, got %v", err)
+	}
+	if b.State() != StateClosed {
+		t.Errorf("expected StateClosed, got %v", b.State())
+	}
+}
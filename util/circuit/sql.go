@@ -0,0 +1,285 @@
+package circuit
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+)
+
+// sqlConfig 数据库适配器配置
+type sqlConfig struct {
+	isFailure func(error) bool
+}
+
+// SQLOption 数据库适配器配置选项
+type SQLOption func(*sqlConfig)
+
+// WithSQLFailure 设置哪些数据库错误计入熔断失败，默认为 IsConnError
+//
+// SQL 语法错误、唯一键冲突等业务错误不代表数据库不可用，不应触发熔断。
+func WithSQLFailure(fn func(error) bool) SQLOption {
+	return func(c *sqlConfig) { c.isFailure = fn }
+}
+
+// IsConnError 判断是否为连接级错误（坏连接、网络错误、超时、连接被意外断开）
+func IsConnError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// WrapConnector 包装 driver.Connector，使通过它打开的 *sql.DB 的所有调用经过熔断器
+//
+// 建立连接、Exec、Query、Prepare、预编译语句的执行、BeginTx 和 Ping 都会经过熔断器，
+// 熔断时返回 ErrCircuitOpen/ErrTooManyRequests；事务的 Commit/Rollback 不受限制，
+// 避免已开始的事务无法结束。降级函数（WithFallback）不在适配器中生效。
+//
+// 示例:
+//
+//	connector, _ := mysql.NewConnector(cfg)
+//	db := sql.OpenDB(circuit.WrapConnector(connector, breaker))
+func WrapConnector(c driver.Connector, b *Breaker, opts ...SQLOption) driver.Connector {
+	cfg := sqlConfig{isFailure: IsConnError}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &sqlConnector{connector: c, breaker: b, config: cfg}
+}
+
+// WrapDriver 使用驱动和 DSN 创建经过熔断器的 driver.Connector
+//
+// 示例:
+//
+//	db := sql.OpenDB(circuit.WrapDriver(mysql.MySQLDriver{}, dsn, breaker))
+func WrapDriver(d driver.Driver, dsn string, b *Breaker, opts ...SQLOption) driver.Connector {
+	if dc, ok := d.(driver.DriverContext); ok {
+		if c, err := dc.OpenConnector(dsn); err == nil {
+			return WrapConnector(c, b, opts...)
+		}
+	}
+	return WrapConnector(dsnConnector{dsn: dsn, driver: d}, b, opts...)
+}
+
+// dsnConnector 不支持 DriverContext 的驱动使用的 Connector
+type dsnConnector struct {
+	dsn    string
+	driver driver.Driver
+}
+
+func (c dsnConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.dsn)
+}
+
+func (c dsnConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+// sqlConnector 经过熔断器的 Connector
+type sqlConnector struct {
+	connector driver.Connector
+	breaker   *Breaker
+	config    sqlConfig
+}
+
+// call 经过熔断器执行 fn，只有被判定为失败的错误计入熔断统计
+func (c *sqlConnector) call(fn func() error) error {
+	var callErr error
+	_, rejected, err := c.breaker.execute(func() (any, error) {
+		callErr = fn()
+		if callErr != nil && !errors.Is(callErr, driver.ErrSkip) && c.config.isFailure(callErr) {
+			return nil, callErr
+		}
+		return nil, nil
+	})
+	if rejected {
+		return err
+	}
+	return callErr
+}
+
+func (c *sqlConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	var conn driver.Conn
+	err := c.call(func() (err error) {
+		conn, err = c.connector.Connect(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &sqlConn{Conn: conn, c: c}, nil
+}
+
+func (c *sqlConnector) Driver() driver.Driver {
+	return c.connector.Driver()
+}
+
+// sqlConn 经过熔断器的连接，未实现的可选接口按 database/sql 的约定回退
+type sqlConn struct {
+	driver.Conn
+	c *sqlConnector
+}
+
+func (s *sqlConn) Prepare(query string) (driver.Stmt, error) {
+	return s.PrepareContext(context.Background(), query)
+}
+
+func (s *sqlConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	var stmt driver.Stmt
+	err := s.c.call(func() (err error) {
+		if pc, ok := s.Conn.(driver.ConnPrepareContext); ok {
+			stmt, err = pc.PrepareContext(ctx, query)
+		} else {
+			stmt, err = s.Conn.Prepare(query)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &sqlStmt{Stmt: stmt, conn: s}, nil
+}
+
+func (s *sqlConn) Begin() (driver.Tx, error) {
+	return s.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (s *sqlConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	var tx driver.Tx
+	err := s.c.call(func() (err error) {
+		if bt, ok := s.Conn.(driver.ConnBeginTx); ok {
+			tx, err = bt.BeginTx(ctx, opts)
+		} else {
+			tx, err = s.Conn.Begin() // 驱动未实现 ConnBeginTx 时的回退
+		}
+		return err
+	})
+	return tx, err
+}
+
+func (s *sqlConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	ec, ok := s.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	var result driver.Result
+	err := s.c.call(func() (err error) {
+		result, err = ec.ExecContext(ctx, query, args)
+		return err
+	})
+	return result, err
+}
+
+func (s *sqlConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	qc, ok := s.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	var rows driver.Rows
+	err := s.c.call(func() (err error) {
+		rows, err = qc.QueryContext(ctx, query, args)
+		return err
+	})
+	return rows, err
+}
+
+func (s *sqlConn) Ping(ctx context.Context) error {
+	p, ok := s.Conn.(driver.Pinger)
+	if !ok {
+		return nil
+	}
+	return s.c.call(func() error {
+		return p.Ping(ctx)
+	})
+}
+
+func (s *sqlConn) ResetSession(ctx context.Context) error {
+	if r, ok := s.Conn.(driver.SessionResetter); ok {
+		return r.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (s *sqlConn) IsValid() bool {
+	if v, ok := s.Conn.(driver.Validator); ok {
+		return v.IsValid()
+	}
+	return true
+}
+
+func (s *sqlConn) CheckNamedValue(nv *driver.NamedValue) error {
+	if c, ok := s.Conn.(driver.NamedValueChecker); ok {
+		return c.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
+
+// sqlStmt 经过熔断器的预编译语句
+type sqlStmt struct {
+	driver.Stmt
+	conn *sqlConn
+}
+
+func (s *sqlStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	var result driver.Result
+	err := s.conn.c.call(func() (err error) {
+		if ec, ok := s.Stmt.(driver.StmtExecContext); ok {
+			result, err = ec.ExecContext(ctx, args)
+			return err
+		}
+		values, err := namedToValues(args)
+		if err != nil {
+			return err
+		}
+		result, err = s.Stmt.Exec(values) // 驱动未实现 StmtExecContext 时的回退
+		return err
+	})
+	return result, err
+}
+
+func (s *sqlStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	var rows driver.Rows
+	err := s.conn.c.call(func() (err error) {
+		if qc, ok := s.Stmt.(driver.StmtQueryContext); ok {
+			rows, err = qc.QueryContext(ctx, args)
+			return err
+		}
+		values, err := namedToValues(args)
+		if err != nil {
+			return err
+		}
+		rows, err = s.Stmt.Query(values) // 驱动未实现 StmtQueryContext 时的回退
+		return err
+	})
+	return rows, err
+}
+
+// CheckNamedValue 优先使用语句的参数检查，其次使用连接的参数检查
+//
+// database/sql 找到语句级检查后不再查询连接级检查，因此需要在这里回退到连接
+func (s *sqlStmt) CheckNamedValue(nv *driver.NamedValue) error {
+	if c, ok := s.Stmt.(driver.NamedValueChecker); ok {
+		return c.CheckNamedValue(nv)
+	}
+	return s.conn.CheckNamedValue(nv)
+}
+
+// namedToValues 将命名参数转换为位置参数，驱动不支持命名参数时返回错误
+func namedToValues(named []driver.NamedValue) ([]driver.Value, error) {
+	values := make([]driver.Value, len(named))
+	for i, nv := range named {
+		if nv.Name != "" {
+			return nil, errors.New("circuit: driver does not support named parameters")
+		}
+		values[i] = nv.Value
+	}
+	return values, nil
+}
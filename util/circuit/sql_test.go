@@ -0,0 +1,113 @@
+package circuit
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"testing"
+)
+
+// fakeDriver 返回可配置错误的测试驱动
+type fakeDriver struct {
+	err     error // Exec/Query/Ping 返回的错误
+	queries int   // 到达驱动的查询次数
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{d: d}, nil }
+
+type fakeConn struct{ d *fakeDriver }
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return fakeTx{}, nil }
+
+func (c *fakeConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	c.d.queries++
+	if c.d.err != nil {
+		return nil, c.d.err
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) Ping(context.Context) error { return nil }
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+func TestWrapDriver(t *testing.T) {
+	d := &fakeDriver{}
+	b := New(WithThreshold(2))
+	db := sql.OpenDB(WrapDriver(d, "fake", b))
+	defer db.Close()
+	db.SetMaxIdleConns(1)
+
+	ctx := context.Background()
+	if _, err := db.ExecContext(ctx, "UPDATE t SET a = 1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 业务错误不计入熔断
+	d.err = errors.New("duplicate entry")
+	for range 3 {
+		_, _ = db.ExecContext(ctx, "INSERT INTO t VALUES (1)")
+	}
+	if b.State() != StateClosed {
+		t.Fatalf("expected StateClosed after non-connection errors, got %v", b.State())
+	}
+
+	// 连接级错误计入熔断
+	d.err = &net.OpError{Op: "read", Err: io.ErrUnexpectedEOF}
+	for range 2 {
+		_, _ = db.ExecContext(ctx, "UPDATE t SET a = 1")
+	}
+	if b.State() != StateOpen {
+		t.Fatalf("expected StateOpen, got %v", b.State())
+	}
+
+	queries := d.queries
+	_, err := db.ExecContext(ctx, "UPDATE t SET a = 1")
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("expected ErrCircuitOpen, got %v", err)
+	}
+	if d.queries != queries {
+		t.Error("query should not reach the driver when open")
+	}
+
+	// 已开始的事务不受熔断影响，可以正常结束
+	b.Reset()
+	d.err = nil
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx: %v", err)
+	}
+	for range 2 {
+		b.Failure()
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Errorf("Rollback should not be blocked by the breaker: %v", err)
+	}
+}
+
+func TestWrapConnector_CustomFailure(t *testing.T) {
+	errInvalid := errors.New("invalid connection")
+	d := &fakeDriver{err: errInvalid}
+	b := New(WithThreshold(1))
+	db := sql.OpenDB(WrapConnector(dsnConnector{dsn: "fake", driver: d}, b,
+		WithSQLFailure(func(err error) bool { return errors.Is(err, errInvalid) }),
+	))
+	defer db.Close()
+
+	_, _ = db.ExecContext(context.Background(), "SELECT 1")
+	if b.State() != StateOpen {
+		t.Errorf("expected StateOpen with custom failure predicate, got %v", b.State())
+	}
+
+	if IsConnError(errors.New("syntax error")) || !IsConnError(driver.ErrBadConn) {
+		t.Error("IsConnError misclassified errors")
+	}
+}
@@ -0,0 +1,92 @@
+package circuit
+
+import (
+	"sync"
+	"time"
+)
+
+// windowBuckets 滑动窗口的桶数量，窗口按桶粒度滚动
+const windowBuckets = 10
+
+// windowBucket 单个时间桶的计数
+type windowBucket struct {
+	epoch     int64 // 桶对应的时间序号（UnixNano / 桶长度）
+	successes int64
+	failures  int64
+	slow      int64
+}
+
+// windowCounts 窗口内的汇总计数
+type windowCounts struct {
+	total    int64
+	failures int64
+	slow     int64
+}
+
+// rollingWindow 按时间分桶的滑动窗口计数器
+//
+// 窗口被切分为 windowBuckets 个桶，过期桶在下次写入或读取时被复用，
+// 因此统计精度为一个桶的长度。
+type rollingWindow struct {
+	mu        sync.Mutex
+	bucketDur int64
+	buckets   [windowBuckets]windowBucket
+}
+
+// newRollingWindow 创建滑动窗口
+func newRollingWindow(window time.Duration) *rollingWindow {
+	bucketDur := int64(window) / windowBuckets
+	if bucketDur <= 0 {
+		bucketDur = 1
+	}
+	return &rollingWindow{bucketDur: bucketDur}
+}
+
+// record 记录一次调用结果，返回记录后窗口内的汇总计数
+func (w *rollingWindow) record(now time.Time, failure, slow bool) windowCounts {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	epoch := now.UnixNano() / w.bucketDur
+	b := &w.buckets[epoch%windowBuckets]
+	if b.epoch != epoch {
+		*b = windowBucket{epoch: epoch}
+	}
+	if failure {
+		b.failures++
+	} else {
+		b.successes++
+	}
+	if slow {
+		b.slow++
+	}
+	return w.sumLocked(epoch)
+}
+
+// counts 返回窗口内的汇总计数
+func (w *rollingWindow) counts(now time.Time) windowCounts {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.sumLocked(now.UnixNano() / w.bucketDur)
+}
+
+// sumLocked 汇总未过期的桶，调用方需持有锁
+func (w *rollingWindow) sumLocked(epoch int64) windowCounts {
+	var c windowCounts
+	for i := range w.buckets {
+		b := &w.buckets[i]
+		if b.epoch > epoch-windowBuckets && b.epoch <= epoch {
+			c.total += b.successes + b.failures
+			c.failures += b.failures
+			c.slow += b.slow
+		}
+	}
+	return c
+}
+
+// reset 清空窗口
+func (w *rollingWindow) reset() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.buckets = [windowBuckets]windowBucket{}
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"os"
@@ -24,8 +25,9 @@ var (
 
 // Config 配置管理器
 type Config struct {
-	data map[string]any
-	mu   sync.RWMutex
+	data      map[string]any
+	mu        sync.RWMutex
+	providers map[string]SecretProvider
 }
 
 // New 创建配置管理器
@@ -60,30 +62,44 @@ func (c *Config) LoadFile(path string) error {
 	return c.loadData(data, ext)
 }
 
-// loadData 根据格式解析数据
+// loadData 根据格式解析数据，解析密钥占位符后合并到现有配置
 func (c *Config) loadData(data []byte, format string) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
+	parsed := make(map[string]any)
+	var err error
 	switch format {
 	case ".json":
-		return json.Unmarshal(data, &c.data)
+		err = json.Unmarshal(data, &parsed)
 	case ".yaml", ".yml":
-		return c.parseYAML(data)
+		err = parseYAML(data, parsed)
 	case ".toml":
-		return c.parseTOML(data)
+		err = parseTOML(data, parsed)
 	case ".env":
-		return c.parseEnv(data)
+		err = parseEnv(data, parsed)
 	default:
 		return ErrUnsupportedFormat
 	}
+	if err != nil {
+		return err
+	}
+
+	// 只解析新加载的数据，已解析的密钥值中即使包含 "${" 也不会被再次解析
+	if err := c.resolveMap(context.Background(), parsed); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, v := range parsed {
+		c.data[k] = v
+	}
+	return nil
 }
 
 // parseYAML 简单的 YAML 解析（不依赖外部库）
 // 警告：这是简化实现，只支持简单的 key: value 格式
 // 不支持嵌套结构、数组、多行字符串等复杂 YAML 特性
 // 对于复杂配置，建议使用 gopkg.in/yaml.v3
-func (c *Config) parseYAML(data []byte) error {
+func parseYAML(data []byte, dst map[string]any) error {
 	// 简化实现：只支持简单的 key: value 格式
 	lines := strings.Split(string(data), "\n")
 	for _, line := range lines {
@@ -103,7 +119,7 @@ func (c *Config) parseYAML(data []byte) error {
 		// 移除引号
 		value = strings.Trim(value, "\"'")
 
-		c.data[key] = parseValue(value)
+		dst[key] = parseValue(value)
 	}
 	return nil
 }
@@ -112,7 +128,7 @@ func (c *Config) parseYAML(data []byte) error {
 // 警告：这是简化实现，只支持简单的 key = value 格式和基本的 [section]
 // 不支持嵌套表、数组、内联表等复杂 TOML 特性
 // 对于复杂配置，建议使用 github.com/BurntSushi/toml
-func (c *Config) parseTOML(data []byte) error {
+func parseTOML(data []byte, dst map[string]any) error {
 	// 简化实现：只支持简单的 key = value 格式
 	lines := strings.Split(string(data), "\n")
 	currentSection := ""
@@ -144,13 +160,13 @@ func (c *Config) parseTOML(data []byte) error {
 			key = currentSection + "." + key
 		}
 
-		c.data[key] = parseValue(value)
+		dst[key] = parseValue(value)
 	}
 	return nil
 }
 
 // parseEnv 解析 .env 文件
-func (c *Config) parseEnv(data []byte) error {
+func parseEnv(data []byte, dst map[string]any) error {
 	lines := strings.Split(string(data), "\n")
 	for _, line := range lines {
 		line = strings.TrimSpace(line)
@@ -169,7 +185,7 @@ func (c *Config) parseEnv(data []byte) error {
 		// 移除引号
 		value = strings.Trim(value, "\"'")
 
-		c.data[key] = value
+		dst[key] = value
 	}
 	return nil
 }
@@ -0,0 +1,244 @@
+package config
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadFile_ResolveSecrets(t *testing.T) {
+	t.Setenv("TEST_DB_PASS", "s3cret")
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "api_key"), []byte("key-123\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	path := writeConfigFile(t, "config.json", `{
+		"db": {"password": "${env:TEST_DB_PASS}", "hosts": ["${env:TEST_DB_PASS}-host"]},
+		"dsn": "app:${env:TEST_DB_PASS}@tcp(db:3306)/app",
+		"api.key": "${file:api_key}",
+		"literal": "$${env:TEST_DB_PASS}",
+		"shell": "${HOME}",
+		"port": 3306
+	}`)
+
+	c := New()
+	c.RegisterSecretProvider("env", EnvProvider{})
+	c.RegisterSecretProvider("file", FileProvider{Dir: dir})
+	if err := c.LoadFile(path); err != nil {
+		t.Fatalf("LoadFile failed: %v", err)
+	}
+
+	var db struct {
+		Password string   `json:"password"`
+		Hosts    []string `json:"hosts"`
+	}
+	if err := c.UnmarshalKey("db", &db); err != nil {
+		t.Fatal(err)
+	}
+	if db.Password != "s3cret" || len(db.Hosts) != 1 || db.Hosts[0] != "s3cret-host" {
+		t.Errorf("nested values not resolved: %+v", db)
+	}
+
+	tests := map[string]string{
+		"dsn":     "app:s3cret@tcp(db:3306)/app",
+		"api.key": "key-123",
+		"literal": "${env:TEST_DB_PASS}",
+		"shell":   "${HOME}",
+	}
+	for key, want := range tests {
+		if got := c.GetString(key); got != want {
+			t.Errorf("GetString(%q) = %q, want %q", key, got, want)
+		}
+	}
+	if c.GetInt("port") != 3306 {
+		t.Errorf("non-string values should be kept")
+	}
+}
+
+func TestLoadFile_SecretErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{"missing env", `{"a": "${env:TEST_SURELY_NOT_SET}"}`, ErrSecretNotFound},
+		{"unterminated", `{"a": "${env:X"}`, ErrInvalidSecretRef},
+		{"empty ref", `{"a": "${env:}"}`, ErrInvalidSecretRef},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New()
+			c.RegisterSecretProvider("env", EnvProvider{})
+			err := c.LoadFile(writeConfigFile(t, "config.json", tt.content))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if c.Has("a") {
+				t.Error("config should not be modified when resolution fails")
+			}
+		})
+	}
+}
+
+func TestLoadFile_NonSecretPlaceholders(t *testing.T) {
+	t.Setenv("TEST_DB_PASS", "s3cret")
+	path := writeConfigFile(t, "config.json", `{
+		"default": "${VAR:-fallback}",
+		"unknown": "${nope:x}",
+		"mixed": "${LOG_DIR:-/var/log}/${env:TEST_DB_PASS}",
+		"open": "price ${VAR:-1",
+		"bare": "${HOME"
+	}`)
+
+	c := New()
+	c.RegisterSecretProvider("env", EnvProvider{})
+	if err := c.LoadFile(path); err != nil {
+		t.Fatalf("LoadFile failed: %v", err)
+	}
+
+	// 只有已注册提供者的占位符被解析，其余原样保留
+	tests := map[string]string{
+		"default": "${VAR:-fallback}",
+		"unknown": "${nope:x}",
+		"mixed":   "${LOG_DIR:-/var/log}/s3cret",
+		"open":    "price ${VAR:-1",
+		"bare":    "${HOME",
+	}
+	for key, want := range tests {
+		if got := c.GetString(key); got != want {
+			t.Errorf("GetString(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestLoadFile_NoProviders(t *testing.T) {
+	c, err := Load(writeConfigFile(t, "config.yaml", "password: ${env:X}\n"))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if got := c.GetString("password"); got != "${env:X}" {
+		t.Errorf("placeholders should be kept without providers, got %q", got)
+	}
+}
+
+func TestResolveSecrets(t *testing.T) {
+	calls := 0
+	c := New()
+	c.RegisterSecretProvider("test", SecretProviderFunc(func(_ context.Context, ref string) (string, error) {
+		calls++
+		if ref == "bad" {
+			return "", errors.New("boom")
+		}
+		return strings.ToUpper(ref), nil
+	}))
+
+	c.Set("a", "${test:x}")
+	c.Set("b", "${test:x}-${test:y}")
+	if err := c.ResolveSecrets(context.Background()); err != nil {
+		t.Fatalf("ResolveSecrets failed: %v", err)
+	}
+	if c.GetString("a") != "X" || c.GetString("b") != "X-Y" {
+		t.Errorf("unexpected values: a=%q b=%q", c.GetString("a"), c.GetString("b"))
+	}
+	if calls != 2 {
+		t.Errorf("expected each placeholder to be resolved once, got %d calls", calls)
+	}
+
+	c.Set("c", "${test:bad}")
+	c.Set("d", map[string]any{"e": "${test:z}"})
+	if err := c.ResolveSecrets(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+	if c.GetString("c") != "${test:bad}" {
+		t.Errorf("config should not be modified on failure")
+	}
+	if d, _ := c.Get("d"); d.(map[string]any)["e"] != "${test:z}" {
+		t.Errorf("nested config should not be modified on failure")
+	}
+}
+
+func TestFileProvider_Escape(t *testing.T) {
+	p := FileProvider{Dir: t.TempDir()}
+	for _, ref := range []string{"../etc/passwd", "/etc/passwd", "a/../../x"} {
+		if _, err := p.Resolve(context.Background(), ref); !errors.Is(err, ErrInvalidSecretRef) {
+			t.Errorf("Resolve(%q) expected ErrInvalidSecretRef, got %v", ref, err)
+		}
+	}
+}
+
+func TestVaultProvider(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/db":
+			w.Write([]byte(`{"data": {"data": {"password": "v2-pass", "port": 5432}, "metadata": {"version": 1}}}`))
+		case "/v1/kv/db":
+			w.Write([]byte(`{"data": {"password": "v1-pass"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	p := NewVaultProvider(srv.URL+"/", "token")
+	ctx := context.Background()
+
+	tests := map[string]string{
+		"secret/data/db#password": "v2-pass",
+		"secret/data/db#port":     "5432",
+		"kv/db#password":          "v1-pass",
+	}
+	for ref, want := range tests {
+		if got, err := p.Resolve(ctx, ref); err != nil || got != want {
+			t.Errorf("Resolve(%q) = %q, %v; want %q", ref, got, err, want)
+		}
+	}
+
+	if _, err := p.Resolve(ctx, "secret/data/db#missing"); !errors.Is(err, ErrSecretNotFound) {
+		t.Errorf("expected ErrSecretNotFound for missing field, got %v", err)
+	}
+	if _, err := p.Resolve(ctx, "secret/data/other#password"); !errors.Is(err, ErrSecretNotFound) {
+		t.Errorf("expected ErrSecretNotFound for missing path, got %v", err)
+	}
+	if _, err := p.Resolve(ctx, "secret/data/db"); !errors.Is(err, ErrInvalidSecretRef) {
+		t.Errorf("expected ErrInvalidSecretRef without field, got %v", err)
+	}
+
+	p.Token = "wrong"
+	if _, err := p.Resolve(ctx, "secret/data/db#password"); err == nil {
+		t.Error("expected error for forbidden request")
+	}
+}
+
+func TestKMSProvider(t *testing.T) {
+	p := NewKMSProvider(func(_ context.Context, ct []byte) ([]byte, error) {
+		return []byte(strings.TrimPrefix(string(ct), "enc:")), nil
+	})
+	ref := base64.StdEncoding.EncodeToString([]byte("enc:plain"))
+	if got, err := p.Resolve(context.Background(), ref); err != nil || got != "plain" {
+		t.Errorf("Resolve = %q, %v", got, err)
+	}
+	if _, err := p.Resolve(context.Background(), "!!!"); !errors.Is(err, ErrInvalidSecretRef) {
+		t.Errorf("expected ErrInvalidSecretRef, got %v", err)
+	}
+}
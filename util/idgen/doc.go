@@ -1,11 +1,19 @@
 // Package idgen 提供 ID 生成工具
 //
-// 包括雪花算法 ID 生成器和 UUID 工具。
+// 包括雪花算法 ID 生成器、号段模式分配器和 UUID 工具。
 //
 // 雪花算法用法:
 //
 //	gen := idgen.NewSnowflake(1)  // 节点 ID
 //	id := gen.Generate()
+//	ids, err := gen.GenerateBatch(100)  // 批量生成，严格递增
+//
+// 号段模式（从 MySQL/Redis 批量申请 ID 区间，双 buffer 预取，严格递增）:
+//
+//	store := idgen.NewMySQLSegmentStore(db, "id_segment")
+//	alloc := idgen.NewSegmentAllocator(store, "order", idgen.WithSegmentStep(2000))
+//	id, err := alloc.Next(ctx)
+//	ids, err := alloc.NextBatch(ctx, 100)
 //
 // UUID 用法:
 //
@@ -16,12 +24,20 @@
 //
 // Package idgen provides ID generation utilities.
 //
-// Includes Snowflake ID generator and UUID utilities.
+// Includes Snowflake ID generator, segment allocator and UUID utilities.
 //
 // Snowflake usage:
 //
 //	gen := idgen.NewSnowflake(1)  // node ID
 //	id := gen.Generate()
+//	ids, err := gen.GenerateBatch(100)  // batch generation, strictly increasing
+//
+// Segment mode (reserves ID ranges from MySQL/Redis with double-buffer prefetch, strictly increasing):
+//
+//	store := idgen.NewMySQLSegmentStore(db, "id_segment")
+//	alloc := idgen.NewSegmentAllocator(store, "order", idgen.WithSegmentStep(2000))
+//	id, err := alloc.Next(ctx)
+//	ids, err := alloc.NextBatch(ctx, 100)
 //
 // UUID usage:
 //
@@ -165,6 +165,31 @@ func TestSnowflake_Concurrent(t *testing.T) {
 	}
 }
 
+func TestSnowflake_GenerateBatch(t *testing.T) {
+	gen, _ := NewSnowflake(1)
+
+	ids, err := gen.GenerateBatch(10000)
+	if err != nil {
+		t.Fatalf("GenerateBatch failed: %v", err)
+	}
+	if len(ids) != 10000 {
+		t.Fatalf("expected 10000 IDs, got %d", len(ids))
+	}
+	for i := 1; i < len(ids); i++ {
+		if ids[i] <= ids[i-1] {
+			t.Fatalf("IDs not strictly increasing at %d: %d <= %d", i, ids[i], ids[i-1])
+		}
+	}
+
+	if next := gen.Generate(); next <= ids[len(ids)-1] {
+		t.Errorf("ID after batch should be greater: %d <= %d", next, ids[len(ids)-1])
+	}
+
+	if ids, err := gen.GenerateBatch(0); ids != nil || err != nil {
+		t.Errorf("expected nil, nil for n=0, got %v, %v", ids, err)
+	}
+}
+
 func TestInitSnowflake(t *testing.T) {
 	err := InitSnowflake(5)
 	if err != nil {
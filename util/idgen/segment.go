@@ -0,0 +1,344 @@
+package idgen
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	// ErrBizTagNotFound 号段存储中不存在该业务标识
+	ErrBizTagNotFound = errors.New("idgen: biz tag not found")
+
+	// ErrInvalidSegment 号段存储返回了非法的号段（如 max 未增长）
+	ErrInvalidSegment = errors.New("idgen: invalid segment")
+)
+
+// SegmentStore 号段存储
+//
+// 每次调用将 bizTag 对应的 max_id 原子地增加 step，并返回增加后的值；
+// 调用方获得号段 (maxID-step, maxID]。实现必须保证并发调用和多实例之间
+// 返回的号段互不重叠且单调递增。
+type SegmentStore interface {
+	// NextMaxID 原子地将 bizTag 的 max_id 增加 step，返回增加后的 max_id
+	NextMaxID(ctx context.Context, bizTag string, step int64) (int64, error)
+}
+
+// SegmentOption 号段分配器选项
+type SegmentOption func(*segmentOptions)
+
+type segmentOptions struct {
+	step             int64
+	prefetchRatio    float64
+	loadTimeout      time.Duration
+	onPrefetchFailed func(err error)
+}
+
+// WithSegmentStep 设置每次从存储申请的号段长度，默认 1000
+//
+// 步长越大，访问存储的次数越少，但进程重启时浪费的 ID 越多
+func WithSegmentStep(step int64) SegmentOption {
+	return func(o *segmentOptions) {
+		if step > 0 {
+			o.step = step
+		}
+	}
+}
+
+// WithPrefetchRatio 设置预取阈值，默认 0.5
+//
+// 当前号段剩余 ID 数低于 ratio*step 时，后台异步申请下一个号段（双 buffer），
+// 使当前号段用完时可以无等待切换。ratio 取值 (0, 1]，越大预取越早，
+// 存储短暂不可用时可支撑的时间越长。
+func WithPrefetchRatio(ratio float64) SegmentOption {
+	return func(o *segmentOptions) {
+		if ratio > 0 && ratio <= 1 {
+			o.prefetchRatio = ratio
+		}
+	}
+}
+
+// WithSegmentLoadTimeout 设置后台申请号段的超时时间，默认 3s
+func WithSegmentLoadTimeout(timeout time.Duration) SegmentOption {
+	return func(o *segmentOptions) {
+		if timeout > 0 {
+			o.loadTimeout = timeout
+		}
+	}
+}
+
+// WithOnPrefetchFailed 设置后台预取失败时的回调，可用于日志和告警
+//
+// 预取失败不影响当前号段的使用，当前号段用完时会重新同步申请
+func WithOnPrefetchFailed(fn func(err error)) SegmentOption {
+	return func(o *segmentOptions) {
+		o.onPrefetchFailed = fn
+	}
+}
+
+// segment 号段 [cursor, end]
+type segment struct {
+	cursor int64
+	end    int64
+}
+
+// remaining 号段剩余 ID 数
+func (s *segment) remaining() int64 {
+	return s.end - s.cursor + 1
+}
+
+// SegmentAllocator 号段模式 ID 分配器
+//
+// 从 SegmentStore（MySQL/Redis 等）批量申请 ID 区间并在内存中分配，
+// 生成的 ID 严格递增，持久化由存储保证：进程重启后从存储申请新号段，
+// 不会产生重复 ID（未用完的号段会被跳过）。
+//
+// 采用双 buffer 预取：当前号段消耗到阈值时后台申请下一个号段，
+// 号段切换时不阻塞，存储短暂抖动也不影响分配。
+//
+// 并发安全。
+type SegmentAllocator struct {
+	store  SegmentStore
+	bizTag string
+	opts   segmentOptions
+
+	mu       sync.Mutex
+	cur      *segment
+	next     *segment
+	loading  bool
+	loadDone chan struct{}
+	loadErr  error
+}
+
+// NewSegmentAllocator 创建号段分配器
+//
+// 参数:
+//   - store: 号段存储，见 NewMySQLSegmentStore 和 NewRedisSegmentStore
+//   - bizTag: 业务标识，不同业务使用独立的 ID 序列
+//   - opts: 可选配置
+//
+// 示例:
+//
+//	store := idgen.NewMySQLSegmentStore(db, "id_segment")
+//	alloc := idgen.NewSegmentAllocator(store, "order", idgen.WithSegmentStep(2000))
+//	id, err := alloc.Next(ctx)
+func NewSegmentAllocator(store SegmentStore, bizTag string, opts ...SegmentOption) *SegmentAllocator {
+	o := segmentOptions{
+		step:          1000,
+		prefetchRatio: 0.5,
+		loadTimeout:   3 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &SegmentAllocator{
+		store:  store,
+		bizTag: bizTag,
+		opts:   o,
+	}
+}
+
+// Next 分配下一个 ID
+//
+// 当前号段可用时直接返回；号段用完且下一号段尚未就绪时，等待存储返回新号段，
+// 此时受 ctx 控制。存储失败时返回其错误，后续调用会重新申请。
+func (a *SegmentAllocator) Next(ctx context.Context) (int64, error) {
+	a.mu.Lock()
+	for {
+		if a.cur != nil && a.cur.remaining() > 0 {
+			id := a.cur.cursor
+			a.cur.cursor++
+			if a.next == nil && !a.loading &&
+				float64(a.cur.remaining()) < a.opts.prefetchRatio*float64(a.opts.step) {
+				a.startLoad()
+			}
+			a.mu.Unlock()
+			return id, nil
+		}
+
+		if a.next != nil {
+			a.cur, a.next = a.next, nil
+			continue
+		}
+
+		if !a.loading {
+			a.startLoad()
+		}
+		done := a.loadDone
+		a.mu.Unlock()
+
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+
+		a.mu.Lock()
+		if a.next == nil && a.loadErr != nil && !a.loading {
+			err := a.loadErr
+			a.loadErr = nil
+			a.mu.Unlock()
+			return 0, err
+		}
+	}
+}
+
+// NextBatch 分配 n 个严格递增的 ID
+//
+// 可能跨越多个号段，中途失败时返回错误，已分配的 ID 被丢弃（不会被重复分配）
+func (a *SegmentAllocator) NextBatch(ctx context.Context, n int) ([]int64, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	ids := make([]int64, n)
+	for i := range ids {
+		id, err := a.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// startLoad 后台申请下一个号段，调用方必须持有 a.mu
+func (a *SegmentAllocator) startLoad() {
+	a.loading = true
+	a.loadErr = nil
+	a.loadDone = make(chan struct{})
+	prefetch := a.cur != nil && a.cur.remaining() > 0
+
+	go func(done chan struct{}) {
+		ctx, cancel := context.WithTimeout(context.Background(), a.opts.loadTimeout)
+		seg, err := a.load(ctx)
+		cancel()
+
+		a.mu.Lock()
+		a.loading = false
+		if err != nil {
+			a.loadErr = err
+		} else {
+			a.next = seg
+		}
+		close(done)
+		a.mu.Unlock()
+
+		if err != nil && prefetch && a.opts.onPrefetchFailed != nil {
+			a.opts.onPrefetchFailed(err)
+		}
+	}(a.loadDone)
+}
+
+// load 从存储申请一个号段
+func (a *SegmentAllocator) load(ctx context.Context) (*segment, error) {
+	step := a.opts.step
+	maxID, err := a.store.NextMaxID(ctx, a.bizTag, step)
+	if err != nil {
+		return nil, err
+	}
+	if maxID < step {
+		return nil, fmt.Errorf("%w: max id %d is less than step %d", ErrInvalidSegment, maxID, step)
+	}
+	seg := &segment{cursor: maxID - step + 1, end: maxID}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	// 存储必须单调递增，否则会分配出重复或倒退的 ID
+	if last := a.lastEnd(); seg.cursor <= last {
+		return nil, fmt.Errorf("%w: segment starts at %d, not after %d", ErrInvalidSegment, seg.cursor, last)
+	}
+	return seg, nil
+}
+
+// lastEnd 已持有号段的最大 ID，调用方必须持有 a.mu
+func (a *SegmentAllocator) lastEnd() int64 {
+	switch {
+	case a.next != nil:
+		return a.next.end
+	case a.cur != nil:
+		return a.cur.end
+	default:
+		return 0
+	}
+}
+
+// MySQLSegmentStore 基于 MySQL 的号段存储
+//
+// 表结构:
+//
+//	CREATE TABLE id_segment (
+//	    biz_tag     VARCHAR(128) NOT NULL PRIMARY KEY,
+//	    max_id      BIGINT       NOT NULL DEFAULT 0,
+//	    update_time TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+//	);
+//	INSERT INTO id_segment (biz_tag, max_id) VALUES ('order', 0);
+//
+// 每个 biz_tag 需要预先插入一行，不存在时返回 ErrBizTagNotFound
+type MySQLSegmentStore struct {
+	db    *sql.DB
+	table string
+}
+
+// NewMySQLSegmentStore 创建 MySQL 号段存储
+//
+// table 为表名，直接拼接进 SQL，不能来自外部输入
+func NewMySQLSegmentStore(db *sql.DB, table string) *MySQLSegmentStore {
+	return &MySQLSegmentStore{db: db, table: table}
+}
+
+// NextMaxID 在事务中更新并读取 max_id，行锁保证多实例之间号段不重叠
+func (s *MySQLSegmentStore) NextMaxID(ctx context.Context, bizTag string, step int64) (maxID int64, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	res, err := tx.ExecContext(ctx,
+		"UPDATE `"+s.table+"` SET max_id = max_id + ? WHERE biz_tag = ?", step, bizTag)
+	if err != nil {
+		return 0, err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return 0, fmt.Errorf("%w: %s", ErrBizTagNotFound, bizTag)
+	}
+
+	if err = tx.QueryRowContext(ctx,
+		"SELECT max_id FROM `"+s.table+"` WHERE biz_tag = ?", bizTag).Scan(&maxID); err != nil {
+		return 0, err
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+	return maxID, nil
+}
+
+// RedisSegmentStore 基于 Redis INCRBY 的号段存储
+//
+// 持久性取决于 Redis 的持久化配置：RDB 快照可能在重启后回退 max_id，
+// 导致重复 ID，需要严格不重复时请开启 AOF（appendfsync always/everysec）或使用 MySQLSegmentStore
+type RedisSegmentStore struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisSegmentStore 创建 Redis 号段存储
+//
+// key 为 prefix + bizTag，不存在时从 0 开始
+func NewRedisSegmentStore(client redis.UniversalClient, prefix string) *RedisSegmentStore {
+	return &RedisSegmentStore{client: client, prefix: prefix}
+}
+
+// NextMaxID 对 key 执行 INCRBY step
+func (s *RedisSegmentStore) NextMaxID(ctx context.Context, bizTag string, step int64) (int64, error) {
+	return s.client.IncrBy(ctx, s.prefix+bizTag, step).Result()
+}
@@ -0,0 +1,221 @@
+package idgen
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+// memSegmentStore 内存号段存储
+type memSegmentStore struct {
+	mu    sync.Mutex
+	max   map[string]int64
+	calls atomic.Int64
+	err   error
+	delay time.Duration
+}
+
+func newMemSegmentStore() *memSegmentStore {
+	return &memSegmentStore{max: make(map[string]int64)}
+}
+
+func (s *memSegmentStore) NextMaxID(ctx context.Context, bizTag string, step int64) (int64, error) {
+	s.calls.Add(1)
+	if s.delay > 0 {
+		select {
+		case <-time.After(s.delay):
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return 0, s.err
+	}
+	s.max[bizTag] += step
+	return s.max[bizTag], nil
+}
+
+func TestSegmentAllocator_Next(t *testing.T) {
+	store := newMemSegmentStore()
+	alloc := NewSegmentAllocator(store, "order", WithSegmentStep(10))
+	ctx := context.Background()
+
+	for want := int64(1); want <= 35; want++ {
+		id, err := alloc.Next(ctx)
+		if err != nil {
+			t.Fatalf("Next failed: %v", err)
+		}
+		if id != want {
+			t.Fatalf("expected %d, got %d", want, id)
+		}
+	}
+}
+
+func TestSegmentAllocator_Concurrent(t *testing.T) {
+	store := newMemSegmentStore()
+	alloc := NewSegmentAllocator(store, "order", WithSegmentStep(50))
+	ctx := context.Background()
+
+	const goroutines, perGoroutine = 20, 500
+	var (
+		mu  sync.Mutex
+		ids = make(map[int64]bool)
+		wg  sync.WaitGroup
+	)
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			last := int64(0)
+			for range perGoroutine {
+				id, err := alloc.Next(ctx)
+				if err != nil {
+					t.Errorf("Next failed: %v", err)
+					return
+				}
+				if id <= last {
+					t.Errorf("IDs not increasing within goroutine: %d <= %d", id, last)
+				}
+				last = id
+				mu.Lock()
+				if ids[id] {
+					t.Errorf("duplicate ID: %d", id)
+				}
+				ids[id] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(ids) != goroutines*perGoroutine {
+		t.Errorf("expected %d unique IDs, got %d", goroutines*perGoroutine, len(ids))
+	}
+}
+
+func TestSegmentAllocator_Prefetch(t *testing.T) {
+	store := newMemSegmentStore()
+	alloc := NewSegmentAllocator(store, "order", WithSegmentStep(10), WithPrefetchRatio(0.5))
+	ctx := context.Background()
+
+	for range 6 {
+		if _, err := alloc.Next(ctx); err != nil {
+			t.Fatalf("Next failed: %v", err)
+		}
+	}
+	// 剩余 4 < 5，应已触发预取
+	deadline := time.Now().Add(time.Second)
+	for store.calls.Load() < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if got := store.calls.Load(); got != 2 {
+		t.Fatalf("expected prefetch to load a second segment, calls = %d", got)
+	}
+
+	// 预取后存储失败，不影响已预取号段的使用
+	store.mu.Lock()
+	store.err = errors.New("store down")
+	store.mu.Unlock()
+	ids, err := alloc.NextBatch(ctx, 14)
+	if err != nil {
+		t.Fatalf("NextBatch failed: %v", err)
+	}
+	if ids[0] != 7 || ids[13] != 20 {
+		t.Errorf("unexpected IDs: %v", ids)
+	}
+}
+
+func TestSegmentAllocator_StoreError(t *testing.T) {
+	store := newMemSegmentStore()
+	store.err = errors.New("store down")
+	var prefetchErrs atomic.Int64
+	alloc := NewSegmentAllocator(store, "order", WithSegmentStep(10),
+		WithOnPrefetchFailed(func(error) { prefetchErrs.Add(1) }))
+	ctx := context.Background()
+
+	if _, err := alloc.Next(ctx); err == nil || err.Error() != "store down" {
+		t.Fatalf("expected store error, got %v", err)
+	}
+	if prefetchErrs.Load() != 0 {
+		t.Errorf("synchronous load failure should not be reported as prefetch failure")
+	}
+
+	// 存储恢复后重新申请
+	store.mu.Lock()
+	store.err = nil
+	store.mu.Unlock()
+	if id, err := alloc.Next(ctx); err != nil || id != 1 {
+		t.Errorf("expected 1, nil after recovery, got %d, %v", id, err)
+	}
+}
+
+func TestSegmentAllocator_ContextCanceled(t *testing.T) {
+	store := newMemSegmentStore()
+	store.delay = time.Second
+	alloc := NewSegmentAllocator(store, "order")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := alloc.Next(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestSegmentAllocator_InvalidSegment(t *testing.T) {
+	store := newMemSegmentStore()
+	alloc := NewSegmentAllocator(store, "order", WithSegmentStep(10))
+	ctx := context.Background()
+
+	if _, err := alloc.NextBatch(ctx, 10); err != nil {
+		t.Fatalf("NextBatch failed: %v", err)
+	}
+	// 存储回退（如 Redis 丢失数据），不能分配重复 ID
+	store.mu.Lock()
+	store.max["order"] = 0
+	store.mu.Unlock()
+	if _, err := alloc.Next(ctx); !errors.Is(err, ErrInvalidSegment) {
+		t.Errorf("expected ErrInvalidSegment, got %v", err)
+	}
+}
+
+func TestRedisSegmentStore(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("Failed to start miniredis: %v", err)
+	}
+	defer mr.Close()
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+
+	store := NewRedisSegmentStore(client, "idgen:")
+	ctx := context.Background()
+
+	a := NewSegmentAllocator(store, "order", WithSegmentStep(5))
+	b := NewSegmentAllocator(store, "order", WithSegmentStep(5))
+	ids := make(map[int64]bool)
+	for range 20 {
+		for _, alloc := range []*SegmentAllocator{a, b} {
+			id, err := alloc.Next(ctx)
+			if err != nil {
+				t.Fatalf("Next failed: %v", err)
+			}
+			if ids[id] {
+				t.Fatalf("duplicate ID across allocators: %d", id)
+			}
+			ids[id] = true
+		}
+	}
+
+	v, err := mr.Get("idgen:order")
+	if err != nil || v == "" {
+		t.Errorf("expected counter key to exist, got %q, %v", v, err)
+	}
+}
@@ -104,7 +104,40 @@ func (s *Snowflake) Generate() int64 {
 func (s *Snowflake) GenerateSafe() (int64, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	return s.nextID()
+}
+
+// GenerateBatch 一次生成 n 个 Snowflake ID
+//
+// 整批 ID 在一次加锁内生成，严格递增，比循环调用 GenerateSafe 开销更小；
+// 单毫秒内序列号用尽时会等待下一毫秒。n <= 0 时返回 nil。
+//
+// 检测到时钟回拨且超过最大等待时间时返回 ErrClockSkew，已生成的部分不会返回。
+//
+// 示例:
+//
+//	ids, err := gen.GenerateBatch(100)
+func (s *Snowflake) GenerateBatch(n int) ([]int64, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]int64, n)
+	for i := range ids {
+		id, err := s.nextID()
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
 
+// nextID 生成下一个 ID，调用方必须持有 s.mu
+func (s *Snowflake) nextID() (int64, error) {
 	timestamp := s.currentTimestamp()
 
 	if timestamp < s.lastTimestamp {
@@ -0,0 +1,522 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ErrAuditChainBroken 审计日志哈希链校验失败（记录被篡改、删除或乱序）
+var ErrAuditChainBroken = errors.New("logger: audit chain broken")
+
+// AuditEntry 一次审计事件
+type AuditEntry struct {
+	// Actor 操作人，如用户 ID 或 "system"
+	Actor string
+	// Action 操作，如 "user.delete"
+	Action string
+	// Resource 操作对象，如 "user:123"
+	Resource string
+	// Result 操作结果，如 "success"、"denied"
+	Result string
+	// Details 附加信息
+	Details map[string]any
+}
+
+// AuditRecord 审计记录
+//
+// Hash = SHA256(PrevHash + 不含 Hash 字段的记录 JSON)，配置了密钥时为 HMAC-SHA256。
+// 每条记录包含上一条的哈希，修改、删除或插入任意一条都会导致后续校验失败。
+type AuditRecord struct {
+	Seq      uint64         `json:"seq"`
+	Time     time.Time      `json:"time"`
+	Actor    string         `json:"actor"`
+	Action   string         `json:"action"`
+	Resource string         `json:"resource,omitempty"`
+	Result   string         `json:"result,omitempty"`
+	Details  map[string]any `json:"details,omitempty"`
+	PrevHash string         `json:"prev_hash"`
+	Hash     string         `json:"hash,omitempty"`
+}
+
+// computeHash 计算记录的链式哈希
+func (r *AuditRecord) computeHash(key []byte) (string, error) {
+	unsigned := *r
+	unsigned.Hash = ""
+	payload, err := json.Marshal(&unsigned)
+	if err != nil {
+		return "", err
+	}
+
+	var h hash.Hash
+	if len(key) > 0 {
+		h = hmac.New(sha256.New, key)
+	} else {
+		h = sha256.New()
+	}
+	h.Write([]byte(r.PrevHash))
+	h.Write(payload)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// AuditSink 审计记录的存储
+//
+// 写入成功后记录即被视为已提交，哈希链随之推进
+type AuditSink interface {
+	Write(ctx context.Context, rec *AuditRecord) error
+}
+
+// AuditTailer 可读取最后一条记录的存储
+//
+// AuditLogger 创建时从实现了该接口的存储恢复序号和哈希，进程重启后哈希链不会断开
+type AuditTailer interface {
+	// Last 返回最后一条记录，存储为空时返回 nil, nil
+	Last(ctx context.Context) (*AuditRecord, error)
+}
+
+// AuditOption 审计日志选项
+type AuditOption func(*AuditLogger)
+
+// WithAuditKey 设置 HMAC 密钥
+//
+// 未设置时使用 SHA256，能发现误改和部分删除，但能写入存储的人可以重算整条链；
+// 设置密钥后，没有密钥无法伪造出能通过校验的记录
+func WithAuditKey(key []byte) AuditOption {
+	return func(l *AuditLogger) {
+		l.key = key
+	}
+}
+
+// WithAuditExporter 添加导出目标（如 ClickHouse），记录提交到主存储后再写入
+//
+// 导出失败不影响哈希链和 Log 的返回值，通过 WithAuditExportErrorHandler 处理
+func WithAuditExporter(sink AuditSink) AuditOption {
+	return func(l *AuditLogger) {
+		l.exporters = append(l.exporters, sink)
+	}
+}
+
+// WithAuditExportErrorHandler 设置导出失败的回调
+func WithAuditExportErrorHandler(fn func(rec *AuditRecord, err error)) AuditOption {
+	return func(l *AuditLogger) {
+		l.onExportError = fn
+	}
+}
+
+// AuditLogger 审计日志记录器
+//
+// 与普通日志分开，记录只追加不修改，每条记录通过哈希链与上一条关联，
+// 可用 VerifyAuditChain 校验是否被篡改。并发安全，记录按调用顺序串行写入。
+type AuditLogger struct {
+	mu            sync.Mutex
+	sink          AuditSink
+	exporters     []AuditSink
+	onExportError func(rec *AuditRecord, err error)
+	key           []byte
+	seq           uint64
+	prevHash      string
+	now           func() time.Time
+}
+
+// NewAuditLogger 创建审计日志记录器
+//
+// sink 为主存储，决定哈希链的推进；实现了 AuditTailer 时从最后一条记录继续。
+//
+// 示例:
+//
+//	sink, err := logger.NewAuditFileSink("/var/log/app/audit.log")
+//	audit, err := logger.NewAuditLogger(sink,
+//	    logger.WithAuditKey(key),
+//	    logger.WithAuditExporter(logger.NewAuditSQLSink(chDB, "audit_log")),
+//	)
+//	defer audit.Close()
+//
+//	audit.Log(ctx, logger.AuditEntry{
+//	    Actor:    "admin:42",
+//	    Action:   "user.delete",
+//	    Resource: "user:123",
+//	    Result:   "success",
+//	})
+func NewAuditLogger(sink AuditSink, opts ...AuditOption) (*AuditLogger, error) {
+	l := &AuditLogger{
+		sink: sink,
+		now:  time.Now,
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	if tailer, ok := sink.(AuditTailer); ok {
+		last, err := tailer.Last(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("logger: load last audit record: %w", err)
+		}
+		if last != nil {
+			l.seq = last.Seq
+			l.prevHash = last.Hash
+		}
+	}
+	return l, nil
+}
+
+// Log 记录一条审计事件，返回已提交的记录
+//
+// Details 先经过一次 JSON 编解码再计算哈希，记录中保存的是解码后的值（结构体变为
+// map，数字为 json.Number），与从存储读回的记录一致，校验时才能得到相同的哈希。
+// 写入主存储失败时返回错误，哈希链不推进，可以安全重试
+func (l *AuditLogger) Log(ctx context.Context, e AuditEntry) (*AuditRecord, error) {
+	details, err := normalizeAuditDetails(e.Details)
+	if err != nil {
+		return nil, fmt.Errorf("logger: encode audit details: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	rec := &AuditRecord{
+		Seq:      l.seq + 1,
+		Time:     l.now().UTC().Truncate(time.Microsecond), // 数据库时间列（如 MySQL DATETIME(6)）最多保存到微秒
+		Actor:    e.Actor,
+		Action:   e.Action,
+		Resource: e.Resource,
+		Result:   e.Result,
+		Details:  details,
+		PrevHash: l.prevHash,
+	}
+	h, err := rec.computeHash(l.key)
+	if err != nil {
+		return nil, fmt.Errorf("logger: hash audit record: %w", err)
+	}
+	rec.Hash = h
+
+	if err := l.sink.Write(ctx, rec); err != nil {
+		return nil, err
+	}
+	l.seq = rec.Seq
+	l.prevHash = rec.Hash
+
+	for _, exp := range l.exporters {
+		if err := exp.Write(ctx, rec); err != nil && l.onExportError != nil {
+			l.onExportError(rec, err)
+		}
+	}
+	return rec, nil
+}
+
+// Close 关闭主存储和导出目标中实现了 io.Closer 的部分
+func (l *AuditLogger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var errs []error
+	for _, s := range append([]AuditSink{l.sink}, l.exporters...) {
+		if c, ok := s.(io.Closer); ok {
+			errs = append(errs, c.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// VerifyAuditChain 校验 JSON Lines 格式的审计日志（如 AuditFileSink 写入的文件）
+//
+// 检查序号连续、prev_hash 与上一条的 hash 一致、每条记录的 hash 正确。
+// 第一条记录作为起点，不校验其 prev_hash，因此可以校验轮转后的片段。
+// key 为 WithAuditKey 使用的密钥，未使用时传 nil。
+//
+// 返回:
+//   - error: 校验失败时返回包装了 ErrAuditChainBroken 的错误，包含出错的行号和序号
+func VerifyAuditChain(r io.Reader, key []byte) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	var prev *AuditRecord
+	line := 0
+	for sc.Scan() {
+		line++
+		data := bytes.TrimSpace(sc.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		rec, err := decodeAuditRecord(data)
+		if err != nil {
+			return fmt.Errorf("%w: line %d: %v", ErrAuditChainBroken, line, err)
+		}
+		if err := verifyAuditRecord(prev, rec, key); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		prev = rec
+	}
+	return sc.Err()
+}
+
+// VerifyAuditRecords 校验按序号排列的审计记录，规则同 VerifyAuditChain
+//
+// 适用于从数据库读出的记录
+func VerifyAuditRecords(records []*AuditRecord, key []byte) error {
+	var prev *AuditRecord
+	for _, rec := range records {
+		if err := verifyAuditRecord(prev, rec, key); err != nil {
+			return err
+		}
+		prev = rec
+	}
+	return nil
+}
+
+// verifyAuditRecord 校验单条记录及其与上一条的链接
+func verifyAuditRecord(prev, rec *AuditRecord, key []byte) error {
+	if prev != nil {
+		if rec.Seq != prev.Seq+1 {
+			return fmt.Errorf("%w: seq %d follows %d", ErrAuditChainBroken, rec.Seq, prev.Seq)
+		}
+		if rec.PrevHash != prev.Hash {
+			return fmt.Errorf("%w: seq %d prev_hash does not match previous record", ErrAuditChainBroken, rec.Seq)
+		}
+	}
+	want, err := rec.computeHash(key)
+	if err != nil {
+		return fmt.Errorf("%w: seq %d: %v", ErrAuditChainBroken, rec.Seq, err)
+	}
+	if !hmac.Equal([]byte(want), []byte(rec.Hash)) {
+		return fmt.Errorf("%w: seq %d hash mismatch", ErrAuditChainBroken, rec.Seq)
+	}
+	return nil
+}
+
+// normalizeAuditDetails 将 details 转换为从 JSON 读回时的形式
+//
+// 结构体字段按定义顺序编码，而 map 按 key 排序编码，不先转换的话
+// 读回的记录重新编码后与写入时不同，哈希无法通过校验
+func normalizeAuditDetails(details map[string]any) (map[string]any, error) {
+	if details == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(details)
+	if err != nil {
+		return nil, err
+	}
+	return decodeAuditDetails(data)
+}
+
+// decodeAuditDetails 解析 details JSON，数字保留原始文本
+func decodeAuditDetails(data []byte) (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var details map[string]any
+	if err := dec.Decode(&details); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// decodeAuditRecord 解析一条记录，数字保留原始文本以保证重新计算的哈希一致
+func decodeAuditRecord(data []byte) (*AuditRecord, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var rec AuditRecord
+	if err := dec.Decode(&rec); err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+// AuditFileSink 以 JSON Lines 格式追加写入文件的审计存储
+//
+// 每条记录写入后立即 fsync；实现了 AuditTailer，重启后从文件最后一条记录继续
+type AuditFileSink struct {
+	file *os.File
+}
+
+// NewAuditFileSink 打开（或创建）审计日志文件
+func NewAuditFileSink(path string) (*AuditFileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &AuditFileSink{file: file}, nil
+}
+
+// Write 追加一条记录
+func (s *AuditFileSink) Write(_ context.Context, rec *AuditRecord) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	if _, err := s.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+// Last 读取文件最后一条记录
+func (s *AuditFileSink) Last(_ context.Context) (*AuditRecord, error) {
+	info, err := s.file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	// 从文件末尾向前按块读取，直到找到最后一行的起点
+	const chunk = 4096
+	end := info.Size()
+	var tail []byte
+	for pos := end; pos > 0; {
+		n := int64(chunk)
+		if pos < n {
+			n = pos
+		}
+		pos -= n
+		buf := make([]byte, n)
+		if _, err := s.file.ReadAt(buf, pos); err != nil {
+			return nil, err
+		}
+		tail = append(buf, tail...)
+
+		trimmed := bytes.TrimRight(tail, "\r\n ")
+		if i := bytes.LastIndexByte(trimmed, '\n'); i >= 0 {
+			return decodeAuditRecord(trimmed[i+1:])
+		}
+		if pos == 0 && len(trimmed) > 0 {
+			return decodeAuditRecord(trimmed)
+		}
+	}
+	return nil, nil
+}
+
+// Close 关闭文件
+func (s *AuditFileSink) Close() error {
+	return s.file.Close()
+}
+
+// AuditSQLSink 写入数据库表的审计存储，适用于 ClickHouse（clickhouse-go 的 database/sql 驱动）、MySQL 等
+//
+// 记录时间在哈希前已截断到微秒，time 列至少需要微秒精度，否则读回的记录无法通过校验。
+//
+// ClickHouse 建表示例:
+//
+//	CREATE TABLE audit_log (
+//	    seq       UInt64,
+//	    time      DateTime64(9, 'UTC'),
+//	    actor     String,
+//	    action    String,
+//	    resource  String,
+//	    result    String,
+//	    details   String,
+//	    prev_hash String,
+//	    hash      String
+//	) ENGINE = MergeTree ORDER BY seq;
+//
+// MySQL 建表示例（time 必须为 DATETIME(6)，DSN 需包含 parseTime=true；
+// ScanAuditRecord 会将读出的时间转为 UTC，loc 设置不影响校验）:
+//
+//	CREATE TABLE audit_log (
+//	    seq       BIGINT UNSIGNED NOT NULL PRIMARY KEY,
+//	    time      DATETIME(6)     NOT NULL,
+//	    actor     VARCHAR(255)    NOT NULL,
+//	    action    VARCHAR(255)    NOT NULL,
+//	    resource  VARCHAR(255)    NOT NULL,
+//	    result    VARCHAR(64)     NOT NULL,
+//	    details   TEXT            NOT NULL,
+//	    prev_hash CHAR(64)        NOT NULL,
+//	    hash      CHAR(64)        NOT NULL
+//	);
+//
+// details 以 JSON 字符串存储。通常作为 WithAuditExporter 的导出目标，
+// 也可以作为主存储（实现了 AuditTailer）。
+type AuditSQLSink struct {
+	db    *sql.DB
+	table string
+}
+
+// NewAuditSQLSink 创建数据库审计存储
+//
+// table 为表名，直接拼接进 SQL，不能来自外部输入
+func NewAuditSQLSink(db *sql.DB, table string) *AuditSQLSink {
+	return &AuditSQLSink{db: db, table: table}
+}
+
+// Write 插入一条记录
+func (s *AuditSQLSink) Write(ctx context.Context, rec *AuditRecord) error {
+	details, err := marshalAuditDetails(rec.Details)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx,
+		"INSERT INTO "+s.table+" ("+AuditSQLColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		rec.Seq, rec.Time, rec.Actor, rec.Action, rec.Resource, rec.Result, details, rec.PrevHash, rec.Hash)
+	return err
+}
+
+// Last 读取序号最大的记录
+func (s *AuditSQLSink) Last(ctx context.Context) (*AuditRecord, error) {
+	rec, err := ScanAuditRecord(s.db.QueryRowContext(ctx,
+		"SELECT "+AuditSQLColumns+" FROM "+s.table+" ORDER BY seq DESC LIMIT 1"))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return rec, err
+}
+
+// AuditSQLColumns AuditSQLSink 表的列，顺序与 ScanAuditRecord 一致
+const AuditSQLColumns = "seq, time, actor, action, resource, result, details, prev_hash, hash"
+
+// ScanAuditRecord 从查询结果（*sql.Row 或 *sql.Rows）读取一条 AuditSQLSink 写入的记录
+//
+// 查询需按 AuditSQLColumns 的顺序选择列，读出的记录可直接交给 VerifyAuditRecords
+//
+// 示例:
+//
+//	rows, err := db.QueryContext(ctx, "SELECT "+logger.AuditSQLColumns+" FROM audit_log ORDER BY seq")
+//	var records []*logger.AuditRecord
+//	for rows.Next() {
+//	    rec, err := logger.ScanAuditRecord(rows)
+//	    ...
+//	    records = append(records, rec)
+//	}
+//	err = logger.VerifyAuditRecords(records, key)
+func ScanAuditRecord(row interface{ Scan(dest ...any) error }) (*AuditRecord, error) {
+	var (
+		rec     AuditRecord
+		details string
+	)
+	if err := row.Scan(&rec.Seq, &rec.Time, &rec.Actor, &rec.Action, &rec.Resource, &rec.Result, &details, &rec.PrevHash, &rec.Hash); err != nil {
+		return nil, err
+	}
+	// 驱动可能按连接时区返回时间，哈希计算使用的是 UTC
+	rec.Time = rec.Time.UTC()
+	if details != "" {
+		var err error
+		if rec.Details, err = decodeAuditDetails([]byte(details)); err != nil {
+			return nil, err
+		}
+	}
+	return &rec, nil
+}
+
+// marshalAuditDetails details 转 JSON 字符串，nil 时为空字符串
+func marshalAuditDetails(details map[string]any) (string, error) {
+	if details == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(details)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,384 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memAuditSink 内存审计存储
+type memAuditSink struct {
+	records []*AuditRecord
+	err     error
+}
+
+func (s *memAuditSink) Write(_ context.Context, rec *AuditRecord) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.records = append(s.records, rec)
+	return nil
+}
+
+func TestAuditLogger_Chain(t *testing.T) {
+	sink := &memAuditSink{}
+	audit, err := NewAuditLogger(sink)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := audit.Log(ctx, AuditEntry{Actor: "admin", Action: "user.delete", Details: map[string]any{"n": i}}); err != nil {
+			t.Fatalf("Log failed: %v", err)
+		}
+	}
+
+	recs := sink.records
+	if recs[0].Seq != 1 || recs[0].PrevHash != "" {
+		t.Errorf("unexpected first record: %+v", recs[0])
+	}
+	for i := 1; i < len(recs); i++ {
+		if recs[i].Seq != recs[i-1].Seq+1 || recs[i].PrevHash != recs[i-1].Hash {
+			t.Errorf("record %d not chained to previous", i)
+		}
+	}
+	if err := VerifyAuditRecords(recs, nil); err != nil {
+		t.Errorf("VerifyAuditRecords failed: %v", err)
+	}
+
+	recs[1].Actor = "someone else"
+	if err := VerifyAuditRecords(recs, nil); !errors.Is(err, ErrAuditChainBroken) {
+		t.Errorf("expected ErrAuditChainBroken after tampering, got %v", err)
+	}
+}
+
+func TestAuditLogger_WriteFailure(t *testing.T) {
+	sink := &memAuditSink{}
+	audit, _ := NewAuditLogger(sink)
+	ctx := context.Background()
+
+	audit.Log(ctx, AuditEntry{Actor: "a", Action: "x"})
+	sink.err = errors.New("disk full")
+	if _, err := audit.Log(ctx, AuditEntry{Actor: "a", Action: "y"}); err == nil {
+		t.Fatal("expected write error")
+	}
+	sink.err = nil
+	rec, err := audit.Log(ctx, AuditEntry{Actor: "a", Action: "z"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Seq != 2 {
+		t.Errorf("failed write should not advance the chain, got seq %d", rec.Seq)
+	}
+	if err := VerifyAuditRecords(sink.records, nil); err != nil {
+		t.Errorf("chain should stay valid after a failed write: %v", err)
+	}
+}
+
+func TestAuditLogger_Exporter(t *testing.T) {
+	primary := &memAuditSink{}
+	exporter := &memAuditSink{err: errors.New("clickhouse down")}
+	var exportErrs int
+	audit, _ := NewAuditLogger(primary,
+		WithAuditExporter(exporter),
+		WithAuditExportErrorHandler(func(*AuditRecord, error) { exportErrs++ }))
+
+	if _, err := audit.Log(context.Background(), AuditEntry{Actor: "a", Action: "x"}); err != nil {
+		t.Fatalf("export failure should not fail Log: %v", err)
+	}
+	if len(primary.records) != 1 || exportErrs != 1 {
+		t.Errorf("expected 1 primary record and 1 export error, got %d, %d", len(primary.records), exportErrs)
+	}
+
+	exporter.err = nil
+	audit.Log(context.Background(), AuditEntry{Actor: "a", Action: "y"})
+	if len(exporter.records) != 1 || exporter.records[0].Seq != 2 {
+		t.Errorf("exporter should receive committed records")
+	}
+}
+
+func TestAuditFileSink_ResumeAndVerify(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	key := []byte("secret-key")
+	ctx := context.Background()
+
+	sink, err := NewAuditFileSink(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	audit, _ := NewAuditLogger(sink, WithAuditKey(key))
+	audit.Log(ctx, AuditEntry{Actor: "admin", Action: "login", Result: "success"})
+	audit.Log(ctx, AuditEntry{Actor: "admin", Action: "config.update",
+		Details: map[string]any{"key": "rate", "old": 1.5, "new": int64(9007199254740993)}})
+	if err := audit.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// 重启后从文件继续哈希链
+	sink, err = NewAuditFileSink(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	audit, err = NewAuditLogger(sink, WithAuditKey(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec, _ := audit.Log(ctx, AuditEntry{Actor: "admin", Action: "logout"})
+	audit.Close()
+	if rec.Seq != 3 {
+		t.Errorf("expected seq 3 after resume, got %d", rec.Seq)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyAuditChain(bytes.NewReader(data), key); err != nil {
+		t.Errorf("VerifyAuditChain failed: %v", err)
+	}
+	if err := VerifyAuditChain(bytes.NewReader(data), []byte("wrong")); !errors.Is(err, ErrAuditChainBroken) {
+		t.Errorf("expected failure with wrong key, got %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+
+	// 删除中间一条
+	deleted := lines[0] + "\n" + lines[2] + "\n"
+	if err := VerifyAuditChain(strings.NewReader(deleted), key); !errors.Is(err, ErrAuditChainBroken) {
+		t.Errorf("expected failure after deleting a record, got %v", err)
+	}
+
+	// 修改字段
+	modified := strings.Replace(string(data), `"action":"login"`, `"action":"logon"`, 1)
+	if err := VerifyAuditChain(strings.NewReader(modified), key); !errors.Is(err, ErrAuditChainBroken) {
+		t.Errorf("expected failure after modifying a record, got %v", err)
+	}
+
+	// 从中间开始的片段（如轮转后）仍可校验
+	if err := VerifyAuditChain(strings.NewReader(lines[1]+"\n"+lines[2]), key); err != nil {
+		t.Errorf("segment should verify: %v", err)
+	}
+}
+
+func TestAuditFileSink_LastEmpty(t *testing.T) {
+	sink, err := NewAuditFileSink(filepath.Join(t.TempDir(), "audit.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+
+	last, err := sink.Last(context.Background())
+	if err != nil || last != nil {
+		t.Errorf("expected nil, nil for empty file, got %v, %v", last, err)
+	}
+}
+
+// auditUser 结构体类型的 details 值，字段顺序与 JSON key 排序不同
+type auditUser struct {
+	Name string
+	Age  int
+}
+
+func TestAuditLogger_StructDetails(t *testing.T) {
+	key := []byte("secret-key")
+	ctx := context.Background()
+	entries := []AuditEntry{
+		{Actor: "admin", Action: "user.create", Details: map[string]any{"user": auditUser{Name: "x", Age: 3}}},
+		{Actor: "admin", Action: "user.update", Details: map[string]any{
+			"before": &auditUser{Name: "x", Age: 3},
+			"after":  map[string]any{"Name": "y", "Tags": []auditUser{{Name: "z", Age: 1}}},
+		}},
+	}
+
+	t.Run("file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.log")
+		sink, err := NewAuditFileSink(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		audit, _ := NewAuditLogger(sink, WithAuditKey(key))
+		for _, e := range entries {
+			if _, err := audit.Log(ctx, e); err != nil {
+				t.Fatalf("Log failed: %v", err)
+			}
+		}
+		audit.Close()
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := VerifyAuditChain(bytes.NewReader(data), key); err != nil {
+			t.Errorf("VerifyAuditChain failed: %v", err)
+		}
+	})
+
+	t.Run("sql", func(t *testing.T) {
+		db := sql.OpenDB(&memAuditConnector{})
+		defer db.Close()
+		sink := NewAuditSQLSink(db, "audit_log")
+		audit, _ := NewAuditLogger(sink, WithAuditKey(key))
+		for _, e := range entries {
+			if _, err := audit.Log(ctx, e); err != nil {
+				t.Fatalf("Log failed: %v", err)
+			}
+		}
+
+		rows, err := db.QueryContext(ctx, "SELECT "+AuditSQLColumns+" FROM audit_log ORDER BY seq")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		var records []*AuditRecord
+		for rows.Next() {
+			rec, err := ScanAuditRecord(rows)
+			if err != nil {
+				t.Fatal(err)
+			}
+			records = append(records, rec)
+		}
+		if len(records) != len(entries) {
+			t.Fatalf("expected %d records, got %d", len(entries), len(records))
+		}
+		if err := VerifyAuditRecords(records, key); err != nil {
+			t.Errorf("VerifyAuditRecords failed: %v", err)
+		}
+
+		// 重启后从表中最后一条继续
+		audit, err = NewAuditLogger(sink, WithAuditKey(key))
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec, err := audit.Log(ctx, AuditEntry{Actor: "admin", Action: "logout"})
+		if err != nil || rec.Seq != 3 || rec.PrevHash != records[1].Hash {
+			t.Errorf("expected chain to resume from the table, got %+v, %v", rec, err)
+		}
+	})
+}
+
+func TestAuditSQLSink_MySQLDatetime(t *testing.T) {
+	key := []byte("secret-key")
+	ctx := context.Background()
+
+	// 模拟 MySQL DATETIME(6) + loc=Local：时间截断到微秒并按本地时区返回
+	conn := &memAuditConnector{loc: time.FixedZone("CST", 8*3600)}
+	db := sql.OpenDB(conn)
+	defer db.Close()
+
+	audit, _ := NewAuditLogger(NewAuditSQLSink(db, "audit_log"), WithAuditKey(key))
+	base := time.Date(2026, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	audit.now = func() time.Time { return base }
+	for _, action := range []string{"login", "logout"} {
+		rec, err := audit.Log(ctx, AuditEntry{Actor: "admin", Action: action})
+		if err != nil {
+			t.Fatalf("Log failed: %v", err)
+		}
+		if rec.Time.Nanosecond()%1000 != 0 {
+			t.Errorf("expected time truncated to microseconds, got %v", rec.Time)
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT "+AuditSQLColumns+" FROM audit_log ORDER BY seq")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var records []*AuditRecord
+	for rows.Next() {
+		rec, err := ScanAuditRecord(rows)
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, rec)
+	}
+	if err := VerifyAuditRecords(records, key); err != nil {
+		t.Errorf("VerifyAuditRecords failed: %v", err)
+	}
+}
+
+// memAuditConnector 内存表的 database/sql 驱动，仅支持 AuditSQLSink 使用的语句：
+// INSERT 追加一行，SELECT 按插入顺序返回所有行，带 DESC 时只返回最后一行
+//
+// loc 非 nil 时模拟 MySQL DATETIME(6)：时间截断到微秒并以 loc 时区返回
+type memAuditConnector struct {
+	mu   sync.Mutex
+	rows [][]driver.Value
+	loc  *time.Location
+}
+
+func (c *memAuditConnector) Connect(context.Context) (driver.Conn, error) {
+	return memAuditConn{c}, nil
+}
+func (c *memAuditConnector) Driver() driver.Driver { return nil }
+
+type memAuditConn struct{ c *memAuditConnector }
+
+func (c memAuditConn) Prepare(query string) (driver.Stmt, error) {
+	return memAuditStmt{c: c.c, query: query}, nil
+}
+func (c memAuditConn) Close() error              { return nil }
+func (c memAuditConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+type memAuditStmt struct {
+	c     *memAuditConnector
+	query string
+}
+
+func (s memAuditStmt) Close() error  { return nil }
+func (s memAuditStmt) NumInput() int { return -1 }
+
+func (s memAuditStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+	s.c.rows = append(s.c.rows, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s memAuditStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+	rows := s.c.rows
+	if strings.Contains(s.query, "DESC") && len(rows) > 0 {
+		rows = rows[len(rows)-1:]
+	}
+	return &memAuditRows{rows: rows, loc: s.c.loc}, nil
+}
+
+type memAuditRows struct {
+	rows [][]driver.Value
+	loc  *time.Location
+}
+
+func (r *memAuditRows) Columns() []string { return strings.Split(AuditSQLColumns, ", ") }
+func (r *memAuditRows) Close() error      { return nil }
+
+func (r *memAuditRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	for i, v := range r.rows[0] {
+		if u, ok := v.(uint64); ok {
+			v = int64(u)
+		}
+		if tm, ok := v.(time.Time); ok {
+			if r.loc != nil {
+				v = tm.Truncate(time.Microsecond).In(r.loc)
+			} else {
+				v = tm.UTC()
+			}
+		}
+		dest[i] = v
+	}
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -15,6 +15,19 @@
 //	    logger.WithFormat(logger.JSONFormat),
 //	)
 //
+// 审计日志（只追加、哈希链防篡改，可导出到 ClickHouse 等数据库）:
+//
+//	sink, _ := logger.NewAuditFileSink("/var/log/app/audit.log")
+//	audit, _ := logger.NewAuditLogger(sink,
+//	    logger.WithAuditKey(key),
+//	    logger.WithAuditExporter(logger.NewAuditSQLSink(chDB, "audit_log")),
+//	)
+//	audit.Log(ctx, logger.AuditEntry{Actor: "admin:42", Action: "user.delete", Resource: "user:123"})
+//
+//	// 校验（数据库中的记录用 ScanAuditRecord 读出后交给 VerifyAuditRecords）
+//	f, _ := os.Open("/var/log/app/audit.log")
+//	err := logger.VerifyAuditChain(f, key)
+//
 // --- English ---
 //
 // Package logger provides structured logging utilities.
@@ -33,4 +46,17 @@
 //	    logger.WithLevel(logger.InfoLevel),
 //	    logger.WithFormat(logger.JSONFormat),
 //	)
+//
+// Audit log (append-only, tamper-evident hash chain, exportable to ClickHouse or other databases):
+//
+//	sink, _ := logger.NewAuditFileSink("/var/log/app/audit.log")
+//	audit, _ := logger.NewAuditLogger(sink,
+//	    logger.WithAuditKey(key),
+//	    logger.WithAuditExporter(logger.NewAuditSQLSink(chDB, "audit_log")),
+//	)
+//	audit.Log(ctx, logger.AuditEntry{Actor: "admin:42", Action: "user.delete", Resource: "user:123"})
+//
+//	// Verification (read database records with ScanAuditRecord, then call VerifyAuditRecords)
+//	f, _ := os.Open("/var/log/app/audit.log")
+//	err := logger.VerifyAuditChain(f, key)
 package logger
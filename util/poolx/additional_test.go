@@ -3,9 +3,15 @@ package poolx
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
 )
 
 // ============================================================================
@@ -188,6 +194,194 @@ func TestTrySubmitFunc(t *testing.T) {
 	}
 }
 
+func TestFuture_CancelBeforeStart(t *testing.T) {
+	p := New("test-future-cancel",
+		WithMaxWorkers(1),
+		WithAutoScale(false),
+		WithPriorityQueue(true),
+	)
+	defer p.Release()
+
+	// Occupy the only worker so the next task is queued
+	blocker := make(chan struct{})
+	_ = p.Submit(func() {
+		<-blocker
+	})
+
+	var ran atomic.Bool
+	future := SubmitFunc(p, func() (int, error) {
+		ran.Store(true)
+		return 42, nil
+	})
+	future.Cancel()
+	close(blocker)
+	p.Release()
+
+	if ran.Load() {
+		t.Error("canceled task should not run")
+	}
+	if _, err := future.Get(); err != ErrFutureCanceled {
+		t.Errorf("expected ErrFutureCanceled, got %v", err)
+	}
+}
+
+func TestSubmitCtx_Release(t *testing.T) {
+	p := New("test-submit-ctx", WithMaxWorkers(1), WithAutoScale(false))
+
+	started := make(chan struct{})
+	var cause error
+	err := p.SubmitCtx(context.Background(), func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		cause = context.Cause(ctx)
+	})
+	if err != nil {
+		t.Fatalf("SubmitCtx failed: %v", err)
+	}
+	<-started
+
+	// Release cancels the task's context and waits for it to return
+	p.Release()
+	if cause != ErrPoolClosed {
+		t.Errorf("expected ErrPoolClosed cause, got %v", cause)
+	}
+}
+
+func TestSubmitCtx_CanceledBeforeStart(t *testing.T) {
+	p := New("test-submit-ctx-canceled", WithMaxWorkers(1), WithAutoScale(false))
+	defer p.Release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := p.SubmitCtx(ctx, func(context.Context) {}); err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestSubmitWithTimeout(t *testing.T) {
+	p := New("test-submit-timeout",
+		WithMaxWorkers(1),
+		WithAutoScale(false),
+		WithWorkStealing(false),
+	)
+	defer p.Release()
+
+	if err := p.SubmitWithTimeout(func(context.Context) {}, 0); err != ErrInvalidArg {
+		t.Errorf("expected ErrInvalidArg, got %v", err)
+	}
+
+	// A runaway task must not pin the only worker
+	stopped := make(chan error, 1)
+	release := make(chan struct{})
+	defer close(release)
+	err := p.SubmitWithTimeout(func(ctx context.Context) {
+		<-ctx.Done()
+		stopped <- ctx.Err()
+		<-release
+	}, 20*time.Millisecond)
+	if err != nil {
+		t.Fatalf("SubmitWithTimeout failed: %v", err)
+	}
+
+	select {
+	case err := <-stopped:
+		if err != context.DeadlineExceeded {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("task context was not canceled on timeout")
+	}
+
+	done := make(chan struct{})
+	_ = p.Submit(func() { close(done) })
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker still pinned by timed-out task")
+	}
+}
+
+func TestSubmitFunc_Panic(t *testing.T) {
+	var handled atomic.Bool
+	p := New("test-future-panic",
+		WithMaxWorkers(1),
+		WithAutoScale(false),
+		WithPanicHandler(func(v any, stack []byte) {
+			if v == "boom" && strings.Contains(string(stack), "TestSubmitFunc_Panic") {
+				handled.Store(true)
+			}
+		}),
+	)
+	defer p.Release()
+
+	future := SubmitFunc(p, func() (int, error) {
+		panic("boom")
+	})
+
+	_, err := future.GetWithTimeout(time.Second)
+	var perr *errorx.PanicError
+	if !errors.As(err, &perr) || perr.Value != "boom" {
+		t.Fatalf("expected PanicError with value boom, got %v", err)
+	}
+
+	p.Release()
+	if !handled.Load() {
+		t.Error("panic handler should receive the value and the panicking stack")
+	}
+	if got := p.Metrics().FailedTasks; got != 1 {
+		t.Errorf("expected 1 failed task, got %d", got)
+	}
+}
+
+func TestPool_PanicAfterTimeout(t *testing.T) {
+	handled := make(chan any, 1)
+	p := New("test-panic-timeout",
+		WithMaxWorkers(1),
+		WithAutoScale(false),
+		WithPanicHandler(func(v any, stack []byte) {
+			handled <- v
+		}),
+	)
+	defer p.Release()
+
+	// The worker stops waiting after 10ms, the panic must still be reported
+	_ = p.SubmitWithOptions(func() {
+		time.Sleep(50 * time.Millisecond)
+		panic("late")
+	}, WithTaskTimeout(10*time.Millisecond))
+
+	select {
+	case v := <-handled:
+		if v != "late" {
+			t.Errorf("expected late, got %v", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("panic after timeout was not reported")
+	}
+}
+
+func TestMap_Panic(t *testing.T) {
+	_, err := Map(context.Background(), []int{1, 2, 3}, 2, func(n int) (int, error) {
+		if n == 2 {
+			panic("bad item")
+		}
+		return n, nil
+	})
+
+	var perr *errorx.PanicError
+	if !errors.As(err, &perr) || perr.Value != "bad item" {
+		t.Errorf("expected PanicError, got %v", err)
+	}
+
+	err = ForEach(context.Background(), []int{1}, 1, func(int) error {
+		panic("bad item")
+	})
+	if !errors.As(err, &perr) {
+		t.Errorf("expected PanicError from ForEach, got %v", err)
+	}
+}
+
 func TestFutureGroup_WaitWithTimeout(t *testing.T) {
 	p := New("test-group-timeout", WithMaxWorkers(4), WithAutoScale(false))
 	defer p.Release()
@@ -375,6 +569,97 @@ func TestAwaitAny(t *testing.T) {
 	_ = idx // May vary based on timing
 }
 
+func TestThen(t *testing.T) {
+	f := NewFuture[int]()
+	doubled := Then(f, func(v int) (int, error) { return v * 2, nil })
+	label := Then(doubled, func(v int) (string, error) { return fmt.Sprint("v=", v), nil })
+	f.Complete(21)
+
+	if v, err := label.GetWithTimeout(time.Second); err != nil || v != "v=42" {
+		t.Errorf("expected v=42, got %q, %v", v, err)
+	}
+
+	// Errors propagate without calling fn
+	errBad := errors.New("bad")
+	failed := NewFuture[int]()
+	var called atomic.Bool
+	next := Then(failed, func(v int) (int, error) {
+		called.Store(true)
+		return v, nil
+	})
+	failed.Fail(errBad)
+	if _, err := next.GetWithTimeout(time.Second); err != errBad || called.Load() {
+		t.Errorf("expected errBad without calling fn, got %v (called=%v)", err, called.Load())
+	}
+
+	// Panics become PanicError
+	src := NewFuture[int]()
+	src.Complete(1)
+	_, err := Then(src, func(int) (int, error) { panic("boom") }).GetWithTimeout(time.Second)
+	var perr *errorx.PanicError
+	if !errors.As(err, &perr) {
+		t.Errorf("expected PanicError, got %v", err)
+	}
+}
+
+func TestWhenAll(t *testing.T) {
+	p := New("test-when-all", WithMaxWorkers(4), WithAutoScale(false))
+	defer p.Release()
+
+	var futures []*Future[int]
+	for i := range 5 {
+		futures = append(futures, SubmitFunc(p, func() (int, error) {
+			time.Sleep(time.Duration(5-i) * time.Millisecond)
+			return i, nil
+		}))
+	}
+	results, err := WhenAll(futures...).GetWithTimeout(time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(results, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("results should keep argument order, got %v", results)
+	}
+
+	// Fails fast on the first error
+	errBad := errors.New("bad")
+	pending := NewFuture[int]()
+	failed := NewFuture[int]()
+	failed.Fail(errBad)
+	if _, err := WhenAll(pending, failed).GetWithTimeout(time.Second); err != errBad {
+		t.Errorf("expected errBad, got %v", err)
+	}
+
+	if results, err := WhenAll[int]().Get(); err != nil || len(results) != 0 {
+		t.Errorf("expected empty results, got %v, %v", results, err)
+	}
+}
+
+func TestWhenAny(t *testing.T) {
+	errBad := errors.New("bad")
+	slow, failed, ok := NewFuture[string](), NewFuture[string](), NewFuture[string]()
+	anyF := WhenAny(slow, failed, ok)
+	failed.Fail(errBad)
+	ok.Complete("ok")
+
+	if v, err := anyF.GetWithTimeout(time.Second); err != nil || v != "ok" {
+		t.Errorf("expected first success, got %q, %v", v, err)
+	}
+
+	a, b := NewFuture[string](), NewFuture[string]()
+	a.Fail(errors.New("first"))
+	allFailed := WhenAny(a, b)
+	time.Sleep(10 * time.Millisecond)
+	b.Fail(errBad)
+	if _, err := allFailed.GetWithTimeout(time.Second); err != errBad {
+		t.Errorf("expected last error, got %v", err)
+	}
+
+	if _, err := WhenAny[int]().Get(); err != ErrInvalidArg {
+		t.Errorf("expected ErrInvalidArg, got %v", err)
+	}
+}
+
 // ============================================================================
 // Hooks 扩展测试
 // ============================================================================
@@ -624,6 +909,73 @@ func TestWithPriorityQueue(t *testing.T) {
 	}
 }
 
+func TestSubmitWithPriority(t *testing.T) {
+	p := New("test-submit-priority",
+		WithMaxWorkers(1),
+		WithMinWorkers(0),
+		WithAutoScale(false),
+		WithWorkStealing(false),
+		WithPriorityQueue(true),
+		WithPriorityAging(0),
+	)
+	defer p.Release()
+
+	// Occupy the only worker so later tasks are queued
+	block := make(chan struct{})
+	started := make(chan struct{})
+	_ = p.Submit(func() {
+		close(started)
+		<-block
+	})
+	<-started
+
+	var mu sync.Mutex
+	var order []int
+	record := func(priority int) func() {
+		return func() {
+			mu.Lock()
+			order = append(order, priority)
+			mu.Unlock()
+		}
+	}
+
+	for _, priority := range []int{PriorityLow, PriorityNormal, PriorityHigh, PriorityLow} {
+		if err := p.SubmitWithPriority(record(priority), priority); err != nil {
+			t.Fatalf("SubmitWithPriority failed: %v", err)
+		}
+	}
+	if got := p.Waiting(); got != 4 {
+		t.Errorf("expected 4 waiting tasks, got %d", got)
+	}
+
+	close(block)
+	p.Release()
+
+	want := []int{PriorityHigh, PriorityNormal, PriorityLow, PriorityLow}
+	if !slices.Equal(order, want) {
+		t.Errorf("expected order %v, got %v", want, order)
+	}
+}
+
+func TestPriorityQueueAging(t *testing.T) {
+	pq := NewPriorityQueueWithAging(0, 10*time.Millisecond)
+
+	var popped []string
+	pq.Push(func() { popped = append(popped, "old-low") }, PriorityLow)
+	time.Sleep(40 * time.Millisecond)
+	pq.Push(func() { popped = append(popped, "new-higher") }, PriorityLow+1)
+
+	for fn := pq.Pop(); fn != nil; fn = pq.Pop() {
+		fn()
+	}
+
+	// Waiting four aging intervals outranks one priority level
+	want := []string{"old-low", "new-higher"}
+	if !slices.Equal(popped, want) {
+		t.Errorf("expected %v, got %v", want, popped)
+	}
+}
+
 // testLogger implements Logger interface for testing
 type testLogger struct {
 	called *atomic.Bool
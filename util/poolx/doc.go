@@ -19,6 +19,51 @@
 //	})
 //	result, err := future.Get()
 //
+// Future 组合，无需手写 channel 实现扇出/扇入:
+//
+//	names := poolx.Then(future, func(v int) (string, error) { return strconv.Itoa(v), nil })
+//	all, err := poolx.WhenAll(f1, f2, f3).GetWithTimeout(time.Second)
+//	first, err := poolx.WhenAny(primary, replica).Get()
+//
+// 优先级调度，worker 全忙时高优先级任务先于批处理任务执行，
+// 排队时间会逐步提升优先级以避免饥饿:
+//
+//	p := poolx.New("shared", poolx.WithPriorityQueue(true))
+//	p.SubmitWithPriority(handleRequest, poolx.PriorityHigh)
+//	p.SubmitWithPriority(rebuildIndex, poolx.PriorityLow)
+//
+// 任务超时与取消，任务的 context 在超时或池关闭时取消:
+//
+//	p.SubmitWithTimeout(func(ctx context.Context) { crawl(ctx, url) }, 5*time.Second)
+//	p.SubmitCtx(reqCtx, func(ctx context.Context) { sync(ctx) })
+//	future.Cancel() // 尚未开始的任务不再执行
+//
+// 任务 panic 不会终止 worker 或进程：交给 WithPanicHandler(func(v any, stack []byte))，
+// Future、Map、ForEach 以 *errorx.PanicError 返回。
+//
+// 在池上并行处理切片，结果保持顺序，错误按下标合并:
+//
+//	users, err := poolx.MapPool(ctx, p, ids, 8, repo.Get)
+//	total, err := poolx.MapReduce(ctx, p, files, 4, countLines, 0, add)
+//
+// 按 key 串行执行，同一 key 的任务按提交顺序执行，不同 key 并行:
+//
+//	kp := poolx.NewKeyedPool[int64](p)
+//	kp.Submit(ev.UserID, func() { apply(ev) })
+//
+// 流水线，每个阶段独立的 worker 数，阶段之间为有界通道，任一阶段出错即停止:
+//
+//	pl := poolx.NewPipeline(ctx, p)
+//	records := poolx.Stage(poolx.FromSlice(pl, 64, lines), 4, 64, parseRecord)
+//	poolx.Sink(records, 2, saveRecord)
+//	err := pl.Run()
+//
+// 按任务类别隔离（舱壁模式），每个名称一个独立限额的池:
+//
+//	g := poolx.NewPoolGroup(poolx.WithMaxWorkers(8))
+//	g.Configure("openai", poolx.WithMaxWorkers(4))
+//	g.Pool("openai").Submit(callLLM)
+//
 // 全局默认池:
 //
 //	poolx.Go(func() { /* 任务 */ })
@@ -47,6 +92,56 @@
 //	})
 //	result, err := future.Get()
 //
+// Future combinators for fan-out/fan-in without channel plumbing:
+//
+//	names := poolx.Then(future, func(v int) (string, error) { return strconv.Itoa(v), nil })
+//	all, err := poolx.WhenAll(f1, f2, f3).GetWithTimeout(time.Second)
+//	first, err := poolx.WhenAny(primary, replica).Get()
+//
+// Priority scheduling, latency-sensitive tasks run ahead of batch work when all
+// workers are busy, and queued tasks age upward to avoid starvation:
+//
+//	p := poolx.New("shared", poolx.WithPriorityQueue(true))
+//	p.SubmitWithPriority(handleRequest, poolx.PriorityHigh)
+//	p.SubmitWithPriority(rebuildIndex, poolx.PriorityLow)
+//
+// Task timeout and cancellation, the task's context is canceled on timeout or
+// pool shutdown:
+//
+//	p.SubmitWithTimeout(func(ctx context.Context) { crawl(ctx, url) }, 5*time.Second)
+//	p.SubmitCtx(reqCtx, func(ctx context.Context) { sync(ctx) })
+//	future.Cancel() // tasks that have not started will not run
+//
+// Task panics never kill a worker or the process: they go to
+// WithPanicHandler(func(v any, stack []byte)), and Future, Map and ForEach
+// return them as *errorx.PanicError.
+//
+// Parallel slice processing on a pool, keeping result order and joining
+// per-item errors:
+//
+//	users, err := poolx.MapPool(ctx, p, ids, 8, repo.Get)
+//	total, err := poolx.MapReduce(ctx, p, files, 4, countLines, 0, add)
+//
+// Serial execution per key, tasks with the same key run in submission order
+// while different keys run in parallel:
+//
+//	kp := poolx.NewKeyedPool[int64](p)
+//	kp.Submit(ev.UserID, func() { apply(ev) })
+//
+// Pipelines with a worker count per stage and bounded channels between
+// stages; the first stage error stops the pipeline:
+//
+//	pl := poolx.NewPipeline(ctx, p)
+//	records := poolx.Stage(poolx.FromSlice(pl, 64, lines), 4, 64, parseRecord)
+//	poolx.Sink(records, 2, saveRecord)
+//	err := pl.Run()
+//
+// Per-class isolation (bulkheads), one independently limited pool per name:
+//
+//	g := poolx.NewPoolGroup(poolx.WithMaxWorkers(8))
+//	g.Configure("openai", poolx.WithMaxWorkers(4))
+//	g.Pool("openai").Submit(callLLM)
+//
 // Global default pool:
 //
 //	poolx.Go(func() { /* task */ })
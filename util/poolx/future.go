@@ -5,6 +5,8 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
 )
 
 // ============================================================================
@@ -85,7 +87,9 @@ func (f *Future[T]) Fail(err error) {
 }
 
 // Cancel cancels the future.
-// This can only be called once; subsequent calls are ignored.
+// A task submitted through SubmitFunc, SubmitFuncCtx or TrySubmitFunc that
+// has not started yet will not run; a running task sees its context canceled
+// (SubmitFuncCtx only). Has no effect once the future is done.
 func (f *Future[T]) Cancel() {
 	f.once.Do(func() {
 		f.mu.Lock()
@@ -171,16 +175,16 @@ func (f *Future[T]) Done() <-chan struct{} {
 
 // SubmitFunc submits a function that returns a result to the pool.
 // Returns a Future that can be used to retrieve the result.
+// If fn panics, the Future fails with a *errorx.PanicError.
 func SubmitFunc[T any](p *Pool, fn func() (T, error)) *Future[T] {
 	future := NewFuture[T]()
 
 	err := p.Submit(func() {
-		result, err := fn()
-		if err != nil {
-			future.Fail(err)
-		} else {
-			future.Complete(result)
+		// Skip tasks canceled before they started
+		if future.IsDone() {
+			return
 		}
+		runFuture(future, fn)
 	})
 
 	if err != nil {
@@ -208,12 +212,9 @@ func SubmitFuncCtx[T any](p *Pool, ctx context.Context, fn func(context.Context)
 		default:
 		}
 
-		result, err := fn(childCtx)
-		if err != nil {
-			future.Fail(err)
-		} else {
-			future.Complete(result)
-		}
+		runFuture(future, func() (T, error) {
+			return fn(childCtx)
+		})
 	})
 
 	if err != nil {
@@ -230,12 +231,10 @@ func TrySubmitFunc[T any](p *Pool, fn func() (T, error)) *Future[T] {
 	future := NewFuture[T]()
 
 	ok := p.TrySubmit(func() {
-		result, err := fn()
-		if err != nil {
-			future.Fail(err)
-		} else {
-			future.Complete(result)
+		if future.IsDone() {
+			return
 		}
+		runFuture(future, fn)
 	})
 
 	if !ok {
@@ -245,6 +244,26 @@ func TrySubmitFunc[T any](p *Pool, fn func() (T, error)) *Future[T] {
 	return future
 }
 
+// runFuture runs fn and settles the future with its outcome.
+// A panic fails the future with *errorx.PanicError and is re-raised so the
+// pool still counts it and passes it to the PanicHandler.
+func runFuture[T any](future *Future[T], fn func() (T, error)) {
+	defer func() {
+		if r := recover(); r != nil {
+			perr := errorx.NewPanicError(r)
+			future.Fail(perr)
+			panic(perr)
+		}
+	}()
+
+	result, err := fn()
+	if err != nil {
+		future.Fail(err)
+	} else {
+		future.Complete(result)
+	}
+}
+
 // ============================================================================
 // FutureGroup - Wait for Multiple Futures
 // ============================================================================
@@ -494,3 +513,128 @@ func AwaitAny[T any](futures ...*Future[T]) (T, int, error) {
 	var zero T
 	return zero, -1, lastErr
 }
+
+// ============================================================================
+// Combinators
+// ============================================================================
+
+// Then returns a Future that applies fn to f's result once f completes.
+// If f fails or is canceled, the returned Future fails with the same error
+// and fn is not called. fn runs on its own goroutine; a panic in fn fails
+// the returned Future with a *errorx.PanicError. Canceling the returned
+// Future before f completes prevents fn from running.
+//
+// Example:
+//
+//	user := poolx.SubmitFunc(p, loadUser)
+//	name := poolx.Then(user, func(u *User) (string, error) { return u.Name, nil })
+func Then[T, R any](f *Future[T], fn func(T) (R, error)) *Future[R] {
+	next := NewFuture[R]()
+	go func() {
+		select {
+		case <-f.done:
+		case <-next.done:
+			return
+		}
+
+		v, err := f.Get()
+		if err != nil {
+			next.Fail(err)
+			return
+		}
+		if next.IsDone() {
+			return
+		}
+
+		result, err := callCatching(func() (R, error) { return fn(v) })
+		if err != nil {
+			next.Fail(err)
+		} else {
+			next.Complete(result)
+		}
+	}()
+	return next
+}
+
+// WhenAll returns a Future that completes with the results of all futures,
+// in argument order, once every one has completed successfully.
+// It fails as soon as any future fails, with that future's error.
+// With no futures it completes immediately with an empty slice.
+//
+// Example:
+//
+//	all := poolx.WhenAll(poolx.SubmitFunc(p, fetchA), poolx.SubmitFunc(p, fetchB))
+//	results, err := all.GetWithTimeout(time.Second)
+func WhenAll[T any](futures ...*Future[T]) *Future[[]T] {
+	all := NewFuture[[]T]()
+	results := make([]T, len(futures))
+	var remaining atomic.Int32
+	remaining.Store(int32(len(futures)))
+	if len(futures) == 0 {
+		all.Complete(results)
+		return all
+	}
+
+	for i, f := range futures {
+		go func() {
+			select {
+			case <-f.done:
+			case <-all.done:
+				return
+			}
+			v, err := f.Get()
+			if err != nil {
+				all.Fail(err)
+				return
+			}
+			results[i] = v
+			if remaining.Add(-1) == 0 {
+				all.Complete(results)
+			}
+		}()
+	}
+	return all
+}
+
+// WhenAny returns a Future that completes with the first successful result
+// among futures. If every future fails, it fails with the error of the last
+// one to fail. With no futures it fails with ErrInvalidArg.
+//
+// Example:
+//
+//	fastest := poolx.WhenAny(poolx.SubmitFunc(p, queryPrimary), poolx.SubmitFunc(p, queryReplica))
+//	v, err := fastest.Get()
+func WhenAny[T any](futures ...*Future[T]) *Future[T] {
+	anyF := NewFuture[T]()
+	if len(futures) == 0 {
+		anyF.Fail(ErrInvalidArg)
+		return anyF
+	}
+
+	var remaining atomic.Int32
+	remaining.Store(int32(len(futures)))
+	for _, f := range futures {
+		go func() {
+			select {
+			case <-f.done:
+			case <-anyF.done:
+				return
+			}
+			v, err := f.Get()
+			if err == nil {
+				anyF.Complete(v)
+				return
+			}
+			if remaining.Add(-1) == 0 {
+				anyF.Fail(err)
+			}
+		}()
+	}
+	return anyF
+}
+
+// callCatching calls fn, converting a panic into a *errorx.PanicError
+func callCatching[R any](fn func() (R, error)) (result R, err error) {
+	defer catchPanic(&err)
+	return fn()
+}
@@ -0,0 +1,171 @@
+package poolx
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// ============================================================================
+// PoolGroup - Bulkhead Isolation
+// ============================================================================
+
+// PoolGroup manages independent pools per task class (bulkhead isolation).
+//
+// Each name gets its own pool with its own worker and queue limits, so a
+// saturated dependency (e.g. a slow upstream API) only exhausts its own pool
+// and cannot starve unrelated work. Pools are created lazily on first use.
+//
+// Pools in a group are scoped to the group and are not registered in the
+// global named pool registry (GetPool).
+//
+// Example:
+//
+//	g := poolx.NewPoolGroup(poolx.WithMaxWorkers(8), poolx.WithNonBlocking(true))
+//	g.Configure("openai", poolx.WithMaxWorkers(4))
+//	g.Configure("db", poolx.WithMaxWorkers(32))
+//	defer g.Release()
+//
+//	g.Pool("openai").Submit(callLLM)
+//	g.Submit("db", flushBatch)
+type PoolGroup struct {
+	mu       sync.RWMutex
+	defaults []Option
+	configs  map[string][]Option
+	pools    map[string]*Pool
+	closed   bool
+}
+
+// NewPoolGroup creates a pool group. defaults apply to every pool in the
+// group; per-name options set via Configure are applied after them.
+func NewPoolGroup(defaults ...Option) *PoolGroup {
+	return &PoolGroup{
+		defaults: defaults,
+		configs:  make(map[string][]Option),
+		pools:    make(map[string]*Pool),
+	}
+}
+
+// Configure sets options for the named pool.
+// Returns ErrInvalidArg if the pool has already been created, and
+// ErrPoolClosed if the group has been released.
+func (g *PoolGroup) Configure(name string, opts ...Option) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.closed {
+		return ErrPoolClosed
+	}
+	if _, ok := g.pools[name]; ok {
+		return ErrInvalidArg
+	}
+	g.configs[name] = opts
+	return nil
+}
+
+// Pool returns the named pool, creating it on first use.
+// Returns nil after the group has been released.
+func (g *PoolGroup) Pool(name string) *Pool {
+	g.mu.RLock()
+	p, ok := g.pools[name]
+	closed := g.closed
+	g.mu.RUnlock()
+	if ok || closed {
+		return p
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.closed {
+		return nil
+	}
+	if p, ok := g.pools[name]; ok {
+		return p
+	}
+	opts := append(slices.Clone(g.defaults), g.configs[name]...)
+	// Named after the class so hooks, metrics and errors identify it,
+	// but not registered globally where names could collide
+	p = newPool(name, false, opts...)
+	g.pools[name] = p
+	return p
+}
+
+// Get returns the named pool if it has been created.
+func (g *PoolGroup) Get(name string) (*Pool, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	p, ok := g.pools[name]
+	return p, ok
+}
+
+// Submit submits a task to the named pool.
+func (g *PoolGroup) Submit(name string, fn func()) error {
+	p := g.Pool(name)
+	if p == nil {
+		return ErrPoolClosed
+	}
+	return p.Submit(fn)
+}
+
+// TrySubmit attempts to submit a task to the named pool without blocking.
+func (g *PoolGroup) TrySubmit(name string, fn func()) bool {
+	p := g.Pool(name)
+	if p == nil {
+		return false
+	}
+	return p.TrySubmit(fn)
+}
+
+// SubmitWithContext submits a task to the named pool with context support.
+func (g *PoolGroup) SubmitWithContext(ctx context.Context, name string, fn func()) error {
+	p := g.Pool(name)
+	if p == nil {
+		return ErrPoolClosed
+	}
+	return p.SubmitWithContext(ctx, fn)
+}
+
+// Names returns the names of created pools in sorted order.
+func (g *PoolGroup) Names() []string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	names := make([]string, 0, len(g.pools))
+	for name := range g.pools {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Metrics returns a metrics snapshot for each created pool.
+func (g *PoolGroup) Metrics() map[string]MetricsSnapshot {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	result := make(map[string]MetricsSnapshot, len(g.pools))
+	for name, p := range g.pools {
+		result[name] = p.Metrics()
+	}
+	return result
+}
+
+// Release releases all pools in the group concurrently and waits for them.
+// Subsequent submissions return ErrPoolClosed.
+func (g *PoolGroup) Release() {
+	g.mu.Lock()
+	if g.closed {
+		g.mu.Unlock()
+		return
+	}
+	g.closed = true
+	pools := make([]*Pool, 0, len(g.pools))
+	for _, p := range g.pools {
+		pools = append(pools, p)
+	}
+	g.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, p := range pools {
+		wg.Go(p.Release)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,157 @@
+package poolx
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// PoolGroup 测试
+// ============================================================================
+
+func TestPoolGroup_LazyCreate(t *testing.T) {
+	g := NewPoolGroup(WithMaxWorkers(2), WithAutoScale(false))
+	defer g.Release()
+
+	if _, ok := g.Get("db"); ok {
+		t.Fatal("pool should not exist before first use")
+	}
+
+	p := g.Pool("db")
+	if p == nil || g.Pool("db") != p {
+		t.Fatal("Pool should return the same instance")
+	}
+	if p.Cap() != 2 {
+		t.Errorf("expected default cap 2, got %d", p.Cap())
+	}
+	if _, ok := GetPool("db"); ok {
+		t.Error("group pools should not be registered globally")
+	}
+}
+
+func TestPoolGroup_Configure(t *testing.T) {
+	g := NewPoolGroup(WithMaxWorkers(2), WithAutoScale(false))
+	defer g.Release()
+
+	if err := g.Configure("openai", WithMaxWorkers(5)); err != nil {
+		t.Fatalf("Configure failed: %v", err)
+	}
+	if got := g.Pool("openai").Cap(); got != 5 {
+		t.Errorf("expected configured cap 5, got %d", got)
+	}
+	if err := g.Configure("openai", WithMaxWorkers(1)); err != ErrInvalidArg {
+		t.Errorf("expected ErrInvalidArg after creation, got %v", err)
+	}
+}
+
+func TestPoolGroup_Isolation(t *testing.T) {
+	g := NewPoolGroup(WithMaxWorkers(1), WithAutoScale(false), WithNonBlocking(true))
+	defer g.Release()
+
+	// 占满 slow 池
+	blocker := make(chan struct{})
+	defer close(blocker)
+	if err := g.Submit("slow", func() { <-blocker }); err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if err := g.Submit("slow", func() {}); err != ErrPoolOverload {
+		t.Errorf("expected ErrPoolOverload from saturated pool, got %v", err)
+	}
+
+	// fast 池不受影响
+	done := make(chan struct{})
+	if err := g.Submit("fast", func() { close(done) }); err != nil {
+		t.Fatalf("Submit to fast pool failed: %v", err)
+	}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("fast pool task was starved")
+	}
+
+	if names := g.Names(); len(names) != 2 || names[0] != "fast" || names[1] != "slow" {
+		t.Errorf("unexpected names: %v", names)
+	}
+	if m := g.Metrics(); len(m) != 2 {
+		t.Errorf("expected metrics for 2 pools, got %d", len(m))
+	}
+}
+
+func TestPoolGroup_Release(t *testing.T) {
+	g := NewPoolGroup(WithMaxWorkers(4), WithAutoScale(false))
+
+	var counter atomic.Int32
+	var wg sync.WaitGroup
+	for _, name := range []string{"a", "b", "c"} {
+		wg.Add(1)
+		_ = g.Submit(name, func() {
+			defer wg.Done()
+			counter.Add(1)
+		})
+	}
+	wg.Wait()
+
+	g.Release()
+	g.Release() // 重复调用安全
+
+	if counter.Load() != 3 {
+		t.Errorf("expected 3, got %d", counter.Load())
+	}
+	if p, _ := g.Get("a"); !p.IsClosed() {
+		t.Error("pools should be closed after Release")
+	}
+	if err := g.Submit("a", func() {}); err != ErrPoolClosed {
+		t.Errorf("expected ErrPoolClosed, got %v", err)
+	}
+	if g.Pool("new") != nil {
+		t.Error("Pool should return nil after Release")
+	}
+	if err := g.Configure("new"); err != ErrPoolClosed {
+		t.Errorf("expected ErrPoolClosed, got %v", err)
+	}
+}
+
+func TestPoolGroup_ClassNames(t *testing.T) {
+	var mu sync.Mutex
+	var names []string
+	hooks := NewHookBuilder().
+		BeforeSubmit(func(info *TaskInfo) {
+			mu.Lock()
+			names = append(names, info.PoolName)
+			mu.Unlock()
+		}).
+		Build()
+	g := NewPoolGroup(WithMaxWorkers(1), WithAutoScale(false), WithHooks(hooks))
+	defer g.Release()
+
+	global := New("db", WithMaxWorkers(1))
+	defer global.Release()
+
+	if got := g.Pool("db").Name(); got != "db" {
+		t.Errorf("expected class pool name db, got %q", got)
+	}
+	if got := g.Pool("openai").Name(); got != "openai" {
+		t.Errorf("expected class pool name openai, got %q", got)
+	}
+	if err := g.Submit("openai", func() {}); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	if len(names) != 1 || names[0] != "openai" {
+		t.Errorf("expected hooks to see pool name openai, got %v", names)
+	}
+	mu.Unlock()
+
+	// Class pools must not shadow or unregister global pools with the same name
+	if p, ok := GetPool("db"); !ok || p != global {
+		t.Error("class pool should not replace the global pool")
+	}
+	g.Pool("db").Release()
+	if p, ok := GetPool("db"); !ok || p != global {
+		t.Error("releasing a class pool should not unregister the global pool")
+	}
+}
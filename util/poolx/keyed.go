@@ -0,0 +1,136 @@
+package poolx
+
+import (
+	"sync"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
+)
+
+// ============================================================================
+// KeyedPool - Ordered Execution per Key
+// ============================================================================
+
+// KeyedPool runs tasks on a Pool so that tasks with the same key execute one
+// at a time in submission order, while tasks with different keys run in
+// parallel.
+//
+// Each key with pending tasks occupies at most one worker, which runs the
+// key's tasks back to back until its queue is empty. Keys without pending
+// tasks hold no state, so an unbounded key space (user IDs, aggregate IDs)
+// is fine. A very busy key keeps its worker until it drains; size the pool
+// for the number of keys expected to be active at once.
+//
+// A panicking task is passed to the pool's PanicHandler and does not stop
+// the remaining tasks of its key. Releasing the underlying pool waits for
+// all queued keyed tasks to finish.
+//
+// Example:
+//
+//	kp := poolx.NewKeyedPool[int64](p)
+//	for _, ev := range events {
+//	    kp.Submit(ev.UserID, func() { apply(ev) }) // per-user order preserved
+//	}
+type KeyedPool[K comparable] struct {
+	pool   *Pool
+	mu     sync.Mutex
+	queues map[K]*keyQueue
+}
+
+// keyQueue holds the pending tasks of a key whose runner is active
+type keyQueue struct {
+	tasks []func()
+}
+
+// NewKeyedPool creates a keyed pool on top of p (the default pool if nil).
+func NewKeyedPool[K comparable](p *Pool) *KeyedPool[K] {
+	if p == nil {
+		initDefaultPool()
+		p = defaultPool
+	}
+	return &KeyedPool[K]{
+		pool:   p,
+		queues: make(map[K]*keyQueue),
+	}
+}
+
+// Submit queues fn to run after all previously submitted tasks with the same
+// key. It returns immediately when the key already has a runner; otherwise
+// it submits a runner to the pool and may block like Pool.Submit.
+//
+// Returns ErrPoolClosed if the pool is closed. If the pool rejects the
+// runner (e.g. ErrPoolOverload in non-blocking mode), the error is returned
+// and tasks queued for the key in the meantime are discarded.
+func (k *KeyedPool[K]) Submit(key K, fn func()) error {
+	if k.pool.IsClosed() {
+		return ErrPoolClosed
+	}
+
+	k.mu.Lock()
+	if q, ok := k.queues[key]; ok {
+		q.tasks = append(q.tasks, fn)
+		k.mu.Unlock()
+		return nil
+	}
+	q := &keyQueue{tasks: []func(){fn}}
+	k.queues[key] = q
+	k.mu.Unlock()
+
+	if err := k.pool.Submit(func() { k.drain(key, q) }); err != nil {
+		k.mu.Lock()
+		delete(k.queues, key)
+		k.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Pending returns the number of tasks of key that have not started yet.
+func (k *KeyedPool[K]) Pending(key K) int {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if q, ok := k.queues[key]; ok {
+		return len(q.tasks)
+	}
+	return 0
+}
+
+// ActiveKeys returns the number of keys that are running or have pending tasks.
+func (k *KeyedPool[K]) ActiveKeys() int {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return len(k.queues)
+}
+
+// Pool returns the underlying pool.
+func (k *KeyedPool[K]) Pool() *Pool {
+	return k.pool
+}
+
+// drain runs the tasks of key in order until its queue is empty
+func (k *KeyedPool[K]) drain(key K, q *keyQueue) {
+	for {
+		k.mu.Lock()
+		if len(q.tasks) == 0 {
+			delete(k.queues, key)
+			k.mu.Unlock()
+			return
+		}
+		fn := q.tasks[0]
+		q.tasks[0] = nil
+		q.tasks = q.tasks[1:]
+		k.mu.Unlock()
+
+		k.run(fn)
+	}
+}
+
+// run executes one task, reporting a panic instead of abandoning the key
+func (k *KeyedPool[K]) run(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			k.pool.metrics.FailedTasks.Add(1)
+			callPanicHandler(k.pool.config.PanicHandler, errorx.NewPanicError(r))
+		}
+	}()
+	fn()
+}
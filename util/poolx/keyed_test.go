@@ -0,0 +1,106 @@
+package poolx
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// KeyedPool 测试
+// ============================================================================
+
+func TestKeyedPool_OrderPerKey(t *testing.T) {
+	p := New("test-keyed-order", WithMaxWorkers(4), WithAutoScale(false))
+	kp := NewKeyedPool[int](p)
+
+	var mu sync.Mutex
+	got := make(map[int][]int)
+	for i := range 50 {
+		for key := range 3 {
+			err := kp.Submit(key, func() {
+				mu.Lock()
+				got[key] = append(got[key], i)
+				mu.Unlock()
+			})
+			if err != nil {
+				t.Fatalf("Submit failed: %v", err)
+			}
+		}
+	}
+	p.Release()
+
+	for key := range 3 {
+		if len(got[key]) != 50 {
+			t.Fatalf("key %d: expected 50 tasks, got %d", key, len(got[key]))
+		}
+		for i, v := range got[key] {
+			if v != i {
+				t.Fatalf("key %d: out of order at %d: %v", key, i, got[key])
+			}
+		}
+	}
+	if n := kp.ActiveKeys(); n != 0 {
+		t.Errorf("expected no active keys after drain, got %d", n)
+	}
+}
+
+func TestKeyedPool_SerialPerKeyParallelAcrossKeys(t *testing.T) {
+	p := New("test-keyed-parallel", WithMaxWorkers(4), WithAutoScale(false))
+	defer p.Release()
+	kp := NewKeyedPool[string](p)
+
+	var running, peakSame atomic.Int32
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		_ = kp.Submit("same", func() {
+			defer wg.Done()
+			if n := running.Add(1); n > peakSame.Load() {
+				peakSame.Store(n)
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+		})
+	}
+
+	// A different key is not blocked behind "same"
+	other := make(chan struct{})
+	_ = kp.Submit("other", func() { close(other) })
+	select {
+	case <-other:
+	case <-time.After(time.Second):
+		t.Fatal("different key should run in parallel")
+	}
+
+	wg.Wait()
+	if peakSame.Load() != 1 {
+		t.Errorf("tasks of the same key overlapped, peak %d", peakSame.Load())
+	}
+}
+
+func TestKeyedPool_PanicContinues(t *testing.T) {
+	var handled atomic.Bool
+	p := New("test-keyed-panic",
+		WithMaxWorkers(1),
+		WithAutoScale(false),
+		WithPanicHandler(func(any, []byte) { handled.Store(true) }),
+	)
+	kp := NewKeyedPool[string](p)
+
+	var ran atomic.Bool
+	_ = kp.Submit("k", func() { panic("boom") })
+	_ = kp.Submit("k", func() { ran.Store(true) })
+	p.Release()
+
+	if !handled.Load() {
+		t.Error("panic should reach the pool's panic handler")
+	}
+	if !ran.Load() {
+		t.Error("tasks after a panic should still run")
+	}
+	if err := kp.Submit("k", func() {}); err != ErrPoolClosed {
+		t.Errorf("expected ErrPoolClosed, got %v", err)
+	}
+}
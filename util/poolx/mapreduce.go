@@ -0,0 +1,138 @@
+package poolx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ============================================================================
+// Pool-backed Map/ForEach/MapReduce
+// ============================================================================
+
+// MapPool applies fn to each item on the pool's workers and returns the
+// results in item order.
+//
+// Unlike Map, which starts its own goroutines, MapPool runs on p (the
+// default pool if nil), so fan-out work shares the pool's worker limit.
+// maxConcurrency further caps how many items of this call run at once;
+// values <= 0 leave the limit to the pool.
+//
+// All items are processed even if some fail. The returned error joins the
+// errors of every failed item in item order, each wrapped with its index;
+// a panic in fn is reported as a *errorx.PanicError. If ctx is canceled or
+// the pool is closed, remaining items are not started and the cause is
+// included in the error. Results of failed or skipped items are zero values.
+//
+// Do not call MapPool from a task running on the same pool: the caller
+// would hold a worker while waiting for others and can deadlock a small pool.
+//
+// Example:
+//
+//	users, err := poolx.MapPool(ctx, p, ids, 8, func(id int64) (*User, error) {
+//	    return repo.Get(ctx, id)
+//	})
+func MapPool[T, R any](ctx context.Context, p *Pool, items []T, maxConcurrency int, fn func(T) (R, error)) ([]R, error) {
+	results := make([]R, len(items))
+	err := runOnPool(ctx, p, len(items), maxConcurrency, func(i int) (err error) {
+		results[i], err = fn(items[i])
+		return err
+	})
+	return results, err
+}
+
+// ForEachPool applies fn to each item on the pool's workers.
+// Concurrency, error aggregation and cancellation behave as in MapPool.
+func ForEachPool[T any](ctx context.Context, p *Pool, items []T, maxConcurrency int, fn func(T) error) error {
+	return runOnPool(ctx, p, len(items), maxConcurrency, func(i int) error {
+		return fn(items[i])
+	})
+}
+
+// MapReduce maps items in parallel on the pool's workers, then folds the
+// results in item order with reduce, starting from initial.
+// If any item fails, reduce is not called and the aggregated error is
+// returned as in MapPool.
+//
+// Example:
+//
+//	total, err := poolx.MapReduce(ctx, p, files, 4,
+//	    func(path string) (int, error) { return countLines(path) },
+//	    0,
+//	    func(sum, n int) int { return sum + n },
+//	)
+func MapReduce[T, R, A any](ctx context.Context, p *Pool, items []T, maxConcurrency int, mapFn func(T) (R, error), initial A, reduce func(A, R) A) (A, error) {
+	results, err := MapPool(ctx, p, items, maxConcurrency, mapFn)
+	if err != nil {
+		return initial, err
+	}
+	acc := initial
+	for _, r := range results {
+		acc = reduce(acc, r)
+	}
+	return acc, nil
+}
+
+// runOnPool runs fn(0..n-1) on the pool with at most maxConcurrency in
+// flight and returns the joined, index-annotated errors.
+func runOnPool(ctx context.Context, p *Pool, n, maxConcurrency int, fn func(i int) error) error {
+	if n == 0 {
+		return nil
+	}
+	if p == nil {
+		initDefaultPool()
+		p = defaultPool
+	}
+
+	var sem chan struct{}
+	if maxConcurrency > 0 && maxConcurrency < n {
+		sem = make(chan struct{}, maxConcurrency)
+	}
+
+	errs := make([]error, n)
+	var stopErr error
+	var wg sync.WaitGroup
+
+	for i := range n {
+		if sem != nil {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				stopErr = ctx.Err()
+			}
+			if stopErr != nil {
+				break
+			}
+		}
+
+		wg.Add(1)
+		err := p.SubmitWithContext(ctx, func() {
+			defer func() {
+				if sem != nil {
+					<-sem
+				}
+				wg.Done()
+			}()
+			defer catchPanic(&errs[i])
+			errs[i] = fn(i)
+		})
+		if err != nil {
+			wg.Done()
+			stopErr = err
+			break
+		}
+	}
+	wg.Wait()
+
+	var all []error
+	for i, err := range errs {
+		if err != nil {
+			all = append(all, fmt.Errorf("item %d: %w", i, err))
+		}
+	}
+	if stopErr != nil {
+		all = append(all, stopErr)
+	}
+	return errors.Join(all...)
+}
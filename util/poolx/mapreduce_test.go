@@ -0,0 +1,143 @@
+package poolx
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
+)
+
+// ============================================================================
+// MapPool / ForEachPool / MapReduce 测试
+// ============================================================================
+
+func TestMapPool_Order(t *testing.T) {
+	p := New("test-map-pool", WithMaxWorkers(4), WithAutoScale(false))
+	defer p.Release()
+
+	items := []int{5, 1, 4, 2, 3}
+	results, err := MapPool(context.Background(), p, items, 0, func(n int) (int, error) {
+		time.Sleep(time.Duration(n) * time.Millisecond)
+		return n * 10, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, n := range items {
+		if results[i] != n*10 {
+			t.Errorf("results[%d] = %d, want %d", i, results[i], n*10)
+		}
+	}
+}
+
+func TestMapPool_Errors(t *testing.T) {
+	errOdd := errors.New("odd")
+	var calls atomic.Int32
+
+	results, err := MapPool(context.Background(), nil, []int{1, 2, 3, 4}, 2, func(n int) (int, error) {
+		calls.Add(1)
+		if n == 4 {
+			panic("four")
+		}
+		if n%2 == 1 {
+			return 0, errOdd
+		}
+		return n, nil
+	})
+
+	if calls.Load() != 4 {
+		t.Errorf("all items should run, got %d calls", calls.Load())
+	}
+	if results[1] != 2 {
+		t.Errorf("successful results should be kept, got %v", results)
+	}
+	if !errors.Is(err, errOdd) {
+		t.Errorf("expected joined errOdd, got %v", err)
+	}
+	var perr *errorx.PanicError
+	if !errors.As(err, &perr) {
+		t.Errorf("expected PanicError in %v", err)
+	}
+	msg := err.Error()
+	if !strings.Contains(msg, "item 0: odd") || !strings.Contains(msg, "item 2: odd") || !strings.Contains(msg, "item 3: panic: four") {
+		t.Errorf("errors should be annotated with item index, got %q", msg)
+	}
+}
+
+func TestForEachPool_MaxConcurrency(t *testing.T) {
+	p := New("test-foreach-pool", WithMaxWorkers(8), WithAutoScale(false))
+	defer p.Release()
+
+	var running, peak atomic.Int32
+	err := ForEachPool(context.Background(), p, make([]int, 20), 2, func(int) error {
+		n := running.Add(1)
+		for {
+			old := peak.Load()
+			if n <= old || peak.CompareAndSwap(old, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		running.Add(-1)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if peak.Load() > 2 {
+		t.Errorf("expected at most 2 concurrent items, got %d", peak.Load())
+	}
+}
+
+func TestForEachPool_Canceled(t *testing.T) {
+	p := New("test-foreach-cancel", WithMaxWorkers(1), WithAutoScale(false))
+	defer p.Release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	err := ForEachPool(ctx, p, make([]int, 10), 1, func(int) error {
+		if calls.Add(1) == 2 {
+			cancel()
+		}
+		return nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if calls.Load() >= 10 {
+		t.Error("remaining items should not start after cancel")
+	}
+}
+
+func TestMapReduce(t *testing.T) {
+	p := New("test-map-reduce", WithMaxWorkers(4), WithAutoScale(false))
+	defer p.Release()
+
+	words := []string{"a", "bb", "ccc"}
+	joined, err := MapReduce(context.Background(), p, words, 0,
+		func(s string) (int, error) { return len(s), nil },
+		"",
+		func(acc string, n int) string { return acc + strings.Repeat("x", n) + "|" },
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if joined != "x|xx|xxx|" {
+		t.Errorf("reduce should run in item order, got %q", joined)
+	}
+
+	errBad := errors.New("bad")
+	sum, err := MapReduce(context.Background(), p, []int{1, 2}, 0,
+		func(n int) (int, error) { return 0, errBad },
+		100,
+		func(acc, n int) int { return acc + n },
+	)
+	if !errors.Is(err, errBad) || sum != 100 {
+		t.Errorf("expected initial value and errBad, got %d, %v", sum, err)
+	}
+}
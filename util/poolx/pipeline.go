@@ -0,0 +1,277 @@
+package poolx
+
+import (
+	"context"
+	"sync"
+)
+
+// ============================================================================
+// Pipeline - Staged Concurrent Processing
+// ============================================================================
+
+// Pipeline connects a source, transform stages and sinks with bounded
+// channels. Every stage runs its own number of workers on a Pool.
+//
+// A pipeline is built with Source, Stage and Sink and started with Run.
+// The first error returned by any stage (a panic counts as a
+// *errorx.PanicError) cancels the pipeline context, stops all stages and is
+// returned by Run. Stages with more than one worker do not preserve item
+// order.
+//
+// Each stage worker holds a pool worker for the whole run, so the pool must
+// have capacity for the sum of all stage workers; Run returns ErrInvalidArg
+// otherwise.
+//
+// Example:
+//
+//	pl := poolx.NewPipeline(ctx, p)
+//	lines := poolx.Source(pl, 64, func(ctx context.Context, emit func(string) error) error {
+//	    for scanner.Scan() {
+//	        if err := emit(scanner.Text()); err != nil {
+//	            return err
+//	        }
+//	    }
+//	    return scanner.Err()
+//	})
+//	records := poolx.Stage(lines, 4, 64, parseRecord)
+//	poolx.Sink(records, 2, saveRecord)
+//	err := pl.Run()
+type Pipeline struct {
+	pool   *Pool
+	parent context.Context
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+
+	mu         sync.Mutex
+	stages     []pipelineStage
+	workers    int // Total workers of all stages
+	unconsumed int // Streams without a consumer
+	buildErr   error
+	started    bool
+
+	errOnce sync.Once
+	err     error
+}
+
+// pipelineStage is one stage's worker body, started workers times by Run.
+// done runs after all of the stage's workers have returned.
+type pipelineStage struct {
+	workers int
+	run     func()
+	done    func()
+}
+
+// Stream is the output of a pipeline stage, consumed by exactly one Stage
+// or Sink.
+type Stream[T any] struct {
+	pl       *Pipeline
+	ch       chan T
+	consumed bool
+}
+
+// NewPipeline creates a pipeline whose stages run on p (the default pool if
+// nil). Canceling ctx stops the pipeline.
+func NewPipeline(ctx context.Context, p *Pool) *Pipeline {
+	if p == nil {
+		initDefaultPool()
+		p = defaultPool
+	}
+	pctx, cancel := context.WithCancelCause(ctx)
+	return &Pipeline{
+		pool:   p,
+		parent: ctx,
+		ctx:    pctx,
+		cancel: cancel,
+	}
+}
+
+// Source adds the pipeline's producer. fn runs on one worker and passes
+// items downstream with emit, which blocks while the output buffer is full
+// and returns an error once the pipeline is stopping; fn should return that
+// error. buffer is the capacity of the output channel.
+func Source[T any](pl *Pipeline, buffer int, fn func(ctx context.Context, emit func(T) error) error) *Stream[T] {
+	out := newStream[T](pl, buffer)
+	emit := func(v T) error {
+		select {
+		case out.ch <- v:
+			return nil
+		case <-pl.ctx.Done():
+			return context.Cause(pl.ctx)
+		}
+	}
+	pl.addStage(1, func() {
+		pl.fail(callStage(func() error { return fn(pl.ctx, emit) }))
+	}, func() { close(out.ch) })
+	return out
+}
+
+// FromSlice adds a source that emits items in order.
+func FromSlice[T any](pl *Pipeline, buffer int, items []T) *Stream[T] {
+	return Source(pl, buffer, func(_ context.Context, emit func(T) error) error {
+		for _, v := range items {
+			if err := emit(v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Stage adds a transform stage that applies fn to every item of in with
+// the given number of workers. buffer is the capacity of the output channel.
+func Stage[T, U any](in *Stream[T], workers, buffer int, fn func(context.Context, T) (U, error)) *Stream[U] {
+	pl := in.pl
+	out := newStream[U](pl, buffer)
+	pl.consume(in)
+	pl.addStage(workers, func() {
+		for v := range in.ch {
+			if pl.ctx.Err() != nil {
+				return
+			}
+			var u U
+			err := callStage(func() (err error) {
+				u, err = fn(pl.ctx, v)
+				return err
+			})
+			if err != nil {
+				pl.fail(err)
+				return
+			}
+			select {
+			case out.ch <- u:
+			case <-pl.ctx.Done():
+				return
+			}
+		}
+	}, func() { close(out.ch) })
+	return out
+}
+
+// Sink adds a terminal stage that passes every item of in to fn with the
+// given number of workers.
+func Sink[T any](in *Stream[T], workers int, fn func(context.Context, T) error) {
+	pl := in.pl
+	pl.consume(in)
+	pl.addStage(workers, func() {
+		for v := range in.ch {
+			if pl.ctx.Err() != nil {
+				return
+			}
+			if err := callStage(func() error { return fn(pl.ctx, v) }); err != nil {
+				pl.fail(err)
+				return
+			}
+		}
+	}, nil)
+}
+
+// Run starts all stages and waits until the source is exhausted and every
+// item has passed through, or until the pipeline stops.
+//
+// Returns the first stage error, the cause of ctx if it was canceled, or
+// ErrInvalidArg if the pipeline is malformed (a stream without consumer,
+// a stream consumed twice, a non-positive worker count, Run called twice)
+// or needs more workers than the pool's capacity.
+func (pl *Pipeline) Run() error {
+	pl.mu.Lock()
+	if pl.started || pl.buildErr != nil || pl.unconsumed > 0 || len(pl.stages) == 0 ||
+		pl.workers > int(pl.pool.Cap()) {
+		pl.mu.Unlock()
+		return ErrInvalidArg
+	}
+	pl.started = true
+	stages := pl.stages
+	pl.mu.Unlock()
+	defer pl.cancel(nil)
+
+	var all sync.WaitGroup
+	for _, s := range stages {
+		var wg sync.WaitGroup
+		wg.Add(s.workers)
+		all.Add(s.workers)
+		for range s.workers {
+			err := pl.pool.Submit(func() {
+				defer all.Done()
+				defer wg.Done()
+				s.run()
+			})
+			if err != nil {
+				// Unstarted workers still count as returned so that
+				// downstream channels are closed and Run can finish
+				all.Done()
+				wg.Done()
+				pl.fail(err)
+			}
+		}
+		if s.done != nil {
+			go func() {
+				wg.Wait()
+				s.done()
+			}()
+		}
+	}
+	all.Wait()
+
+	if pl.err != nil {
+		return pl.err
+	}
+	return context.Cause(pl.parent)
+}
+
+// addStage registers a stage, recording invalid worker counts
+func (pl *Pipeline) addStage(workers int, run, done func()) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	if workers <= 0 {
+		pl.buildErr = ErrInvalidArg
+		return
+	}
+	pl.stages = append(pl.stages, pipelineStage{workers: workers, run: run, done: done})
+	pl.workers += workers
+}
+
+// consume marks a stream as having a consumer
+func (pl *Pipeline) consume(s interface{ markConsumed() bool }) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	if !s.markConsumed() {
+		pl.buildErr = ErrInvalidArg
+	}
+}
+
+// fail records the first error and stops the pipeline
+func (pl *Pipeline) fail(err error) {
+	if err == nil {
+		return
+	}
+	pl.errOnce.Do(func() {
+		pl.err = err
+		pl.cancel(err)
+	})
+}
+
+// newStream creates a stream and tracks it until it gets a consumer
+func newStream[T any](pl *Pipeline, buffer int) *Stream[T] {
+	s := &Stream[T]{pl: pl, ch: make(chan T, max(buffer, 0))}
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	pl.unconsumed++
+	return s
+}
+
+// markConsumed reports whether the stream had no consumer yet.
+// Called with the pipeline lock held.
+func (s *Stream[T]) markConsumed() bool {
+	if s.consumed {
+		return false
+	}
+	s.consumed = true
+	s.pl.unconsumed--
+	return true
+}
+
+// callStage calls fn, converting a panic into a *errorx.PanicError
+func callStage(fn func() error) (err error) {
+	defer catchPanic(&err)
+	return fn()
+}
@@ -0,0 +1,150 @@
+package poolx
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
+)
+
+// ============================================================================
+// Pipeline 测试
+// ============================================================================
+
+func TestPipeline_Run(t *testing.T) {
+	p := New("test-pipeline", WithMaxWorkers(8), WithAutoScale(false))
+	defer p.Release()
+
+	pl := NewPipeline(context.Background(), p)
+	nums := FromSlice(pl, 4, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	squares := Stage(nums, 3, 4, func(_ context.Context, n int) (int, error) {
+		return n * n, nil
+	})
+	labels := Stage(squares, 2, 4, func(_ context.Context, n int) (string, error) {
+		return strconv.Itoa(n), nil
+	})
+
+	var mu sync.Mutex
+	var got []string
+	Sink(labels, 1, func(_ context.Context, s string) error {
+		mu.Lock()
+		got = append(got, s)
+		mu.Unlock()
+		return nil
+	})
+
+	if err := pl.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	slices.SortFunc(got, func(a, b string) int {
+		x, _ := strconv.Atoi(a)
+		y, _ := strconv.Atoi(b)
+		return x - y
+	})
+	want := []string{"1", "4", "9", "16", "25", "36", "49", "64", "81", "100"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestPipeline_StageError(t *testing.T) {
+	p := New("test-pipeline-error", WithMaxWorkers(4), WithAutoScale(false))
+	defer p.Release()
+
+	errBad := errors.New("bad item")
+	pl := NewPipeline(context.Background(), p)
+
+	// An endless source must stop once a later stage fails
+	src := Source(pl, 1, func(ctx context.Context, emit func(int) error) error {
+		for i := 0; ; i++ {
+			if err := emit(i); err != nil {
+				return err
+			}
+		}
+	})
+	checked := Stage(src, 2, 1, func(_ context.Context, n int) (int, error) {
+		if n == 5 {
+			return 0, errBad
+		}
+		return n, nil
+	})
+	Sink(checked, 1, func(context.Context, int) error { return nil })
+
+	if err := pl.Run(); !errors.Is(err, errBad) {
+		t.Errorf("expected errBad, got %v", err)
+	}
+}
+
+func TestPipeline_Panic(t *testing.T) {
+	p := New("test-pipeline-panic", WithMaxWorkers(4), WithAutoScale(false))
+	defer p.Release()
+
+	pl := NewPipeline(context.Background(), p)
+	Sink(FromSlice(pl, 0, []int{1}), 1, func(context.Context, int) error {
+		panic("sink")
+	})
+
+	var perr *errorx.PanicError
+	if err := pl.Run(); !errors.As(err, &perr) {
+		t.Errorf("expected PanicError, got %v", err)
+	}
+}
+
+func TestPipeline_Canceled(t *testing.T) {
+	p := New("test-pipeline-cancel", WithMaxWorkers(4), WithAutoScale(false))
+	defer p.Release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pl := NewPipeline(ctx, p)
+	var seen atomic.Int32
+	src := Source(pl, 0, func(ctx context.Context, emit func(int) error) error {
+		for i := 0; ; i++ {
+			if err := emit(i); err != nil {
+				return err
+			}
+		}
+	})
+	Sink(src, 1, func(context.Context, int) error {
+		if seen.Add(1) == 3 {
+			cancel()
+		}
+		return nil
+	})
+
+	if err := pl.Run(); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestPipeline_Invalid(t *testing.T) {
+	p := New("test-pipeline-invalid", WithMaxWorkers(2), WithAutoScale(false))
+	defer p.Release()
+
+	// Stream without consumer
+	pl := NewPipeline(context.Background(), p)
+	FromSlice(pl, 0, []int{1})
+	if err := pl.Run(); err != ErrInvalidArg {
+		t.Errorf("unconsumed stream: expected ErrInvalidArg, got %v", err)
+	}
+
+	// Stream consumed twice
+	pl = NewPipeline(context.Background(), p)
+	src := FromSlice(pl, 0, []int{1})
+	Sink(src, 1, func(context.Context, int) error { return nil })
+	Sink(src, 1, func(context.Context, int) error { return nil })
+	if err := pl.Run(); err != ErrInvalidArg {
+		t.Errorf("double consume: expected ErrInvalidArg, got %v", err)
+	}
+
+	// More workers than the pool can run
+	pl = NewPipeline(context.Background(), p)
+	Sink(FromSlice(pl, 0, []int{1}), 4, func(context.Context, int) error { return nil })
+	if err := pl.Run(); err != ErrInvalidArg {
+		t.Errorf("over capacity: expected ErrInvalidArg, got %v", err)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
 )
 
 // ============================================================================
@@ -31,7 +33,7 @@ type Config struct {
 	ScaleDownRatio  float64       // Scale down when load is below this ratio
 
 	// Panic recovery
-	PanicHandler func(any) // Panic handler function
+	PanicHandler func(recovered any, stack []byte) // Panic handler function
 
 	// Work stealing
 	EnableWorkStealing bool  // Enable work stealing
@@ -42,7 +44,9 @@ type Config struct {
 	NonBlocking      bool  // Non-blocking mode (reject when full)
 
 	// Priority queue
-	EnablePriorityQueue bool // Enable priority-based scheduling
+	EnablePriorityQueue bool          // Enable priority-based scheduling
+	PriorityLevels      int           // Number of priority levels, priorities range over [0, PriorityLevels-1]
+	PriorityAging       time.Duration // Queue wait that raises a task by one level (0 = no aging)
 
 	// Hooks
 	Hooks *Hooks // Lifecycle hooks
@@ -79,15 +83,25 @@ func DefaultConfig() Config {
 		MaxBlockingTasks:    0,
 		NonBlocking:         false,
 		EnablePriorityQueue: false,
+		PriorityLevels:      PriorityHigh + 1,
+		PriorityAging:       time.Second,
 		Hooks:               nil,
 		Logger:              nil,
 	}
 }
 
-func defaultPanicHandler(v any) {
-	buf := make([]byte, 4096)
-	n := runtime.Stack(buf, false)
-	fmt.Printf("[POOL PANIC] recovered: %v\n%s\n", v, buf[:n])
+func defaultPanicHandler(v any, stack []byte) {
+	fmt.Printf("[POOL PANIC] recovered: %v\n%s\n", v, stack)
+}
+
+// callPanicHandler passes a recovered task panic to the handler.
+// A panicking handler is ignored so it cannot kill the worker.
+func callPanicHandler(h func(any, []byte), pe *errorx.PanicError) {
+	if h == nil {
+		return
+	}
+	defer func() { _ = recover() }()
+	h(pe.Value, pe.Stack)
 }
 
 // Option is a configuration option function
@@ -142,8 +156,10 @@ func WithScaleInterval(d time.Duration) Option {
 	}
 }
 
-// WithPanicHandler sets the panic handler function
-func WithPanicHandler(h func(any)) Option {
+// WithPanicHandler sets the function called when a task panics.
+// It receives the recovered value and the stack of the panicking goroutine;
+// the worker survives the panic and keeps serving tasks.
+func WithPanicHandler(h func(recovered any, stack []byte)) Option {
 	return func(c *Config) {
 		c.PanicHandler = h
 	}
@@ -170,13 +186,32 @@ func WithNonBlocking(enable bool) Option {
 	}
 }
 
-// WithPriorityQueue enables priority-based scheduling
+// WithPriorityQueue enables priority-based scheduling.
+// When all workers are busy, submitted tasks wait in a priority queue of
+// QueueSize entries and idle workers take the highest priority task first.
 func WithPriorityQueue(enable bool) Option {
 	return func(c *Config) {
 		c.EnablePriorityQueue = enable
 	}
 }
 
+// WithPriorityLevels sets the number of priority levels.
+// Task priorities are clamped to [0, levels-1]; the default is PriorityHigh+1.
+func WithPriorityLevels(levels int) Option {
+	return func(c *Config) {
+		c.PriorityLevels = levels
+	}
+}
+
+// WithPriorityAging sets how long a queued task waits before it is raised by
+// one priority level, so batch work is not starved by a steady stream of
+// high-priority tasks. Zero disables aging.
+func WithPriorityAging(d time.Duration) Option {
+	return func(c *Config) {
+		c.PriorityAging = d
+	}
+}
+
 // WithHooks sets the lifecycle hooks
 func WithHooks(hooks *Hooks) Option {
 	return func(c *Config) {
@@ -579,13 +614,11 @@ func (w *worker) execute(t *task) {
 	}
 
 	// Execute with timeout if specified
-	var panicked bool
-	var panicVal any
-
+	var perr *errorx.PanicError
 	if t.timeout > 0 {
-		panicked, panicVal = w.executeWithTimeout(t)
+		perr = w.executeWithTimeout(t)
 	} else {
-		panicked, panicVal = w.executeDirect(t)
+		perr = w.executeDirect(t)
 	}
 
 	execTime := time.Since(startTime)
@@ -594,24 +627,14 @@ func (w *worker) execute(t *task) {
 	w.pool.metrics.TotalWaitTime.Add(int64(waitTime))
 	w.pool.metrics.TotalExecTime.Add(int64(execTime))
 
-	if panicked {
+	if perr != nil {
 		w.pool.metrics.FailedTasks.Add(1)
-		if w.pool.config.PanicHandler != nil {
-			// 包装 panic handler 调用，防止它本身 panic 导致 goroutine 崩溃
-			func() {
-				defer func() {
-					if r := recover(); r != nil {
-						// PanicHandler 本身 panic 了，静默处理
-					}
-				}()
-				w.pool.config.PanicHandler(panicVal)
-			}()
-		}
+		callPanicHandler(w.pool.config.PanicHandler, perr)
 
 		// Trigger panic hook
 		if w.pool.hooks != nil && w.pool.hooks.HasHooks(HookOnPanic) {
 			if taskInfo != nil {
-				taskInfo.Error = panicVal
+				taskInfo.Error = perr.Value
 				taskInfo.FinishedAt = time.Now()
 				taskInfo.ExecTime = execTime
 			}
@@ -633,15 +656,14 @@ func (w *worker) execute(t *task) {
 	releaseTask(t)
 }
 
-func (w *worker) executeDirect(t *task) (panicked bool, panicVal any) {
+func (w *worker) executeDirect(t *task) (perr *errorx.PanicError) {
 	defer func() {
 		if r := recover(); r != nil {
-			panicked = true
-			panicVal = r
+			perr = errorx.NewPanicError(r)
 		}
 	}()
 	t.fn()
-	return false, nil
+	return nil
 }
 
 // executeWithTimeout 执行带超时的任务。
@@ -649,36 +671,47 @@ func (w *worker) executeDirect(t *task) (panicked bool, panicVal any) {
 // 这是 Go 的基本限制 - goroutine 无法被强制终止。
 // 如果任务需要提前停止，应在任务函数中检查取消信号。
 // 建议使用 SubmitWithContext 来支持可取消的任务。
-func (w *worker) executeWithTimeout(t *task) (panicked bool, panicVal any) {
-	type result struct {
-		panicked bool
-		panicVal any
-	}
-	resultCh := make(chan result, 1)
+func (w *worker) executeWithTimeout(t *task) *errorx.PanicError {
+	const (
+		running = iota
+		finished
+		abandoned
+	)
+	var state atomic.Int32
+	resultCh := make(chan *errorx.PanicError, 1)
 
-	// Copy the function to avoid race when task is released
+	// Copy the function and handler to avoid races when the task is released
+	// or the worker has moved on
 	fn := t.fn
+	panicHandler := w.pool.config.PanicHandler
 
 	go func() {
-		var r result
+		var perr *errorx.PanicError
 		defer func() {
-			if rec := recover(); rec != nil {
-				r.panicked = true
-				r.panicVal = rec
+			if r := recover(); r != nil {
+				perr = errorx.NewPanicError(r)
 			}
-			// Use select to avoid blocking if nobody is listening
-			select {
-			case resultCh <- r:
-			default:
+			if state.CompareAndSwap(running, finished) {
+				resultCh <- perr
+			} else if perr != nil {
+				// The worker gave up waiting, report the panic here
+				callPanicHandler(panicHandler, perr)
 			}
 		}()
 		fn()
 	}()
 
+	timer := time.NewTimer(t.timeout)
+	defer timer.Stop()
+
 	select {
-	case res := <-resultCh:
-		return res.panicked, res.panicVal
-	case <-time.After(t.timeout):
+	case perr := <-resultCh:
+		return perr
+	case <-timer.C:
+		if !state.CompareAndSwap(running, abandoned) {
+			// Finished just as the timeout fired
+			return <-resultCh
+		}
 		// Trigger timeout hook
 		if w.pool.hooks != nil && w.pool.hooks.HasHooks(HookOnTimeout) {
 			w.pool.hooks.Trigger(HookOnTimeout, &TaskInfo{
@@ -689,7 +722,7 @@ func (w *worker) executeWithTimeout(t *task) (panicked bool, panicVal any) {
 				Timeout:     t.timeout,
 			})
 		}
-		return false, nil
+		return nil
 	}
 }
 
@@ -703,8 +736,9 @@ func (w *worker) finish() {
 
 // Pool is a high-performance goroutine pool
 type Pool struct {
-	config Config
-	name   string
+	config     Config
+	name       string
+	registered bool // Registered in namedPools, see GetPool
 
 	// Task queue
 	taskQueue chan *task
@@ -733,6 +767,10 @@ type Pool struct {
 	heartbeat chan struct{}
 	wg        sync.WaitGroup
 
+	// Shutdown context, canceled by Release to stop context-aware tasks
+	shutdownCtx context.Context
+	shutdown    context.CancelCauseFunc
+
 	// Metrics
 	metrics *Metrics
 
@@ -800,6 +838,11 @@ func NewWithName(name string, maxWorkers int) *Pool {
 
 // New creates a new pool
 func New(name string, opts ...Option) *Pool {
+	return newPool(name, name != "", opts...)
+}
+
+// newPool creates a pool, registering it for GetPool if register is set
+func newPool(name string, register bool, opts ...Option) *Pool {
 	config := DefaultConfig()
 	for _, opt := range opts {
 		opt(&config)
@@ -818,6 +861,9 @@ func New(name string, opts ...Option) *Pool {
 	if config.QueueSize <= 0 {
 		config.QueueSize = config.MaxWorkers * 2
 	}
+	if config.PriorityLevels <= 0 {
+		config.PriorityLevels = PriorityHigh + 1
+	}
 
 	p := &Pool{
 		config:    config,
@@ -834,6 +880,7 @@ func New(name string, opts ...Option) *Pool {
 	p.maxWorkers.Store(config.MaxWorkers)
 
 	p.cond = sync.NewCond(&p.lock)
+	p.shutdownCtx, p.shutdown = context.WithCancelCause(context.Background())
 
 	p.workerCache.New = func() any {
 		w := &worker{
@@ -849,7 +896,7 @@ func New(name string, opts ...Option) *Pool {
 
 	// Initialize priority queue if enabled
 	if config.EnablePriorityQueue {
-		p.priorityQueue = NewPriorityQueue(int(config.QueueSize))
+		p.priorityQueue = NewPriorityQueueWithAging(int(config.QueueSize), config.PriorityAging)
 	}
 
 	// Initialize work stealing scheduler if enabled
@@ -898,7 +945,8 @@ func New(name string, opts ...Option) *Pool {
 	}
 
 	// Register to named pools
-	if name != "" {
+	if register {
+		p.registered = true
 		namedPools.Store(name, p)
 	}
 
@@ -999,6 +1047,19 @@ func (p *Pool) revertWorker(w *worker) bool {
 	p.lock.Lock()
 	defer p.lock.Unlock()
 
+	// Run queued tasks before going idle. Submitters queue under the same
+	// lock after finding no idle worker, so no queued task is left behind.
+	// Queued tasks are drained even after Release, which waits for them.
+	if p.priorityQueue != nil {
+		if t := p.priorityQueue.popTask(); t != nil {
+			// The worker is the only receiver and its channel is empty here
+			w.taskCh <- t
+			// Queue space freed, let a blocked submitter enqueue
+			p.cond.Signal()
+			return true
+		}
+	}
+
 	// Check if pool is closed under lock to avoid race with Release
 	if p.state.Load() == stateClosed {
 		return false
@@ -1066,8 +1127,8 @@ func (p *Pool) cleanupExpiredWorkers() {
 
 // Submit submits a task (blocks until accepted or pool closed)
 func (p *Pool) Submit(fn func()) error {
-	// Fast path: no hooks, no options
-	if p.hooks == nil && !p.config.NonBlocking && p.config.MaxBlockingTasks == 0 {
+	// Fast path: no hooks, no options, no priority queue
+	if p.hooks == nil && !p.config.NonBlocking && p.config.MaxBlockingTasks == 0 && p.priorityQueue == nil {
 		return p.submitFast(fn)
 	}
 	return p.SubmitWithOptions(fn)
@@ -1140,6 +1201,7 @@ func (p *Pool) SubmitWithOptions(fn func(), opts ...TaskOption) error {
 		if taskOpts.ID == 0 {
 			taskOpts.ID = p.taskIDGen.Add(1)
 		}
+		taskOpts.Priority = min(max(taskOpts.Priority, PriorityLow), p.config.PriorityLevels-1)
 	}
 
 	t := acquireTaskWithOptions(fn, taskOpts)
@@ -1178,6 +1240,20 @@ func (p *Pool) SubmitWithOptions(fn func(), opts ...TaskOption) error {
 		return nil
 	}
 
+	// Queue behind busy workers when priority scheduling is enabled
+	if p.priorityQueue != nil {
+		queued, err := p.submitQueued(t)
+		if err != nil {
+			return err
+		}
+		if queued {
+			if taskInfo != nil {
+				p.hooks.Trigger(HookAfterSubmit, taskInfo)
+			}
+			return nil
+		}
+	}
+
 	// Non-blocking mode
 	if p.config.NonBlocking {
 		releaseTask(t)
@@ -1252,11 +1328,64 @@ func (p *Pool) SubmitWithOptions(fn func(), opts ...TaskOption) error {
 			return nil
 		}
 
+		// Enqueue once the priority queue has room
+		if p.priorityQueue != nil && p.priorityQueue.pushTask(t) {
+			p.lock.Unlock()
+			if taskInfo != nil {
+				p.hooks.Trigger(HookAfterSubmit, taskInfo)
+			}
+			return nil
+		}
+
 		// Wait
 		p.cond.Wait()
 	}
 }
 
+// SubmitWithPriority submits a task with the given priority (blocks until
+// accepted or pool closed). Higher values run first; see PriorityLow,
+// PriorityNormal and PriorityHigh.
+//
+// Priorities only take effect on pools created with WithPriorityQueue(true):
+// when all workers are busy, tasks wait in the priority queue and each worker
+// that becomes free takes the highest priority task. Tasks submitted without
+// a priority are queued at PriorityNormal. Without the priority queue this is
+// equivalent to Submit.
+func (p *Pool) SubmitWithPriority(fn func(), priority int) error {
+	return p.SubmitWithOptions(fn, WithTaskPriority(priority))
+}
+
+// submitQueued hands t to an available worker or parks it in the priority
+// queue. Both happen under p.lock so a worker returning to the idle stack
+// cannot miss a task queued concurrently (see revertWorker).
+// Returns false if the queue is full.
+func (p *Pool) submitQueued(t *task) (bool, error) {
+	p.lock.Lock()
+	if p.state.Load() == stateClosed {
+		p.lock.Unlock()
+		releaseTask(t)
+		return false, ErrPoolClosed
+	}
+
+	if w := p.workers.pop(); w != nil {
+		p.metrics.IdleWorkers.Add(-1)
+		p.lock.Unlock()
+		w.taskCh <- t
+		return true, nil
+	}
+
+	if w := p.createWorker(); w != nil {
+		p.lock.Unlock()
+		w.run()
+		w.taskCh <- t
+		return true, nil
+	}
+
+	queued := p.priorityQueue.pushTask(t)
+	p.lock.Unlock()
+	return queued, nil
+}
+
 // TrySubmit attempts to submit a task (non-blocking)
 func (p *Pool) TrySubmit(fn func()) bool {
 	if p.state.Load() == stateClosed {
@@ -1351,6 +1480,14 @@ func (p *Pool) submitBlockingFast(fn func()) error {
 			return nil
 		}
 
+		if p.priorityQueue != nil {
+			t.priority = PriorityNormal
+			if p.priorityQueue.pushTask(t) {
+				p.lock.Unlock()
+				return nil
+			}
+		}
+
 		// Wait
 		p.cond.Wait()
 	}
@@ -1465,10 +1602,78 @@ func (p *Pool) SubmitWithContext(ctx context.Context, fn func()) error {
 			return nil
 		}
 
+		if p.priorityQueue != nil {
+			t := acquireTaskFast(fn)
+			t.priority = PriorityNormal
+			if p.priorityQueue.pushTask(t) {
+				p.lock.Unlock()
+				close(done)
+				p.metrics.SubmittedTasks.Add(1)
+				return nil
+			}
+			releaseTask(t)
+		}
+
 		p.cond.Wait()
 	}
 }
 
+// SubmitCtx submits a task that receives a context.
+// The task's context is canceled when ctx is done or the pool is released
+// (context.Cause reports ErrPoolClosed); long-running tasks should return
+// once it is done. Waiting for a worker also stops when ctx is done, and a
+// task whose ctx is done before it starts is skipped.
+func (p *Pool) SubmitCtx(ctx context.Context, fn func(context.Context)) error {
+	shutdownCtx := p.shutdownContext()
+	return p.SubmitWithContext(ctx, func() {
+		runWithContext(ctx, shutdownCtx, 0, fn)
+	})
+}
+
+// SubmitWithTimeout submits a task that may run for at most timeout.
+// The task's context is canceled when the timeout expires or the pool is
+// released. On timeout the worker stops waiting for the task and returns to
+// the pool, so a runaway task cannot pin it; the task itself keeps running
+// in the background until it observes the canceled context.
+func (p *Pool) SubmitWithTimeout(fn func(context.Context), timeout time.Duration) error {
+	if timeout <= 0 {
+		return ErrInvalidArg
+	}
+	shutdownCtx := p.shutdownContext()
+	return p.SubmitWithOptions(func() {
+		runWithContext(context.Background(), shutdownCtx, timeout, fn)
+	}, WithTaskTimeout(timeout))
+}
+
+// shutdownContext returns the context canceled by the next Release
+func (p *Pool) shutdownContext() context.Context {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.shutdownCtx
+}
+
+// runWithContext runs fn with a context derived from ctx that is also
+// canceled on pool shutdown and, if timeout > 0, after timeout.
+func runWithContext(ctx, shutdownCtx context.Context, timeout time.Duration, fn func(context.Context)) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	taskCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	stop := context.AfterFunc(shutdownCtx, func() {
+		cancel(context.Cause(shutdownCtx))
+	})
+	defer stop()
+
+	if timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		taskCtx, cancelTimeout = context.WithTimeout(taskCtx, timeout)
+		defer cancelTimeout()
+	}
+	fn(taskCtx)
+}
+
 // Running returns the number of running workers
 func (p *Pool) Running() int32 {
 	return p.workerCount.Load()
@@ -1479,9 +1684,14 @@ func (p *Pool) Free() int32 {
 	return p.config.MaxWorkers - p.workerCount.Load()
 }
 
-// Waiting returns the number of waiting tasks
+// Waiting returns the number of waiting tasks, including tasks held in the
+// priority queue
 func (p *Pool) Waiting() int32 {
-	return p.metrics.BlockingTasks.Load()
+	waiting := p.metrics.BlockingTasks.Load()
+	if pq := p.priorityQueue; pq != nil {
+		waiting += int32(pq.Len())
+	}
+	return waiting
 }
 
 // Idle returns the number of idle workers
@@ -1568,6 +1778,8 @@ func (p *Pool) Release() {
 	p.lock.Lock()
 	// Wake up all waiting submitters
 	p.cond.Broadcast()
+	// Signal context-aware tasks to stop
+	p.shutdown(ErrPoolClosed)
 	p.lock.Unlock()
 
 	// Stop cleaner goroutine
@@ -1593,8 +1805,8 @@ func (p *Pool) Release() {
 	p.wg.Wait()
 
 	// Remove from named pools
-	if p.name != "" {
-		namedPools.Delete(p.name)
+	if p.registered {
+		namedPools.CompareAndDelete(p.name, p)
 	}
 }
 
@@ -1610,6 +1822,7 @@ func (p *Pool) ReleaseTimeout(timeout time.Duration) error {
 
 	p.lock.Lock()
 	p.cond.Broadcast()
+	p.shutdown(ErrPoolClosed)
 	p.lock.Unlock()
 
 	close(p.heartbeat)
@@ -1640,8 +1853,8 @@ func (p *Pool) ReleaseTimeout(timeout time.Duration) error {
 
 	select {
 	case <-done:
-		if p.name != "" {
-			namedPools.Delete(p.name)
+		if p.registered {
+			namedPools.CompareAndDelete(p.name, p)
 		}
 		return nil
 	case <-time.After(remaining):
@@ -1657,13 +1870,14 @@ func (p *Pool) Reboot() {
 
 	p.lock.Lock()
 	p.heartbeat = make(chan struct{})
+	p.shutdownCtx, p.shutdown = context.WithCancelCause(context.Background())
 	p.workers = newWorkerStack(int(p.config.MaxWorkers))
 	p.metrics.Reset()
 	p.createdAt = time.Now()
 
 	// Reinitialize priority queue if enabled
 	if p.config.EnablePriorityQueue {
-		p.priorityQueue = NewPriorityQueue(int(p.config.QueueSize))
+		p.priorityQueue = NewPriorityQueueWithAging(int(p.config.QueueSize), p.config.PriorityAging)
 	}
 
 	// Reinitialize work stealing scheduler if enabled
@@ -1685,7 +1899,7 @@ func (p *Pool) Reboot() {
 	}
 
 	// Re-register
-	if p.name != "" {
+	if p.registered {
 		namedPools.Store(p.name, p)
 	}
 }
@@ -1788,7 +2002,7 @@ func SetCap(cap int32) {
 }
 
 // SetPanicHandler sets the panic handler for the default pool
-func SetPanicHandler(handler func(any)) {
+func SetPanicHandler(handler func(recovered any, stack []byte)) {
 	initDefaultPool()
 	defaultPool.lock.Lock()
 	defer defaultPool.lock.Unlock()
@@ -2133,7 +2347,8 @@ func NewParallelExecutor(maxConcurrency int) *ParallelExecutor {
 	}
 }
 
-// Execute executes multiple tasks in parallel
+// Execute executes multiple tasks in parallel.
+// A panicking task yields a *errorx.PanicError in its slot.
 func (e *ParallelExecutor) Execute(ctx context.Context, tasks ...func() error) []error {
 	errs := make([]error, len(tasks))
 	var wg sync.WaitGroup
@@ -2152,6 +2367,7 @@ func (e *ParallelExecutor) Execute(ctx context.Context, tasks ...func() error) [
 				<-e.sem
 				wg.Done()
 			}()
+			defer catchPanic(&errs[idx])
 			errs[idx] = t()
 		}(i, task)
 	}
@@ -2171,11 +2387,20 @@ func (e *ParallelExecutor) ExecuteAll(ctx context.Context, tasks ...func() error
 	return nil
 }
 
+// catchPanic recovers a panic in the calling goroutine and stores it in err
+// as a *errorx.PanicError. It must be deferred directly.
+func catchPanic(err *error) {
+	if r := recover(); r != nil {
+		*err = errorx.NewPanicError(r)
+	}
+}
+
 // ============================================================================
 // Parallel Map/ForEach
 // ============================================================================
 
-// Map applies a function to each item in parallel
+// Map applies a function to each item in parallel.
+// A panic in fn is returned as a *errorx.PanicError.
 func Map[T, R any](ctx context.Context, items []T, maxConcurrency int, fn func(T) (R, error)) ([]R, error) {
 	if maxConcurrency <= 0 {
 		maxConcurrency = 1
@@ -2199,6 +2424,7 @@ func Map[T, R any](ctx context.Context, items []T, maxConcurrency int, fn func(T
 				<-sem
 				wg.Done()
 			}()
+			defer catchPanic(&errs[idx])
 			results[idx], errs[idx] = fn(it)
 		}(i, item)
 	}
@@ -2214,7 +2440,8 @@ func Map[T, R any](ctx context.Context, items []T, maxConcurrency int, fn func(T
 	return results, nil
 }
 
-// ForEach applies a function to each item in parallel
+// ForEach applies a function to each item in parallel.
+// A panic in fn is returned as a *errorx.PanicError.
 func ForEach[T any](ctx context.Context, items []T, maxConcurrency int, fn func(T) error) error {
 	if maxConcurrency <= 0 {
 		maxConcurrency = 1
@@ -2244,12 +2471,17 @@ func ForEach[T any](ctx context.Context, items []T, maxConcurrency int, fn func(
 				<-sem
 				wg.Done()
 			}()
-			if err := fn(it); err != nil {
-				select {
-				case errChan <- err:
-				default:
+			var err error
+			defer func() {
+				if err != nil {
+					select {
+					case errChan <- err:
+					default:
+					}
 				}
-			}
+			}()
+			defer catchPanic(&err)
+			err = fn(it)
 		}(item)
 	}
 
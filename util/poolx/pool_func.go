@@ -5,6 +5,8 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
 )
 
 // ============================================================================
@@ -608,13 +610,7 @@ func (w *workerFunc) execute(arg any) {
 	defer func() {
 		if r := recover(); r != nil {
 			w.pool.metrics.FailedTasks.Add(1)
-			if w.pool.config.PanicHandler != nil {
-				// 包装 panic handler 调用，防止它本身 panic
-				func() {
-					defer func() { recover() }()
-					w.pool.config.PanicHandler(r)
-				}()
-			}
+			callPanicHandler(w.pool.config.PanicHandler, errorx.NewPanicError(r))
 		}
 	}()
 
@@ -269,7 +269,7 @@ func TestPool_PanicRecovery(t *testing.T) {
 	p := New("test",
 		WithMaxWorkers(2),
 		WithAutoScale(false),
-		WithPanicHandler(func(v any) {
+		WithPanicHandler(func(v any, stack []byte) {
 			recovered.Store(true)
 		}),
 	)
@@ -1436,7 +1436,7 @@ func TestHooks_OnPanic(t *testing.T) {
 		WithMaxWorkers(2),
 		WithAutoScale(false),
 		WithHooks(hooks),
-		WithPanicHandler(func(v any) {}),
+		WithPanicHandler(func(v any, stack []byte) {}),
 	)
 	defer p.Release()
 
@@ -171,7 +171,9 @@ const (
 // PriorityTask represents a task with priority
 type PriorityTask struct {
 	fn        func()
+	task      *task // Pool task, set when queued by a Pool
 	priority  int
+	rank      int64 // Ordering key: priority, adjusted for aging when enabled
 	submitted time.Time
 	index     int // Index in the heap, managed by heap.Interface
 }
@@ -182,9 +184,9 @@ type priorityHeap []*PriorityTask
 func (h priorityHeap) Len() int { return len(h) }
 
 func (h priorityHeap) Less(i, j int) bool {
-	// Higher priority first
-	if h[i].priority != h[j].priority {
-		return h[i].priority > h[j].priority
+	// Higher rank first
+	if h[i].rank != h[j].rank {
+		return h[i].rank > h[j].rank
 	}
 	// Same priority: earlier submitted first (FIFO within priority)
 	return h[i].submitted.Before(h[j].submitted)
@@ -215,18 +217,30 @@ func (h *priorityHeap) Pop() any {
 
 // PriorityQueue is a thread-safe priority queue for tasks
 type PriorityQueue struct {
-	heap priorityHeap
-	lock sync.Mutex
-	cond *sync.Cond
-	cap  int
+	heap  priorityHeap
+	lock  sync.Mutex
+	cond  *sync.Cond
+	cap   int
+	aging time.Duration // Wait time that raises a task by one priority level (0 = no aging)
+	epoch time.Time     // Reference time for aging ranks
 }
 
 // NewPriorityQueue creates a new priority queue with optional capacity.
 // If cap <= 0, the queue is unbounded.
 func NewPriorityQueue(cap int) *PriorityQueue {
+	return NewPriorityQueueWithAging(cap, 0)
+}
+
+// NewPriorityQueueWithAging creates a priority queue that avoids starvation:
+// every aging interval a task spends in the queue counts as one extra priority
+// level, so low-priority tasks eventually run ahead of newer high-priority ones.
+// If aging <= 0, tasks are ordered strictly by priority.
+func NewPriorityQueueWithAging(cap int, aging time.Duration) *PriorityQueue {
 	pq := &PriorityQueue{
-		heap: make(priorityHeap, 0),
-		cap:  cap,
+		heap:  make(priorityHeap, 0),
+		cap:   cap,
+		aging: max(aging, 0),
+		epoch: time.Now(),
 	}
 	pq.cond = sync.NewCond(&pq.lock)
 	heap.Init(&pq.heap)
@@ -236,6 +250,15 @@ func NewPriorityQueue(cap int) *PriorityQueue {
 // Push adds a task to the queue with the given priority.
 // Returns false if the queue is full (when bounded).
 func (pq *PriorityQueue) Push(fn func(), priority int) bool {
+	return pq.push(&PriorityTask{fn: fn, priority: priority})
+}
+
+// pushTask queues a pool task using its priority.
+func (pq *PriorityQueue) pushTask(t *task) bool {
+	return pq.push(&PriorityTask{fn: t.fn, task: t, priority: t.priority})
+}
+
+func (pq *PriorityQueue) push(pt *PriorityTask) bool {
 	pq.lock.Lock()
 	defer pq.lock.Unlock()
 
@@ -243,16 +266,31 @@ func (pq *PriorityQueue) Push(fn func(), priority int) bool {
 		return false
 	}
 
-	task := &PriorityTask{
-		fn:        fn,
-		priority:  priority,
-		submitted: time.Now(),
+	pt.submitted = time.Now()
+	pt.rank = int64(pt.priority)
+	if pq.aging > 0 {
+		// A task waiting one aging interval ranks equal to a task one level
+		// higher submitted now. The difference between two ranks does not
+		// change over time, so the heap order stays valid without reordering.
+		pt.rank = pt.rank*int64(pq.aging) - int64(pt.submitted.Sub(pq.epoch))
 	}
-	heap.Push(&pq.heap, task)
+	heap.Push(&pq.heap, pt)
 	pq.cond.Signal()
 	return true
 }
 
+// popTask removes and returns the highest ranked pool task.
+// Returns nil if the queue is empty.
+func (pq *PriorityQueue) popTask() *task {
+	pq.lock.Lock()
+	defer pq.lock.Unlock()
+
+	if len(pq.heap) == 0 {
+		return nil
+	}
+	return heap.Pop(&pq.heap).(*PriorityTask).task
+}
+
 // Pop removes and returns the highest priority task.
 // Returns nil if the queue is empty.
 func (pq *PriorityQueue) Pop() func() {
@@ -189,7 +189,7 @@ func (s *AutoScaler) calculateLoad() float64 {
 	// This gives us a sense of how busy the pool is
 	idle := int32(s.pool.workers.size())
 	active := running - idle
-	queued := s.pool.metrics.BlockingTasks.Load()
+	queued := s.pool.Waiting()
 
 	// Use atomic maxWorkers for thread-safe access
 	maxWorkers := s.pool.maxWorkers.Load()
@@ -5,11 +5,13 @@ import (
 	"errors"
 	"sync"
 	"time"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
 )
 
 var (
 	// ErrRateLimitExceeded 超过速率限制
-	ErrRateLimitExceeded = errors.New("rate limit exceeded")
+	ErrRateLimitExceeded = errorx.NewSentinel(errorx.CategoryRateLimited, "rate limit exceeded")
 	// ErrInsufficientTokens Token 不足
 	ErrInsufficientTokens = errors.New("insufficient tokens")
 )
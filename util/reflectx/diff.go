@@ -0,0 +1,184 @@
+package reflectx
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// FieldChange 字段变更
+type FieldChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// diffOptions Diff 的配置
+type diffOptions struct {
+	tagName string          // 用于生成字段路径的标签名
+	ignore  map[string]bool // 忽略的字段
+	equal   []EqualOption   // 叶子字段的比较选项
+}
+
+// DiffOption Diff 选项函数
+type DiffOption func(*diffOptions)
+
+// WithDiffTag 使用指定标签名生成字段路径，默认 "json"，为空时使用字段名
+func WithDiffTag(tag string) DiffOption {
+	return func(o *diffOptions) {
+		o.tagName = tag
+	}
+}
+
+// WithDiffIgnore 忽略指定字段
+//
+// 可以是字段名、标签名，或由它们组成的字段路径（如 "updated_at"、"Address.Zip"）
+func WithDiffIgnore(fields ...string) DiffOption {
+	return func(o *diffOptions) {
+		if o.ignore == nil {
+			o.ignore = make(map[string]bool, len(fields))
+		}
+		for _, f := range fields {
+			o.ignore[f] = true
+		}
+	}
+}
+
+// WithDiffEqual 设置叶子字段的比较选项，如浮点误差、自定义比较函数
+func WithDiffEqual(opts ...EqualOption) DiffOption {
+	return func(o *diffOptions) {
+		o.equal = append(o.equal, opts...)
+	}
+}
+
+// Diff 比较同类型结构体的两个值，返回发生变化的字段
+//
+// 嵌套结构体（含结构体指针）逐字段展开，路径以 "." 连接；
+// 切片、map 以及没有导出字段的结构体（如 time.Time）作为整体比较。
+// 只比较导出字段，标签为 "-" 的字段被跳过，匿名嵌入的结构体字段会被提升。
+//
+// 参数:
+//   - old: 旧值，结构体或结构体指针
+//   - new: 新值，类型必须与 old 相同
+//   - opts: 选项
+//
+// 返回:
+//   - map[string]FieldChange: 字段路径到变更的映射，没有变化时为空 map
+//   - error: 类型不同或不是结构体时返回错误
+//
+// 示例:
+//
+//	changes, _ := reflectx.Diff(before, after, reflectx.WithDiffIgnore("updated_at"))
+//	// map[string]FieldChange{"name": {Old: "Alice", New: "Alicia"}, "address.city": {...}}
+func Diff(old, new any, opts ...DiffOption) (map[string]FieldChange, error) {
+	o := &diffOptions{tagName: "json"}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	ov, nv := reflect.ValueOf(old), reflect.ValueOf(new)
+	if !ov.IsValid() || !nv.IsValid() || ov.Type() != nv.Type() {
+		return nil, fmt.Errorf("old and new must be values of the same type")
+	}
+	for ov.Kind() == reflect.Pointer {
+		if ov.IsNil() || nv.IsNil() {
+			return nil, fmt.Errorf("old and new must be non-nil")
+		}
+		ov, nv = ov.Elem(), nv.Elem()
+	}
+	if ov.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("old and new must be structs, got %v", ov.Type())
+	}
+
+	changes := make(map[string]FieldChange)
+	o.diffStruct(ov, nv, "", "", changes)
+	return changes, nil
+}
+
+// diffStruct 逐字段比较结构体，path 为输出路径，goPath 为字段名路径
+func (o *diffOptions) diffStruct(ov, nv reflect.Value, path, goPath string, changes map[string]FieldChange) {
+	t := ov.Type()
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() && !f.Anonymous {
+			continue
+		}
+
+		name, tagged, skip := o.fieldName(f)
+		if skip {
+			continue
+		}
+		fo, fn := ov.Field(i), nv.Field(i)
+
+		// 无标签名的匿名嵌入结构体，字段提升到当前层级
+		if f.Anonymous && !tagged {
+			if fo, fn, ok := derefPair(fo, fn); ok && fo.Kind() == reflect.Struct {
+				o.diffStruct(fo, fn, path, goPath, changes)
+				continue
+			}
+			if !f.IsExported() {
+				continue
+			}
+		}
+
+		fp, gp := joinPath(path, name), joinPath(goPath, f.Name)
+		if o.ignore[fp] || o.ignore[gp] || o.ignore[name] || o.ignore[f.Name] {
+			continue
+		}
+		o.diffValue(fo, fn, fp, gp, changes)
+	}
+}
+
+// diffValue 比较单个字段，可展开的结构体继续递归，其余作为叶子比较
+func (o *diffOptions) diffValue(ov, nv reflect.Value, path, goPath string, changes map[string]FieldChange) {
+	if do, dn, ok := derefPair(ov, nv); ok && do.Kind() == reflect.Struct && hasExportedField(do.Type()) {
+		o.diffStruct(do, dn, path, goPath, changes)
+		return
+	}
+
+	oi, ni := ov.Interface(), nv.Interface()
+	if !DeepEqual(oi, ni, o.equal...) {
+		changes[path] = FieldChange{Old: oi, New: ni}
+	}
+}
+
+// fieldName 获取字段在路径中的名称
+//
+// 返回:
+//   - string: 名称
+//   - bool: 是否来自标签
+//   - bool: 是否跳过该字段
+func (o *diffOptions) fieldName(f reflect.StructField) (string, bool, bool) {
+	if o.tagName == "" {
+		return f.Name, false, false
+	}
+	tag, _, _ := strings.Cut(f.Tag.Get(o.tagName), ",")
+	switch tag {
+	case "-":
+		return "", false, true
+	case "":
+		return f.Name, false, false
+	default:
+		return tag, true, false
+	}
+}
+
+// derefPair 同时解引用两个指针，任意一个为 nil 时返回 false
+func derefPair(a, b reflect.Value) (reflect.Value, reflect.Value, bool) {
+	for a.Kind() == reflect.Pointer {
+		if a.IsNil() || b.IsNil() {
+			return a, b, false
+		}
+		a, b = a.Elem(), b.Elem()
+	}
+	return a, b, true
+}
+
+// hasExportedField 检查结构体类型是否有导出字段
+func hasExportedField(t reflect.Type) bool {
+	for i := range t.NumField() {
+		if t.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
@@ -3,11 +3,19 @@
 // 主要功能:
 //   - StructToMap: 将结构体转换为 map
 //   - MapToStruct: 将 map 转换为结构体
-//   - GetField: 获取结构体字段值
-//   - SetField: 设置结构体字段值
+//   - MapperOf/MapperFor: 按类型缓存字段信息的映射器，StructToMap/MapToStruct 内部同样使用
+//   - Getter/Setter: 按字段偏移量生成的类型安全读写函数，用于热路径
+//   - GetField: 获取结构体字段值，支持 "Address.City"、"Items[2].Price" 路径
+//   - SetField: 设置结构体字段值，路径上的 nil 指针自动分配
 //   - DeepCopy: 深度拷贝
+//   - DeepEqual/FirstDiff: 带选项的深度比较（忽略字段、浮点误差、nil 与空相等、自定义比较），可返回第一个不同的路径
+//   - Diff: 返回结构体变更字段（路径 → 旧值/新值），默认使用 json 标签，支持忽略列表
+//   - Walk: 深度优先遍历结构体/切片/map，visitor 获得路径、值和字段标签，可修改值或跳过子树，用于脱敏、默认值填充等
 //   - IsZero: 检查值是否为零值
 //   - IsNil: 检查值是否为 nil
+//   - IsDeepZero: 深度检查零值（指针指向零值、空切片/map 也视为零值）
+//   - Zero/ZeroOf: 获取类型的零值
+//   - TypeName/QualifiedTypeName/PkgPath: 类型名称与包路径，可用于序列化类型标识和依赖注入 key
 //
 // 示例:
 //
@@ -39,11 +47,19 @@
 // Main features:
 //   - StructToMap: convert a struct to a map
 //   - MapToStruct: convert a map to a struct
-//   - GetField: get a struct field value
-//   - SetField: set a struct field value
+//   - MapperOf/MapperFor: mappers with per-type cached field metadata, also used by StructToMap/MapToStruct
+//   - Getter/Setter: type-safe accessors compiled from field offsets, for hot paths
+//   - GetField: get a struct field value, accepting paths like "Address.City" and "Items[2].Price"
+//   - SetField: set a struct field value, allocating nil pointers along the path
 //   - DeepCopy: deep copy a value
+//   - DeepEqual/FirstDiff: options-driven deep equality (ignored fields, float tolerance, nil equals empty, custom comparers) reporting the first differing path
+//   - Diff: changed struct fields (path → old/new value), json tags by default, with ignore lists
+//   - Walk: depth-first traversal of structs/slices/maps; the visitor gets the path, value and field tag and may mutate values or skip subtrees, for redaction, scrubbing and defaulting
 //   - IsZero: check if a value is the zero value
 //   - IsNil: check if a value is nil
+//   - IsDeepZero: deep zero check (pointers to zero values and empty slices/maps count as zero)
+//   - Zero/ZeroOf: get the zero value of a type
+//   - TypeName/QualifiedTypeName/PkgPath: type names and package paths, usable as serializer type IDs or DI keys
 //
 // Examples:
 //
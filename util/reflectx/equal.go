@@ -0,0 +1,319 @@
+package reflectx
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"unsafe"
+)
+
+// equalOptions DeepEqual 的配置
+type equalOptions struct {
+	ignore           map[string]bool                      // 忽略的字段名或字段路径
+	ignoreUnexported bool                                 // 是否忽略未导出字段
+	floatTolerance   float64                              // 浮点数允许的误差
+	nilEqualsEmpty   bool                                 // nil 与空切片/map 是否视为相等
+	comparers        map[reflect.Type]func(a, b any) bool // 按类型自定义比较
+}
+
+// EqualOption DeepEqual 选项函数
+type EqualOption func(*equalOptions)
+
+// WithIgnoreFields 忽略指定字段
+//
+// 既可以是字段名（任意层级的同名字段都忽略），也可以是不含下标的字段路径
+//
+// 示例:
+//
+//	reflectx.DeepEqual(a, b, reflectx.WithIgnoreFields("UpdatedAt", "Items.Version"))
+func WithIgnoreFields(fields ...string) EqualOption {
+	return func(o *equalOptions) {
+		if o.ignore == nil {
+			o.ignore = make(map[string]bool, len(fields))
+		}
+		for _, f := range fields {
+			o.ignore[f] = true
+		}
+	}
+}
+
+// WithIgnoreUnexported 忽略未导出字段，默认会比较未导出字段
+func WithIgnoreUnexported() EqualOption {
+	return func(o *equalOptions) {
+		o.ignoreUnexported = true
+	}
+}
+
+// WithFloatTolerance 设置浮点数（含复数的实部和虚部）比较允许的误差
+func WithFloatTolerance(epsilon float64) EqualOption {
+	return func(o *equalOptions) {
+		o.floatTolerance = math.Abs(epsilon)
+	}
+}
+
+// WithNilEqualsEmpty 将 nil 切片/map 与空切片/map 视为相等
+func WithNilEqualsEmpty() EqualOption {
+	return func(o *equalOptions) {
+		o.nilEqualsEmpty = true
+	}
+}
+
+// WithComparer 为类型 T 指定比较函数，替代默认的逐字段比较
+//
+// 对未导出字段同样生效，适合通过访问方法比较内部状态不可直接比较的类型
+//
+// 示例:
+//
+//	reflectx.DeepEqual(a, b, reflectx.WithComparer(func(x, y time.Time) bool {
+//	    return x.Equal(y)
+//	}))
+func WithComparer[T any](fn func(a, b T) bool) EqualOption {
+	return func(o *equalOptions) {
+		if o.comparers == nil {
+			o.comparers = make(map[reflect.Type]func(a, b any) bool)
+		}
+		o.comparers[reflect.TypeFor[T]()] = func(a, b any) bool {
+			return fn(a.(T), b.(T))
+		}
+	}
+}
+
+// DeepEqual 按选项深度比较两个值
+//
+// 不带选项时语义与 reflect.DeepEqual 一致，支持循环引用
+//
+// 参数:
+//   - a, b: 要比较的值
+//   - opts: 比较选项
+//
+// 返回:
+//   - bool: 是否相等
+//
+// 示例:
+//
+//	reflectx.DeepEqual(got, want,
+//	    reflectx.WithIgnoreFields("ID", "CreatedAt"),
+//	    reflectx.WithFloatTolerance(1e-9),
+//	    reflectx.WithNilEqualsEmpty(),
+//	)
+func DeepEqual(a, b any, opts ...EqualOption) bool {
+	_, differ := FirstDiff(a, b, opts...)
+	return !differ
+}
+
+// FirstDiff 按选项深度比较两个值，返回第一个不同的位置
+//
+// 结构体字段、切片下标和 map key 按确定顺序遍历，因此结果稳定
+//
+// 返回:
+//   - string: 不同的路径，如 "Items[2].Price"、"Meta[region]"，根值不同时为空字符串
+//   - bool: 是否存在不同
+//
+// 示例:
+//
+//	if path, differ := reflectx.FirstDiff(got, want); differ {
+//	    t.Errorf("mismatch at %s", path)
+//	}
+func FirstDiff(a, b any, opts ...EqualOption) (string, bool) {
+	o := &equalOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !va.IsValid() || !vb.IsValid() {
+		return "", va.IsValid() != vb.IsValid()
+	}
+	if va.Type() != vb.Type() {
+		return "", true
+	}
+
+	// 复制到可寻址的值，使未导出字段也能交给自定义比较函数
+	pa, pb := reflect.New(va.Type()).Elem(), reflect.New(vb.Type()).Elem()
+	pa.Set(va)
+	pb.Set(vb)
+
+	c := &equalState{opts: o, visited: make(map[visit]bool)}
+	return c.diff(pa, pb, "", "")
+}
+
+// visit 已比较过的引用对，用于检测循环引用
+type visit struct {
+	a, b unsafe.Pointer
+	typ  reflect.Type
+}
+
+// equalState 一次比较过程的状态
+type equalState struct {
+	opts    *equalOptions
+	visited map[visit]bool
+}
+
+// diff 递归比较，path 为带下标的完整路径，fieldPath 为仅含字段名的路径（用于匹配忽略规则）
+func (s *equalState) diff(a, b reflect.Value, path, fieldPath string) (string, bool) {
+	a, b = exported(a), exported(b)
+
+	if fn, ok := s.opts.comparers[a.Type()]; ok && a.CanInterface() && b.CanInterface() {
+		return path, !fn(a.Interface(), b.Interface())
+	}
+
+	// 引用类型的循环检测
+	switch a.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if !a.IsNil() && !b.IsNil() {
+			v := visit{a.UnsafePointer(), b.UnsafePointer(), a.Type()}
+			if s.visited[v] {
+				return "", false
+			}
+			s.visited[v] = true
+		}
+	}
+
+	switch a.Kind() {
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			return path, a.IsNil() != b.IsNil()
+		}
+		return s.diff(a.Elem(), b.Elem(), path, fieldPath)
+
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return path, a.IsNil() != b.IsNil()
+		}
+		ea, eb := addressable(a.Elem()), addressable(b.Elem())
+		if ea.Type() != eb.Type() {
+			return path, true
+		}
+		return s.diff(ea, eb, path, fieldPath)
+
+	case reflect.Struct:
+		t := a.Type()
+		for i := range t.NumField() {
+			f := t.Field(i)
+			fp := joinPath(fieldPath, f.Name)
+			if s.opts.ignore[f.Name] || s.opts.ignore[fp] || (s.opts.ignoreUnexported && !f.IsExported()) {
+				continue
+			}
+			if p, differ := s.diff(a.Field(i), b.Field(i), joinPath(path, f.Name), fp); differ {
+				return p, true
+			}
+		}
+		return "", false
+
+	case reflect.Slice:
+		if a.IsNil() != b.IsNil() && !(s.opts.nilEqualsEmpty && a.Len() == 0 && b.Len() == 0) {
+			return path, true
+		}
+		return s.diffElems(a, b, path, fieldPath)
+
+	case reflect.Array:
+		return s.diffElems(a, b, path, fieldPath)
+
+	case reflect.Map:
+		if a.IsNil() != b.IsNil() && !(s.opts.nilEqualsEmpty && a.Len() == 0 && b.Len() == 0) {
+			return path, true
+		}
+		if a.Len() != b.Len() {
+			return path, true
+		}
+		keys := a.MapKeys()
+		slices.SortFunc(keys, func(x, y reflect.Value) int {
+			return cmp.Compare(formatKey(x), formatKey(y))
+		})
+		for _, k := range keys {
+			kp := path + "[" + formatKey(k) + "]"
+			vb := b.MapIndex(k)
+			if !vb.IsValid() {
+				return kp, true
+			}
+			if p, differ := s.diff(addressable(a.MapIndex(k)), addressable(vb), kp, fieldPath); differ {
+				return p, true
+			}
+		}
+		return "", false
+
+	case reflect.Float32, reflect.Float64:
+		return path, !floatEqual(a.Float(), b.Float(), s.opts.floatTolerance)
+
+	case reflect.Complex64, reflect.Complex128:
+		x, y := a.Complex(), b.Complex()
+		tol := s.opts.floatTolerance
+		return path, !floatEqual(real(x), real(y), tol) || !floatEqual(imag(x), imag(y), tol)
+
+	case reflect.Bool:
+		return path, a.Bool() != b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return path, a.Int() != b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return path, a.Uint() != b.Uint()
+	case reflect.String:
+		return path, a.String() != b.String()
+
+	case reflect.Func:
+		// 与 reflect.DeepEqual 一致：只有都为 nil 时相等
+		return path, !a.IsNil() || !b.IsNil()
+
+	default:
+		// Chan、UnsafePointer 按地址比较
+		return path, a.Pointer() != b.Pointer()
+	}
+}
+
+// diffElems 逐个比较切片或数组元素
+func (s *equalState) diffElems(a, b reflect.Value, path, fieldPath string) (string, bool) {
+	if a.Len() != b.Len() {
+		return path, true
+	}
+	for i := range a.Len() {
+		if p, differ := s.diff(a.Index(i), b.Index(i), path+"["+strconv.Itoa(i)+"]", fieldPath); differ {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+// exported 将通过未导出字段获取的可寻址值转换为可 Interface 的值
+func exported(v reflect.Value) reflect.Value {
+	if v.CanInterface() || !v.CanAddr() {
+		return v
+	}
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+}
+
+// addressable 将不可寻址的值复制为可寻址的值，使其未导出字段也能被 exported 转换
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() || !v.CanInterface() {
+		return v
+	}
+	p := reflect.New(v.Type()).Elem()
+	p.Set(v)
+	return p
+}
+
+// floatEqual 在误差范围内比较浮点数
+func floatEqual(x, y, tolerance float64) bool {
+	if x == y {
+		return true
+	}
+	return tolerance > 0 && math.Abs(x-y) <= tolerance
+}
+
+// formatKey 格式化 map key 用于路径和排序
+func formatKey(k reflect.Value) string {
+	k = exported(k)
+	if k.CanInterface() {
+		return fmt.Sprint(k.Interface())
+	}
+	return k.String()
+}
+
+// joinPath 拼接字段路径
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,263 @@
+package reflectx
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// mapperKey 映射器缓存的 key
+type mapperKey struct {
+	typ     reflect.Type
+	tagName string
+}
+
+// mapperCache 按类型和标签名缓存的映射器
+var mapperCache sync.Map // map[mapperKey]*StructMapper
+
+// mapperField 缓存的字段信息
+type mapperField struct {
+	name     string // 字段名
+	key      string // map 中使用的 key
+	lowerKey string // 小写 key，用于大小写不敏感匹配
+	index    int    // 字段下标
+}
+
+// StructMapper 预编译的结构体映射器
+//
+// 字段信息（名称、标签、下标）在首次使用时解析并按类型缓存，
+// 之后的 ToMap/FromMap 不再重复解析类型。并发安全。
+type StructMapper struct {
+	typ    reflect.Type
+	fields []mapperField
+}
+
+// MapperOf 获取类型的缓存映射器
+//
+// 参数:
+//   - t: 结构体类型或结构体指针类型
+//   - tagName: 用于确定 map key 的标签名，为空时使用字段名
+//
+// 返回:
+//   - *StructMapper: 映射器，同一类型和标签名返回同一实例
+//   - error: t 不是结构体时返回错误
+//
+// 示例:
+//
+//	m, _ := reflectx.MapperOf(reflect.TypeFor[User](), "json")
+//	data := m.ToMap(user)
+func MapperOf(t reflect.Type, tagName string) (*StructMapper, error) {
+	if t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("type %v is not a struct", t)
+	}
+
+	key := mapperKey{typ: t, tagName: tagName}
+	if m, ok := mapperCache.Load(key); ok {
+		return m.(*StructMapper), nil
+	}
+	m, _ := mapperCache.LoadOrStore(key, newStructMapper(t, tagName))
+	return m.(*StructMapper), nil
+}
+
+// MapperFor 获取类型 T 的缓存映射器（泛型版本）
+//
+// 示例:
+//
+//	var userMapper = reflectx.MapperFor[User]("json")
+//
+//	data := userMapper.ToMap(user)
+//	err := userMapper.FromMap(data, &user)
+func MapperFor[T any](tagName string) *StructMapper {
+	m, err := MapperOf(reflect.TypeFor[T](), tagName)
+	if err != nil {
+		panic("reflectx: " + err.Error())
+	}
+	return m
+}
+
+// newStructMapper 解析结构体类型，规则与 StructToMapWithTag 一致
+func newStructMapper(t reflect.Type, tagName string) *StructMapper {
+	m := &StructMapper{typ: t}
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		key := field.Name
+		if tagName != "" {
+			tag, _, _ := strings.Cut(field.Tag.Get(tagName), ",")
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				key = tag
+			}
+		}
+
+		m.fields = append(m.fields, mapperField{
+			name:     field.Name,
+			key:      key,
+			lowerKey: strings.ToLower(key),
+			index:    i,
+		})
+	}
+	return m
+}
+
+// Type 返回映射器对应的结构体类型
+func (m *StructMapper) Type() reflect.Type {
+	return m.typ
+}
+
+// Keys 返回所有字段对应的 map key，按字段定义顺序
+func (m *StructMapper) Keys() []string {
+	keys := make([]string, len(m.fields))
+	for i, f := range m.fields {
+		keys[i] = f.key
+	}
+	return keys
+}
+
+// ToMap 将结构体转换为 map，语义与 StructToMapWithTag 一致
+//
+// 参数:
+//   - v: 结构体或结构体指针，类型必须与映射器一致
+//
+// 返回:
+//   - map[string]any: 类型不匹配或 v 为 nil 指针时返回 nil
+func (m *StructMapper) ToMap(v any) map[string]any {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Type() != m.typ {
+		return nil
+	}
+
+	result := make(map[string]any, len(m.fields))
+	for _, f := range m.fields {
+		result[f.key] = rv.Field(f.index).Interface()
+	}
+	return result
+}
+
+// FromMap 将 map 中的值设置到结构体，语义与 MapToStructWithTag 一致
+//
+// key 优先精确匹配，其次大小写不敏感匹配；map 中不存在的字段保持不变
+//
+// 参数:
+//   - data: 源 map
+//   - v: 目标结构体指针，类型必须与映射器一致
+//
+// 返回:
+//   - error: 转换错误
+func (m *StructMapper) FromMap(data map[string]any, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("v must be a non-nil pointer to struct")
+	}
+	rv = rv.Elem()
+	if rv.Type() != m.typ {
+		return fmt.Errorf("v must be a pointer to %v", m.typ)
+	}
+
+	var folded map[string]any // 小写 key -> 值，首次精确匹配失败时构建
+	for _, f := range m.fields {
+		value, ok := data[f.key]
+		if !ok {
+			if folded == nil {
+				folded = make(map[string]any, len(data))
+				for k, v := range data {
+					folded[strings.ToLower(k)] = v
+				}
+			}
+			if value, ok = folded[f.lowerKey]; !ok {
+				continue
+			}
+		}
+		if err := setFieldValue(rv.Field(f.index), value); err != nil {
+			return fmt.Errorf("field %s: %w", f.name, err)
+		}
+	}
+	return nil
+}
+
+// fieldOffset 查找字段并校验类型，返回相对结构体起始地址的偏移量
+//
+// 只支持不经过嵌入指针的导出字段（含提升字段）
+func fieldOffset[S, F any](name string) (uintptr, error) {
+	st := reflect.TypeFor[S]()
+	if st.Kind() != reflect.Struct {
+		return 0, fmt.Errorf("type %v is not a struct", st)
+	}
+	sf, ok := st.FieldByName(name)
+	if !ok || !sf.IsExported() {
+		return 0, fmt.Errorf("field %s not found in %v", name, st)
+	}
+	if ft := reflect.TypeFor[F](); sf.Type != ft {
+		return 0, fmt.Errorf("field %s is %v, not %v", name, sf.Type, ft)
+	}
+
+	var offset uintptr
+	t := st
+	for _, i := range sf.Index {
+		if t.Kind() != reflect.Struct {
+			return 0, fmt.Errorf("field %s is promoted through embedded pointer", name)
+		}
+		f := t.Field(i)
+		offset += f.Offset
+		t = f.Type
+	}
+	return offset, nil
+}
+
+// Getter 生成字段的类型安全读取函数
+//
+// 字段偏移量在生成时计算一次，调用时直接读取内存，不再经过反射，
+// 适合在热路径上按名称反复读取同一字段。
+//
+// 参数:
+//   - name: 字段名（支持非指针嵌入的提升字段）
+//
+// 返回:
+//   - func(*S) F: 读取函数，传入 nil 会 panic
+//   - error: 字段不存在、未导出或类型不是 F 时返回错误
+//
+// 示例:
+//
+//	getAge, err := reflectx.Getter[User, int]("Age")
+//	age := getAge(&user)
+func Getter[S, F any](name string) (func(*S) F, error) {
+	offset, err := fieldOffset[S, F](name)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *S) F {
+		return *(*F)(unsafe.Add(unsafe.Pointer(s), offset))
+	}, nil
+}
+
+// Setter 生成字段的类型安全写入函数，规则同 Getter
+//
+// 示例:
+//
+//	setAge, err := reflectx.Setter[User, int]("Age")
+//	setAge(&user, 21)
+func Setter[S, F any](name string) (func(*S, F), error) {
+	offset, err := fieldOffset[S, F](name)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *S, v F) {
+		*(*F)(unsafe.Add(unsafe.Pointer(s), offset)) = v
+	}, nil
+}
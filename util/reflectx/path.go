@@ -0,0 +1,148 @@
+package reflectx
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// pathSegment 字段路径中的一段：字段名或切片/数组下标
+type pathSegment struct {
+	name  string
+	index int
+	isIdx bool
+}
+
+// String 返回该段在路径中的写法
+func (s pathSegment) String() string {
+	if s.isIdx {
+		return "[" + strconv.Itoa(s.index) + "]"
+	}
+	return s.name
+}
+
+// parseFieldPath 解析字段路径，如 "Address.City"、"Items[2].Price"
+func parseFieldPath(path string) ([]pathSegment, error) {
+	if path == "" {
+		return nil, fmt.Errorf("empty field path")
+	}
+
+	var segs []pathSegment
+	for part := range strings.SplitSeq(path, ".") {
+		name, rest, _ := strings.Cut(part, "[")
+		if name == "" && (len(segs) == 0 || rest == "") {
+			return nil, fmt.Errorf("invalid field path %q", path)
+		}
+		if name != "" {
+			segs = append(segs, pathSegment{name: name})
+		}
+		for rest != "" {
+			idx, after, ok := strings.Cut(rest, "]")
+			if !ok {
+				return nil, fmt.Errorf("invalid field path %q: missing ]", path)
+			}
+			i, err := strconv.Atoi(idx)
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("invalid field path %q: bad index %q", path, idx)
+			}
+			segs = append(segs, pathSegment{index: i, isIdx: true})
+			if after == "" {
+				break
+			}
+			if after[0] != '[' {
+				return nil, fmt.Errorf("invalid field path %q", path)
+			}
+			rest = after[1:]
+		}
+	}
+	return segs, nil
+}
+
+// allocPlan 记录路径解析过程中待挂接的指针分配
+//
+// 解析时 nil 指针只分配新对象、不写回原位置，整条路径校验通过后再 commit，
+// 保证路径无效时目标不被修改
+type allocPlan []func()
+
+// commit 将分配的对象依次写回路径上的 nil 指针
+func (p allocPlan) commit() {
+	for _, set := range p {
+		set()
+	}
+}
+
+// resolvePath 沿路径定位字段
+//
+// plan 非 nil 时为路径上的 nil 指针分配内存（rv 必须可寻址），
+// 分配结果记录在 plan 中，由调用方在确认成功后 commit
+func resolvePath(rv reflect.Value, path string, plan *allocPlan) (reflect.Value, error) {
+	segs, err := parseFieldPath(path)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	walked := ""
+	for _, seg := range segs {
+		rv, err = indirectValue(rv, plan)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("field %s: %w", walked, err)
+		}
+
+		if seg.isIdx {
+			walked += seg.String()
+			if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+				return reflect.Value{}, fmt.Errorf("field %s is not a slice or array", walked)
+			}
+			if seg.index >= rv.Len() {
+				return reflect.Value{}, fmt.Errorf("field %s: index out of range (len %d)", walked, rv.Len())
+			}
+			rv = rv.Index(seg.index)
+			continue
+		}
+
+		walked = joinPath(walked, seg.name)
+		if rv.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("field %s not found", walked)
+		}
+		sf, ok := rv.Type().FieldByName(seg.name)
+		if !ok || !sf.IsExported() {
+			return reflect.Value{}, fmt.Errorf("field %s not found", walked)
+		}
+		rv, err = fieldByIndex(rv, sf.Index, plan)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("field %s: %w", walked, err)
+		}
+	}
+	return rv, nil
+}
+
+// indirectValue 解引用指针和接口
+func indirectValue(rv reflect.Value, plan *allocPlan) (reflect.Value, error) {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			if plan == nil || rv.Kind() != reflect.Pointer || !rv.CanSet() {
+				return reflect.Value{}, fmt.Errorf("nil %s", rv.Kind())
+			}
+			ptr, target := reflect.New(rv.Type().Elem()), rv
+			*plan = append(*plan, func() { target.Set(ptr) })
+			rv = ptr
+		}
+		rv = rv.Elem()
+	}
+	return rv, nil
+}
+
+// fieldByIndex 按索引链获取字段，支持经过嵌入指针的提升字段
+func fieldByIndex(rv reflect.Value, index []int, plan *allocPlan) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 {
+			var err error
+			if rv, err = indirectValue(rv, plan); err != nil {
+				return reflect.Value{}, fmt.Errorf("embedded %w", err)
+			}
+		}
+		rv = rv.Field(x)
+	}
+	return rv, nil
+}
@@ -1,7 +1,11 @@
 package reflectx
 
 import (
+	"io"
+	"reflect"
 	"testing"
+	"time"
+	"unsafe"
 )
 
 // ========== util.go 测试 ==========
@@ -102,6 +106,121 @@ func TestFullTypeName(t *testing.T) {
 	}
 }
 
+func TestIsDeepZero(t *testing.T) {
+	type Inner struct {
+		N    int
+		Tags []string
+	}
+	type Outer struct {
+		Name  string
+		Inner *Inner
+		Items map[string]int
+		Arr   [2]int
+		Any   any
+	}
+	type Node struct {
+		Val  int
+		Next *Node
+	}
+	cyclic := &Node{}
+	cyclic.Next = cyclic
+
+	tests := []struct {
+		name     string
+		value    any
+		expected bool
+	}{
+		{"nil", nil, true},
+		{"zero int", 0, true},
+		{"non-zero int", 1, false},
+		{"empty slice", []int{}, true},
+		{"non-empty slice", []int{0}, false},
+		{"empty map", map[string]int{}, true},
+		{"pointer to zero struct", &Outer{}, true},
+		{"nested empty", Outer{Inner: &Inner{Tags: []string{}}, Items: map[string]int{}}, true},
+		{"nested non-zero", Outer{Inner: &Inner{N: 1}}, false},
+		{"array non-zero", Outer{Arr: [2]int{0, 1}}, false},
+		{"interface holding zero", Outer{Any: 0}, true},
+		{"cyclic zero", cyclic, true},
+		{"cyclic non-zero", &Node{Val: 1, Next: cyclic}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsDeepZero(tt.value); got != tt.expected {
+				t.Errorf("IsDeepZero(%v) = %v, want %v", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsNil_UnsafePointer(t *testing.T) {
+	if !IsNil(unsafe.Pointer(nil)) {
+		t.Error("IsNil(unsafe.Pointer(nil)) should be true")
+	}
+	x := 1
+	if IsNil(unsafe.Pointer(&x)) {
+		t.Error("IsNil(unsafe.Pointer(&x)) should be false")
+	}
+}
+
+func TestZero(t *testing.T) {
+	type User struct{ Name string }
+
+	if Zero[int]() != 0 || Zero[string]() != "" || Zero[*User]() != nil {
+		t.Error("Zero returned a non-zero value")
+	}
+	if got := ZeroOf(User{Name: "Alice"}); got != (User{}) {
+		t.Errorf("ZeroOf(User) = %v, want User{}", got)
+	}
+	if got := ZeroOf(42); got != 0 {
+		t.Errorf("ZeroOf(42) = %v, want 0", got)
+	}
+	if ZeroOf(nil) != nil {
+		t.Error("ZeroOf(nil) should be nil")
+	}
+}
+
+type namedPtr *int
+
+func TestTypeNameHelpers(t *testing.T) {
+	type User struct{ Name string }
+	pkg := "github.com/hexagon-codes/toolkit/util/reflectx"
+
+	if got := TypeName([]int{}); got != "[]int" {
+		t.Errorf("TypeName([]int) = %q, want []int", got)
+	}
+	if got := TypeName(new(*User)); got != "**User" {
+		t.Errorf("TypeName(**User) = %q, want **User", got)
+	}
+	if got := TypeName(namedPtr(nil)); got != "namedPtr" {
+		t.Errorf("TypeName(namedPtr) = %q, want namedPtr", got)
+	}
+	if got := TypeNameOf[error](); got != "error" {
+		t.Errorf("TypeNameOf[error]() = %q, want error", got)
+	}
+	if got := TypeNameOf[*User](); got != "*User" {
+		t.Errorf("TypeNameOf[*User]() = %q, want *User", got)
+	}
+
+	if got := PkgPath(&User{}); got != pkg {
+		t.Errorf("PkgPath(&User{}) = %q, want %q", got, pkg)
+	}
+	if got := PkgPath(42); got != "" {
+		t.Errorf("PkgPath(42) = %q, want empty", got)
+	}
+
+	if got := QualifiedTypeName(&User{}); got != "*"+pkg+".User" {
+		t.Errorf("QualifiedTypeName(&User{}) = %q", got)
+	}
+	if got := QualifiedTypeName(42); got != "int" {
+		t.Errorf("QualifiedTypeName(42) = %q, want int", got)
+	}
+	if got := QualifiedTypeNameOf[io.Reader](); got != "io.Reader" {
+		t.Errorf("QualifiedTypeNameOf[io.Reader]() = %q, want io.Reader", got)
+	}
+}
+
 func TestKindOf(t *testing.T) {
 	if got := KindOf(nil); got.String() != "invalid" {
 		t.Errorf("KindOf(nil) = %v, want invalid", got)
@@ -760,3 +879,672 @@ func TestDeepCopy_SelfReference(t *testing.T) {
 		t.Error("modifying original should not affect copy")
 	}
 }
+
+// ========== equal.go 测试 ==========
+
+type eqItem struct {
+	Name    string
+	Price   float64
+	Version int
+}
+
+type eqOrder struct {
+	ID        int
+	Items     []eqItem
+	Tags      []string
+	Meta      map[string]any
+	Next      *eqOrder
+	UpdatedAt time.Time
+	note      string
+}
+
+func TestDeepEqual_Default(t *testing.T) {
+	a := eqOrder{ID: 1, Items: []eqItem{{"a", 1.5, 1}}, Meta: map[string]any{"k": 1}, note: "x"}
+	b := eqOrder{ID: 1, Items: []eqItem{{"a", 1.5, 1}}, Meta: map[string]any{"k": 1}, note: "x"}
+	if !DeepEqual(a, b) {
+		t.Error("expected equal")
+	}
+	if !DeepEqual(nil, nil) || DeepEqual(nil, 1) || DeepEqual(1, int64(1)) {
+		t.Error("unexpected nil/type comparison result")
+	}
+
+	b.note = "y"
+	if path, differ := FirstDiff(a, b); !differ || path != "note" {
+		t.Errorf("expected diff at note, got %q, %v", path, differ)
+	}
+	if !DeepEqual(a, b, WithIgnoreUnexported()) {
+		t.Error("expected equal when ignoring unexported fields")
+	}
+}
+
+func TestFirstDiff_Path(t *testing.T) {
+	a := &eqOrder{Items: []eqItem{{"a", 1, 1}, {"b", 2, 1}}, Meta: map[string]any{"x": 1, "y": []int{1, 2}}}
+	b := &eqOrder{Items: []eqItem{{"a", 1, 1}, {"b", 3, 1}}, Meta: map[string]any{"x": 1, "y": []int{1, 2}}}
+	if path, _ := FirstDiff(a, b); path != "Items[1].Price" {
+		t.Errorf("expected Items[1].Price, got %q", path)
+	}
+
+	b.Items[1].Price = 2
+	b.Meta["y"] = []int{1, 3}
+	if path, _ := FirstDiff(a, b); path != "Meta[y][1]" {
+		t.Errorf("expected Meta[y][1], got %q", path)
+	}
+
+	if path, differ := FirstDiff(1, 2); !differ || path != "" {
+		t.Errorf("expected root diff, got %q, %v", path, differ)
+	}
+}
+
+func TestDeepEqual_IgnoreFields(t *testing.T) {
+	a := eqOrder{ID: 1, Items: []eqItem{{"a", 1, 1}}, UpdatedAt: time.Unix(1, 0)}
+	b := eqOrder{ID: 2, Items: []eqItem{{"a", 1, 2}}, UpdatedAt: time.Unix(2, 0)}
+
+	if DeepEqual(a, b, WithIgnoreFields("ID", "UpdatedAt")) {
+		t.Error("Items.Version still differs")
+	}
+	if !DeepEqual(a, b, WithIgnoreFields("ID", "UpdatedAt", "Items.Version")) {
+		t.Error("expected equal when ignoring by path")
+	}
+	if !DeepEqual(a, b, WithIgnoreFields("ID", "UpdatedAt", "Version")) {
+		t.Error("expected equal when ignoring by name")
+	}
+}
+
+func TestDeepEqual_FloatTolerance(t *testing.T) {
+	x, y := 0.1, 0.2
+	a := eqItem{Price: x + y}
+	b := eqItem{Price: 0.3}
+	if DeepEqual(a, b) {
+		t.Error("expected float mismatch without tolerance")
+	}
+	if !DeepEqual(a, b, WithFloatTolerance(1e-9)) {
+		t.Error("expected equal within tolerance")
+	}
+	if DeepEqual(eqItem{Price: 1}, eqItem{Price: 1.1}, WithFloatTolerance(1e-9)) {
+		t.Error("expected mismatch beyond tolerance")
+	}
+	if !DeepEqual(complex(1, x+y), complex(1, 0.3), WithFloatTolerance(1e-9)) {
+		t.Error("expected complex equal within tolerance")
+	}
+}
+
+func TestDeepEqual_NilEqualsEmpty(t *testing.T) {
+	a := eqOrder{Tags: nil, Meta: nil}
+	b := eqOrder{Tags: []string{}, Meta: map[string]any{}}
+	if path, differ := FirstDiff(a, b); !differ || path != "Tags" {
+		t.Errorf("expected diff at Tags, got %q, %v", path, differ)
+	}
+	if !DeepEqual(a, b, WithNilEqualsEmpty()) {
+		t.Error("expected nil and empty to be equal")
+	}
+	if DeepEqual([]int(nil), []int{1}, WithNilEqualsEmpty()) {
+		t.Error("nil should not equal non-empty slice")
+	}
+}
+
+func TestDeepEqual_Comparer(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*3600)
+	now := time.Now()
+	a := eqOrder{UpdatedAt: now}
+	b := eqOrder{UpdatedAt: now.In(loc)}
+	if DeepEqual(a, b) {
+		t.Error("expected mismatch for different locations")
+	}
+	if !DeepEqual(a, b, WithComparer(func(x, y time.Time) bool { return x.Equal(y) })) {
+		t.Error("expected equal with time comparer")
+	}
+
+	// 未导出字段同样使用自定义比较
+	type wrapper struct{ at time.Time }
+	if !DeepEqual(wrapper{now}, wrapper{now.In(loc)}, WithComparer(func(x, y time.Time) bool { return x.Equal(y) })) {
+		t.Error("comparer should apply to unexported fields")
+	}
+}
+
+func TestDeepEqual_Cycle(t *testing.T) {
+	a := &eqOrder{ID: 1}
+	a.Next = a
+	b := &eqOrder{ID: 1}
+	b.Next = b
+	if !DeepEqual(a, b) {
+		t.Error("expected cyclic structures to be equal")
+	}
+}
+
+// ========== path.go 测试 ==========
+
+type pathAddress struct {
+	City string
+	Geo  *struct{ Lat, Lng float64 }
+}
+
+type PathBase struct {
+	Tenant string
+}
+
+type pathItem struct {
+	SKU   string
+	Price float64
+}
+
+type pathOrder struct {
+	*PathBase
+	ID      int
+	Address *pathAddress
+	Items   []pathItem
+	Grid    [2][2]int
+	Extra   any
+	secret  string
+}
+
+func TestGetField_Path(t *testing.T) {
+	o := pathOrder{
+		PathBase: &PathBase{Tenant: "t1"},
+		Address:  &pathAddress{City: "Paris"},
+		Items:    []pathItem{{"a", 1.5}, {"b", 2.5}},
+		Grid:     [2][2]int{{1, 2}, {3, 4}},
+		Extra:    &pathItem{SKU: "x"},
+		secret:   "s",
+	}
+
+	tests := []struct {
+		path string
+		want any
+	}{
+		{"Address.City", "Paris"},
+		{"Items[1].Price", 2.5},
+		{"Items[0]", pathItem{"a", 1.5}},
+		{"Grid[1][0]", 3},
+		{"Tenant", "t1"},
+		{"Extra.SKU", "x"},
+	}
+	for _, tt := range tests {
+		got, ok := GetField(&o, tt.path)
+		if !ok || got != tt.want {
+			t.Errorf("%s: expected %v, got %v (%v)", tt.path, tt.want, got, ok)
+		}
+	}
+
+	for _, path := range []string{"Items[5].Price", "Address.Geo.Lat", "Address.Zip", "ID.X", "secret", "Items[x]", "Items[1", "", "Address..City"} {
+		if _, ok := GetField(o, path); ok {
+			t.Errorf("%s: expected not found", path)
+		}
+	}
+
+	if price, ok := GetFieldValue[float64](o, "Items[0].Price"); !ok || price != 1.5 {
+		t.Errorf("expected 1.5, got %v", price)
+	}
+	if !HasField(o, "Address.City") || HasField(o, "Address.Geo.Lat") {
+		t.Error("unexpected HasField result for path")
+	}
+}
+
+func TestSetField_Path(t *testing.T) {
+	o := &pathOrder{Items: []pathItem{{"a", 1}}}
+
+	if err := SetField(o, "Address.City", "Berlin"); err != nil {
+		t.Fatalf("SetField error: %v", err)
+	}
+	if o.Address == nil || o.Address.City != "Berlin" {
+		t.Error("expected Address allocated and City set")
+	}
+
+	if err := SetField(o, "Address.Geo.Lat", 52.5); err != nil {
+		t.Fatalf("SetField error: %v", err)
+	}
+	if o.Address.Geo == nil || o.Address.Geo.Lat != 52.5 {
+		t.Error("expected nested anonymous struct pointer allocated")
+	}
+
+	if err := SetField(o, "Items[0].Price", 9); err != nil {
+		t.Fatalf("SetField error: %v", err)
+	}
+	if o.Items[0].Price != 9 {
+		t.Errorf("expected Price=9, got %v", o.Items[0].Price)
+	}
+
+	if err := SetField(o, "Grid[1][1]", 7); err != nil || o.Grid[1][1] != 7 {
+		t.Errorf("expected Grid[1][1]=7, got %v (%v)", o.Grid[1][1], err)
+	}
+
+	// 嵌入指针自动分配
+	if err := SetField(o, "Tenant", "t2"); err != nil || o.PathBase == nil || o.Tenant != "t2" {
+		t.Errorf("expected promoted field through nil embedded pointer to be set: %v", err)
+	}
+
+	if err := SetField(o, "Items[3].Price", 1); err == nil {
+		t.Error("expected error for index out of range")
+	}
+	if err := SetField(o, "Address.Zip", "x"); err == nil {
+		t.Error("expected error for unknown nested field")
+	}
+	if err := SetField(o, "Extra.SKU", "x"); err == nil {
+		t.Error("expected error for nil interface")
+	}
+}
+
+func TestSetField_BadPathNoMutation(t *testing.T) {
+	o := &pathOrder{}
+
+	// 路径后段无效时，前段的 nil 指针不应被分配
+	if err := SetField(o, "Address.Zip", "x"); err == nil {
+		t.Error("expected error for unknown nested field")
+	}
+	if o.Address != nil {
+		t.Error("expected Address to stay nil after failed SetField")
+	}
+
+	if err := SetField(o, "Address.Geo.Lat", "not a number"); err == nil {
+		t.Error("expected error for mismatched value type")
+	}
+	if o.Address != nil {
+		t.Error("expected Address to stay nil after failed assignment")
+	}
+
+	if err := SetField(o, "Tenant", struct{}{}); err == nil {
+		t.Error("expected error for mismatched value type")
+	}
+	if o.PathBase != nil {
+		t.Error("expected embedded PathBase to stay nil after failed assignment")
+	}
+}
+
+// ========== diff.go 测试 ==========
+
+type diffAudit struct {
+	CreatedBy string `json:"created_by"`
+}
+
+type diffAddress struct {
+	City string `json:"city"`
+	Zip  string `json:"zip"`
+}
+
+type diffUser struct {
+	diffAudit
+	ID        int               `json:"id"`
+	Name      string            `json:"name,omitempty"`
+	Score     float64           `json:"score"`
+	Tags      []string          `json:"tags"`
+	Address   *diffAddress      `json:"address"`
+	Labels    map[string]string `json:"labels"`
+	UpdatedAt time.Time         `json:"updated_at"`
+	Password  string            `json:"-"`
+	Nickname  string
+	internal  int
+}
+
+func TestDiff(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	old := diffUser{
+		diffAudit: diffAudit{CreatedBy: "a"},
+		ID:        1, Name: "Alice", Tags: []string{"x"},
+		Address:   &diffAddress{City: "Paris", Zip: "75001"},
+		UpdatedAt: now, Password: "p1", internal: 1,
+	}
+	updated := old
+	updated.diffAudit.CreatedBy = "b"
+	updated.Name = "Alicia"
+	updated.Tags = []string{"x", "y"}
+	updated.Address = &diffAddress{City: "Lyon", Zip: "75001"}
+	updated.UpdatedAt = now.Add(time.Hour)
+	updated.Password = "p2"
+	updated.Nickname = "Ali"
+	updated.internal = 2
+
+	if _, err := Diff(old, &updated); err == nil {
+		t.Fatal("expected error for mismatched types")
+	}
+
+	changes, err := Diff(&old, &updated)
+	if err != nil {
+		t.Fatalf("Diff error: %v", err)
+	}
+	want := map[string]FieldChange{
+		"created_by":   {"a", "b"},
+		"name":         {"Alice", "Alicia"},
+		"tags":         {[]string{"x"}, []string{"x", "y"}},
+		"address.city": {"Paris", "Lyon"},
+		"updated_at":   {now, now.Add(time.Hour)},
+		"Nickname":     {"", "Ali"},
+	}
+	if !DeepEqual(changes, want) {
+		t.Errorf("unexpected changes:\n got  %v\n want %v", changes, want)
+	}
+}
+
+func TestDiff_Options(t *testing.T) {
+	old := diffUser{ID: 1, Score: 0.3, Address: &diffAddress{City: "Paris"}}
+	updated := diffUser{ID: 2, Score: 0.30000000001, Address: &diffAddress{City: "Lyon", Zip: "1"}}
+
+	changes, err := Diff(old, updated,
+		WithDiffTag(""),
+		WithDiffIgnore("ID", "Address.Zip"),
+		WithDiffEqual(WithFloatTolerance(1e-6)),
+	)
+	if err != nil {
+		t.Fatalf("Diff error: %v", err)
+	}
+	if len(changes) != 1 || changes["Address.City"] != (FieldChange{"Paris", "Lyon"}) {
+		t.Errorf("unexpected changes: %v", changes)
+	}
+
+	// 忽略列表也可以使用标签路径
+	changes, _ = Diff(old, updated, WithDiffIgnore("id", "address", "score"))
+	if len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
+
+func TestDiff_NilPointer(t *testing.T) {
+	old := diffUser{}
+	updated := diffUser{Address: &diffAddress{City: "Paris"}}
+
+	changes, _ := Diff(old, updated)
+	c, ok := changes["address"]
+	if !ok || c.Old != (*diffAddress)(nil) || c.New != updated.Address {
+		t.Errorf("expected whole address change, got %v", changes)
+	}
+
+	if changes, _ := Diff(updated, updated); len(changes) != 0 {
+		t.Errorf("expected no changes for identical values, got %v", changes)
+	}
+	if _, err := Diff(1, 2); err == nil {
+		t.Error("expected error for non-struct")
+	}
+}
+
+// ========== mapper.go 测试 ==========
+
+type mapperEmbedded struct {
+	Region string
+}
+
+type mapperUser struct {
+	mapperEmbedded
+	Name    string `json:"name"`
+	Age     int    `json:"age,omitempty"`
+	Email   string `json:"-"`
+	Score   float64
+	private string
+}
+
+func TestMapperOf(t *testing.T) {
+	m1, err := MapperOf(reflect.TypeFor[mapperUser](), "json")
+	if err != nil {
+		t.Fatalf("MapperOf error: %v", err)
+	}
+	m2, _ := MapperOf(reflect.TypeFor[*mapperUser](), "json")
+	if m1 != m2 || MapperFor[mapperUser]("json") != m1 {
+		t.Error("mapper should be cached per type and tag")
+	}
+	if MapperFor[mapperUser]("") == m1 {
+		t.Error("different tag should use a different mapper")
+	}
+	if _, err := MapperOf(reflect.TypeFor[int](), ""); err == nil {
+		t.Error("expected error for non-struct type")
+	}
+
+	want := []string{"name", "age", "Score"}
+	if keys := m1.Keys(); len(keys) != len(want) || keys[0] != "name" || keys[1] != "age" || keys[2] != "Score" {
+		t.Errorf("unexpected keys: %v", keys)
+	}
+}
+
+func TestStructMapper_ToMapFromMap(t *testing.T) {
+	m := MapperFor[mapperUser]("json")
+	u := mapperUser{Name: "Alice", Age: 20, Email: "a@x", Score: 1.5, private: "p"}
+
+	data := m.ToMap(&u)
+	if len(data) != 3 || data["name"] != "Alice" || data["age"] != 20 || data["Score"] != 1.5 {
+		t.Errorf("unexpected map: %v", data)
+	}
+	if m.ToMap(testUser{}) != nil || m.ToMap((*mapperUser)(nil)) != nil {
+		t.Error("expected nil for mismatched type or nil pointer")
+	}
+
+	var out mapperUser
+	err := m.FromMap(map[string]any{"NAME": "Bob", "name": "Carol", "age": int64(30), "score": 2}, &out)
+	if err != nil {
+		t.Fatalf("FromMap error: %v", err)
+	}
+	if out.Name != "Carol" || out.Age != 30 || out.Score != 2 {
+		t.Errorf("unexpected struct: %+v", out)
+	}
+
+	if err := m.FromMap(map[string]any{"age": "x"}, &out); err == nil {
+		t.Error("expected conversion error")
+	}
+	if err := m.FromMap(nil, out); err == nil {
+		t.Error("expected error for non-pointer")
+	}
+	if err := m.FromMap(nil, &testUser{}); err == nil {
+		t.Error("expected error for mismatched type")
+	}
+}
+
+func TestGetterSetter(t *testing.T) {
+	getName, err := Getter[mapperUser, string]("Name")
+	if err != nil {
+		t.Fatalf("Getter error: %v", err)
+	}
+	setScore, err := Setter[mapperUser, float64]("Score")
+	if err != nil {
+		t.Fatalf("Setter error: %v", err)
+	}
+	getRegion, err := Getter[mapperUser, string]("Region")
+	if err != nil {
+		t.Fatalf("Getter for promoted field error: %v", err)
+	}
+
+	u := &mapperUser{Name: "Alice", mapperEmbedded: mapperEmbedded{Region: "eu"}}
+	setScore(u, 9.5)
+	if getName(u) != "Alice" || u.Score != 9.5 || getRegion(u) != "eu" {
+		t.Errorf("unexpected values: %+v", u)
+	}
+
+	if _, err := Getter[mapperUser, int]("Name"); err == nil {
+		t.Error("expected error for type mismatch")
+	}
+	if _, err := Getter[mapperUser, string]("private"); err == nil {
+		t.Error("expected error for unexported field")
+	}
+	if _, err := Setter[pathOrder, string]("Tenant"); err == nil {
+		t.Error("expected error for field promoted through embedded pointer")
+	}
+	if _, err := Getter[int, int]("X"); err == nil {
+		t.Error("expected error for non-struct")
+	}
+}
+
+func BenchmarkStructToMap(b *testing.B) {
+	u := mapperUser{Name: "Alice", Age: 20, Score: 1.5}
+	b.Run("StructToMapWithTag", func(b *testing.B) {
+		for b.Loop() {
+			StructToMapWithTag(u, "json")
+		}
+	})
+	b.Run("Mapper", func(b *testing.B) {
+		m := MapperFor[mapperUser]("json")
+		for b.Loop() {
+			m.ToMap(u)
+		}
+	})
+}
+
+func BenchmarkGetField(b *testing.B) {
+	u := &mapperUser{Name: "Alice"}
+	b.Run("GetField", func(b *testing.B) {
+		for b.Loop() {
+			GetField(u, "Name")
+		}
+	})
+	b.Run("Getter", func(b *testing.B) {
+		get, _ := Getter[mapperUser, string]("Name")
+		for b.Loop() {
+			get(u)
+		}
+	})
+}
+
+// ========== walk.go 测试 ==========
+
+type walkAddress struct {
+	City  string
+	Token string `redact:"true"`
+}
+
+type walkUser struct {
+	Name    string
+	Secrets []string `redact:"true"`
+	Address *walkAddress
+	Meta    map[string]walkAddress
+	Any     any
+	secret  string
+}
+
+func TestWalk_Paths(t *testing.T) {
+	u := walkUser{
+		Name:    "Alice",
+		Secrets: []string{"a", "b"},
+		Address: &walkAddress{City: "Paris"},
+		Meta:    map[string]walkAddress{"y": {}, "x": {}},
+		Any:     []int{1},
+		secret:  "hidden",
+	}
+
+	var paths []string
+	err := Walk(u, func(path string, v reflect.Value, tag reflect.StructTag) error {
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk() error = %v", err)
+	}
+
+	want := []string{
+		"", "Name", "Secrets", "Secrets[0]", "Secrets[1]",
+		"Address", "Address.City", "Address.Token",
+		"Meta", "Meta[x]", "Meta[x].City", "Meta[x].Token", "Meta[y]", "Meta[y].City", "Meta[y].Token",
+		"Any", "Any[0]",
+	}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+}
+
+func TestWalk_Redact(t *testing.T) {
+	u := &walkUser{
+		Name:    "Alice",
+		Secrets: []string{"a", "b"},
+		Address: &walkAddress{City: "Paris", Token: "t1"},
+		Meta:    map[string]walkAddress{"home": {City: "Lyon", Token: "t2"}},
+	}
+
+	err := Walk(u, func(path string, v reflect.Value, tag reflect.StructTag) error {
+		if tag.Get("redact") == "true" && v.Kind() == reflect.String && v.CanSet() {
+			v.SetString("***")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk() error = %v", err)
+	}
+
+	if u.Name != "Alice" || u.Address.City != "Paris" {
+		t.Errorf("untagged fields changed: %+v", u)
+	}
+	if u.Secrets[0] != "***" || u.Secrets[1] != "***" {
+		t.Errorf("Secrets = %v, want redacted", u.Secrets)
+	}
+	if u.Address.Token != "***" {
+		t.Errorf("Address.Token = %q, want redacted", u.Address.Token)
+	}
+	if got := u.Meta["home"]; got.Token != "***" || got.City != "Lyon" {
+		t.Errorf("Meta[home] = %+v, want redacted token", got)
+	}
+}
+
+func TestWalk_Defaulting(t *testing.T) {
+	u := &walkUser{}
+	err := Walk(u, func(path string, v reflect.Value, tag reflect.StructTag) error {
+		switch path {
+		case "Address":
+			v.Set(reflect.ValueOf(&walkAddress{}))
+		case "Address.City":
+			v.SetString("Berlin")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk() error = %v", err)
+	}
+	if u.Address == nil || u.Address.City != "Berlin" {
+		t.Errorf("Address = %+v, want allocated with default city", u.Address)
+	}
+}
+
+func TestWalk_SkipAndError(t *testing.T) {
+	u := walkUser{Secrets: []string{"a"}, Address: &walkAddress{}}
+
+	var paths []string
+	err := Walk(u, func(path string, v reflect.Value, tag reflect.StructTag) error {
+		paths = append(paths, path)
+		if path == "Secrets" || path == "Address" {
+			return SkipSubtree
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk() error = %v", err)
+	}
+	want := []string{"", "Name", "Secrets", "Address", "Meta", "Any"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+
+	stop := io.EOF
+	paths = nil
+	err = Walk(u, func(path string, v reflect.Value, tag reflect.StructTag) error {
+		paths = append(paths, path)
+		if path == "Secrets[0]" {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Errorf("Walk() error = %v, want %v", err, stop)
+	}
+	if paths[len(paths)-1] != "Secrets[0]" {
+		t.Errorf("walk continued after error: %v", paths)
+	}
+}
+
+func TestWalk_Cycle(t *testing.T) {
+	type node struct {
+		Name string
+		Next *node
+	}
+	a := &node{Name: "a"}
+	a.Next = &node{Name: "b", Next: a}
+
+	count := 0
+	err := Walk(a, func(path string, v reflect.Value, tag reflect.StructTag) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk() error = %v", err)
+	}
+	// 根、Name、Next、Next.Name、Next.Next（不再展开）
+	if count != 5 {
+		t.Errorf("visited %d nodes, want 5", count)
+	}
+
+	if err := Walk(nil, func(string, reflect.Value, reflect.StructTag) error {
+		t.Error("visitor called for nil")
+		return nil
+	}); err != nil {
+		t.Errorf("Walk(nil) error = %v", err)
+	}
+}
@@ -3,7 +3,6 @@ package reflectx
 import (
 	"fmt"
 	"reflect"
-	"strings"
 )
 
 // StructToMap 将结构体转换为 map
@@ -54,33 +53,8 @@ func StructToMapWithTag(v any, tagName string) map[string]any {
 		return nil
 	}
 
-	result := make(map[string]any)
-	rt := rv.Type()
-	for i := 0; i < rv.NumField(); i++ {
-		field := rt.Field(i)
-		if !field.IsExported() {
-			continue
-		}
-
-		key := field.Name
-		if tagName != "" {
-			if tag := field.Tag.Get(tagName); tag != "" {
-				// 处理 tag 中的选项（如 `json:"name,omitempty"`）
-				if idx := strings.Index(tag, ","); idx != -1 {
-					tag = tag[:idx]
-				}
-				if tag == "-" {
-					continue
-				}
-				if tag != "" {
-					key = tag
-				}
-			}
-		}
-
-		result[key] = rv.Field(i).Interface()
-	}
-	return result
+	m, _ := MapperOf(rv.Type(), tagName)
+	return m.ToMap(v)
 }
 
 // MapToStruct 将 map 转换为结构体
@@ -122,54 +96,8 @@ func MapToStructWithTag(m map[string]any, v any, tagName string) error {
 		return fmt.Errorf("v must be a pointer to struct")
 	}
 
-	rt := rv.Type()
-	for i := 0; i < rv.NumField(); i++ {
-		field := rt.Field(i)
-		if !field.IsExported() {
-			continue
-		}
-
-		// 确定要查找的 key
-		key := field.Name
-		if tagName != "" {
-			if tag := field.Tag.Get(tagName); tag != "" {
-				if idx := strings.Index(tag, ","); idx != -1 {
-					tag = tag[:idx]
-				}
-				if tag == "-" {
-					continue
-				}
-				if tag != "" {
-					key = tag
-				}
-			}
-		}
-
-		// 查找 map 中的值（大小写不敏感）
-		var value any
-		var found bool
-		for k, v := range m {
-			if strings.EqualFold(k, key) {
-				value = v
-				found = true
-				break
-			}
-		}
-		if !found {
-			continue
-		}
-
-		// 设置字段值
-		fieldValue := rv.Field(i)
-		if !fieldValue.CanSet() {
-			continue
-		}
-
-		if err := setFieldValue(fieldValue, value); err != nil {
-			return fmt.Errorf("field %s: %w", field.Name, err)
-		}
-	}
-	return nil
+	mapper, _ := MapperOf(rv.Type(), tagName)
+	return mapper.FromMap(m, v)
 }
 
 // setFieldValue 设置字段值
@@ -196,17 +124,18 @@ func setFieldValue(field reflect.Value, value any) error {
 //
 // 参数:
 //   - v: 结构体或结构体指针
-//   - name: 字段名
+//   - name: 字段名，支持嵌套路径（"Address.City"）和切片/数组下标（"Items[2].Price"）
 //
 // 返回:
 //   - any: 字段值
-//   - bool: 是否找到
+//   - bool: 是否找到（路径上遇到 nil 指针、下标越界或未导出字段时为 false）
 //
 // 示例:
 //
 //	user := User{Name: "Alice", Age: 20}
 //	name, ok := reflectx.GetField(user, "Name")
 //	// "Alice", true
+//	price, ok := reflectx.GetField(order, "Items[0].Price")
 func GetField(v any, name string) (any, bool) {
 	rv := reflect.ValueOf(v)
 	if rv.Kind() == reflect.Ptr {
@@ -216,8 +145,8 @@ func GetField(v any, name string) (any, bool) {
 		return nil, false
 	}
 
-	field := rv.FieldByName(name)
-	if !field.IsValid() {
+	field, err := resolvePath(rv, name, nil)
+	if err != nil {
 		return nil, false
 	}
 	return field.Interface(), true
@@ -251,16 +180,20 @@ func GetFieldValue[T any](v any, name string) (T, bool) {
 //
 // 参数:
 //   - v: 结构体指针
-//   - name: 字段名
+//   - name: 字段名，支持嵌套路径（"Address.City"）和切片/数组下标（"Items[2].Price"）
 //   - value: 要设置的值
 //
 // 返回:
 //   - error: 设置错误
 //
+// 注意: 路径上的 nil 指针会自动分配，切片下标越界时返回错误（不会自动扩容）；
+// 路径无效或赋值失败时目标保持不变
+//
 // 示例:
 //
 //	user := &User{Name: "Alice", Age: 20}
 //	err := reflectx.SetField(user, "Age", 21)
+//	err = reflectx.SetField(user, "Address.City", "Paris")  // Address 为 nil 时自动分配
 func SetField(v any, name string, value any) error {
 	rv := reflect.ValueOf(v)
 	if rv.Kind() != reflect.Ptr || rv.IsNil() {
@@ -271,25 +204,30 @@ func SetField(v any, name string, value any) error {
 		return fmt.Errorf("v must be a pointer to struct")
 	}
 
-	field := rv.FieldByName(name)
-	if !field.IsValid() {
-		return fmt.Errorf("field %s not found", name)
+	// 先解析整条路径并完成赋值，全部成功后才把新分配的对象挂接到 nil 指针上
+	var plan allocPlan
+	field, err := resolvePath(rv, name, &plan)
+	if err != nil {
+		return err
 	}
 	if !field.CanSet() {
 		return fmt.Errorf("field %s cannot be set", name)
 	}
-
-	return setFieldValue(field, value)
+	if err := setFieldValue(field, value); err != nil {
+		return err
+	}
+	plan.commit()
+	return nil
 }
 
 // HasField 检查结构体是否有指定字段
 //
 // 参数:
 //   - v: 结构体或结构体指针
-//   - name: 字段名
+//   - name: 字段名，支持与 GetField 相同的路径写法
 //
 // 返回:
-//   - bool: 是否有该字段
+//   - bool: 是否有该导出字段且路径可达
 func HasField(v any, name string) bool {
 	rv := reflect.ValueOf(v)
 	if rv.Kind() == reflect.Ptr {
@@ -298,7 +236,8 @@ func HasField(v any, name string) bool {
 	if rv.Kind() != reflect.Struct {
 		return false
 	}
-	return rv.FieldByName(name).IsValid()
+	_, err := resolvePath(rv, name, nil)
+	return err == nil
 }
 
 // FieldNames 返回结构体所有导出字段名
@@ -27,6 +27,91 @@ func IsZero(v any) bool {
 	return rv.IsZero()
 }
 
+// IsDeepZero 深度检查值是否为零值
+//
+// 与 IsZero 不同:
+//   - 指针/接口: nil 或指向的值为深度零值时返回 true
+//   - 切片/map: nil 或长度为 0 时返回 true
+//   - 结构体/数组: 所有字段/元素均为深度零值时返回 true
+//
+// 循环引用的指针只检查一次，不会死循环
+//
+// 示例:
+//
+//	reflectx.IsZero(&User{})        // false（指针非 nil）
+//	reflectx.IsDeepZero(&User{})    // true
+//	reflectx.IsDeepZero([]int{})    // true
+//	reflectx.IsDeepZero(User{Tags: []string{}})  // true
+func IsDeepZero(v any) bool {
+	if v == nil {
+		return true
+	}
+	return isDeepZero(reflect.ValueOf(v), make(map[uintptr]bool))
+}
+
+// isDeepZero IsDeepZero 的递归实现，visited 记录已检查的指针
+func isDeepZero(rv reflect.Value, visited map[uintptr]bool) bool {
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return true
+		}
+		ptr := rv.Pointer()
+		if visited[ptr] {
+			return true
+		}
+		visited[ptr] = true
+		return isDeepZero(rv.Elem(), visited)
+	case reflect.Interface:
+		return rv.IsNil() || isDeepZero(rv.Elem(), visited)
+	case reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	case reflect.Struct:
+		for i := range rv.NumField() {
+			if !isDeepZero(rv.Field(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Array:
+		for i := range rv.Len() {
+			if !isDeepZero(rv.Index(i), visited) {
+				return false
+			}
+		}
+		return true
+	default:
+		return rv.IsZero()
+	}
+}
+
+// Zero 返回类型 T 的零值
+//
+// 示例:
+//
+//	reflectx.Zero[int]()     // 0
+//	reflectx.Zero[*User]()   // nil
+func Zero[T any]() T {
+	var zero T
+	return zero
+}
+
+// ZeroOf 返回与 v 同类型的零值
+//
+// 示例:
+//
+//	reflectx.ZeroOf(42)          // 0
+//	reflectx.ZeroOf(User{Name: "Alice"})  // User{}
+//	reflectx.ZeroOf(nil)         // nil
+func ZeroOf(v any) any {
+	if v == nil {
+		return nil
+	}
+	return reflect.Zero(reflect.TypeOf(v)).Interface()
+}
+
 // IsNil 检查值是否为 nil
 //
 // 参数:
@@ -35,7 +120,8 @@ func IsZero(v any) bool {
 // 返回:
 //   - bool: 如果是 nil 返回 true
 //
-// 注意: 只检查可以为 nil 的类型（指针、接口、切片、map、channel、函数）
+// 注意: 只检查可以为 nil 的类型（指针、接口、切片、map、channel、函数、unsafe.Pointer），
+// 其他类型返回 false，不会 panic
 //
 // 示例:
 //
@@ -49,7 +135,7 @@ func IsNil(v any) bool {
 	}
 	rv := reflect.ValueOf(v)
 	switch rv.Kind() {
-	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func:
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func, reflect.UnsafePointer:
 		return rv.IsNil()
 	}
 	return false
@@ -68,15 +154,96 @@ func IsNil(v any) bool {
 //	reflectx.TypeName(42)        // "int"
 //	reflectx.TypeName("hello")   // "string"
 //	reflectx.TypeName(User{})    // "User"
+//	reflectx.TypeName(&User{})   // "*User"
+//	reflectx.TypeName([]int{})   // "[]int"（未命名类型返回类型字面量）
 func TypeName(v any) string {
 	if v == nil {
 		return "nil"
 	}
+	return typeName(reflect.TypeOf(v))
+}
+
+// TypeNameOf 返回类型 T 的类型名称，规则与 TypeName 相同
+//
+// 与 TypeName 不同，T 可以是接口类型
+//
+// 示例:
+//
+//	reflectx.TypeNameOf[User]()    // "User"
+//	reflectx.TypeNameOf[error]()   // "error"
+func TypeNameOf[T any]() string {
+	return typeName(reflect.TypeFor[T]())
+}
+
+// typeName 返回不含包路径的类型名称
+func typeName(t reflect.Type) string {
+	if t.Name() != "" {
+		return t.Name()
+	}
+	if t.Kind() == reflect.Ptr {
+		return "*" + typeName(t.Elem())
+	}
+	return t.String()
+}
+
+// PkgPath 返回值的类型所在的包路径（指针会被解引用）
+//
+// 内置类型和未命名类型返回空字符串
+//
+// 示例:
+//
+//	reflectx.PkgPath(User{})   // "github.com/example/app/model"
+//	reflectx.PkgPath(&User{})  // "github.com/example/app/model"
+//	reflectx.PkgPath(42)       // ""
+func PkgPath(v any) string {
+	if v == nil {
+		return ""
+	}
 	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.PkgPath()
+}
+
+// QualifiedTypeName 返回带完整包路径的类型名称
+//
+// 与 FullTypeName（如 "model.User"）不同，使用完整包路径，
+// 不同包中的同名类型不会冲突，适合作为序列化类型标识或依赖注入容器的 key
+//
+// 示例:
+//
+//	reflectx.QualifiedTypeName(User{})   // "github.com/example/app/model.User"
+//	reflectx.QualifiedTypeName(&User{})  // "*github.com/example/app/model.User"
+//	reflectx.QualifiedTypeName(42)       // "int"
+func QualifiedTypeName(v any) string {
+	if v == nil {
+		return "nil"
+	}
+	return qualifiedTypeName(reflect.TypeOf(v))
+}
+
+// QualifiedTypeNameOf 返回类型 T 带完整包路径的类型名称，T 可以是接口类型
+//
+// 示例:
+//
+//	reflectx.QualifiedTypeNameOf[io.Reader]()  // "io.Reader"
+func QualifiedTypeNameOf[T any]() string {
+	return qualifiedTypeName(reflect.TypeFor[T]())
+}
+
+// qualifiedTypeName 返回带完整包路径的类型名称
+func qualifiedTypeName(t reflect.Type) string {
+	if t.Name() != "" {
+		if t.PkgPath() == "" {
+			return t.Name()
+		}
+		return t.PkgPath() + "." + t.Name()
+	}
 	if t.Kind() == reflect.Ptr {
-		return "*" + t.Elem().Name()
+		return "*" + qualifiedTypeName(t.Elem())
 	}
-	return t.Name()
+	return t.String()
 }
 
 // FullTypeName 返回值的完整类型名称（包含包路径）
@@ -0,0 +1,140 @@
+package reflectx
+
+import (
+	"cmp"
+	"errors"
+	"reflect"
+	"slices"
+	"strconv"
+)
+
+// SkipSubtree 作为 Visitor 的返回值时，跳过当前值的子节点，遍历继续进行
+var SkipSubtree = errors.New("reflectx: skip subtree")
+
+// Visitor Walk 的访问函数
+//
+// 参数:
+//   - path: 值的路径，格式与 FirstDiff 一致（如 "Items[1].Price"、"Meta[region]"），根值为空字符串
+//   - v: 当前值，可通过 v.CanSet() 判断能否修改
+//   - tag: 所在结构体字段的标签；切片、数组、map 的元素继承容器字段的标签，根值为空
+//
+// 返回:
+//   - error: 返回 SkipSubtree 跳过子节点，返回其他非 nil 错误终止遍历
+type Visitor func(path string, v reflect.Value, tag reflect.StructTag) error
+
+// walkState 一次遍历过程的状态
+type walkState struct {
+	fn      Visitor
+	mutable bool           // 根值是否以指针传入，决定 map 元素是否回写
+	visited map[visit]bool // 已遍历的指针，用于检测循环引用
+}
+
+// Walk 深度优先遍历值，对每个节点先调用 visitor 再遍历其子节点
+//
+// 结构体按字段定义顺序遍历导出字段，切片和数组按下标遍历，map 按 key 排序遍历；
+// 指针和接口会被透明解引用，不产生额外节点，循环引用只遍历一次。
+// visitor 在子节点之前调用，因此可以先为 nil 指针分配值再遍历其内容。
+//
+// 以指针传入时值可修改：结构体字段、切片元素可直接 Set，
+// map 元素以副本形式提供，visitor 及其子节点返回后写回 map。
+//
+// 参数:
+//   - v: 要遍历的值，需要修改时传入指针
+//   - visitor: 访问函数
+//
+// 返回:
+//   - error: visitor 返回的第一个非 SkipSubtree 错误
+//
+// 示例:
+//
+//	// 按标签脱敏
+//	reflectx.Walk(&req, func(path string, v reflect.Value, tag reflect.StructTag) error {
+//	    if tag.Get("redact") == "true" && v.Kind() == reflect.String && v.CanSet() {
+//	        v.SetString("***")
+//	    }
+//	    return nil
+//	})
+func Walk(v any, visitor Visitor) error {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return nil
+	}
+	w := &walkState{
+		fn:      visitor,
+		mutable: rv.Kind() == reflect.Pointer,
+		visited: make(map[visit]bool),
+	}
+	return w.walk(rv, "", "")
+}
+
+// walk 访问单个节点，然后遍历其子节点
+func (w *walkState) walk(v reflect.Value, path string, tag reflect.StructTag) error {
+	if err := w.fn(path, v, tag); err != nil {
+		if errors.Is(err, SkipSubtree) {
+			return nil
+		}
+		return err
+	}
+	return w.walkChildren(v, path, tag)
+}
+
+// walkChildren 遍历节点的子节点，tag 为节点自身的标签，由容器元素继承
+func (w *walkState) walkChildren(v reflect.Value, path string, tag reflect.StructTag) error {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		if v.Kind() == reflect.Pointer {
+			key := visit{a: v.UnsafePointer(), typ: v.Type()}
+			if w.visited[key] {
+				return nil
+			}
+			w.visited[key] = true
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := range t.NumField() {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			if err := w.walk(v.Field(i), joinPath(path, f.Name), f.Tag); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			if err := w.walk(v.Index(i), path+"["+strconv.Itoa(i)+"]", tag); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Map:
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(x, y reflect.Value) int {
+			return cmp.Compare(formatKey(x), formatKey(y))
+		})
+		for _, k := range keys {
+			elem := v.MapIndex(k)
+			if w.mutable {
+				// map 元素不可寻址，复制一份供修改，遍历完成后写回
+				c := reflect.New(elem.Type()).Elem()
+				c.Set(elem)
+				elem = c
+			}
+			err := w.walk(elem, path+"["+formatKey(k)+"]", tag)
+			if w.mutable {
+				v.SetMapIndex(k, elem)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// CrossFieldRuleFunc 跨字段验证规则函数类型
+// 参数: value-字段值, param-规则参数, parent-字段所在的结构体
+// 返回: 验证是否通过
+type CrossFieldRuleFunc func(value any, param string, parent reflect.Value) bool
+
+// StructRuleFunc 结构体级验证函数类型
+// 参数: obj-结构体值（非指针）
+// 返回: 验证错误列表，无错误返回 nil
+type StructRuleFunc func(obj any) []FieldError
+
+// RegisterCrossFieldRule 注册自定义跨字段验证规则
+//
+// 参数:
+//   - name: 规则名称
+//   - fn: 验证函数，可通过 parent 访问同一结构体的其他字段
+//
+// 返回:
+//   - *Validator: 返回自身以支持链式调用
+//
+// 注意: 名称以 required 开头的规则在字段为空时也会执行
+//
+// 示例:
+//
+//	v.RegisterCrossFieldRule("before", func(value any, param string, parent reflect.Value) bool {
+//	    other := parent.FieldByName(param)
+//	    return other.IsValid() && value.(time.Time).Before(other.Interface().(time.Time))
+//	})
+func (v *Validator) RegisterCrossFieldRule(name string, fn CrossFieldRuleFunc) *Validator {
+	v.crossRules[name] = fn
+	return v
+}
+
+// RegisterStructRule 注册结构体级验证
+//
+// 参数:
+//   - sample: 结构体类型样例（值或指针均可）
+//   - fn: 验证函数，在所有字段规则之后执行
+//
+// 返回:
+//   - *Validator: 返回自身以支持链式调用
+//
+// 示例:
+//
+//	v.RegisterStructRule(Order{}, func(obj any) []validator.FieldError {
+//	    o := obj.(Order)
+//	    if o.Coupon != "" && o.Amount < 100 {
+//	        return []validator.FieldError{{Field: "coupon", Tag: "coupon", Message: "订单满 100 才能使用优惠券"}}
+//	    }
+//	    return nil
+//	})
+func (v *Validator) RegisterStructRule(sample any, fn StructRuleFunc) *Validator {
+	t := reflect.TypeOf(sample)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	v.structRules[t] = fn
+	return v
+}
+
+// registerDefaultCrossFieldRules 注册默认跨字段验证规则
+func (v *Validator) registerDefaultCrossFieldRules() {
+	// eqfield - 与另一字段相等
+	v.crossRules["eqfield"] = func(value any, param string, parent reflect.Value) bool {
+		other, ok := lookupField(parent, param)
+		if !ok {
+			return false
+		}
+		if reflect.DeepEqual(value, other.Interface()) {
+			return true
+		}
+		// 不同数字类型之间按数值比较（如 int 与 int64）
+		rv := reflect.ValueOf(value)
+		if !isNumberKind(rv.Kind()) || !isNumberKind(other.Kind()) {
+			return false
+		}
+		cmp, ok := compareValues(rv, other)
+		return ok && cmp == 0
+	}
+
+	// nefield - 与另一字段不等
+	v.crossRules["nefield"] = func(value any, param string, parent reflect.Value) bool {
+		return !v.crossRules["eqfield"](value, param, parent)
+	}
+
+	// gtfield/gtefield/ltfield/ltefield - 与另一字段比较
+	compareRule := func(accept func(int) bool) CrossFieldRuleFunc {
+		return func(value any, param string, parent reflect.Value) bool {
+			other, ok := lookupField(parent, param)
+			if !ok {
+				return false
+			}
+			cmp, ok := compareValues(reflect.ValueOf(value), other)
+			return ok && accept(cmp)
+		}
+	}
+	v.crossRules["gtfield"] = compareRule(func(c int) bool { return c > 0 })
+	v.crossRules["gtefield"] = compareRule(func(c int) bool { return c >= 0 })
+	v.crossRules["ltfield"] = compareRule(func(c int) bool { return c < 0 })
+	v.crossRules["ltefield"] = compareRule(func(c int) bool { return c <= 0 })
+
+	// required_if - 另一字段等于指定值时必填（可指定多组 "Field value"，全部满足才生效）
+	v.crossRules["required_if"] = func(value any, param string, parent reflect.Value) bool {
+		if !fieldsMatch(parent, param) {
+			return true
+		}
+		return !isEmpty(value)
+	}
+
+	// required_unless - 除非另一字段等于指定值，否则必填
+	v.crossRules["required_unless"] = func(value any, param string, parent reflect.Value) bool {
+		if fieldsMatch(parent, param) {
+			return true
+		}
+		return !isEmpty(value)
+	}
+
+	// required_with - 任一指定字段非空时必填
+	v.crossRules["required_with"] = func(value any, param string, parent reflect.Value) bool {
+		for _, name := range strings.Fields(param) {
+			if other, ok := lookupField(parent, name); ok && !isEmpty(other.Interface()) {
+				return !isEmpty(value)
+			}
+		}
+		return true
+	}
+
+	// required_without - 任一指定字段为空时必填
+	v.crossRules["required_without"] = func(value any, param string, parent reflect.Value) bool {
+		for _, name := range strings.Fields(param) {
+			if other, ok := lookupField(parent, name); !ok || isEmpty(other.Interface()) {
+				return !isEmpty(value)
+			}
+		}
+		return true
+	}
+}
+
+// lookupField 按 Go 字段名查找同级字段
+func lookupField(parent reflect.Value, name string) (reflect.Value, bool) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return reflect.Value{}, false
+	}
+	field, ok := parent.Type().FieldByName(name)
+	if !ok || !field.IsExported() {
+		return reflect.Value{}, false
+	}
+	return parent.FieldByIndex(field.Index), true
+}
+
+// fieldsMatch 检查 "Field1 value1 Field2 value2" 形式的条件是否全部满足
+func fieldsMatch(parent reflect.Value, param string) bool {
+	parts := strings.Fields(param)
+	if len(parts) == 0 || len(parts)%2 != 0 {
+		return false
+	}
+	for i := 0; i < len(parts); i += 2 {
+		other, ok := lookupField(parent, parts[i])
+		if !ok || fmt.Sprintf("%v", other.Interface()) != parts[i+1] {
+			return false
+		}
+	}
+	return true
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// compareValues 比较两个值，返回 -1/0/1
+// 数字按数值比较，time.Time 按时间先后，字符串按字典序（strings.Compare）；
+// 其他类型（切片/map/结构体等）或类型不匹配时返回 false，规则视为不通过
+func compareValues(a, b reflect.Value) (int, bool) {
+	for a.Kind() == reflect.Ptr || a.Kind() == reflect.Interface {
+		if a.IsNil() {
+			return 0, false
+		}
+		a = a.Elem()
+	}
+	for b.Kind() == reflect.Ptr || b.Kind() == reflect.Interface {
+		if b.IsNil() {
+			return 0, false
+		}
+		b = b.Elem()
+	}
+
+	if a.Type() == timeType && b.Type() == timeType {
+		return a.Interface().(time.Time).Compare(b.Interface().(time.Time)), true
+	}
+
+	if a.Kind() == reflect.String && b.Kind() == reflect.String {
+		return strings.Compare(a.String(), b.String()), true
+	}
+
+	af, aok := numericValue(a)
+	bf, bok := numericValue(b)
+	if aok && bok {
+		switch {
+		case af < bf:
+			return -1, true
+		case af > bf:
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// numericValue 将数字转换为 float64
+func numericValue(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// isNumberKind 检查是否为数字类型
+func isNumberKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
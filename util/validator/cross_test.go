@@ -0,0 +1,230 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testSignup struct {
+	Password string `validate:"required" json:"password"`
+	Confirm  string `validate:"required,eqfield=Password" json:"confirm"`
+	Nickname string `validate:"nefield=Password"`
+}
+
+func TestValidator_EqField(t *testing.T) {
+	v := NewValidator()
+
+	if err := v.Struct(testSignup{Password: "Abc12345", Confirm: "Abc12345"}); err != nil {
+		t.Errorf("matching fields should pass: %v", err)
+	}
+
+	err := v.Struct(testSignup{Password: "Abc12345", Confirm: "Xyz12345"})
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 1 || errs[0].Tag != "eqfield" || errs[0].Field != "confirm" {
+		t.Fatalf("expected eqfield error on confirm, got %v", err)
+	}
+	if errs[0].Message != "confirm 必须等于 Password" {
+		t.Errorf("unexpected message: %s", errs[0].Message)
+	}
+
+	if err := v.Struct(testSignup{Password: "same", Confirm: "same", Nickname: "same"}); err == nil {
+		t.Error("nefield should fail for equal values")
+	}
+}
+
+func TestValidator_CompareFields(t *testing.T) {
+	v := NewValidator()
+
+	type Window struct {
+		Start    time.Time
+		End      time.Time `validate:"gtfield=Start"`
+		MinPrice int
+		MaxPrice int64 `validate:"gtefield=MinPrice"`
+		Limit    float64
+		Used     float64 `validate:"ltefield=Limit"`
+	}
+
+	now := time.Now()
+	ok := Window{Start: now, End: now.Add(time.Hour), MinPrice: 10, MaxPrice: 10, Limit: 5, Used: 5}
+	if err := v.Struct(ok); err != nil {
+		t.Errorf("valid window should pass: %v", err)
+	}
+
+	bad := Window{Start: now, End: now.Add(-time.Hour), MinPrice: 10, MaxPrice: 9, Limit: 5, Used: 6}
+	errs, _ := v.Struct(bad).(ValidationErrors)
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors, got %v", errs)
+	}
+	for i, tag := range []string{"gtfield", "gtefield", "ltefield"} {
+		if errs[i].Tag != tag {
+			t.Errorf("error %d: expected tag %s, got %s", i, tag, errs[i].Tag)
+		}
+	}
+}
+
+func TestValidator_RequiredIf(t *testing.T) {
+	v := NewValidator()
+
+	type Payment struct {
+		Method  string
+		Country string
+		CardNo  string `validate:"required_if=Method card"`
+		TaxID   string `validate:"required_if=Method invoice Country CN"`
+		Remark  string `validate:"required_unless=Method cash"`
+	}
+
+	if err := v.Struct(Payment{Method: "cash"}); err != nil {
+		t.Errorf("cash payment should pass: %v", err)
+	}
+
+	errs, _ := v.Struct(Payment{Method: "card", Remark: "x"}).(ValidationErrors)
+	if len(errs) != 1 || errs[0].Tag != "required_if" || errs[0].Field != "CardNo" {
+		t.Errorf("expected CardNo required_if error, got %v", errs)
+	}
+
+	// 多条件需全部满足
+	if err := v.Struct(Payment{Method: "invoice", Country: "US", Remark: "x"}); err != nil {
+		t.Errorf("TaxID should only be required for CN invoices: %v", err)
+	}
+	if err := v.Struct(Payment{Method: "invoice", Country: "CN", Remark: "x"}); err == nil {
+		t.Error("TaxID should be required for CN invoices")
+	}
+
+	errs, _ = v.Struct(Payment{Method: "transfer"}).(ValidationErrors)
+	if len(errs) != 1 || errs[0].Tag != "required_unless" {
+		t.Errorf("expected required_unless error, got %v", errs)
+	}
+}
+
+func TestValidator_RequiredWithWithout(t *testing.T) {
+	v := NewValidator()
+
+	type Contact struct {
+		Phone string `validate:"required_without=Email"`
+		Email string `validate:"required_without=Phone"`
+		City  string
+		Zip   string `validate:"required_with=City"`
+	}
+
+	if err := v.Struct(Contact{Phone: "13812345678"}); err != nil {
+		t.Errorf("phone only should pass: %v", err)
+	}
+	errs, _ := v.Struct(Contact{}).(ValidationErrors)
+	if len(errs) != 2 {
+		t.Errorf("expected 2 required_without errors, got %v", errs)
+	}
+	errs, _ = v.Struct(Contact{Email: "a@b.com", City: "Shanghai"}).(ValidationErrors)
+	if len(errs) != 1 || errs[0].Tag != "required_with" {
+		t.Errorf("expected required_with error, got %v", errs)
+	}
+}
+
+func TestValidator_RegisterCrossFieldRule(t *testing.T) {
+	v := NewValidator()
+	v.RegisterCrossFieldRule("prefixfield", func(value any, param string, parent reflect.Value) bool {
+		prefix := parent.FieldByName(param).String()
+		s, _ := value.(string)
+		return len(s) >= len(prefix) && s[:len(prefix)] == prefix
+	})
+
+	type Path struct {
+		Root string
+		File string `validate:"prefixfield=Root"`
+	}
+
+	if err := v.Struct(Path{Root: "/data", File: "/data/a.txt"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := v.Struct(Path{Root: "/data", File: "/tmp/a.txt"}); err == nil {
+		t.Error("expected prefixfield error")
+	}
+
+	// Var 没有父结构体，跨字段规则被跳过
+	if err := v.Var("x", "eqfield=Other"); err != nil {
+		t.Errorf("cross-field rules should be skipped in Var: %v", err)
+	}
+}
+
+func TestValidator_RegisterStructRule(t *testing.T) {
+	v := NewValidator()
+
+	type Order struct {
+		Amount int    `validate:"min=0"`
+		Coupon string `json:"coupon"`
+	}
+	v.RegisterStructRule(&Order{}, func(obj any) []FieldError {
+		o := obj.(Order)
+		if o.Coupon != "" && o.Amount < 100 {
+			return []FieldError{{Field: "coupon", Tag: "coupon", Value: o.Coupon, Message: "订单满 100 才能使用优惠券"}}
+		}
+		return nil
+	})
+
+	if err := v.Struct(&Order{Amount: 200, Coupon: "SAVE"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	errs, _ := v.Struct(Order{Amount: 50, Coupon: "SAVE"}).(ValidationErrors)
+	if len(errs) != 1 || errs[0].Tag != "coupon" {
+		t.Errorf("expected struct-level error, got %v", errs)
+	}
+}
+
+func TestValidator_StructPartial(t *testing.T) {
+	v := NewValidator()
+
+	user := testUserStruct{Email: "bad-email", Age: 200}
+
+	// 只验证 email（显示名）和 Age（Go 字段名）
+	errs, _ := v.StructPartial(user, "email", "Age").(ValidationErrors)
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %v", errs)
+	}
+	for _, e := range errs {
+		if e.Field == "name" {
+			t.Error("name should not be validated in partial mode")
+		}
+	}
+
+	if err := v.StructPartial(user); err != nil {
+		t.Errorf("no fields selected should pass: %v", err)
+	}
+}
+
+func TestValidator_CompareFieldsKinds(t *testing.T) {
+	v := NewValidator()
+
+	type Range struct {
+		From string
+		To   string `validate:"gtfield=From"`
+	}
+
+	// 字符串按字典序比较，而非按长度
+	if err := v.Struct(Range{From: "aaa", To: "b"}); err != nil {
+		t.Errorf("\"b\" should be lexically greater than \"aaa\": %v", err)
+	}
+	if err := v.Struct(Range{From: "b", To: "aaa"}); err == nil {
+		t.Error("\"aaa\" should not be greater than \"b\"")
+	}
+	if err := v.Struct(Range{From: "abc", To: "abc"}); err == nil {
+		t.Error("equal strings should fail gtfield")
+	}
+
+	// 切片等非数字/时间/字符串类型不支持比较，规则不通过
+	type Lists struct {
+		A []int
+		B []int `validate:"gtfield=A"`
+	}
+	if err := v.Struct(Lists{A: []int{1}, B: []int{1, 2}}); err == nil {
+		t.Error("slice comparison should be rejected")
+	}
+
+	// 类型不匹配（字符串与数字）不通过
+	type Mixed struct {
+		N int
+		S string `validate:"ltfield=N"`
+	}
+	if err := v.Struct(Mixed{N: 10, S: "1"}); err == nil {
+		t.Error("string vs number comparison should be rejected")
+	}
+}
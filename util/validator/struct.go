@@ -1,8 +1,10 @@
 package validator
 
 import (
+	"cmp"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -38,6 +40,15 @@ func (e ValidationErrors) Error() string {
 	return sb.String()
 }
 
+// Unwrap 返回各字段错误，支持 errors.As 提取 FieldError 以及 errorx.Walk 遍历
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, fe := range e {
+		errs[i] = fe
+	}
+	return errs
+}
+
 // HasErrors 检查是否有错误
 func (e ValidationErrors) HasErrors() bool {
 	return len(e) > 0
@@ -50,9 +61,11 @@ type RuleFunc func(value any, param string) bool
 
 // Validator 结构体验证器
 type Validator struct {
-	tagName string              // 验证标签名，默认 "validate"
-	rules   map[string]RuleFunc // 注册的验证规则
-	msgs    map[string]string   // 错误消息模板
+	tagName     string                          // 验证标签名，默认 "validate"
+	rules       map[string]RuleFunc             // 注册的验证规则
+	crossRules  map[string]CrossFieldRuleFunc   // 注册的跨字段验证规则
+	structRules map[reflect.Type]StructRuleFunc // 注册的结构体级验证
+	msgs        map[string]string               // 错误消息模板
 }
 
 // NewValidator 创建验证器
@@ -75,6 +88,16 @@ type Validator struct {
 //   - alpha: 纯字母
 //   - alphanum: 字母数字
 //   - numeric: 纯数字
+//   - dive: 递归验证字段中嵌套的结构体（含指针、切片/数组/map 元素）
+//
+// 跨字段与条件规则:
+//   - eqfield=Field / nefield=Field: 与另一字段相等/不等
+//   - gtfield=Field / gtefield=Field / ltfield=Field / ltefield=Field: 与另一字段比较
+//     （数字按数值、time.Time 按时间、字符串按字典序；其他类型一律不通过）
+//   - required_if=Field value: 另一字段等于 value 时必填
+//   - required_unless=Field value: 另一字段不等于 value 时必填
+//   - required_with=Field: 另一字段非空时必填
+//   - required_without=Field: 另一字段为空时必填
 //
 // 示例:
 //
@@ -87,11 +110,14 @@ type Validator struct {
 //	err := v.Struct(&user)
 func NewValidator() *Validator {
 	v := &Validator{
-		tagName: "validate",
-		rules:   make(map[string]RuleFunc),
-		msgs:    make(map[string]string),
+		tagName:     "validate",
+		rules:       make(map[string]RuleFunc),
+		crossRules:  make(map[string]CrossFieldRuleFunc),
+		structRules: make(map[reflect.Type]StructRuleFunc),
+		msgs:        make(map[string]string),
 	}
 	v.registerDefaultRules()
+	v.registerDefaultCrossFieldRules()
 	v.registerDefaultMessages()
 	return v
 }
@@ -275,6 +301,16 @@ func (v *Validator) registerDefaultMessages() {
 	v.msgs["password"] = "%s 必须包含大小写字母和数字，至少8位"
 	v.msgs["username"] = "%s 只能包含字母、数字和下划线，4-20位"
 	v.msgs["idcard"] = "%s 必须是有效的身份证号"
+	v.msgs["eqfield"] = "%s 必须等于 %s"
+	v.msgs["nefield"] = "%s 不能等于 %s"
+	v.msgs["gtfield"] = "%s 必须大于 %s"
+	v.msgs["gtefield"] = "%s 必须大于或等于 %s"
+	v.msgs["ltfield"] = "%s 必须小于 %s"
+	v.msgs["ltefield"] = "%s 必须小于或等于 %s"
+	v.msgs["required_if"] = "%s 在 %s 时是必填字段"
+	v.msgs["required_unless"] = "%s 除非 %s，否则是必填字段"
+	v.msgs["required_with"] = "%s 在 %s 存在时是必填字段"
+	v.msgs["required_without"] = "%s 在 %s 不存在时是必填字段"
 }
 
 // RegisterRule 注册自定义验证规则
@@ -326,11 +362,15 @@ func (v *Validator) SetTagName(tagName string) *Validator {
 
 // Struct 验证结构体
 //
+// 嵌套的结构体默认不验证；字段标签包含 dive 时递归验证其中的结构体、结构体指针以及
+// 切片/数组/map 中的结构体元素，错误字段名带有路径（如 "address.city"、"items[1].sku"），
+// map 元素按 key 排序验证。
+//
 // 参数:
 //   - obj: 结构体或结构体指针
 //
 // 返回:
-//   - error: 验证错误，无错误返回 nil
+//   - error: 验证错误（ValidationErrors），无错误返回 nil
 //
 // 示例:
 //
@@ -346,6 +386,35 @@ func (v *Validator) SetTagName(tagName string) *Validator {
 //	    }
 //	}
 func (v *Validator) Struct(obj any) error {
+	return v.validateStruct(obj, nil)
+}
+
+// StructPartial 仅验证指定字段（适用于 PATCH 等部分更新场景）
+//
+// 参数:
+//   - obj: 结构体或结构体指针
+//   - fields: 要验证的字段，可使用 Go 字段名或显示名（json/label 标签）
+//
+// 返回:
+//   - error: 验证错误，无错误返回 nil
+//
+// 注意: 部分验证不会执行结构体级验证（RegisterStructRule），
+// 跨字段规则仍可引用未列出的字段
+//
+// 示例:
+//
+//	// 只校验请求中实际出现的字段
+//	err := v.StructPartial(&req, "email", "Age")
+func (v *Validator) StructPartial(obj any, fields ...string) error {
+	only := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		only[f] = true
+	}
+	return v.validateStruct(obj, only)
+}
+
+// validateStruct 验证结构体，only 非 nil 时仅验证其中的字段
+func (v *Validator) validateStruct(obj any, only map[string]bool) error {
 	rv := reflect.ValueOf(obj)
 	if rv.Kind() == reflect.Ptr {
 		rv = rv.Elem()
@@ -355,6 +424,17 @@ func (v *Validator) Struct(obj any) error {
 	}
 
 	var errors ValidationErrors
+	v.walkStruct(rv, "", only, make(map[uintptr]bool), &errors)
+
+	if len(errors) > 0 {
+		return errors
+	}
+	return nil
+}
+
+// walkStruct 验证结构体字段，并递归验证标记了 dive 的字段中嵌套的结构体
+// prefix 为嵌套路径前缀（如 "address."），only 仅作用于顶层字段
+func (v *Validator) walkStruct(rv reflect.Value, prefix string, only map[string]bool, visited map[uintptr]bool, errors *ValidationErrors) {
 	rt := rv.Type()
 
 	for i := range rv.NumField() {
@@ -368,38 +448,118 @@ func (v *Validator) Struct(obj any) error {
 			continue
 		}
 
-		fieldValue := rv.Field(i).Interface()
 		fieldName := getFieldName(field)
+		if only != nil && !only[field.Name] && !only[fieldName] {
+			continue
+		}
+		fieldName = prefix + fieldName
 
-		fieldErrors := v.validateField(fieldName, fieldValue, tag)
-		errors = append(errors, fieldErrors...)
+		*errors = append(*errors, v.validateField(fieldName, rv.Field(i).Interface(), tag, rv)...)
+		if slices.Contains(parseTag(tag), "dive") {
+			v.walkNested(rv.Field(i), fieldName, visited, errors)
+		}
 	}
 
-	if len(errors) > 0 {
-		return errors
+	// 结构体级验证（部分验证时跳过）
+	if fn, ok := v.structRules[rt]; ok && only == nil {
+		for _, e := range fn(rv.Interface()) {
+			e.Field = prefix + e.Field
+			*errors = append(*errors, e)
+		}
 	}
-	return nil
+}
+
+// walkNested 递归验证字段中嵌套的结构体，包括结构体指针以及切片、数组、map 中的结构体元素
+//
+// 嵌套错误的字段名带有路径，如 "address.city"、"items[0].name"
+func (v *Validator) walkNested(fv reflect.Value, name string, visited map[uintptr]bool, errors *ValidationErrors) {
+	for fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Interface {
+		if fv.IsNil() {
+			return
+		}
+		if fv.Kind() == reflect.Ptr {
+			// 防止循环引用导致无限递归
+			if visited[fv.Pointer()] {
+				return
+			}
+			visited[fv.Pointer()] = true
+		}
+		fv = fv.Elem()
+	}
+
+	switch fv.Kind() {
+	case reflect.Struct:
+		v.walkStruct(fv, name+".", nil, visited, errors)
+	case reflect.Slice, reflect.Array:
+		if !mayContainStruct(fv.Type().Elem()) {
+			return
+		}
+		for i := range fv.Len() {
+			v.walkNested(fv.Index(i), fmt.Sprintf("%s[%d]", name, i), visited, errors)
+		}
+	case reflect.Map:
+		if !mayContainStruct(fv.Type().Elem()) {
+			return
+		}
+		keys := fv.MapKeys()
+		slices.SortFunc(keys, compareMapKeys)
+		for _, k := range keys {
+			v.walkNested(fv.MapIndex(k), fmt.Sprintf("%s[%v]", name, k), visited, errors)
+		}
+	}
+}
+
+// compareMapKeys map key 排序，使 map 元素的错误顺序稳定
+func compareMapKeys(a, b reflect.Value) int {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(a.Float(), b.Float())
+	case reflect.String:
+		return cmp.Compare(a.String(), b.String())
+	default:
+		return cmp.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+	}
+}
+
+// mayContainStruct 检查元素类型是否可能包含需要验证的结构体
+func mayContainStruct(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct || t.Kind() == reflect.Interface
 }
 
 // validateField 验证单个字段
-func (v *Validator) validateField(fieldName string, value any, tag string) []FieldError {
+// parent 为字段所在结构体，用于跨字段规则；无父结构体时传零值，跨字段规则将被跳过
+func (v *Validator) validateField(fieldName string, value any, tag string, parent reflect.Value) []FieldError {
 	var errors []FieldError
 	rules := parseTag(tag)
 
 	for _, rule := range rules {
 		ruleName, param := parseRule(rule)
+		if ruleName == "dive" {
+			continue
+		}
 
-		// 跳过非必填且为空的字段
-		if ruleName != "required" && isEmpty(value) {
+		// 跳过非必填且为空的字段（required 及 required_* 条件规则除外）
+		if !strings.HasPrefix(ruleName, "required") && isEmpty(value) {
 			continue
 		}
 
-		fn, ok := v.rules[ruleName]
-		if !ok {
+		var passed bool
+		if fn, ok := v.rules[ruleName]; ok {
+			passed = fn(value, param)
+		} else if fn, ok := v.crossRules[ruleName]; ok && parent.IsValid() {
+			passed = fn(value, param, parent)
+		} else {
 			continue
 		}
 
-		if !fn(value, param) {
+		if !passed {
 			msg := v.formatMessage(ruleName, fieldName, param)
 			errors = append(errors, FieldError{
 				Field:   fieldName,
@@ -619,7 +779,7 @@ func checkRange(value any, min, max int) bool {
 //
 //	err := v.Var("test@example.com", "required,email")
 func (v *Validator) Var(value any, tag string) error {
-	errors := v.validateField("value", value, tag)
+	errors := v.validateField("value", value, tag, reflect.Value{})
 	if len(errors) > 0 {
 		return ValidationErrors(errors)
 	}
@@ -1,8 +1,11 @@
 package validator
 
 import (
+	"errors"
 	"strings"
 	"testing"
+
+	"github.com/hexagon-codes/toolkit/lang/errorx"
 )
 
 type testUserStruct struct {
@@ -544,3 +547,156 @@ func TestValidator_FieldNameFromLabel(t *testing.T) {
 		t.Errorf("expected field name '用户名', got '%s'", errors[0].Field)
 	}
 }
+
+func TestValidator_NestedStruct(t *testing.T) {
+	v := NewValidator()
+
+	type Address struct {
+		City string `validate:"required" json:"city"`
+		Zip  string `validate:"len=6" json:"zip"`
+	}
+	type Item struct {
+		SKU string `validate:"required" json:"sku"`
+		Qty int    `validate:"min=1" json:"qty"`
+	}
+	type Order struct {
+		ID       string             `validate:"required" json:"id"`
+		Address  Address            `validate:"dive" json:"address"`
+		Billing  *Address           `validate:"dive" json:"billing"`
+		Items    []Item             `validate:"required,dive" json:"items"`
+		Extra    map[string]*Item   `validate:"dive" json:"extra"`
+		Skipped  Address            `validate:"-"`
+		Untagged Address            // 未标记 dive 的嵌套结构体不验证
+		Tags     []string           `validate:"required,dive"`
+		Optional *Address           `validate:"dive" json:"optional"`
+		Lookup   map[string]Address `validate:"dive" json:"-"`
+	}
+
+	order := Order{
+		ID:       "o1",
+		Address:  Address{City: "", Zip: "123"},
+		Billing:  &Address{City: "Paris", Zip: "750001"},
+		Items:    []Item{{SKU: "a", Qty: 1}, {SKU: "", Qty: 0}},
+		Extra:    map[string]*Item{"gift": {SKU: "g", Qty: 0}},
+		Skipped:  Address{},
+		Untagged: Address{Zip: "1"},
+		Tags:     []string{"x"},
+	}
+
+	err := v.Struct(order)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	got := map[string]bool{}
+	for _, e := range err.(ValidationErrors) {
+		got[e.Field+":"+e.Tag] = true
+	}
+	want := []string{
+		"address.city:required",
+		"address.zip:len",
+		"items[1].sku:required",
+		"items[1].qty:min",
+		"extra[gift].qty:min",
+	}
+	for _, w := range want {
+		if !got[w] {
+			t.Errorf("missing error %s, got %v", w, got)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("expected %d errors, got %v", len(want), got)
+	}
+}
+
+func TestValidator_NestedCycle(t *testing.T) {
+	v := NewValidator()
+
+	type Node struct {
+		Name string `validate:"required"`
+		Next *Node  `validate:"dive"`
+	}
+	n := &Node{Name: "a"}
+	n.Next = &Node{Next: n}
+
+	err := v.Struct(n)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if errs := err.(ValidationErrors); len(errs) != 1 || errs[0].Field != "Next.Name" {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+}
+
+func TestValidationErrors_Unwrap(t *testing.T) {
+	v := NewValidator()
+
+	type Data struct {
+		Name string `validate:"required" json:"name"`
+		Age  int    `validate:"min=18" json:"age"`
+	}
+	err := v.Struct(Data{Age: 1})
+
+	var fe FieldError
+	if !errors.As(err, &fe) || fe.Field != "name" {
+		t.Errorf("expected errors.As to find first FieldError, got %+v", fe)
+	}
+
+	var fields []string
+	errorx.Walk(err, func(e error) bool {
+		if fe, ok := e.(FieldError); ok {
+			fields = append(fields, fe.Field)
+		}
+		return true
+	})
+	if strings.Join(fields, ",") != "name,age" {
+		t.Errorf("expected errorx.Walk to visit field errors, got %v", fields)
+	}
+}
+
+func TestValidator_NestedDefault(t *testing.T) {
+	v := NewValidator()
+
+	type Inner struct {
+		Name string `validate:"required"`
+	}
+	type Outer struct {
+		Inner Inner
+		Ptr   *Inner
+		List  []Inner `validate:"min=1"`
+	}
+
+	// 未标记 dive 时保持原行为：只验证本字段的规则，不递归
+	err := v.Struct(Outer{Ptr: &Inner{}, List: []Inner{{}}})
+	if err != nil {
+		t.Errorf("nested structs without dive should not be validated, got %v", err)
+	}
+}
+
+func TestValidator_DiveMapOrder(t *testing.T) {
+	v := NewValidator()
+
+	type Item struct {
+		SKU string `validate:"required" json:"sku"`
+	}
+	type Cart struct {
+		ByName map[string]Item `validate:"dive" json:"by_name"`
+		ByID   map[int]Item    `validate:"dive" json:"by_id"`
+	}
+	cart := Cart{
+		ByName: map[string]Item{"c": {}, "a": {}, "b": {}, "d": {SKU: "x"}},
+		ByID:   map[int]Item{10: {}, 2: {}, 1: {}},
+	}
+
+	for range 5 {
+		err := v.Struct(cart)
+		var fields []string
+		for _, e := range err.(ValidationErrors) {
+			fields = append(fields, e.Field)
+		}
+		want := "by_name[a].sku,by_name[b].sku,by_name[c].sku,by_id[1].sku,by_id[2].sku,by_id[10].sku"
+		if got := strings.Join(fields, ","); got != want {
+			t.Fatalf("expected %s, got %s", want, got)
+		}
+	}
+}